@@ -78,6 +78,52 @@ type AnalyticsReportGetSegment struct {
 	URLExpirationDate string `json:"urlExpirationDate,omitempty"`
 }
 
+// AnalyticsFetchResult represents CLI output for analytics fetch. The same
+// document is written to the output directory as its manifest.
+type AnalyticsFetchResult struct {
+	AppID          string                  `json:"appId"`
+	RequestID      string                  `json:"requestId"`
+	RequestCreated bool                    `json:"requestCreated,omitempty"`
+	Category       string                  `json:"category,omitempty"`
+	From           string                  `json:"from"`
+	To             string                  `json:"to"`
+	Dir            string                  `json:"dir"`
+	Manifest       string                  `json:"manifest"`
+	GeneratedAt    string                  `json:"generatedAt"`
+	Files          []AnalyticsFetchFile    `json:"files"`
+	Failures       []AnalyticsFetchFailure `json:"failures,omitempty"`
+}
+
+// AnalyticsFetchFailure records a report that could not be fetched. Nothing
+// is written for it, so re-running the command retries it.
+type AnalyticsFetchFailure struct {
+	ReportID    string `json:"reportId"`
+	Name        string `json:"name"`
+	Granularity string `json:"granularity"`
+	File        string `json:"file"`
+	Error       string `json:"error"`
+}
+
+// AnalyticsFetchFile describes one merged CSV: every segment of a report's
+// instances at one granularity within the fetched date range.
+type AnalyticsFetchFile struct {
+	ReportID    string                   `json:"reportId"`
+	Name        string                   `json:"name"`
+	Category    string                   `json:"category,omitempty"`
+	Granularity string                   `json:"granularity"`
+	File        string                   `json:"file"`
+	Rows        int                      `json:"rows"`
+	SHA256      string                   `json:"sha256"`
+	Instances   []AnalyticsFetchInstance `json:"instances"`
+}
+
+// AnalyticsFetchInstance lists the segments merged from one report instance.
+type AnalyticsFetchInstance struct {
+	ID         string   `json:"id"`
+	ReportDate string   `json:"reportDate"`
+	Segments   []string `json:"segments"`
+}
+
 func printSalesReportResultTable(result *SalesReportResult) error {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 	fmt.Fprintln(w, "Vendor\tType\tSubtype\tFrequency\tDate\tVersion\tCompressed File\tCompressed Size\tDecompressed File\tDecompressed Size")
@@ -257,3 +303,70 @@ func countSegments(instances []AnalyticsReportGetInstance) int {
 	}
 	return total
 }
+
+func printAnalyticsFetchResultTable(result *AnalyticsFetchResult) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Name\tGranularity\tInstances\tSegments\tRows\tFile")
+	for _, file := range result.Files {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\t%s\n",
+			file.Name,
+			file.Granularity,
+			len(file.Instances),
+			countFetchSegments(file.Instances),
+			file.Rows,
+			file.File,
+		)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if len(result.Failures) == 0 {
+		return nil
+	}
+	fmt.Fprintln(os.Stdout)
+	w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Failed Report\tGranularity\tError")
+	for _, failure := range result.Failures {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", failure.Name, failure.Granularity, compactWhitespace(failure.Error))
+	}
+	return w.Flush()
+}
+
+func printAnalyticsFetchResultMarkdown(result *AnalyticsFetchResult) error {
+	fmt.Fprintln(os.Stdout, "| Name | Granularity | Instances | Segments | Rows | File |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- | --- |")
+	for _, file := range result.Files {
+		fmt.Fprintf(os.Stdout, "| %s | %s | %d | %d | %d | %s |\n",
+			escapeMarkdown(file.Name),
+			escapeMarkdown(file.Granularity),
+			len(file.Instances),
+			countFetchSegments(file.Instances),
+			file.Rows,
+			escapeMarkdown(file.File),
+		)
+	}
+
+	if len(result.Failures) == 0 {
+		return nil
+	}
+	fmt.Fprintln(os.Stdout)
+	fmt.Fprintln(os.Stdout, "| Failed Report | Granularity | Error |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- |")
+	for _, failure := range result.Failures {
+		fmt.Fprintf(os.Stdout, "| %s | %s | %s |\n",
+			escapeMarkdown(failure.Name),
+			escapeMarkdown(failure.Granularity),
+			escapeMarkdown(failure.Error),
+		)
+	}
+	return nil
+}
+
+func countFetchSegments(instances []AnalyticsFetchInstance) int {
+	total := 0
+	for _, instance := range instances {
+		total += len(instance.Segments)
+	}
+	return total
+}
@@ -0,0 +1,85 @@
+package asc
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// AnalyticsReportTable is the header and rows of an Analytics Reports API
+// segment. Column sets differ per report, so cells are kept as strings.
+type AnalyticsReportTable struct {
+	Columns []string   `json:"columns"`
+	Rows    [][]string `json:"rows"`
+}
+
+// Value returns the cell of row for the named column, matched
+// case-insensitively, or "" when the report has no such column.
+func (t *AnalyticsReportTable) Value(row []string, column string) string {
+	want := normalizeReportColumn(column)
+	for i, name := range t.Columns {
+		if normalizeReportColumn(name) == want && i < len(row) {
+			return strings.TrimSpace(row[i])
+		}
+	}
+	return ""
+}
+
+// ParseAnalyticsReport parses a downloaded analytics report segment, in plain
+// or gzip-compressed form. Segments are tab-separated; comma-separated input is
+// accepted when the header contains no tabs.
+func ParseAnalyticsReport(r io.Reader) (*AnalyticsReportTable, error) {
+	stream, closeStream, err := openReportStream(r)
+	if err != nil {
+		return nil, err
+	}
+	defer closeStream()
+
+	buffered := bufio.NewReader(stream)
+	peek, err := buffered.Peek(4096)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, bufio.ErrBufferFull) {
+		return nil, fmt.Errorf("read report: %w", err)
+	}
+	firstLine := peek
+	if index := bytes.IndexByte(peek, '\n'); index >= 0 {
+		firstLine = peek[:index]
+	}
+
+	reader := csv.NewReader(buffered)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	if bytes.IndexByte(firstLine, '\t') >= 0 || bytes.IndexByte(firstLine, ',') < 0 {
+		reader.Comma = '\t'
+	}
+
+	table := &AnalyticsReportTable{}
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read report: %w", err)
+		}
+		if table.Columns == nil {
+			record[0] = strings.TrimPrefix(record[0], "\ufeff")
+			for i := range record {
+				record[i] = strings.TrimSpace(record[i])
+			}
+			table.Columns = record
+			continue
+		}
+		if isBlankReportRow(record) {
+			continue
+		}
+		table.Rows = append(table.Rows, record)
+	}
+	if table.Columns == nil {
+		return nil, fmt.Errorf("analytics report header not found")
+	}
+	return table, nil
+}
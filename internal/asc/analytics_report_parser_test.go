@@ -0,0 +1,54 @@
+package asc
+
+import (
+	"bytes"
+	"compress/gzip"
+	"strings"
+	"testing"
+)
+
+func TestParseAnalyticsReport_TabSeparatedGzip(t *testing.T) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	_, _ = gz.Write([]byte("\ufeffDate\tApp Name\tApp Apple Identifier\tSessions\n" +
+		"2025-01-01\tExample, Inc. App\t123\t42\n" +
+		"\n" +
+		"2025-01-02\tExample, Inc. App\t123\t40\n"))
+	_ = gz.Close()
+
+	table, err := ParseAnalyticsReport(&buf)
+	if err != nil {
+		t.Fatalf("ParseAnalyticsReport() error: %v", err)
+	}
+	if strings.Join(table.Columns, "|") != "Date|App Name|App Apple Identifier|Sessions" {
+		t.Fatalf("unexpected columns: %q", table.Columns)
+	}
+	if len(table.Rows) != 2 {
+		t.Fatalf("expected 2 rows, got %d", len(table.Rows))
+	}
+	if got := table.Value(table.Rows[1], "app apple identifier"); got != "123" {
+		t.Fatalf("expected app id 123, got %q", got)
+	}
+	if got := table.Value(table.Rows[0], "App Name"); got != "Example, Inc. App" {
+		t.Fatalf("expected app name with comma preserved, got %q", got)
+	}
+}
+
+func TestParseAnalyticsReport_CommaSeparated(t *testing.T) {
+	table, err := ParseAnalyticsReport(strings.NewReader("Date,Territory,Counts\n2025-01-01,US,5\n"))
+	if err != nil {
+		t.Fatalf("ParseAnalyticsReport() error: %v", err)
+	}
+	if len(table.Columns) != 3 || table.Value(table.Rows[0], "Territory") != "US" {
+		t.Fatalf("unexpected table: %+v", table)
+	}
+	if table.Value(table.Rows[0], "Missing") != "" {
+		t.Fatal("expected empty value for missing column")
+	}
+}
+
+func TestParseAnalyticsReport_Empty(t *testing.T) {
+	if _, err := ParseAnalyticsReport(strings.NewReader("")); err == nil {
+		t.Fatal("expected error for empty report")
+	}
+}
@@ -37,7 +37,10 @@ func UploadAssetFromFile(ctx context.Context, file *os.File, fileSize int64, ope
 		return fmt.Errorf("no upload operations provided")
 	}
 
-	client := &http.Client{Timeout: ResolveTimeout()}
+	client := &http.Client{
+		Timeout:   ResolveTimeout(),
+		Transport: withCassetteTransport(nil),
+	}
 
 	for i, op := range operations {
 		method := strings.ToUpper(strings.TrimSpace(op.Method))
@@ -0,0 +1,108 @@
+package asc
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	// BaseURLEnvVar overrides the App Store Connect API base URL.
+	BaseURLEnvVar = "ASC_BASE_URL"
+	// AllowInsecureBaseURLEnvVar permits base URLs that are not HTTPS Apple hosts.
+	AllowInsecureBaseURLEnvVar = "ASC_ALLOW_INSECURE_BASE_URL"
+)
+
+// ResolveBaseURL returns the API base URL, optionally overridden by
+// ASC_BASE_URL or the base_url config key. Overrides that are not HTTPS
+// Apple hosts are rejected unless ASC_ALLOW_INSECURE_BASE_URL (or the
+// allow_insecure_base_url config key) is enabled.
+func ResolveBaseURL() (string, error) {
+	cfg := loadConfig()
+
+	override, ok := envValue(BaseURLEnvVar)
+	if !ok && cfg != nil {
+		override = strings.TrimSpace(cfg.BaseURL)
+	}
+	if override == "" {
+		return BaseURL, nil
+	}
+
+	parsed, err := url.Parse(override)
+	if err != nil {
+		return "", fmt.Errorf("invalid base URL %q: %w", override, err)
+	}
+	if parsed.Scheme != "https" && parsed.Scheme != "http" {
+		return "", fmt.Errorf("invalid base URL %q: scheme must be http or https", override)
+	}
+	if parsed.Host == "" {
+		return "", fmt.Errorf("invalid base URL %q: missing host", override)
+	}
+	if parsed.RawQuery != "" || parsed.Fragment != "" || parsed.User != nil {
+		return "", fmt.Errorf("invalid base URL %q: must not include credentials, query, or fragment", override)
+	}
+
+	if !isTrustedBaseURL(parsed) && !allowInsecureBaseURL() {
+		return "", fmt.Errorf("base URL %q is not an HTTPS Apple host; set %s=1 to allow it", override, AllowInsecureBaseURLEnvVar)
+	}
+
+	parsed.Path = strings.TrimSuffix(parsed.Path, "/")
+	return parsed.String(), nil
+}
+
+func isTrustedBaseURL(parsed *url.URL) bool {
+	if parsed.Scheme != "https" {
+		return false
+	}
+	host := strings.ToLower(parsed.Hostname())
+	return host == "apple.com" || strings.HasSuffix(host, ".apple.com")
+}
+
+func allowInsecureBaseURL() bool {
+	if value, ok := envValue(AllowInsecureBaseURLEnvVar); ok {
+		return isTruthy(value)
+	}
+	cfg := loadConfig()
+	if cfg == nil {
+		return false
+	}
+	return isTruthy(cfg.AllowInsecureBaseURL)
+}
+
+func isTruthy(value string) bool {
+	parsed, err := strconv.ParseBool(strings.TrimSpace(value))
+	return err == nil && parsed
+}
+
+// IsAPIURL reports whether rawURL points at the default or configured API host.
+func IsAPIURL(rawURL string) bool {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+	return matchesAPIHost(parsed)
+}
+
+func matchesAPIHost(parsed *url.URL) bool {
+	if parsed.Scheme == "https" && parsed.Host == defaultAPIHost() {
+		return true
+	}
+	resolved, err := ResolveBaseURL()
+	if err != nil || resolved == BaseURL {
+		return false
+	}
+	base, err := url.Parse(resolved)
+	if err != nil {
+		return false
+	}
+	return parsed.Scheme == base.Scheme && parsed.Host == base.Host
+}
+
+func defaultAPIHost() string {
+	parsed, err := url.Parse(BaseURL)
+	if err != nil {
+		return ""
+	}
+	return parsed.Host
+}
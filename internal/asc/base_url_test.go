@@ -0,0 +1,96 @@
+package asc
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"testing"
+)
+
+func isolateBaseURLEnv(t *testing.T) {
+	t.Helper()
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "config.json"))
+	t.Setenv(BaseURLEnvVar, "")
+	t.Setenv(AllowInsecureBaseURLEnvVar, "")
+}
+
+func TestResolveBaseURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		baseURL  string
+		insecure string
+		want     string
+		wantErr  bool
+	}{
+		{name: "default", want: BaseURL},
+		{name: "apple host", baseURL: "https://api.appstoreconnect.apple.com/", want: "https://api.appstoreconnect.apple.com"},
+		{name: "apple subdomain", baseURL: "https://staging.appstoreconnect.apple.com", want: "https://staging.appstoreconnect.apple.com"},
+		{name: "non-apple host rejected", baseURL: "https://example.com", wantErr: true},
+		{name: "http rejected", baseURL: "http://api.appstoreconnect.apple.com", wantErr: true},
+		{name: "lookalike host rejected", baseURL: "https://evilapple.com", wantErr: true},
+		{name: "insecure allowed", baseURL: "http://127.0.0.1:8787", insecure: "1", want: "http://127.0.0.1:8787"},
+		{name: "insecure false", baseURL: "http://127.0.0.1:8787", insecure: "false", wantErr: true},
+		{name: "query rejected", baseURL: "http://127.0.0.1:8787?x=1", insecure: "1", wantErr: true},
+		{name: "invalid scheme", baseURL: "ftp://127.0.0.1", insecure: "1", wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			isolateBaseURLEnv(t)
+			t.Setenv(BaseURLEnvVar, test.baseURL)
+			t.Setenv(AllowInsecureBaseURLEnvVar, test.insecure)
+
+			got, err := ResolveBaseURL()
+			if test.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %q", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ResolveBaseURL() error: %v", err)
+			}
+			if got != test.want {
+				t.Fatalf("expected %q, got %q", test.want, got)
+			}
+		})
+	}
+}
+
+func TestValidateNextURL_AllowsConfiguredBaseURL(t *testing.T) {
+	isolateBaseURLEnv(t)
+
+	if err := validateNextURL("http://127.0.0.1:8787/v1/apps?cursor=2"); err == nil {
+		t.Fatal("expected local URL to be rejected without an override")
+	}
+
+	t.Setenv(BaseURLEnvVar, "http://127.0.0.1:8787")
+	t.Setenv(AllowInsecureBaseURLEnvVar, "1")
+
+	if err := validateNextURL("http://127.0.0.1:8787/v1/apps?cursor=2"); err != nil {
+		t.Fatalf("expected configured host to be allowed, got %v", err)
+	}
+	if err := validateNextURL("http://127.0.0.1:9999/v1/apps"); err == nil {
+		t.Fatal("expected a different port to be rejected")
+	}
+	if err := validateNextURL("https://api.appstoreconnect.apple.com/v1/apps?cursor=2"); err != nil {
+		t.Fatalf("expected default host to stay allowed, got %v", err)
+	}
+}
+
+func TestClientUsesBaseURL(t *testing.T) {
+	response := jsonResponse(http.StatusOK, `{"data":[]}`)
+	client := newTestClient(t, func(req *http.Request) {
+		if req.URL.Host != "127.0.0.1:8787" || req.URL.Scheme != "http" {
+			t.Fatalf("expected request to mock host, got %s", req.URL.String())
+		}
+		if req.URL.Path != "/v1/apps" {
+			t.Fatalf("expected path /v1/apps, got %s", req.URL.Path)
+		}
+	}, response)
+	client.baseURL = "http://127.0.0.1:8787"
+
+	if _, err := client.GetApps(context.Background()); err != nil {
+		t.Fatalf("GetApps() error: %v", err)
+	}
+}
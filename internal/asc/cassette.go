@@ -0,0 +1,502 @@
+package asc
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+const (
+	// RecordEnvVar names the directory that request/response cassettes are written to.
+	RecordEnvVar = "ASC_RECORD"
+	// ReplayEnvVar names the directory that request/response cassettes are served from.
+	ReplayEnvVar = "ASC_REPLAY"
+
+	cassetteRedacted       = "REDACTED"
+	cassetteMaxRequestBody = 64 * 1024
+)
+
+var (
+	cassetteJWTPattern = regexp.MustCompile(`eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+`)
+
+	// cassetteURLPattern also matches URLs inside JSON strings, where "/" may be
+	// written as \/ and "&" or "=" as \u0026 or \u003d.
+	cassetteURLPattern     = regexp.MustCompile(`https?:(?:\\?/){2}(?:[^\s"'<>\\]|\\/|\\u[0-9A-Fa-f]{4})+`)
+	cassetteJSONURLEscapes = regexp.MustCompile(`\\/|\\u[0-9A-Fa-f]{4}`)
+
+	// cassetteSensitiveQueryParams are redacted from recorded URLs. Values that
+	// change on every run (dates, expiry) are included so replays match.
+	cassetteSensitiveQueryParams = map[string]bool{
+		"x-amz-signature":      true,
+		"x-amz-credential":     true,
+		"x-amz-security-token": true,
+		"x-amz-date":           true,
+		"x-goog-signature":     true,
+		"x-goog-credential":    true,
+		"x-goog-date":          true,
+		"signature":            true,
+		"key-pair-id":          true,
+		"policy":               true,
+		"expires":              true,
+		"token":                true,
+		"access_token":         true,
+		"sig":                  true,
+	}
+
+	// cassetteRequestHeaders are the only request headers written to cassettes.
+	cassetteRequestHeaders = []string{"Accept", "Content-Type"}
+
+	cassetteMu        sync.Mutex
+	cassetteRecorders = map[string]*cassetteRecorder{}
+	cassetteReplayers = map[string]*replayTransport{}
+)
+
+type cassette struct {
+	Request  cassetteRequest  `json:"request"`
+	Response cassetteResponse `json:"response"`
+}
+
+type cassetteRequest struct {
+	Method     string      `json:"method"`
+	URL        string      `json:"url"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+	BodySHA256 string      `json:"bodySha256,omitempty"`
+}
+
+type cassetteResponse struct {
+	Status     int         `json:"status"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+	BodyBase64 string      `json:"bodyBase64,omitempty"`
+}
+
+// ReplayEnabled reports whether ASC_REPLAY is set.
+func ReplayEnabled() bool {
+	value, _ := envValue(ReplayEnvVar)
+	return value != ""
+}
+
+// ResolveCassetteTransport wraps base with a recording or replaying transport
+// when ASC_RECORD or ASC_REPLAY is set, and returns base unchanged otherwise.
+func ResolveCassetteTransport(base http.RoundTripper) (http.RoundTripper, error) {
+	recordDir, _ := envValue(RecordEnvVar)
+	replayDir, _ := envValue(ReplayEnvVar)
+	switch {
+	case recordDir != "" && replayDir != "":
+		return nil, fmt.Errorf("%s and %s cannot be set together", RecordEnvVar, ReplayEnvVar)
+	case recordDir != "":
+		return NewRecordingTransport(recordDir, base)
+	case replayDir != "":
+		return NewReplayTransport(replayDir)
+	default:
+		return base, nil
+	}
+}
+
+// withCassetteTransport is ResolveCassetteTransport for callers that cannot
+// return an error; a misconfiguration fails every request instead.
+func withCassetteTransport(base http.RoundTripper) http.RoundTripper {
+	transport, err := ResolveCassetteTransport(base)
+	if err != nil {
+		return failingTransport{err: err}
+	}
+	return transport
+}
+
+type failingTransport struct {
+	err error
+}
+
+func (t failingTransport) RoundTrip(*http.Request) (*http.Response, error) {
+	return nil, t.err
+}
+
+// NewReplayClient creates a client for ASC_REPLAY mode. Requests are signed
+// with a throwaway key, so no App Store Connect credentials are required.
+func NewReplayClient() (*Client, error) {
+	transport, err := ResolveCassetteTransport(nil)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := transport.(*replayTransport); !ok {
+		return nil, fmt.Errorf("%s is not set", ReplayEnvVar)
+	}
+
+	baseURL, err := ResolveBaseURL()
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate replay key: %w", err)
+	}
+
+	return &Client{
+		httpClient: &http.Client{
+			Timeout:   ResolveTimeout(),
+			Transport: transport,
+		},
+		baseURL:    baseURL,
+		keyID:      "REPLAY",
+		issuerID:   "REPLAY",
+		privateKey: key,
+	}, nil
+}
+
+type cassetteRecorder struct {
+	dir string
+	mu  sync.Mutex
+	seq int
+}
+
+type recordingTransport struct {
+	recorder *cassetteRecorder
+	base     http.RoundTripper
+}
+
+// NewRecordingTransport returns a transport that forwards requests to base and
+// writes a sanitized cassette for every exchange into dir.
+func NewRecordingTransport(dir string, base http.RoundTripper) (http.RoundTripper, error) {
+	dir = filepath.Clean(strings.TrimSpace(dir))
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	cassetteMu.Lock()
+	defer cassetteMu.Unlock()
+
+	recorder, ok := cassetteRecorders[dir]
+	if !ok {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, fmt.Errorf("%s: %w", RecordEnvVar, err)
+		}
+		existing, err := cassetteFiles(dir)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", RecordEnvVar, err)
+		}
+		recorder = &cassetteRecorder{dir: dir, seq: len(existing)}
+		cassetteRecorders[dir] = recorder
+	}
+	return &recordingTransport{recorder: recorder, base: base}, nil
+}
+
+func (t *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var requestBody []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		data, err := io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("record: read request body: %w", err)
+		}
+		requestBody = data
+		req.Body = io.NopCloser(bytes.NewReader(data))
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("record: read response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	entry := cassette{
+		Request:  newCassetteRequest(req, requestBody),
+		Response: newCassetteResponse(resp, responseBody),
+	}
+	if err := t.recorder.write(entry); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (r *cassetteRecorder) write(entry cassette) error {
+	data, err := json.MarshalIndent(entry, "", "  ")
+	if err != nil {
+		return fmt.Errorf("record: encode cassette: %w", err)
+	}
+	data = append(data, '\n')
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.seq++
+	name := fmt.Sprintf("%04d-%s-%s.json", r.seq, strings.ToLower(entry.Request.Method), cassetteSlug(entry.Request.URL))
+	file, err := os.OpenFile(filepath.Join(r.dir, name), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return fmt.Errorf("record: %w", err)
+	}
+	if _, err := file.Write(data); err != nil {
+		_ = file.Close()
+		return fmt.Errorf("record: %w", err)
+	}
+	return file.Close()
+}
+
+func newCassetteRequest(req *http.Request, body []byte) cassetteRequest {
+	recorded := cassetteRequest{
+		Method: strings.ToUpper(req.Method),
+		URL:    sanitizeCassetteURL(req.URL.String()),
+	}
+	for _, name := range cassetteRequestHeaders {
+		if value := req.Header.Get(name); value != "" {
+			if recorded.Headers == nil {
+				recorded.Headers = http.Header{}
+			}
+			recorded.Headers.Set(name, value)
+		}
+	}
+	if len(body) > 0 {
+		if utf8.Valid(body) && len(body) <= cassetteMaxRequestBody {
+			recorded.Body = sanitizeCassetteText(string(body))
+		} else {
+			sum := sha256.Sum256(body)
+			recorded.BodySHA256 = hex.EncodeToString(sum[:])
+		}
+	}
+	return recorded
+}
+
+func newCassetteResponse(resp *http.Response, body []byte) cassetteResponse {
+	recorded := cassetteResponse{Status: resp.StatusCode}
+	for name, values := range resp.Header {
+		switch http.CanonicalHeaderKey(name) {
+		case "Set-Cookie", "Authorization", "Date":
+			continue
+		}
+		if recorded.Headers == nil {
+			recorded.Headers = http.Header{}
+		}
+		for _, value := range values {
+			recorded.Headers.Add(name, sanitizeCassetteText(value))
+		}
+	}
+	if len(body) > 0 {
+		if utf8.Valid(body) {
+			recorded.Body = sanitizeCassetteText(string(body))
+		} else {
+			recorded.BodyBase64 = base64.StdEncoding.EncodeToString(body)
+		}
+	}
+	return recorded
+}
+
+type replayTransport struct {
+	dir    string
+	mu     sync.Mutex
+	queues map[string][]cassette
+}
+
+// NewReplayTransport returns a transport that serves responses from the
+// cassettes in dir. Requests are matched by method and sanitized URL, and
+// repeated requests are answered in recording order.
+func NewReplayTransport(dir string) (http.RoundTripper, error) {
+	dir = filepath.Clean(strings.TrimSpace(dir))
+
+	cassetteMu.Lock()
+	defer cassetteMu.Unlock()
+
+	if replayer, ok := cassetteReplayers[dir]; ok {
+		return replayer, nil
+	}
+
+	files, err := cassetteFiles(dir)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", ReplayEnvVar, err)
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("%s: no cassettes found in %q", ReplayEnvVar, dir)
+	}
+
+	replayer := &replayTransport{dir: dir, queues: make(map[string][]cassette)}
+	for _, name := range files {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", ReplayEnvVar, err)
+		}
+		var entry cassette
+		if err := json.Unmarshal(data, &entry); err != nil {
+			return nil, fmt.Errorf("%s: invalid cassette %s: %w", ReplayEnvVar, name, err)
+		}
+		key := cassetteKey(entry.Request.Method, entry.Request.URL)
+		replayer.queues[key] = append(replayer.queues[key], entry)
+	}
+
+	cassetteReplayers[dir] = replayer
+	return replayer, nil
+}
+
+func (t *replayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+		_ = req.Body.Close()
+	}
+
+	key := cassetteKey(req.Method, sanitizeCassetteURL(req.URL.String()))
+
+	t.mu.Lock()
+	queue := t.queues[key]
+	if len(queue) == 0 {
+		t.mu.Unlock()
+		return nil, fmt.Errorf("replay: no recorded response for %s", key)
+	}
+	entry := queue[0]
+	t.queues[key] = queue[1:]
+	t.mu.Unlock()
+
+	body := []byte(entry.Response.Body)
+	if entry.Response.BodyBase64 != "" {
+		decoded, err := base64.StdEncoding.DecodeString(entry.Response.BodyBase64)
+		if err != nil {
+			return nil, fmt.Errorf("replay: decode response body for %s: %w", key, err)
+		}
+		body = decoded
+	}
+
+	header := entry.Response.Headers.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", entry.Response.Status, http.StatusText(entry.Response.Status)),
+		StatusCode:    entry.Response.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+func cassetteKey(method, rawURL string) string {
+	return strings.ToUpper(strings.TrimSpace(method)) + " " + rawURL
+}
+
+func cassetteFiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.Type().IsRegular() && filepath.Ext(entry.Name()) == ".json" {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// sanitizeCassetteURL redacts signing parameters and re-encodes the query in
+// sorted order so the same request always produces the same URL.
+func sanitizeCassetteURL(rawURL string) string {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	parsed.User = nil
+	if parsed.RawQuery != "" {
+		values := parsed.Query()
+		for name := range values {
+			if cassetteSensitiveQueryParams[strings.ToLower(name)] {
+				values[name] = []string{cassetteRedacted}
+			}
+		}
+		parsed.RawQuery = values.Encode()
+	}
+	return parsed.String()
+}
+
+// sanitizeCassetteText removes JWTs and signed URL parameters from free text.
+func sanitizeCassetteText(value string) string {
+	value = cassetteJWTPattern.ReplaceAllString(value, cassetteRedacted)
+	return cassetteURLPattern.ReplaceAllStringFunc(value, func(match string) string {
+		if !strings.Contains(match, "\\") {
+			if !strings.Contains(match, "?") {
+				return match
+			}
+			return sanitizeCassetteURL(match)
+		}
+		return sanitizeEscapedCassetteURL(match)
+	})
+}
+
+// sanitizeEscapedCassetteURL sanitizes a URL taken from a JSON string. The URL
+// is unescaped first so signing parameters separated by \u0026 are found, then
+// "/" and "&" are escaped again the way the original text escaped them.
+func sanitizeEscapedCassetteURL(match string) string {
+	unescaped := cassetteJSONURLEscapes.ReplaceAllStringFunc(match, func(escape string) string {
+		if escape == `\/` {
+			return "/"
+		}
+		code, err := strconv.ParseUint(escape[2:], 16, 32)
+		if err != nil {
+			return escape
+		}
+		return string(rune(code))
+	})
+	if !strings.Contains(unescaped, "?") {
+		return match
+	}
+	sanitized := sanitizeCassetteURL(unescaped)
+	if strings.Contains(match, `\/`) {
+		sanitized = strings.ReplaceAll(sanitized, "/", `\/`)
+	}
+	if strings.Contains(strings.ToLower(match), `\u0026`) {
+		sanitized = strings.ReplaceAll(sanitized, "&", `\u0026`)
+	}
+	return sanitized
+}
+
+func cassetteSlug(rawURL string) string {
+	path := rawURL
+	if parsed, err := url.Parse(rawURL); err == nil {
+		path = parsed.Path
+	}
+	var b strings.Builder
+	lastDash := false
+	for _, r := range path {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+			lastDash = false
+			continue
+		}
+		if !lastDash && b.Len() > 0 {
+			b.WriteByte('-')
+			lastDash = true
+		}
+	}
+	slug := strings.TrimSuffix(b.String(), "-")
+	if len(slug) > 80 {
+		slug = slug[:80]
+	}
+	if slug == "" {
+		slug = "root"
+	}
+	return slug
+}
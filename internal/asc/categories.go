@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 )
 
 // AppCategoryAttributes describes app category metadata.
@@ -142,3 +143,39 @@ func (c *Client) UpdateAppInfoCategories(ctx context.Context, appInfoID string,
 
 	return &response, nil
 }
+
+// AppInfoCategoryLinkageResponse is the response from app info category relationship endpoints.
+type AppInfoCategoryLinkageResponse struct {
+	Data  *ResourceData `json:"data"`
+	Links Links         `json:"links,omitempty"`
+}
+
+// GetAppInfoPrimaryCategoryRelationship retrieves the primary category linkage for an app info.
+func (c *Client) GetAppInfoPrimaryCategoryRelationship(ctx context.Context, appInfoID string) (*AppInfoCategoryLinkageResponse, error) {
+	return c.getAppInfoCategoryRelationship(ctx, appInfoID, "primaryCategory")
+}
+
+// GetAppInfoSecondaryCategoryRelationship retrieves the secondary category linkage for an app info.
+func (c *Client) GetAppInfoSecondaryCategoryRelationship(ctx context.Context, appInfoID string) (*AppInfoCategoryLinkageResponse, error) {
+	return c.getAppInfoCategoryRelationship(ctx, appInfoID, "secondaryCategory")
+}
+
+func (c *Client) getAppInfoCategoryRelationship(ctx context.Context, appInfoID, relationship string) (*AppInfoCategoryLinkageResponse, error) {
+	appInfoID = strings.TrimSpace(appInfoID)
+	if appInfoID == "" {
+		return nil, fmt.Errorf("app info ID is required")
+	}
+
+	path := fmt.Sprintf("/v1/appInfos/%s/relationships/%s", appInfoID, relationship)
+	data, err := c.do(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response AppInfoCategoryLinkageResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &response, nil
+}
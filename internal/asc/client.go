@@ -402,7 +402,20 @@ func (c *Client) GetReviews(ctx context.Context, appID string, opts ...ReviewOpt
 		opt(query)
 	}
 
-	path := fmt.Sprintf("/v1/apps/%s/customerReviews", appID)
+	return c.getCustomerReviews(ctx, fmt.Sprintf("/v1/apps/%s/customerReviews", appID), query, opts)
+}
+
+// GetAppStoreVersionReviews retrieves customer reviews left on an App Store version.
+func (c *Client) GetAppStoreVersionReviews(ctx context.Context, versionID string, opts ...ReviewOption) (*ReviewsResponse, error) {
+	query := &reviewQuery{}
+	for _, opt := range opts {
+		opt(query)
+	}
+
+	return c.getCustomerReviews(ctx, fmt.Sprintf("/v1/appStoreVersions/%s/customerReviews", strings.TrimSpace(versionID)), query, opts)
+}
+
+func (c *Client) getCustomerReviews(ctx context.Context, path string, query *reviewQuery, opts []ReviewOption) (*ReviewsResponse, error) {
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
 		if err := validateNextURL(query.nextURL); err != nil {
@@ -521,10 +534,15 @@ func (c *Client) GetBetaGroupTesters(ctx context.Context, groupID string, opts .
 
 // CreateBetaGroup creates a beta group for an app.
 func (c *Client) CreateBetaGroup(ctx context.Context, appID, name string) (*BetaGroupResponse, error) {
+	return c.CreateBetaGroupWithAttributes(ctx, appID, BetaGroupAttributes{Name: name})
+}
+
+// CreateBetaGroupWithAttributes creates a beta group for an app with the given attributes.
+func (c *Client) CreateBetaGroupWithAttributes(ctx context.Context, appID string, attrs BetaGroupAttributes) (*BetaGroupResponse, error) {
 	payload := BetaGroupCreateRequest{
 		Data: BetaGroupCreateData{
 			Type:       ResourceTypeBetaGroups,
-			Attributes: BetaGroupAttributes{Name: name},
+			Attributes: attrs,
 			Relationships: &BetaGroupRelationships{
 				App: &Relationship{
 					Data: ResourceData{
@@ -374,6 +374,21 @@ func (c *Client) CreateBuildUploadFile(ctx context.Context, req BuildUploadFileC
 	return &response, nil
 }
 
+// GetBuildUploadFile retrieves a build upload file, including fresh upload operations.
+func (c *Client) GetBuildUploadFile(ctx context.Context, id string) (*BuildUploadFileResponse, error) {
+	data, err := c.do(ctx, "GET", fmt.Sprintf("/v1/buildUploadFiles/%s", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response BuildUploadFileResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &response, nil
+}
+
 // UpdateBuildUploadFile updates a build upload file (used to commit upload).
 func (c *Client) UpdateBuildUploadFile(ctx context.Context, id string, req BuildUploadFileUpdateRequest) (*BuildUploadFileResponse, error) {
 	body, err := BuildRequestBody(req)
@@ -298,6 +298,7 @@ func resolveTimeoutWithDefaultAndEnv(defaultTimeout time.Duration, durationEnv,
 // Client is an App Store Connect API client
 type Client struct {
 	httpClient *http.Client
+	baseURL    string
 	keyID      string
 	issuerID   string
 	privateKey *ecdsa.PrivateKey
@@ -314,10 +315,22 @@ func NewClient(keyID, issuerID, privateKeyPath string) (*Client, error) {
 		return nil, fmt.Errorf("failed to load private key: %w", err)
 	}
 
+	transport, err := ResolveCassetteTransport(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	baseURL, err := ResolveBaseURL()
+	if err != nil {
+		return nil, err
+	}
+
 	return &Client{
 		httpClient: &http.Client{
-			Timeout: ResolveTimeout(),
+			Timeout:   ResolveTimeout(),
+			Transport: transport,
 		},
+		baseURL:    baseURL,
 		keyID:      keyID,
 		issuerID:   issuerID,
 		privateKey: key,
@@ -27,7 +27,7 @@ func (c *Client) newRequest(ctx context.Context, method, path string, body io.Re
 
 	url := path
 	if !strings.HasPrefix(path, "http://") && !strings.HasPrefix(path, "https://") {
-		url = BaseURL + path
+		url = c.apiBaseURL() + path
 	}
 	req, err := http.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
@@ -41,6 +41,14 @@ func (c *Client) newRequest(ctx context.Context, method, path string, body io.Re
 	return req, nil
 }
 
+// apiBaseURL returns the client's base URL, defaulting to BaseURL.
+func (c *Client) apiBaseURL() string {
+	if c.baseURL != "" {
+		return c.baseURL
+	}
+	return BaseURL
+}
+
 // generateJWT generates a JWT for ASC API authentication
 func (c *Client) generateJWT() (string, error) {
 	return GenerateJWT(c.keyID, c.issuerID, c.privateKey)
@@ -190,7 +198,8 @@ func parseRetryAfterHeader(value string) time.Duration {
 }
 
 // validateNextURL validates that a pagination URL is safe to use.
-// It ensures the URL is on the same host as BaseURL and uses HTTPS.
+// It ensures the URL is on the same host as BaseURL (or the configured
+// base URL override) and uses HTTPS.
 func validateNextURL(nextURL string) error {
 	if nextURL == "" {
 		return nil
@@ -207,6 +216,11 @@ func validateNextURL(nextURL string) error {
 		return fmt.Errorf("invalid pagination URL: %w", err)
 	}
 
+	// Allow URLs on the configured base URL host (see ResolveBaseURL)
+	if matchesAPIHost(parsedURL) {
+		return nil
+	}
+
 	baseURL, err := url.Parse(BaseURL)
 	if err != nil {
 		return fmt.Errorf("invalid base URL: %w", err)
@@ -338,6 +352,13 @@ func (c *Client) doStream(ctx context.Context, method, path string, body io.Read
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		respBody, _ := io.ReadAll(resp.Body)
 		_ = resp.Body.Close()
+		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable {
+			retryAfter := parseRetryAfterHeader(resp.Header.Get("Retry-After"))
+			return nil, &RetryableError{
+				Err:        buildRetryableError(resp.StatusCode, retryAfter, respBody),
+				RetryAfter: retryAfter,
+			}
+		}
 		if err := ParseError(respBody); err != nil {
 			return nil, err
 		}
@@ -1305,6 +1305,45 @@ func TestCreateBetaGroup_SendsRequest(t *testing.T) {
 	}
 }
 
+func TestCreateBetaGroupWithAttributes_SendsRequest(t *testing.T) {
+	response := jsonResponse(http.StatusCreated, `{"data":{"type":"betaGroups","id":"bg1","attributes":{"name":"Internal","isInternalGroup":true}}}`)
+	client := newTestClient(t, func(req *http.Request) {
+		if req.Method != http.MethodPost {
+			t.Fatalf("expected POST, got %s", req.Method)
+		}
+		if req.URL.Path != "/v1/betaGroups" {
+			t.Fatalf("expected path /v1/betaGroups, got %s", req.URL.Path)
+		}
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Fatalf("read body error: %v", err)
+		}
+		var payload BetaGroupCreateRequest
+		if err := json.Unmarshal(body, &payload); err != nil {
+			t.Fatalf("decode body error: %v", err)
+		}
+		if payload.Data.Attributes.Name != "Internal" {
+			t.Fatalf("expected name Internal, got %q", payload.Data.Attributes.Name)
+		}
+		if !payload.Data.Attributes.IsInternalGroup {
+			t.Fatalf("expected isInternalGroup to be true")
+		}
+		if !payload.Data.Attributes.FeedbackEnabled {
+			t.Fatalf("expected feedbackEnabled to be true")
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	attrs := BetaGroupAttributes{
+		Name:            "Internal",
+		IsInternalGroup: true,
+		FeedbackEnabled: true,
+	}
+	if _, err := client.CreateBetaGroupWithAttributes(context.Background(), "app-1", attrs); err != nil {
+		t.Fatalf("CreateBetaGroupWithAttributes() error: %v", err)
+	}
+}
+
 func TestGetBetaGroup_SendsRequest(t *testing.T) {
 	response := jsonResponse(http.StatusOK, `{"data":{"type":"betaGroups","id":"bg1","attributes":{"name":"Beta Testers","isInternalGroup":true}}}`)
 	client := newTestClient(t, func(req *http.Request) {
@@ -2292,6 +2331,33 @@ func TestGetReviews_BuildsQuery(t *testing.T) {
 	}
 }
 
+func TestGetAppStoreVersionReviews_IncludesResponse(t *testing.T) {
+	response := jsonResponse(http.StatusOK, `{"data":[
+		{"type":"customerReviews","id":"1","attributes":{"rating":1},"relationships":{"response":{"data":{"type":"customerReviewResponses","id":"resp-1"}}}},
+		{"type":"customerReviews","id":"2","attributes":{"rating":5},"relationships":{"response":{"data":null}}},
+		{"type":"customerReviews","id":"3","attributes":{"rating":4}}]}`)
+	client := newTestClient(t, func(req *http.Request) {
+		if req.URL.Path != "/v1/appStoreVersions/ver-1/customerReviews" {
+			t.Fatalf("expected version reviews path, got %s", req.URL.Path)
+		}
+		if got := req.URL.Query().Get("include"); got != "response" {
+			t.Fatalf("expected include=response, got %q", got)
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	reviews, err := client.GetAppStoreVersionReviews(context.Background(), "ver-1", WithReviewInclude([]string{"response"}))
+	if err != nil {
+		t.Fatalf("GetAppStoreVersionReviews() error: %v", err)
+	}
+	want := []string{"resp-1", "", ""}
+	for i, review := range reviews.Data {
+		if got := ReviewResponseID(review); got != want[i] {
+			t.Fatalf("review %s: expected response ID %q, got %q", review.ID, want[i], got)
+		}
+	}
+}
+
 func TestGetEndpoints_ReturnsAPIError(t *testing.T) {
 	tests := []struct {
 		name string
@@ -2517,6 +2583,27 @@ func TestCreateBuildUploadFile(t *testing.T) {
 	}
 }
 
+func TestGetBuildUploadFile(t *testing.T) {
+	response := jsonResponse(http.StatusOK, `{"data":{"type":"buildUploadFiles","id":"FILE_123","attributes":{"fileName":"app.ipa","fileSize":1024000,"uploadOperations":[{"method":"PUT","url":"https://example.com/upload","length":1024000,"offset":0}]}}}`)
+	client := newTestClient(t, func(req *http.Request) {
+		if req.Method != http.MethodGet {
+			t.Fatalf("expected GET, got %s", req.Method)
+		}
+		if req.URL.Path != "/v1/buildUploadFiles/FILE_123" {
+			t.Fatalf("expected path /v1/buildUploadFiles/FILE_123, got %s", req.URL.Path)
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	result, err := client.GetBuildUploadFile(context.Background(), "FILE_123")
+	if err != nil {
+		t.Fatalf("GetBuildUploadFile() error: %v", err)
+	}
+	if len(result.Data.Attributes.UploadOperations) != 1 {
+		t.Fatalf("expected 1 upload operation, got %d", len(result.Data.Attributes.UploadOperations))
+	}
+}
+
 func TestUpdateBuildUploadFile(t *testing.T) {
 	response := jsonResponse(http.StatusOK, `{"data":{"type":"buildUploadFiles","id":"FILE_123","attributes":{"uploaded":true}}}`)
 	client := newTestClient(t, func(req *http.Request) {
@@ -4054,6 +4141,26 @@ func TestGetBundleIDCapabilities_SendsRequest(t *testing.T) {
 	}
 }
 
+func TestGetBundleIDProfiles_WithLimit(t *testing.T) {
+	response := jsonResponse(http.StatusOK, `{"data":[{"type":"profiles","id":"p1","attributes":{"name":"AdHoc","profileType":"IOS_APP_ADHOC"}}]}`)
+	client := newTestClient(t, func(req *http.Request) {
+		if req.Method != http.MethodGet {
+			t.Fatalf("expected GET, got %s", req.Method)
+		}
+		if req.URL.Path != "/v1/bundleIds/b1/profiles" {
+			t.Fatalf("expected path /v1/bundleIds/b1/profiles, got %s", req.URL.Path)
+		}
+		if req.URL.Query().Get("limit") != "200" {
+			t.Fatalf("expected limit=200, got %q", req.URL.Query().Get("limit"))
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	if _, err := client.GetBundleIDProfiles(context.Background(), "b1", WithBundleIDProfilesLimit(200)); err != nil {
+		t.Fatalf("GetBundleIDProfiles() error: %v", err)
+	}
+}
+
 func TestCreateBundleIDCapability_SendsRequest(t *testing.T) {
 	response := jsonResponse(http.StatusCreated, `{"data":{"type":"bundleIdCapabilities","id":"cap1","attributes":{"capabilityType":"ICLOUD"}}}`)
 	client := newTestClient(t, func(req *http.Request) {
@@ -4432,6 +4539,62 @@ func TestDeleteProfile_SendsRequest(t *testing.T) {
 	}
 }
 
+func TestGetProfileBundleIDRelationship_SendsRequest(t *testing.T) {
+	response := jsonResponse(http.StatusOK, `{"data":{"type":"bundleIds","id":"b1"}}`)
+	client := newTestClient(t, func(req *http.Request) {
+		if req.Method != http.MethodGet {
+			t.Fatalf("expected GET, got %s", req.Method)
+		}
+		if req.URL.Path != "/v1/profiles/p1/relationships/bundleId" {
+			t.Fatalf("expected path /v1/profiles/p1/relationships/bundleId, got %s", req.URL.Path)
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	resp, err := client.GetProfileBundleIDRelationship(context.Background(), "p1")
+	if err != nil {
+		t.Fatalf("GetProfileBundleIDRelationship() error: %v", err)
+	}
+	if resp.Data.ID != "b1" {
+		t.Fatalf("expected bundle ID b1, got %q", resp.Data.ID)
+	}
+}
+
+func TestGetProfileCertificatesRelationships_WithLimit(t *testing.T) {
+	response := jsonResponse(http.StatusOK, `{"data":[{"type":"certificates","id":"c1"}]}`)
+	client := newTestClient(t, func(req *http.Request) {
+		if req.Method != http.MethodGet {
+			t.Fatalf("expected GET, got %s", req.Method)
+		}
+		if req.URL.Path != "/v1/profiles/p1/relationships/certificates" {
+			t.Fatalf("expected path /v1/profiles/p1/relationships/certificates, got %s", req.URL.Path)
+		}
+		if req.URL.Query().Get("limit") != "200" {
+			t.Fatalf("expected limit=200, got %q", req.URL.Query().Get("limit"))
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	if _, err := client.GetProfileCertificatesRelationships(context.Background(), "p1", WithLinkagesLimit(200)); err != nil {
+		t.Fatalf("GetProfileCertificatesRelationships() error: %v", err)
+	}
+}
+
+func TestGetProfileDevicesRelationships_UsesNextURL(t *testing.T) {
+	next := "https://api.appstoreconnect.apple.com/v1/profiles/p1/relationships/devices?cursor=abc"
+	response := jsonResponse(http.StatusOK, `{"data":[]}`)
+	client := newTestClient(t, func(req *http.Request) {
+		if req.URL.String() != next {
+			t.Fatalf("expected URL %q, got %q", next, req.URL.String())
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	if _, err := client.GetProfileDevicesRelationships(context.Background(), "p1", WithLinkagesNextURL(next)); err != nil {
+		t.Fatalf("GetProfileDevicesRelationships() error: %v", err)
+	}
+}
+
 func TestGetInAppPurchaseV2(t *testing.T) {
 	response := jsonResponse(http.StatusOK, `{"data":{"type":"inAppPurchases","id":"iap-1","attributes":{"name":"Pro","productId":"com.example.pro","inAppPurchaseType":"CONSUMABLE"}}}`)
 	client := newTestClient(t, func(req *http.Request) {
@@ -6861,3 +7024,42 @@ func TestDeleteGameCenterAchievementLocalization(t *testing.T) {
 		t.Fatalf("DeleteGameCenterAchievementLocalization() error: %v", err)
 	}
 }
+
+func TestGetAppInfoPrimaryCategoryRelationship(t *testing.T) {
+	response := jsonResponse(http.StatusOK, `{"data":{"type":"appCategories","id":"GAMES"}}`)
+	client := newTestClient(t, func(req *http.Request) {
+		if req.Method != http.MethodGet {
+			t.Fatalf("expected GET, got %s", req.Method)
+		}
+		if req.URL.Path != "/v1/appInfos/info-1/relationships/primaryCategory" {
+			t.Fatalf("expected path /v1/appInfos/info-1/relationships/primaryCategory, got %s", req.URL.Path)
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	resp, err := client.GetAppInfoPrimaryCategoryRelationship(context.Background(), "info-1")
+	if err != nil {
+		t.Fatalf("GetAppInfoPrimaryCategoryRelationship() error: %v", err)
+	}
+	if resp.Data == nil || resp.Data.ID != "GAMES" {
+		t.Fatalf("expected GAMES linkage, got %+v", resp.Data)
+	}
+}
+
+func TestGetAppInfoSecondaryCategoryRelationship_NullData(t *testing.T) {
+	response := jsonResponse(http.StatusOK, `{"data":null}`)
+	client := newTestClient(t, func(req *http.Request) {
+		if req.URL.Path != "/v1/appInfos/info-1/relationships/secondaryCategory" {
+			t.Fatalf("expected path /v1/appInfos/info-1/relationships/secondaryCategory, got %s", req.URL.Path)
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	resp, err := client.GetAppInfoSecondaryCategoryRelationship(context.Background(), "info-1")
+	if err != nil {
+		t.Fatalf("GetAppInfoSecondaryCategoryRelationship() error: %v", err)
+	}
+	if resp.Data != nil {
+		t.Fatalf("expected nil linkage, got %+v", resp.Data)
+	}
+}
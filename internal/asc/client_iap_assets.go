@@ -0,0 +1,298 @@
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// GetInAppPurchaseReviewScreenshotForIAP retrieves the review screenshot attached to an in-app purchase.
+func (c *Client) GetInAppPurchaseReviewScreenshotForIAP(ctx context.Context, iapID string) (*InAppPurchaseReviewScreenshotResponse, error) {
+	iapID = strings.TrimSpace(iapID)
+	path := fmt.Sprintf("/v2/inAppPurchases/%s/appStoreReviewScreenshot", iapID)
+
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response InAppPurchaseReviewScreenshotResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse in-app purchase review screenshot response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// GetInAppPurchaseReviewScreenshot retrieves a review screenshot by ID.
+func (c *Client) GetInAppPurchaseReviewScreenshot(ctx context.Context, screenshotID string) (*InAppPurchaseReviewScreenshotResponse, error) {
+	screenshotID = strings.TrimSpace(screenshotID)
+	path := fmt.Sprintf("/v1/inAppPurchaseAppStoreReviewScreenshots/%s", screenshotID)
+
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response InAppPurchaseReviewScreenshotResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse in-app purchase review screenshot response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// CreateInAppPurchaseReviewScreenshot reserves a review screenshot upload for an in-app purchase.
+func (c *Client) CreateInAppPurchaseReviewScreenshot(ctx context.Context, iapID, fileName string, fileSize int64) (*InAppPurchaseReviewScreenshotResponse, error) {
+	iapID = strings.TrimSpace(iapID)
+	if iapID == "" {
+		return nil, fmt.Errorf("in-app purchase ID is required")
+	}
+
+	payload := InAppPurchaseReviewScreenshotCreateRequest{
+		Data: InAppPurchaseReviewScreenshotCreateData{
+			Type: ResourceTypeInAppPurchaseReviewScreenshots,
+			Attributes: InAppPurchaseAssetCreateAttributes{
+				FileName: fileName,
+				FileSize: fileSize,
+			},
+			Relationships: InAppPurchaseReviewScreenshotRelationships{
+				InAppPurchaseV2: Relationship{
+					Data: ResourceData{
+						Type: ResourceTypeInAppPurchases,
+						ID:   iapID,
+					},
+				},
+			},
+		},
+	}
+
+	body, err := BuildRequestBody(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.do(ctx, http.MethodPost, "/v1/inAppPurchaseAppStoreReviewScreenshots", body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response InAppPurchaseReviewScreenshotResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse in-app purchase review screenshot response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// UpdateInAppPurchaseReviewScreenshot commits an uploaded review screenshot.
+func (c *Client) UpdateInAppPurchaseReviewScreenshot(ctx context.Context, screenshotID string, uploaded bool, checksumHash string) (*InAppPurchaseReviewScreenshotResponse, error) {
+	screenshotID = strings.TrimSpace(screenshotID)
+	payload := InAppPurchaseAssetUpdateRequest{
+		Data: InAppPurchaseAssetUpdateData{
+			Type: ResourceTypeInAppPurchaseReviewScreenshots,
+			ID:   screenshotID,
+			Attributes: &InAppPurchaseAssetUpdateAttributes{
+				Uploaded:           &uploaded,
+				SourceFileChecksum: &checksumHash,
+			},
+		},
+	}
+
+	body, err := BuildRequestBody(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/v1/inAppPurchaseAppStoreReviewScreenshots/%s", screenshotID)
+	data, err := c.do(ctx, http.MethodPatch, path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response InAppPurchaseReviewScreenshotResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse in-app purchase review screenshot response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// DeleteInAppPurchaseReviewScreenshot deletes a review screenshot.
+func (c *Client) DeleteInAppPurchaseReviewScreenshot(ctx context.Context, screenshotID string) error {
+	screenshotID = strings.TrimSpace(screenshotID)
+	path := fmt.Sprintf("/v1/inAppPurchaseAppStoreReviewScreenshots/%s", screenshotID)
+	_, err := c.do(ctx, http.MethodDelete, path, nil)
+	return err
+}
+
+// GetInAppPurchaseImages lists promotional images for an in-app purchase.
+func (c *Client) GetInAppPurchaseImages(ctx context.Context, iapID string) (*InAppPurchaseImagesResponse, error) {
+	iapID = strings.TrimSpace(iapID)
+	path := fmt.Sprintf("/v2/inAppPurchases/%s/images", iapID)
+
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response InAppPurchaseImagesResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse in-app purchase images response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// GetInAppPurchaseImage retrieves an in-app purchase image by ID.
+func (c *Client) GetInAppPurchaseImage(ctx context.Context, imageID string) (*InAppPurchaseImageResponse, error) {
+	imageID = strings.TrimSpace(imageID)
+	path := fmt.Sprintf("/v1/inAppPurchaseImages/%s", imageID)
+
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response InAppPurchaseImageResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse in-app purchase image response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// CreateInAppPurchaseImage reserves a promotional image upload for an in-app purchase.
+func (c *Client) CreateInAppPurchaseImage(ctx context.Context, iapID, fileName string, fileSize int64) (*InAppPurchaseImageResponse, error) {
+	iapID = strings.TrimSpace(iapID)
+	if iapID == "" {
+		return nil, fmt.Errorf("in-app purchase ID is required")
+	}
+
+	payload := InAppPurchaseImageCreateRequest{
+		Data: InAppPurchaseImageCreateData{
+			Type: ResourceTypeInAppPurchaseImages,
+			Attributes: InAppPurchaseAssetCreateAttributes{
+				FileName: fileName,
+				FileSize: fileSize,
+			},
+			Relationships: InAppPurchaseImageRelationships{
+				InAppPurchase: Relationship{
+					Data: ResourceData{
+						Type: ResourceTypeInAppPurchases,
+						ID:   iapID,
+					},
+				},
+			},
+		},
+	}
+
+	body, err := BuildRequestBody(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.do(ctx, http.MethodPost, "/v1/inAppPurchaseImages", body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response InAppPurchaseImageResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse in-app purchase image response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// UpdateInAppPurchaseImage commits an uploaded in-app purchase image.
+func (c *Client) UpdateInAppPurchaseImage(ctx context.Context, imageID string, uploaded bool, checksumHash string) (*InAppPurchaseImageResponse, error) {
+	imageID = strings.TrimSpace(imageID)
+	payload := InAppPurchaseAssetUpdateRequest{
+		Data: InAppPurchaseAssetUpdateData{
+			Type: ResourceTypeInAppPurchaseImages,
+			ID:   imageID,
+			Attributes: &InAppPurchaseAssetUpdateAttributes{
+				Uploaded:           &uploaded,
+				SourceFileChecksum: &checksumHash,
+			},
+		},
+	}
+
+	body, err := BuildRequestBody(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/v1/inAppPurchaseImages/%s", imageID)
+	data, err := c.do(ctx, http.MethodPatch, path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response InAppPurchaseImageResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse in-app purchase image response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// GetInAppPurchaseContent retrieves hosted content metadata for an in-app purchase.
+func (c *Client) GetInAppPurchaseContent(ctx context.Context, iapID string) (*InAppPurchaseContentResponse, error) {
+	iapID = strings.TrimSpace(iapID)
+	path := fmt.Sprintf("/v2/inAppPurchases/%s/content", iapID)
+
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response InAppPurchaseContentResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse in-app purchase content response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// CreateInAppPurchaseSubmission submits an in-app purchase for App Review.
+func (c *Client) CreateInAppPurchaseSubmission(ctx context.Context, iapID string) (*InAppPurchaseSubmissionResponse, error) {
+	iapID = strings.TrimSpace(iapID)
+	if iapID == "" {
+		return nil, fmt.Errorf("in-app purchase ID is required")
+	}
+
+	payload := InAppPurchaseSubmissionCreateRequest{
+		Data: InAppPurchaseSubmissionCreateData{
+			Type: ResourceTypeInAppPurchaseSubmissions,
+			Relationships: InAppPurchaseSubmissionRelationships{
+				InAppPurchaseV2: Relationship{
+					Data: ResourceData{
+						Type: ResourceTypeInAppPurchases,
+						ID:   iapID,
+					},
+				},
+			},
+		},
+	}
+
+	body, err := BuildRequestBody(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.do(ctx, http.MethodPost, "/v1/inAppPurchaseSubmissions", body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response InAppPurchaseSubmissionResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse in-app purchase submission response: %w", err)
+	}
+
+	return &response, nil
+}
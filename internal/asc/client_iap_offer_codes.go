@@ -0,0 +1,246 @@
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// GetInAppPurchasePricePointEqualizations retrieves equivalent price points in
+// other territories for an in-app purchase price point.
+func (c *Client) GetInAppPurchasePricePointEqualizations(ctx context.Context, pricePointID string, opts ...PricePointsOption) (*InAppPurchasePricePointsResponse, error) {
+	query := &pricePointsQuery{}
+	for _, opt := range opts {
+		opt(query)
+	}
+
+	pricePointID = strings.TrimSpace(pricePointID)
+	path := fmt.Sprintf("/v1/inAppPurchasePricePoints/%s/equalizations", pricePointID)
+	if query.nextURL != "" {
+		// Validate nextURL to prevent credential exfiltration
+		if err := validateNextURL(query.nextURL); err != nil {
+			return nil, fmt.Errorf("inAppPurchasePricePointEqualizations: %w", err)
+		}
+		path = query.nextURL
+	} else if queryString := buildPricePointsWithTerritoryQuery(query); queryString != "" {
+		path += "?" + queryString
+	}
+
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response InAppPurchasePricePointsResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse in-app purchase price point equalizations response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// GetInAppPurchaseOfferCodes retrieves offer codes for an in-app purchase.
+func (c *Client) GetInAppPurchaseOfferCodes(ctx context.Context, iapID string, opts ...OfferCodesOption) (*InAppPurchaseOfferCodesResponse, error) {
+	query := &offerCodesQuery{}
+	for _, opt := range opts {
+		opt(query)
+	}
+
+	iapID = strings.TrimSpace(iapID)
+	path := fmt.Sprintf("/v2/inAppPurchases/%s/offerCodes", iapID)
+	if query.nextURL != "" {
+		// Validate nextURL to prevent credential exfiltration
+		if err := validateNextURL(query.nextURL); err != nil {
+			return nil, fmt.Errorf("inAppPurchaseOfferCodes: %w", err)
+		}
+		path = query.nextURL
+	} else if queryString := buildOfferCodesQuery(query); queryString != "" {
+		path += "?" + queryString
+	}
+
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response InAppPurchaseOfferCodesResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse in-app purchase offer codes response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// GetInAppPurchaseOfferCode retrieves an in-app purchase offer code by ID.
+func (c *Client) GetInAppPurchaseOfferCode(ctx context.Context, offerCodeID string) (*InAppPurchaseOfferCodeResponse, error) {
+	path := fmt.Sprintf("/v1/inAppPurchaseOfferCodes/%s", strings.TrimSpace(offerCodeID))
+
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response InAppPurchaseOfferCodeResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse in-app purchase offer code response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// CreateInAppPurchaseOfferCode creates an in-app purchase offer code with per-territory prices.
+func (c *Client) CreateInAppPurchaseOfferCode(ctx context.Context, iapID string, attrs InAppPurchaseOfferCodeCreateAttributes, prices []InAppPurchaseOfferPrice) (*InAppPurchaseOfferCodeResponse, error) {
+	iapID = strings.TrimSpace(iapID)
+	if iapID == "" {
+		return nil, fmt.Errorf("in-app purchase ID is required")
+	}
+	if len(prices) == 0 {
+		return nil, fmt.Errorf("at least one price is required")
+	}
+
+	refs := make([]ResourceData, 0, len(prices))
+	included := make([]InAppPurchaseOfferPriceResource, 0, len(prices))
+	for i, price := range prices {
+		territory := strings.ToUpper(strings.TrimSpace(price.Territory))
+		pricePointID := strings.TrimSpace(price.PricePointID)
+		if territory == "" || pricePointID == "" {
+			return nil, fmt.Errorf("price %d requires a territory and price point", i+1)
+		}
+		localID := fmt.Sprintf("${local-price-%d}", i+1)
+		refs = append(refs, ResourceData{
+			Type: ResourceTypeInAppPurchaseOfferPrices,
+			ID:   localID,
+		})
+		included = append(included, InAppPurchaseOfferPriceResource{
+			Type: ResourceTypeInAppPurchaseOfferPrices,
+			ID:   localID,
+			Relationships: InAppPurchaseOfferPriceRelationships{
+				Territory: Relationship{
+					Data: ResourceData{
+						Type: ResourceTypeTerritories,
+						ID:   territory,
+					},
+				},
+				PricePoint: Relationship{
+					Data: ResourceData{
+						Type: ResourceTypeInAppPurchasePricePoints,
+						ID:   pricePointID,
+					},
+				},
+			},
+		})
+	}
+
+	payload := InAppPurchaseOfferCodeCreateRequest{
+		Data: InAppPurchaseOfferCodeCreateData{
+			Type:       ResourceTypeInAppPurchaseOfferCodes,
+			Attributes: attrs,
+			Relationships: InAppPurchaseOfferCodeRelationships{
+				InAppPurchase: Relationship{
+					Data: ResourceData{
+						Type: ResourceTypeInAppPurchases,
+						ID:   iapID,
+					},
+				},
+				Prices: RelationshipList{Data: refs},
+			},
+		},
+		Included: included,
+	}
+
+	body, err := BuildRequestBody(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.do(ctx, http.MethodPost, "/v1/inAppPurchaseOfferCodes", body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response InAppPurchaseOfferCodeResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse in-app purchase offer code response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// UpdateInAppPurchaseOfferCodeActive activates or deactivates an in-app purchase offer code.
+func (c *Client) UpdateInAppPurchaseOfferCodeActive(ctx context.Context, offerCodeID string, active bool) (*InAppPurchaseOfferCodeResponse, error) {
+	data, err := c.updateOfferCodeActive(ctx, ResourceTypeInAppPurchaseOfferCodes, "/v1/inAppPurchaseOfferCodes", offerCodeID, active)
+	if err != nil {
+		return nil, err
+	}
+
+	var response InAppPurchaseOfferCodeResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse in-app purchase offer code response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// GetInAppPurchaseOfferCodeCustomCodes retrieves custom codes for an in-app purchase offer code.
+func (c *Client) GetInAppPurchaseOfferCodeCustomCodes(ctx context.Context, offerCodeID string, opts ...OfferCodesOption) (*InAppPurchaseOfferCodeCustomCodesResponse, error) {
+	query := &offerCodesQuery{}
+	for _, opt := range opts {
+		opt(query)
+	}
+
+	offerCodeID = strings.TrimSpace(offerCodeID)
+	path := fmt.Sprintf("/v1/inAppPurchaseOfferCodes/%s/customCodes", offerCodeID)
+	if query.nextURL != "" {
+		// Validate nextURL to prevent credential exfiltration
+		if err := validateNextURL(query.nextURL); err != nil {
+			return nil, fmt.Errorf("inAppPurchaseOfferCodeCustomCodes: %w", err)
+		}
+		path = query.nextURL
+	} else if queryString := buildOfferCodesQuery(query); queryString != "" {
+		path += "?" + queryString
+	}
+
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response InAppPurchaseOfferCodeCustomCodesResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse in-app purchase offer code custom codes response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// CreateInAppPurchaseOfferCodeCustomCode creates a custom code for an in-app purchase offer code.
+func (c *Client) CreateInAppPurchaseOfferCodeCustomCode(ctx context.Context, offerCodeID string, attrs OfferCodeCustomCodeCreateAttributes) (*InAppPurchaseOfferCodeCustomCodeResponse, error) {
+	data, err := c.createOfferCodeCustomCode(ctx, ResourceTypeInAppPurchaseOfferCodeCustomCodes, ResourceTypeInAppPurchaseOfferCodes, "/v1/inAppPurchaseOfferCodeCustomCodes", offerCodeID, attrs)
+	if err != nil {
+		return nil, err
+	}
+
+	var response InAppPurchaseOfferCodeCustomCodeResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse in-app purchase offer code custom code response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// UpdateInAppPurchaseOfferCodeCustomCodeActive activates or deactivates an in-app purchase offer custom code.
+func (c *Client) UpdateInAppPurchaseOfferCodeCustomCodeActive(ctx context.Context, customCodeID string, active bool) (*InAppPurchaseOfferCodeCustomCodeResponse, error) {
+	data, err := c.updateOfferCodeActive(ctx, ResourceTypeInAppPurchaseOfferCodeCustomCodes, "/v1/inAppPurchaseOfferCodeCustomCodes", customCodeID, active)
+	if err != nil {
+		return nil, err
+	}
+
+	var response InAppPurchaseOfferCodeCustomCodeResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse in-app purchase offer code custom code response: %w", err)
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,303 @@
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const iapPriceScheduleManualPriceID = "${local-manual-price-1}"
+
+// GetInAppPurchasePricePoints retrieves price points for an in-app purchase.
+func (c *Client) GetInAppPurchasePricePoints(ctx context.Context, iapID string, opts ...PricePointsOption) (*InAppPurchasePricePointsResponse, error) {
+	query := &pricePointsQuery{}
+	for _, opt := range opts {
+		opt(query)
+	}
+
+	iapID = strings.TrimSpace(iapID)
+	path := fmt.Sprintf("/v2/inAppPurchases/%s/pricePoints", iapID)
+	if query.nextURL != "" {
+		// Validate nextURL to prevent credential exfiltration
+		if err := validateNextURL(query.nextURL); err != nil {
+			return nil, fmt.Errorf("inAppPurchasePricePoints: %w", err)
+		}
+		path = query.nextURL
+	} else if queryString := buildPricePointsQuery(query); queryString != "" {
+		path += "?" + queryString
+	}
+
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response InAppPurchasePricePointsResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse in-app purchase price points response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// GetInAppPurchasePriceSchedule retrieves the price schedule for an in-app purchase.
+func (c *Client) GetInAppPurchasePriceSchedule(ctx context.Context, iapID string) (*InAppPurchasePriceScheduleResponse, error) {
+	iapID = strings.TrimSpace(iapID)
+	path := fmt.Sprintf("/v2/inAppPurchases/%s/iapPriceSchedule", iapID)
+
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response InAppPurchasePriceScheduleResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse in-app purchase price schedule response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// GetInAppPurchasePriceScheduleBaseTerritory retrieves the base territory for an IAP price schedule.
+func (c *Client) GetInAppPurchasePriceScheduleBaseTerritory(ctx context.Context, scheduleID string) (*TerritoryResponse, error) {
+	scheduleID = strings.TrimSpace(scheduleID)
+	path := fmt.Sprintf("/v1/inAppPurchasePriceSchedules/%s/baseTerritory", scheduleID)
+
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response TerritoryResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse base territory response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// GetInAppPurchasePriceScheduleManualPrices retrieves manual prices for an IAP price schedule.
+func (c *Client) GetInAppPurchasePriceScheduleManualPrices(ctx context.Context, scheduleID string, opts ...IAPPricesOption) (*InAppPurchasePricesResponse, error) {
+	return c.getInAppPurchasePriceSchedulePrices(ctx, scheduleID, "manualPrices", opts...)
+}
+
+// GetInAppPurchasePriceScheduleAutomaticPrices retrieves automatic prices for an IAP price schedule.
+func (c *Client) GetInAppPurchasePriceScheduleAutomaticPrices(ctx context.Context, scheduleID string, opts ...IAPPricesOption) (*InAppPurchasePricesResponse, error) {
+	return c.getInAppPurchasePriceSchedulePrices(ctx, scheduleID, "automaticPrices", opts...)
+}
+
+func (c *Client) getInAppPurchasePriceSchedulePrices(ctx context.Context, scheduleID, relationship string, opts ...IAPPricesOption) (*InAppPurchasePricesResponse, error) {
+	query := &iapPricesQuery{}
+	for _, opt := range opts {
+		opt(query)
+	}
+
+	scheduleID = strings.TrimSpace(scheduleID)
+	path := fmt.Sprintf("/v1/inAppPurchasePriceSchedules/%s/%s", scheduleID, relationship)
+	if query.nextURL != "" {
+		// Validate nextURL to prevent credential exfiltration
+		if err := validateNextURL(query.nextURL); err != nil {
+			return nil, fmt.Errorf("%s: %w", relationship, err)
+		}
+		path = query.nextURL
+	} else if queryString := buildIAPPricesQuery(query); queryString != "" {
+		path += "?" + queryString
+	}
+
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response InAppPurchasePricesResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse in-app purchase prices response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// CreateInAppPurchasePriceSchedule replaces an in-app purchase price schedule
+// with a single manual price in the base territory.
+func (c *Client) CreateInAppPurchasePriceSchedule(ctx context.Context, iapID string, attrs InAppPurchasePriceScheduleCreateAttributes) (*InAppPurchasePriceScheduleResponse, error) {
+	iapID = strings.TrimSpace(iapID)
+	pricePointID := strings.TrimSpace(attrs.PricePointID)
+	baseTerritoryID := strings.ToUpper(strings.TrimSpace(attrs.BaseTerritoryID))
+	if iapID == "" {
+		return nil, fmt.Errorf("in-app purchase ID is required")
+	}
+	if pricePointID == "" {
+		return nil, fmt.Errorf("price point ID is required")
+	}
+	if baseTerritoryID == "" {
+		return nil, fmt.Errorf("base territory ID is required")
+	}
+
+	iapRelationship := Relationship{
+		Data: ResourceData{
+			Type: ResourceTypeInAppPurchases,
+			ID:   iapID,
+		},
+	}
+
+	payload := InAppPurchasePriceScheduleCreateRequest{
+		Data: InAppPurchasePriceScheduleCreateData{
+			Type: ResourceTypeInAppPurchasePriceSchedules,
+			Relationships: InAppPurchasePriceScheduleCreateRelationships{
+				InAppPurchase: iapRelationship,
+				BaseTerritory: Relationship{
+					Data: ResourceData{
+						Type: ResourceTypeTerritories,
+						ID:   baseTerritoryID,
+					},
+				},
+				ManualPrices: RelationshipList{
+					Data: []ResourceData{
+						{
+							Type: ResourceTypeInAppPurchasePrices,
+							ID:   iapPriceScheduleManualPriceID,
+						},
+					},
+				},
+			},
+		},
+		Included: []InAppPurchasePriceCreateResource{
+			{
+				Type:       ResourceTypeInAppPurchasePrices,
+				ID:         iapPriceScheduleManualPriceID,
+				Attributes: InAppPurchasePriceAttributes{StartDate: strings.TrimSpace(attrs.StartDate)},
+				Relationships: InAppPurchasePriceRelationships{
+					InAppPurchaseV2: iapRelationship,
+					InAppPurchasePricePoint: Relationship{
+						Data: ResourceData{
+							Type: ResourceTypeInAppPurchasePricePoints,
+							ID:   pricePointID,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	body, err := BuildRequestBody(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.do(ctx, http.MethodPost, "/v1/inAppPurchasePriceSchedules", body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response InAppPurchasePriceScheduleResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse in-app purchase price schedule response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// GetInAppPurchaseAvailability retrieves availability for an in-app purchase.
+func (c *Client) GetInAppPurchaseAvailability(ctx context.Context, iapID string) (*InAppPurchaseAvailabilityResponse, error) {
+	iapID = strings.TrimSpace(iapID)
+	path := fmt.Sprintf("/v2/inAppPurchases/%s/inAppPurchaseAvailability", iapID)
+
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response InAppPurchaseAvailabilityResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse in-app purchase availability response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// GetInAppPurchaseAvailabilityTerritories retrieves territories where an IAP is available.
+func (c *Client) GetInAppPurchaseAvailabilityTerritories(ctx context.Context, availabilityID string, opts ...TerritoriesOption) (*TerritoriesResponse, error) {
+	query := &territoriesQuery{}
+	for _, opt := range opts {
+		opt(query)
+	}
+
+	availabilityID = strings.TrimSpace(availabilityID)
+	path := fmt.Sprintf("/v1/inAppPurchaseAvailabilities/%s/availableTerritories", availabilityID)
+	if query.nextURL != "" {
+		// Validate nextURL to prevent credential exfiltration
+		if err := validateNextURL(query.nextURL); err != nil {
+			return nil, fmt.Errorf("availableTerritories: %w", err)
+		}
+		path = query.nextURL
+	} else if queryString := buildTerritoriesQuery(query); queryString != "" {
+		path += "?" + queryString
+	}
+
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response TerritoriesResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse available territories response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// CreateInAppPurchaseAvailability replaces the territories where an IAP is available.
+func (c *Client) CreateInAppPurchaseAvailability(ctx context.Context, iapID string, territoryIDs []string, attrs InAppPurchaseAvailabilityAttributes) (*InAppPurchaseAvailabilityResponse, error) {
+	iapID = strings.TrimSpace(iapID)
+	territoryIDs = normalizeUpperList(territoryIDs)
+	if iapID == "" {
+		return nil, fmt.Errorf("in-app purchase ID is required")
+	}
+	if len(territoryIDs) == 0 {
+		return nil, fmt.Errorf("territory IDs are required")
+	}
+
+	relData := make([]ResourceData, 0, len(territoryIDs))
+	for _, territoryID := range territoryIDs {
+		relData = append(relData, ResourceData{
+			Type: ResourceTypeTerritories,
+			ID:   territoryID,
+		})
+	}
+
+	payload := InAppPurchaseAvailabilityCreateRequest{
+		Data: InAppPurchaseAvailabilityCreateData{
+			Type:       ResourceTypeInAppPurchaseAvailabilities,
+			Attributes: attrs,
+			Relationships: InAppPurchaseAvailabilityRelationships{
+				InAppPurchase: Relationship{
+					Data: ResourceData{
+						Type: ResourceTypeInAppPurchases,
+						ID:   iapID,
+					},
+				},
+				AvailableTerritories: RelationshipList{Data: relData},
+			},
+		},
+	}
+
+	body, err := BuildRequestBody(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.do(ctx, http.MethodPost, "/v1/inAppPurchaseAvailabilities", body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response InAppPurchaseAvailabilityResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse in-app purchase availability response: %w", err)
+	}
+
+	return &response, nil
+}
@@ -65,6 +65,9 @@ type BundleIDsOption func(*bundleIDsQuery)
 // BundleIDCapabilitiesOption is a functional option for GetBundleIDCapabilities.
 type BundleIDCapabilitiesOption func(*bundleIDCapabilitiesQuery)
 
+// BundleIDProfilesOption is a functional option for GetBundleIDProfiles.
+type BundleIDProfilesOption func(*bundleIDProfilesQuery)
+
 // CertificatesOption is a functional option for GetCertificates.
 type CertificatesOption func(*certificatesQuery)
 
@@ -471,6 +474,13 @@ func WithReviewSort(sort string) ReviewOption {
 	}
 }
 
+// WithReviewInclude sets include for review responses (e.g. response).
+func WithReviewInclude(include []string) ReviewOption {
+	return func(r *reviewQuery) {
+		r.include = normalizeList(include)
+	}
+}
+
 // WithLimit sets the max number of reviews to return.
 func WithLimit(limit int) ReviewOption {
 	return func(r *reviewQuery) {
@@ -1037,6 +1047,24 @@ func WithBundleIDCapabilitiesNextURL(next string) BundleIDCapabilitiesOption {
 	}
 }
 
+// WithBundleIDProfilesLimit sets the max number of profiles to return.
+func WithBundleIDProfilesLimit(limit int) BundleIDProfilesOption {
+	return func(q *bundleIDProfilesQuery) {
+		if limit > 0 {
+			q.limit = limit
+		}
+	}
+}
+
+// WithBundleIDProfilesNextURL uses a next page URL directly.
+func WithBundleIDProfilesNextURL(next string) BundleIDProfilesOption {
+	return func(q *bundleIDProfilesQuery) {
+		if strings.TrimSpace(next) != "" {
+			q.nextURL = strings.TrimSpace(next)
+		}
+	}
+}
+
 // WithCertificatesLimit sets the max number of certificates to return.
 func WithCertificatesLimit(limit int) CertificatesOption {
 	return func(q *certificatesQuery) {
@@ -110,10 +110,30 @@ func PaginateAll(ctx context.Context, firstPage PaginatedResponse, fetchNext Pag
 		result = &AppInfoLocalizationsResponse{Links: Links{}}
 	case *InAppPurchaseLocalizationsResponse:
 		result = &InAppPurchaseLocalizationsResponse{Links: Links{}}
+	case *InAppPurchasePricePointsResponse:
+		result = &InAppPurchasePricePointsResponse{Links: Links{}}
 	case *SubscriptionGroupsResponse:
 		result = &SubscriptionGroupsResponse{Links: Links{}}
 	case *SubscriptionsResponse:
 		result = &SubscriptionsResponse{Links: Links{}}
+	case *SubscriptionPricePointsResponse:
+		result = &SubscriptionPricePointsResponse{Links: Links{}}
+	case *SubscriptionIntroductoryOffersResponse:
+		result = &SubscriptionIntroductoryOffersResponse{Links: Links{}}
+	case *SubscriptionPromotionalOffersResponse:
+		result = &SubscriptionPromotionalOffersResponse{Links: Links{}}
+	case *SubscriptionOfferCodesResponse:
+		result = &SubscriptionOfferCodesResponse{Links: Links{}}
+	case *SubscriptionOfferCodeCustomCodesResponse:
+		result = &SubscriptionOfferCodeCustomCodesResponse{Links: Links{}}
+	case *InAppPurchaseOfferCodesResponse:
+		result = &InAppPurchaseOfferCodesResponse{Links: Links{}}
+	case *WinBackOffersResponse:
+		result = &WinBackOffersResponse{Links: Links{}}
+	case *SubscriptionLocalizationsResponse:
+		result = &SubscriptionLocalizationsResponse{Links: Links{}}
+	case *SubscriptionGroupLocalizationsResponse:
+		result = &SubscriptionGroupLocalizationsResponse{Links: Links{}}
 	case *BetaGroupsResponse:
 		result = &BetaGroupsResponse{Links: Links{}}
 	case *BetaTestersResponse:
@@ -292,10 +312,30 @@ func typeOf(p PaginatedResponse) string {
 		return "AppInfoLocalizationsResponse"
 	case *InAppPurchaseLocalizationsResponse:
 		return "InAppPurchaseLocalizationsResponse"
+	case *InAppPurchasePricePointsResponse:
+		return "InAppPurchasePricePointsResponse"
 	case *SubscriptionGroupsResponse:
 		return "SubscriptionGroupsResponse"
 	case *SubscriptionsResponse:
 		return "SubscriptionsResponse"
+	case *SubscriptionPricePointsResponse:
+		return "SubscriptionPricePointsResponse"
+	case *SubscriptionIntroductoryOffersResponse:
+		return "SubscriptionIntroductoryOffersResponse"
+	case *SubscriptionPromotionalOffersResponse:
+		return "SubscriptionPromotionalOffersResponse"
+	case *SubscriptionOfferCodesResponse:
+		return "SubscriptionOfferCodesResponse"
+	case *SubscriptionOfferCodeCustomCodesResponse:
+		return "SubscriptionOfferCodeCustomCodesResponse"
+	case *InAppPurchaseOfferCodesResponse:
+		return "InAppPurchaseOfferCodesResponse"
+	case *WinBackOffersResponse:
+		return "WinBackOffersResponse"
+	case *SubscriptionLocalizationsResponse:
+		return "SubscriptionLocalizationsResponse"
+	case *SubscriptionGroupLocalizationsResponse:
+		return "SubscriptionGroupLocalizationsResponse"
 	case *BetaGroupsResponse:
 		return "BetaGroupsResponse"
 	case *BetaTestersResponse:
@@ -42,6 +42,7 @@ type reviewQuery struct {
 	rating    int
 	territory string
 	sort      string
+	include   []string
 }
 
 type appsQuery struct {
@@ -163,6 +164,10 @@ type bundleIDCapabilitiesQuery struct {
 	listQuery
 }
 
+type bundleIDProfilesQuery struct {
+	listQuery
+}
+
 type certificatesQuery struct {
 	listQuery
 	certificateTypes []string
@@ -293,6 +298,7 @@ func buildReviewQuery(opts []ReviewOption) string {
 	if query.sort != "" {
 		values.Set("sort", query.sort)
 	}
+	addCSV(values, "include", query.include)
 	addLimit(values, query.limit)
 
 	return values.Encode()
@@ -457,6 +463,12 @@ func buildBundleIDCapabilitiesQuery(_ *bundleIDCapabilitiesQuery) string {
 	return ""
 }
 
+func buildBundleIDProfilesQuery(query *bundleIDProfilesQuery) string {
+	values := url.Values{}
+	addLimit(values, query.limit)
+	return values.Encode()
+}
+
 func buildCertificatesQuery(query *certificatesQuery) string {
 	values := url.Values{}
 	addCSV(values, "filter[certificateType]", query.certificateTypes)
@@ -151,6 +151,38 @@ func (c *Client) GetBundleIDCapabilities(ctx context.Context, bundleID string, o
 	return &response, nil
 }
 
+// GetBundleIDProfiles retrieves the profiles linked to a bundle ID.
+func (c *Client) GetBundleIDProfiles(ctx context.Context, bundleID string, opts ...BundleIDProfilesOption) (*ProfilesResponse, error) {
+	bundleID = strings.TrimSpace(bundleID)
+	query := &bundleIDProfilesQuery{}
+	for _, opt := range opts {
+		opt(query)
+	}
+
+	path := fmt.Sprintf("/v1/bundleIds/%s/profiles", bundleID)
+	if query.nextURL != "" {
+		// Validate nextURL to prevent credential exfiltration
+		if err := validateNextURL(query.nextURL); err != nil {
+			return nil, fmt.Errorf("bundleIdProfiles: %w", err)
+		}
+		path = query.nextURL
+	} else if queryString := buildBundleIDProfilesQuery(query); queryString != "" {
+		path += "?" + queryString
+	}
+
+	data, err := c.do(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response ProfilesResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &response, nil
+}
+
 // CreateBundleIDCapability adds a capability to a bundle ID.
 func (c *Client) CreateBundleIDCapability(ctx context.Context, bundleID string, attrs BundleIDCapabilityCreateAttributes) (*BundleIDCapabilityResponse, error) {
 	bundleID = strings.TrimSpace(bundleID)
@@ -417,6 +449,64 @@ func (c *Client) CreateProfile(ctx context.Context, attrs ProfileCreateAttribute
 	return &response, nil
 }
 
+// GetProfileBundleIDRelationship retrieves the bundle ID linkage for a profile.
+func (c *Client) GetProfileBundleIDRelationship(ctx context.Context, profileID string) (*ProfileBundleIDLinkageResponse, error) {
+	profileID = strings.TrimSpace(profileID)
+	path := fmt.Sprintf("/v1/profiles/%s/relationships/bundleId", profileID)
+	data, err := c.do(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response ProfileBundleIDLinkageResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// GetProfileCertificatesRelationships retrieves certificate linkages for a profile.
+func (c *Client) GetProfileCertificatesRelationships(ctx context.Context, profileID string, opts ...LinkagesOption) (*ProfileCertificatesLinkagesResponse, error) {
+	return c.getProfileLinkages(ctx, profileID, "certificates", opts...)
+}
+
+// GetProfileDevicesRelationships retrieves device linkages for a profile.
+func (c *Client) GetProfileDevicesRelationships(ctx context.Context, profileID string, opts ...LinkagesOption) (*ProfileDevicesLinkagesResponse, error) {
+	return c.getProfileLinkages(ctx, profileID, "devices", opts...)
+}
+
+func (c *Client) getProfileLinkages(ctx context.Context, profileID, relationship string, opts ...LinkagesOption) (*LinkagesResponse, error) {
+	query := &linkagesQuery{}
+	for _, opt := range opts {
+		opt(query)
+	}
+
+	profileID = strings.TrimSpace(profileID)
+	path := fmt.Sprintf("/v1/profiles/%s/relationships/%s", profileID, relationship)
+	if query.nextURL != "" {
+		// Validate nextURL to prevent credential exfiltration
+		if err := validateNextURL(query.nextURL); err != nil {
+			return nil, fmt.Errorf("profile %s relationships: %w", relationship, err)
+		}
+		path = query.nextURL
+	} else if queryString := buildLinkagesQuery(query); queryString != "" {
+		path += "?" + queryString
+	}
+
+	data, err := c.do(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response LinkagesResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &response, nil
+}
+
 // DeleteProfile deletes a profile by ID.
 func (c *Client) DeleteProfile(ctx context.Context, id string) error {
 	id = strings.TrimSpace(id)
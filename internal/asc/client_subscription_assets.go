@@ -0,0 +1,337 @@
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// GetSubscriptionImages lists promotional images for a subscription.
+func (c *Client) GetSubscriptionImages(ctx context.Context, subID string) (*SubscriptionImagesResponse, error) {
+	subID = strings.TrimSpace(subID)
+	path := fmt.Sprintf("/v1/subscriptions/%s/images", subID)
+
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionImagesResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse subscription images response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// GetSubscriptionImage retrieves a subscription image by ID.
+func (c *Client) GetSubscriptionImage(ctx context.Context, imageID string) (*SubscriptionImageResponse, error) {
+	imageID = strings.TrimSpace(imageID)
+	path := fmt.Sprintf("/v1/subscriptionImages/%s", imageID)
+
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionImageResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse subscription image response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// CreateSubscriptionImage reserves a promotional image upload for a subscription.
+func (c *Client) CreateSubscriptionImage(ctx context.Context, subID, fileName string, fileSize int64) (*SubscriptionImageResponse, error) {
+	data, err := c.createSubscriptionAsset(ctx, ResourceTypeSubscriptionImages, "/v1/subscriptionImages", subID, fileName, fileSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionImageResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse subscription image response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// UpdateSubscriptionImage commits an uploaded subscription image.
+func (c *Client) UpdateSubscriptionImage(ctx context.Context, imageID string, uploaded bool, checksumHash string) (*SubscriptionImageResponse, error) {
+	data, err := c.commitSubscriptionAsset(ctx, ResourceTypeSubscriptionImages, "/v1/subscriptionImages", imageID, uploaded, checksumHash)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionImageResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse subscription image response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// DeleteSubscriptionImage deletes a subscription image.
+func (c *Client) DeleteSubscriptionImage(ctx context.Context, imageID string) error {
+	path := fmt.Sprintf("/v1/subscriptionImages/%s", strings.TrimSpace(imageID))
+	_, err := c.do(ctx, http.MethodDelete, path, nil)
+	return err
+}
+
+// GetSubscriptionReviewScreenshotForSubscription retrieves the review screenshot attached to a subscription.
+func (c *Client) GetSubscriptionReviewScreenshotForSubscription(ctx context.Context, subID string) (*SubscriptionReviewScreenshotResponse, error) {
+	subID = strings.TrimSpace(subID)
+	path := fmt.Sprintf("/v1/subscriptions/%s/appStoreReviewScreenshot", subID)
+
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionReviewScreenshotResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse subscription review screenshot response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// GetSubscriptionReviewScreenshot retrieves a subscription review screenshot by ID.
+func (c *Client) GetSubscriptionReviewScreenshot(ctx context.Context, screenshotID string) (*SubscriptionReviewScreenshotResponse, error) {
+	screenshotID = strings.TrimSpace(screenshotID)
+	path := fmt.Sprintf("/v1/subscriptionAppStoreReviewScreenshots/%s", screenshotID)
+
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionReviewScreenshotResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse subscription review screenshot response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// CreateSubscriptionReviewScreenshot reserves a review screenshot upload for a subscription.
+func (c *Client) CreateSubscriptionReviewScreenshot(ctx context.Context, subID, fileName string, fileSize int64) (*SubscriptionReviewScreenshotResponse, error) {
+	data, err := c.createSubscriptionAsset(ctx, ResourceTypeSubscriptionReviewScreenshots, "/v1/subscriptionAppStoreReviewScreenshots", subID, fileName, fileSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionReviewScreenshotResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse subscription review screenshot response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// UpdateSubscriptionReviewScreenshot commits an uploaded subscription review screenshot.
+func (c *Client) UpdateSubscriptionReviewScreenshot(ctx context.Context, screenshotID string, uploaded bool, checksumHash string) (*SubscriptionReviewScreenshotResponse, error) {
+	data, err := c.commitSubscriptionAsset(ctx, ResourceTypeSubscriptionReviewScreenshots, "/v1/subscriptionAppStoreReviewScreenshots", screenshotID, uploaded, checksumHash)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionReviewScreenshotResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse subscription review screenshot response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// DeleteSubscriptionReviewScreenshot deletes a subscription review screenshot.
+func (c *Client) DeleteSubscriptionReviewScreenshot(ctx context.Context, screenshotID string) error {
+	path := fmt.Sprintf("/v1/subscriptionAppStoreReviewScreenshots/%s", strings.TrimSpace(screenshotID))
+	_, err := c.do(ctx, http.MethodDelete, path, nil)
+	return err
+}
+
+// CreateSubscriptionSubmission submits a subscription for App Review.
+func (c *Client) CreateSubscriptionSubmission(ctx context.Context, subID string) (*SubscriptionSubmissionResponse, error) {
+	subID = strings.TrimSpace(subID)
+	if subID == "" {
+		return nil, fmt.Errorf("subscription ID is required")
+	}
+
+	payload := SubscriptionSubmissionCreateRequest{
+		Data: SubscriptionSubmissionCreateData{
+			Type: ResourceTypeSubscriptionSubmissions,
+			Relationships: SubscriptionAssetRelationships{
+				Subscription: Relationship{
+					Data: ResourceData{
+						Type: ResourceTypeSubscriptions,
+						ID:   subID,
+					},
+				},
+			},
+		},
+	}
+
+	body, err := BuildRequestBody(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.do(ctx, http.MethodPost, "/v1/subscriptionSubmissions", body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionSubmissionResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse subscription submission response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// CreateSubscriptionGroupSubmission submits a subscription group for App Review.
+func (c *Client) CreateSubscriptionGroupSubmission(ctx context.Context, groupID string) (*SubscriptionGroupSubmissionResponse, error) {
+	groupID = strings.TrimSpace(groupID)
+	if groupID == "" {
+		return nil, fmt.Errorf("subscription group ID is required")
+	}
+
+	payload := SubscriptionGroupSubmissionCreateRequest{
+		Data: SubscriptionGroupSubmissionCreateData{
+			Type: ResourceTypeSubscriptionGroupSubmissions,
+			Relationships: SubscriptionGroupSubmissionRelationships{
+				SubscriptionGroup: Relationship{
+					Data: ResourceData{
+						Type: ResourceTypeSubscriptionGroups,
+						ID:   groupID,
+					},
+				},
+			},
+		},
+	}
+
+	body, err := BuildRequestBody(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.do(ctx, http.MethodPost, "/v1/subscriptionGroupSubmissions", body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionGroupSubmissionResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse subscription group submission response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// GetSubscriptionGracePeriod retrieves the billing grace period settings for an app.
+func (c *Client) GetSubscriptionGracePeriod(ctx context.Context, appID string) (*SubscriptionGracePeriodResponse, error) {
+	appID = strings.TrimSpace(appID)
+	path := fmt.Sprintf("/v1/apps/%s/subscriptionGracePeriod", appID)
+
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionGracePeriodResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse subscription grace period response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// UpdateSubscriptionGracePeriod updates billing grace period settings.
+func (c *Client) UpdateSubscriptionGracePeriod(ctx context.Context, gracePeriodID string, attrs SubscriptionGracePeriodUpdateAttributes) (*SubscriptionGracePeriodResponse, error) {
+	gracePeriodID = strings.TrimSpace(gracePeriodID)
+	if gracePeriodID == "" {
+		return nil, fmt.Errorf("grace period ID is required")
+	}
+
+	payload := SubscriptionGracePeriodUpdateRequest{
+		Data: SubscriptionGracePeriodUpdateData{
+			Type:       ResourceTypeSubscriptionGracePeriods,
+			ID:         gracePeriodID,
+			Attributes: attrs,
+		},
+	}
+
+	body, err := BuildRequestBody(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.do(ctx, http.MethodPatch, fmt.Sprintf("/v1/subscriptionGracePeriods/%s", gracePeriodID), body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionGracePeriodResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse subscription grace period response: %w", err)
+	}
+
+	return &response, nil
+}
+
+func (c *Client) createSubscriptionAsset(ctx context.Context, resourceType ResourceType, path, subID, fileName string, fileSize int64) ([]byte, error) {
+	subID = strings.TrimSpace(subID)
+	if subID == "" {
+		return nil, fmt.Errorf("subscription ID is required")
+	}
+
+	payload := SubscriptionAssetCreateRequest{
+		Data: SubscriptionAssetCreateData{
+			Type: resourceType,
+			Attributes: SubscriptionAssetCreateAttributes{
+				FileName: fileName,
+				FileSize: fileSize,
+			},
+			Relationships: SubscriptionAssetRelationships{
+				Subscription: Relationship{
+					Data: ResourceData{
+						Type: ResourceTypeSubscriptions,
+						ID:   subID,
+					},
+				},
+			},
+		},
+	}
+
+	body, err := BuildRequestBody(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.do(ctx, http.MethodPost, path, body)
+}
+
+func (c *Client) commitSubscriptionAsset(ctx context.Context, resourceType ResourceType, basePath, assetID string, uploaded bool, checksumHash string) ([]byte, error) {
+	assetID = strings.TrimSpace(assetID)
+	payload := SubscriptionAssetUpdateRequest{
+		Data: SubscriptionAssetUpdateData{
+			Type: resourceType,
+			ID:   assetID,
+			Attributes: &SubscriptionAssetUpdateAttributes{
+				Uploaded:           &uploaded,
+				SourceFileChecksum: &checksumHash,
+			},
+		},
+	}
+
+	body, err := BuildRequestBody(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.do(ctx, http.MethodPatch, fmt.Sprintf("%s/%s", basePath, assetID), body)
+}
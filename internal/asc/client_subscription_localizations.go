@@ -0,0 +1,267 @@
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// GetSubscriptionLocalizations retrieves localizations for a subscription.
+func (c *Client) GetSubscriptionLocalizations(ctx context.Context, subID string, opts ...SubscriptionLocalizationsOption) (*SubscriptionLocalizationsResponse, error) {
+	query := &subscriptionLocalizationsQuery{}
+	for _, opt := range opts {
+		opt(query)
+	}
+
+	subID = strings.TrimSpace(subID)
+	path := fmt.Sprintf("/v1/subscriptions/%s/subscriptionLocalizations", subID)
+	if query.nextURL != "" {
+		// Validate nextURL to prevent credential exfiltration
+		if err := validateNextURL(query.nextURL); err != nil {
+			return nil, fmt.Errorf("subscriptionLocalizations: %w", err)
+		}
+		path = query.nextURL
+	} else if queryString := buildSubscriptionLocalizationsQuery(query); queryString != "" {
+		path += "?" + queryString
+	}
+
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionLocalizationsResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse subscription localizations response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// GetSubscriptionLocalization retrieves a subscription localization by ID.
+func (c *Client) GetSubscriptionLocalization(ctx context.Context, localizationID string) (*SubscriptionLocalizationResponse, error) {
+	path := fmt.Sprintf("/v1/subscriptionLocalizations/%s", strings.TrimSpace(localizationID))
+
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionLocalizationResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse subscription localization response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// CreateSubscriptionLocalization creates a subscription localization.
+func (c *Client) CreateSubscriptionLocalization(ctx context.Context, subID string, attrs SubscriptionLocalizationCreateAttributes) (*SubscriptionLocalizationResponse, error) {
+	subID = strings.TrimSpace(subID)
+	if subID == "" {
+		return nil, fmt.Errorf("subscription ID is required")
+	}
+
+	payload := SubscriptionLocalizationCreateRequest{
+		Data: SubscriptionLocalizationCreateData{
+			Type:       ResourceTypeSubscriptionLocalizations,
+			Attributes: attrs,
+			Relationships: SubscriptionLocalizationRelationships{
+				Subscription: Relationship{
+					Data: ResourceData{
+						Type: ResourceTypeSubscriptions,
+						ID:   subID,
+					},
+				},
+			},
+		},
+	}
+
+	body, err := BuildRequestBody(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.do(ctx, http.MethodPost, "/v1/subscriptionLocalizations", body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionLocalizationResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse subscription localization response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// UpdateSubscriptionLocalization updates a subscription localization.
+func (c *Client) UpdateSubscriptionLocalization(ctx context.Context, localizationID string, attrs SubscriptionLocalizationUpdateAttributes) (*SubscriptionLocalizationResponse, error) {
+	localizationID = strings.TrimSpace(localizationID)
+	if localizationID == "" {
+		return nil, fmt.Errorf("localization ID is required")
+	}
+
+	payload := SubscriptionLocalizationUpdateRequest{
+		Data: SubscriptionLocalizationUpdateData{
+			Type:       ResourceTypeSubscriptionLocalizations,
+			ID:         localizationID,
+			Attributes: attrs,
+		},
+	}
+
+	body, err := BuildRequestBody(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.do(ctx, http.MethodPatch, fmt.Sprintf("/v1/subscriptionLocalizations/%s", localizationID), body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionLocalizationResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse subscription localization response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// DeleteSubscriptionLocalization deletes a subscription localization.
+func (c *Client) DeleteSubscriptionLocalization(ctx context.Context, localizationID string) error {
+	path := fmt.Sprintf("/v1/subscriptionLocalizations/%s", strings.TrimSpace(localizationID))
+	_, err := c.do(ctx, http.MethodDelete, path, nil)
+	return err
+}
+
+// GetSubscriptionGroupLocalizations retrieves localizations for a subscription group.
+func (c *Client) GetSubscriptionGroupLocalizations(ctx context.Context, groupID string, opts ...SubscriptionLocalizationsOption) (*SubscriptionGroupLocalizationsResponse, error) {
+	query := &subscriptionLocalizationsQuery{}
+	for _, opt := range opts {
+		opt(query)
+	}
+
+	groupID = strings.TrimSpace(groupID)
+	path := fmt.Sprintf("/v1/subscriptionGroups/%s/subscriptionGroupLocalizations", groupID)
+	if query.nextURL != "" {
+		// Validate nextURL to prevent credential exfiltration
+		if err := validateNextURL(query.nextURL); err != nil {
+			return nil, fmt.Errorf("subscriptionGroupLocalizations: %w", err)
+		}
+		path = query.nextURL
+	} else if queryString := buildSubscriptionLocalizationsQuery(query); queryString != "" {
+		path += "?" + queryString
+	}
+
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionGroupLocalizationsResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse subscription group localizations response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// GetSubscriptionGroupLocalization retrieves a subscription group localization by ID.
+func (c *Client) GetSubscriptionGroupLocalization(ctx context.Context, localizationID string) (*SubscriptionGroupLocalizationResponse, error) {
+	path := fmt.Sprintf("/v1/subscriptionGroupLocalizations/%s", strings.TrimSpace(localizationID))
+
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionGroupLocalizationResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse subscription group localization response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// CreateSubscriptionGroupLocalization creates a subscription group localization.
+func (c *Client) CreateSubscriptionGroupLocalization(ctx context.Context, groupID string, attrs SubscriptionGroupLocalizationCreateAttributes) (*SubscriptionGroupLocalizationResponse, error) {
+	groupID = strings.TrimSpace(groupID)
+	if groupID == "" {
+		return nil, fmt.Errorf("subscription group ID is required")
+	}
+
+	payload := SubscriptionGroupLocalizationCreateRequest{
+		Data: SubscriptionGroupLocalizationCreateData{
+			Type:       ResourceTypeSubscriptionGroupLocalizations,
+			Attributes: attrs,
+			Relationships: SubscriptionGroupLocalizationRelationships{
+				SubscriptionGroup: Relationship{
+					Data: ResourceData{
+						Type: ResourceTypeSubscriptionGroups,
+						ID:   groupID,
+					},
+				},
+			},
+		},
+	}
+
+	body, err := BuildRequestBody(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.do(ctx, http.MethodPost, "/v1/subscriptionGroupLocalizations", body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionGroupLocalizationResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse subscription group localization response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// UpdateSubscriptionGroupLocalization updates a subscription group localization.
+func (c *Client) UpdateSubscriptionGroupLocalization(ctx context.Context, localizationID string, attrs SubscriptionGroupLocalizationUpdateAttributes) (*SubscriptionGroupLocalizationResponse, error) {
+	localizationID = strings.TrimSpace(localizationID)
+	if localizationID == "" {
+		return nil, fmt.Errorf("localization ID is required")
+	}
+
+	payload := SubscriptionGroupLocalizationUpdateRequest{
+		Data: SubscriptionGroupLocalizationUpdateData{
+			Type:       ResourceTypeSubscriptionGroupLocalizations,
+			ID:         localizationID,
+			Attributes: attrs,
+		},
+	}
+
+	body, err := BuildRequestBody(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.do(ctx, http.MethodPatch, fmt.Sprintf("/v1/subscriptionGroupLocalizations/%s", localizationID), body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionGroupLocalizationResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse subscription group localization response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// DeleteSubscriptionGroupLocalization deletes a subscription group localization.
+func (c *Client) DeleteSubscriptionGroupLocalization(ctx context.Context, localizationID string) error {
+	path := fmt.Sprintf("/v1/subscriptionGroupLocalizations/%s", strings.TrimSpace(localizationID))
+	_, err := c.do(ctx, http.MethodDelete, path, nil)
+	return err
+}
@@ -0,0 +1,284 @@
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// GetSubscriptionOfferCodes retrieves offer codes for a subscription.
+func (c *Client) GetSubscriptionOfferCodes(ctx context.Context, subID string, opts ...OfferCodesOption) (*SubscriptionOfferCodesResponse, error) {
+	query := &offerCodesQuery{}
+	for _, opt := range opts {
+		opt(query)
+	}
+
+	subID = strings.TrimSpace(subID)
+	path := fmt.Sprintf("/v1/subscriptions/%s/offerCodes", subID)
+	if query.nextURL != "" {
+		// Validate nextURL to prevent credential exfiltration
+		if err := validateNextURL(query.nextURL); err != nil {
+			return nil, fmt.Errorf("subscriptionOfferCodes: %w", err)
+		}
+		path = query.nextURL
+	} else if queryString := buildOfferCodesQuery(query); queryString != "" {
+		path += "?" + queryString
+	}
+
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionOfferCodesResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse subscription offer codes response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// GetSubscriptionOfferCode retrieves a subscription offer code by ID.
+func (c *Client) GetSubscriptionOfferCode(ctx context.Context, offerCodeID string) (*SubscriptionOfferCodeResponse, error) {
+	path := fmt.Sprintf("/v1/subscriptionOfferCodes/%s", strings.TrimSpace(offerCodeID))
+
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionOfferCodeResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse subscription offer code response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// CreateSubscriptionOfferCode creates a subscription offer code with per-territory prices.
+// Prices without a price point are sent as territory-only (free) prices.
+func (c *Client) CreateSubscriptionOfferCode(ctx context.Context, subID string, attrs SubscriptionOfferCodeCreateAttributes, prices []SubscriptionOfferPrice) (*SubscriptionOfferCodeResponse, error) {
+	subID = strings.TrimSpace(subID)
+	if subID == "" {
+		return nil, fmt.Errorf("subscription ID is required")
+	}
+	if len(prices) == 0 {
+		return nil, fmt.Errorf("at least one price is required")
+	}
+
+	refs := make([]ResourceData, 0, len(prices))
+	included := make([]SubscriptionOfferCodePriceResource, 0, len(prices))
+	for i, price := range prices {
+		territory := strings.ToUpper(strings.TrimSpace(price.Territory))
+		if territory == "" {
+			return nil, fmt.Errorf("price %d requires a territory", i+1)
+		}
+		localID := fmt.Sprintf("${local-price-%d}", i+1)
+		refs = append(refs, ResourceData{
+			Type: ResourceTypeSubscriptionOfferCodePrices,
+			ID:   localID,
+		})
+		priceResource := SubscriptionOfferCodePriceResource{
+			Type: ResourceTypeSubscriptionOfferCodePrices,
+			ID:   localID,
+			Relationships: SubscriptionOfferCodePriceRelationships{
+				Territory: Relationship{
+					Data: ResourceData{
+						Type: ResourceTypeTerritories,
+						ID:   territory,
+					},
+				},
+			},
+		}
+		if pricePointID := strings.TrimSpace(price.PricePointID); pricePointID != "" {
+			priceResource.Relationships.SubscriptionPricePoint = &Relationship{
+				Data: ResourceData{
+					Type: ResourceTypeSubscriptionPricePoints,
+					ID:   pricePointID,
+				},
+			}
+		}
+		included = append(included, priceResource)
+	}
+
+	payload := SubscriptionOfferCodeCreateRequest{
+		Data: SubscriptionOfferCodeCreateData{
+			Type:       ResourceTypeSubscriptionOfferCodes,
+			Attributes: attrs,
+			Relationships: SubscriptionOfferCodeRelationships{
+				Subscription: Relationship{
+					Data: ResourceData{
+						Type: ResourceTypeSubscriptions,
+						ID:   subID,
+					},
+				},
+				Prices: RelationshipList{Data: refs},
+			},
+		},
+		Included: included,
+	}
+
+	body, err := BuildRequestBody(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.do(ctx, http.MethodPost, "/v1/subscriptionOfferCodes", body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionOfferCodeResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse subscription offer code response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// UpdateSubscriptionOfferCodeActive activates or deactivates a subscription offer code.
+func (c *Client) UpdateSubscriptionOfferCodeActive(ctx context.Context, offerCodeID string, active bool) (*SubscriptionOfferCodeResponse, error) {
+	data, err := c.updateOfferCodeActive(ctx, ResourceTypeSubscriptionOfferCodes, "/v1/subscriptionOfferCodes", offerCodeID, active)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionOfferCodeResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse subscription offer code response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// GetSubscriptionOfferCodeCustomCodes retrieves custom codes for a subscription offer code.
+func (c *Client) GetSubscriptionOfferCodeCustomCodes(ctx context.Context, offerCodeID string, opts ...OfferCodesOption) (*SubscriptionOfferCodeCustomCodesResponse, error) {
+	query := &offerCodesQuery{}
+	for _, opt := range opts {
+		opt(query)
+	}
+
+	offerCodeID = strings.TrimSpace(offerCodeID)
+	path := fmt.Sprintf("/v1/subscriptionOfferCodes/%s/customCodes", offerCodeID)
+	if query.nextURL != "" {
+		// Validate nextURL to prevent credential exfiltration
+		if err := validateNextURL(query.nextURL); err != nil {
+			return nil, fmt.Errorf("subscriptionOfferCodeCustomCodes: %w", err)
+		}
+		path = query.nextURL
+	} else if queryString := buildOfferCodesQuery(query); queryString != "" {
+		path += "?" + queryString
+	}
+
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionOfferCodeCustomCodesResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse subscription offer code custom codes response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// CreateSubscriptionOfferCodeCustomCode creates a custom code for a subscription offer code.
+func (c *Client) CreateSubscriptionOfferCodeCustomCode(ctx context.Context, offerCodeID string, attrs OfferCodeCustomCodeCreateAttributes) (*SubscriptionOfferCodeCustomCodeResponse, error) {
+	data, err := c.createOfferCodeCustomCode(ctx, ResourceTypeSubscriptionOfferCodeCustomCodes, ResourceTypeSubscriptionOfferCodes, "/v1/subscriptionOfferCodeCustomCodes", offerCodeID, attrs)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionOfferCodeCustomCodeResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse subscription offer code custom code response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// UpdateSubscriptionOfferCodeCustomCodeActive activates or deactivates a subscription offer custom code.
+func (c *Client) UpdateSubscriptionOfferCodeCustomCodeActive(ctx context.Context, customCodeID string, active bool) (*SubscriptionOfferCodeCustomCodeResponse, error) {
+	data, err := c.updateOfferCodeActive(ctx, ResourceTypeSubscriptionOfferCodeCustomCodes, "/v1/subscriptionOfferCodeCustomCodes", customCodeID, active)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionOfferCodeCustomCodeResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse subscription offer code custom code response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// UpdateSubscriptionOfferCodeOneTimeUseCodeActive activates or deactivates a one-time use code batch.
+func (c *Client) UpdateSubscriptionOfferCodeOneTimeUseCodeActive(ctx context.Context, oneTimeUseCodeID string, active bool) (*SubscriptionOfferCodeOneTimeUseCodeResponse, error) {
+	data, err := c.updateOfferCodeActive(ctx, ResourceTypeSubscriptionOfferCodeOneTimeUseCodes, "/v1/subscriptionOfferCodeOneTimeUseCodes", oneTimeUseCodeID, active)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionOfferCodeOneTimeUseCodeResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &response, nil
+}
+
+func (c *Client) createOfferCodeCustomCode(ctx context.Context, resourceType, offerCodeType ResourceType, path, offerCodeID string, attrs OfferCodeCustomCodeCreateAttributes) ([]byte, error) {
+	offerCodeID = strings.TrimSpace(offerCodeID)
+	if offerCodeID == "" {
+		return nil, fmt.Errorf("offer code ID is required")
+	}
+
+	payload := OfferCodeCustomCodeCreateRequest{
+		Data: OfferCodeCustomCodeCreateData{
+			Type:       resourceType,
+			Attributes: attrs,
+			Relationships: OfferCodeCustomCodeRelationships{
+				OfferCode: Relationship{
+					Data: ResourceData{
+						Type: offerCodeType,
+						ID:   offerCodeID,
+					},
+				},
+			},
+		},
+	}
+
+	body, err := BuildRequestBody(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.do(ctx, http.MethodPost, path, body)
+}
+
+func (c *Client) updateOfferCodeActive(ctx context.Context, resourceType ResourceType, basePath, id string, active bool) ([]byte, error) {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return nil, fmt.Errorf("ID is required")
+	}
+
+	payload := OfferCodeActiveUpdateRequest{
+		Data: OfferCodeActiveUpdateData{
+			Type: resourceType,
+			ID:   id,
+			Attributes: OfferCodeActiveUpdateAttributes{
+				Active: active,
+			},
+		},
+	}
+
+	body, err := BuildRequestBody(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.do(ctx, http.MethodPatch, fmt.Sprintf("%s/%s", basePath, id), body)
+}
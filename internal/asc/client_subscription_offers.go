@@ -0,0 +1,338 @@
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// GetSubscriptionPricePoints retrieves price points for a subscription.
+func (c *Client) GetSubscriptionPricePoints(ctx context.Context, subID string, opts ...PricePointsOption) (*SubscriptionPricePointsResponse, error) {
+	query := &pricePointsQuery{}
+	for _, opt := range opts {
+		opt(query)
+	}
+
+	subID = strings.TrimSpace(subID)
+	path := fmt.Sprintf("/v1/subscriptions/%s/pricePoints", subID)
+	if query.nextURL != "" {
+		// Validate nextURL to prevent credential exfiltration
+		if err := validateNextURL(query.nextURL); err != nil {
+			return nil, fmt.Errorf("subscriptionPricePoints: %w", err)
+		}
+		path = query.nextURL
+	} else if queryString := buildPricePointsWithTerritoryQuery(query); queryString != "" {
+		path += "?" + queryString
+	}
+
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionPricePointsResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse subscription price points response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// GetSubscriptionPricePointEqualizations retrieves equivalent price points in
+// other territories for a subscription price point.
+func (c *Client) GetSubscriptionPricePointEqualizations(ctx context.Context, pricePointID string, opts ...PricePointsOption) (*SubscriptionPricePointsResponse, error) {
+	query := &pricePointsQuery{}
+	for _, opt := range opts {
+		opt(query)
+	}
+
+	pricePointID = strings.TrimSpace(pricePointID)
+	path := fmt.Sprintf("/v1/subscriptionPricePoints/%s/equalizations", pricePointID)
+	if query.nextURL != "" {
+		// Validate nextURL to prevent credential exfiltration
+		if err := validateNextURL(query.nextURL); err != nil {
+			return nil, fmt.Errorf("subscriptionPricePointEqualizations: %w", err)
+		}
+		path = query.nextURL
+	} else if queryString := buildPricePointsWithTerritoryQuery(query); queryString != "" {
+		path += "?" + queryString
+	}
+
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionPricePointsResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse subscription price point equalizations response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// GetSubscriptionIntroductoryOffers retrieves introductory offers for a subscription.
+func (c *Client) GetSubscriptionIntroductoryOffers(ctx context.Context, subID string, opts ...SubscriptionOffersOption) (*SubscriptionIntroductoryOffersResponse, error) {
+	query := &subscriptionOffersQuery{}
+	for _, opt := range opts {
+		opt(query)
+	}
+
+	subID = strings.TrimSpace(subID)
+	path := fmt.Sprintf("/v1/subscriptions/%s/introductoryOffers", subID)
+	if query.nextURL != "" {
+		// Validate nextURL to prevent credential exfiltration
+		if err := validateNextURL(query.nextURL); err != nil {
+			return nil, fmt.Errorf("subscriptionIntroductoryOffers: %w", err)
+		}
+		path = query.nextURL
+	} else if queryString := buildSubscriptionIntroductoryOffersQuery(query); queryString != "" {
+		path += "?" + queryString
+	}
+
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionIntroductoryOffersResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse subscription introductory offers response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// CreateSubscriptionIntroductoryOffer creates an introductory offer in one territory.
+func (c *Client) CreateSubscriptionIntroductoryOffer(ctx context.Context, subID string, attrs SubscriptionIntroductoryOfferAttributes, price SubscriptionOfferPrice) (*SubscriptionIntroductoryOfferResponse, error) {
+	subID = strings.TrimSpace(subID)
+	if subID == "" {
+		return nil, fmt.Errorf("subscription ID is required")
+	}
+
+	relationships := SubscriptionIntroductoryOfferRelationships{
+		Subscription: Relationship{
+			Data: ResourceData{
+				Type: ResourceTypeSubscriptions,
+				ID:   subID,
+			},
+		},
+	}
+	if territory := strings.ToUpper(strings.TrimSpace(price.Territory)); territory != "" {
+		relationships.Territory = &Relationship{
+			Data: ResourceData{
+				Type: ResourceTypeTerritories,
+				ID:   territory,
+			},
+		}
+	}
+	if pricePointID := strings.TrimSpace(price.PricePointID); pricePointID != "" {
+		relationships.SubscriptionPricePoint = &Relationship{
+			Data: ResourceData{
+				Type: ResourceTypeSubscriptionPricePoints,
+				ID:   pricePointID,
+			},
+		}
+	}
+
+	payload := SubscriptionIntroductoryOfferCreateRequest{
+		Data: SubscriptionIntroductoryOfferCreateData{
+			Type:          ResourceTypeSubscriptionIntroductoryOffers,
+			Attributes:    attrs,
+			Relationships: relationships,
+		},
+	}
+
+	body, err := BuildRequestBody(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.do(ctx, http.MethodPost, "/v1/subscriptionIntroductoryOffers", body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionIntroductoryOfferResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse subscription introductory offer response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// DeleteSubscriptionIntroductoryOffer deletes an introductory offer.
+func (c *Client) DeleteSubscriptionIntroductoryOffer(ctx context.Context, offerID string) error {
+	path := fmt.Sprintf("/v1/subscriptionIntroductoryOffers/%s", strings.TrimSpace(offerID))
+	_, err := c.do(ctx, http.MethodDelete, path, nil)
+	return err
+}
+
+// GetSubscriptionPromotionalOffers retrieves promotional offers for a subscription.
+func (c *Client) GetSubscriptionPromotionalOffers(ctx context.Context, subID string, opts ...SubscriptionOffersOption) (*SubscriptionPromotionalOffersResponse, error) {
+	query := &subscriptionOffersQuery{}
+	for _, opt := range opts {
+		opt(query)
+	}
+
+	subID = strings.TrimSpace(subID)
+	path := fmt.Sprintf("/v1/subscriptions/%s/promotionalOffers", subID)
+	if query.nextURL != "" {
+		// Validate nextURL to prevent credential exfiltration
+		if err := validateNextURL(query.nextURL); err != nil {
+			return nil, fmt.Errorf("subscriptionPromotionalOffers: %w", err)
+		}
+		path = query.nextURL
+	} else if queryString := buildSubscriptionOffersQuery(query); queryString != "" {
+		path += "?" + queryString
+	}
+
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionPromotionalOffersResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse subscription promotional offers response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// CreateSubscriptionPromotionalOffer creates a promotional offer with per-territory prices.
+func (c *Client) CreateSubscriptionPromotionalOffer(ctx context.Context, subID string, attrs SubscriptionPromotionalOfferAttributes, prices []SubscriptionOfferPrice) (*SubscriptionPromotionalOfferResponse, error) {
+	subID = strings.TrimSpace(subID)
+	if subID == "" {
+		return nil, fmt.Errorf("subscription ID is required")
+	}
+
+	relationships, included, err := buildPromotionalOfferPrices(prices)
+	if err != nil {
+		return nil, err
+	}
+	relationships.Subscription = &Relationship{
+		Data: ResourceData{
+			Type: ResourceTypeSubscriptions,
+			ID:   subID,
+		},
+	}
+
+	payload := SubscriptionPromotionalOfferCreateRequest{
+		Data: SubscriptionPromotionalOfferCreateData{
+			Type:          ResourceTypeSubscriptionPromotionalOffers,
+			Attributes:    attrs,
+			Relationships: relationships,
+		},
+		Included: included,
+	}
+
+	body, err := BuildRequestBody(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.do(ctx, http.MethodPost, "/v1/subscriptionPromotionalOffers", body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionPromotionalOfferResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse subscription promotional offer response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// UpdateSubscriptionPromotionalOfferPrices replaces the prices of a promotional offer.
+func (c *Client) UpdateSubscriptionPromotionalOfferPrices(ctx context.Context, offerID string, prices []SubscriptionOfferPrice) (*SubscriptionPromotionalOfferResponse, error) {
+	offerID = strings.TrimSpace(offerID)
+	if offerID == "" {
+		return nil, fmt.Errorf("promotional offer ID is required")
+	}
+
+	relationships, included, err := buildPromotionalOfferPrices(prices)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := SubscriptionPromotionalOfferUpdateRequest{
+		Data: SubscriptionPromotionalOfferUpdateData{
+			Type:          ResourceTypeSubscriptionPromotionalOffers,
+			ID:            offerID,
+			Relationships: relationships,
+		},
+		Included: included,
+	}
+
+	body, err := BuildRequestBody(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/v1/subscriptionPromotionalOffers/%s", offerID)
+	data, err := c.do(ctx, http.MethodPatch, path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionPromotionalOfferResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse subscription promotional offer response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// DeleteSubscriptionPromotionalOffer deletes a promotional offer.
+func (c *Client) DeleteSubscriptionPromotionalOffer(ctx context.Context, offerID string) error {
+	path := fmt.Sprintf("/v1/subscriptionPromotionalOffers/%s", strings.TrimSpace(offerID))
+	_, err := c.do(ctx, http.MethodDelete, path, nil)
+	return err
+}
+
+func buildPromotionalOfferPrices(prices []SubscriptionOfferPrice) (SubscriptionPromotionalOfferRelationships, []SubscriptionPromotionalOfferPriceResource, error) {
+	if len(prices) == 0 {
+		return SubscriptionPromotionalOfferRelationships{}, nil, fmt.Errorf("at least one price is required")
+	}
+
+	refs := make([]ResourceData, 0, len(prices))
+	included := make([]SubscriptionPromotionalOfferPriceResource, 0, len(prices))
+	for i, price := range prices {
+		territory := strings.ToUpper(strings.TrimSpace(price.Territory))
+		pricePointID := strings.TrimSpace(price.PricePointID)
+		if territory == "" || pricePointID == "" {
+			return SubscriptionPromotionalOfferRelationships{}, nil, fmt.Errorf("price %d requires a territory and price point", i+1)
+		}
+		localID := fmt.Sprintf("${local-price-%d}", i+1)
+		refs = append(refs, ResourceData{
+			Type: ResourceTypeSubscriptionPromotionalOfferPrices,
+			ID:   localID,
+		})
+		included = append(included, SubscriptionPromotionalOfferPriceResource{
+			Type: ResourceTypeSubscriptionPromotionalOfferPrices,
+			ID:   localID,
+			Relationships: SubscriptionPromotionalOfferPriceRelationships{
+				Territory: Relationship{
+					Data: ResourceData{
+						Type: ResourceTypeTerritories,
+						ID:   territory,
+					},
+				},
+				SubscriptionPricePoint: Relationship{
+					Data: ResourceData{
+						Type: ResourceTypeSubscriptionPricePoints,
+						ID:   pricePointID,
+					},
+				},
+			},
+		})
+	}
+
+	return SubscriptionPromotionalOfferRelationships{
+		Prices: RelationshipList{Data: refs},
+	}, included, nil
+}
@@ -64,11 +64,27 @@ const (
 	ResourceTypeAnalyticsReportSegments               ResourceType = "analyticsReportSegments"
 	ResourceTypeInAppPurchases                        ResourceType = "inAppPurchases"
 	ResourceTypeInAppPurchaseLocalizations            ResourceType = "inAppPurchaseLocalizations"
+	ResourceTypeInAppPurchasePriceSchedules           ResourceType = "inAppPurchasePriceSchedules"
+	ResourceTypeInAppPurchasePrices                   ResourceType = "inAppPurchasePrices"
+	ResourceTypeInAppPurchasePricePoints              ResourceType = "inAppPurchasePricePoints"
+	ResourceTypeInAppPurchaseAvailabilities           ResourceType = "inAppPurchaseAvailabilities"
+	ResourceTypeInAppPurchaseReviewScreenshots        ResourceType = "inAppPurchaseAppStoreReviewScreenshots"
+	ResourceTypeInAppPurchaseImages                   ResourceType = "inAppPurchaseImages"
+	ResourceTypeInAppPurchaseContents                 ResourceType = "inAppPurchaseContents"
+	ResourceTypeInAppPurchaseSubmissions              ResourceType = "inAppPurchaseSubmissions"
+	ResourceTypeInAppPurchaseOfferCodes               ResourceType = "inAppPurchaseOfferCodes"
+	ResourceTypeInAppPurchaseOfferCodeCustomCodes     ResourceType = "inAppPurchaseOfferCodeCustomCodes"
+	ResourceTypeInAppPurchaseOfferPrices              ResourceType = "inAppPurchaseOfferPrices"
 	ResourceTypeSubscriptionGroups                    ResourceType = "subscriptionGroups"
 	ResourceTypeSubscriptions                         ResourceType = "subscriptions"
 	ResourceTypeSubscriptionPrices                    ResourceType = "subscriptionPrices"
 	ResourceTypeSubscriptionAvailabilities            ResourceType = "subscriptionAvailabilities"
 	ResourceTypeSubscriptionPricePoints               ResourceType = "subscriptionPricePoints"
+	ResourceTypeSubscriptionIntroductoryOffers        ResourceType = "subscriptionIntroductoryOffers"
+	ResourceTypeSubscriptionPromotionalOffers         ResourceType = "subscriptionPromotionalOffers"
+	ResourceTypeSubscriptionPromotionalOfferPrices    ResourceType = "subscriptionPromotionalOfferPrices"
+	ResourceTypeWinBackOffers                         ResourceType = "winBackOffers"
+	ResourceTypeWinBackOfferPrices                    ResourceType = "winBackOfferPrices"
 	ResourceTypeDevices                               ResourceType = "devices"
 	ResourceTypeProfiles                              ResourceType = "profiles"
 	ResourceTypeTerritories                           ResourceType = "territories"
@@ -82,6 +98,15 @@ const (
 	ResourceTypeActors                                ResourceType = "actors"
 	ResourceTypeSubscriptionOfferCodes                ResourceType = "subscriptionOfferCodes"
 	ResourceTypeSubscriptionOfferCodeOneTimeUseCodes  ResourceType = "subscriptionOfferCodeOneTimeUseCodes"
+	ResourceTypeSubscriptionOfferCodeCustomCodes      ResourceType = "subscriptionOfferCodeCustomCodes"
+	ResourceTypeSubscriptionOfferCodePrices           ResourceType = "subscriptionOfferCodePrices"
+	ResourceTypeSubscriptionLocalizations             ResourceType = "subscriptionLocalizations"
+	ResourceTypeSubscriptionGroupLocalizations        ResourceType = "subscriptionGroupLocalizations"
+	ResourceTypeSubscriptionImages                    ResourceType = "subscriptionImages"
+	ResourceTypeSubscriptionReviewScreenshots         ResourceType = "subscriptionAppStoreReviewScreenshots"
+	ResourceTypeSubscriptionSubmissions               ResourceType = "subscriptionSubmissions"
+	ResourceTypeSubscriptionGroupSubmissions          ResourceType = "subscriptionGroupSubmissions"
+	ResourceTypeSubscriptionGracePeriods              ResourceType = "subscriptionGracePeriods"
 	ResourceTypeNominations                           ResourceType = "nominations"
 	ResourceTypeGameCenterDetails                     ResourceType = "gameCenterDetails"
 	ResourceTypeGameCenterAchievements                ResourceType = "gameCenterAchievements"
@@ -172,6 +197,7 @@ type UTI string
 
 const (
 	UTIIPA UTI = "com.apple.ipa"
+	UTIPKG UTI = "com.apple.pkg"
 )
 
 // Relationship represents a generic API relationship.
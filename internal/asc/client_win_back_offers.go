@@ -0,0 +1,179 @@
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// GetWinBackOffers retrieves win-back offers for a subscription.
+func (c *Client) GetWinBackOffers(ctx context.Context, subID string, opts ...SubscriptionOffersOption) (*WinBackOffersResponse, error) {
+	query := &subscriptionOffersQuery{}
+	for _, opt := range opts {
+		opt(query)
+	}
+
+	subID = strings.TrimSpace(subID)
+	path := fmt.Sprintf("/v1/subscriptions/%s/winBackOffers", subID)
+	if query.nextURL != "" {
+		// Validate nextURL to prevent credential exfiltration
+		if err := validateNextURL(query.nextURL); err != nil {
+			return nil, fmt.Errorf("winBackOffers: %w", err)
+		}
+		path = query.nextURL
+	} else if queryString := buildSubscriptionOffersQuery(query); queryString != "" {
+		path += "?" + queryString
+	}
+
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response WinBackOffersResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse win-back offers response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// GetWinBackOffer retrieves a win-back offer by ID.
+func (c *Client) GetWinBackOffer(ctx context.Context, offerID string) (*WinBackOfferResponse, error) {
+	path := fmt.Sprintf("/v1/winBackOffers/%s", strings.TrimSpace(offerID))
+
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response WinBackOfferResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse win-back offer response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// CreateWinBackOffer creates a win-back offer with per-territory prices.
+// Prices without a price point are sent as territory-only (free) prices.
+func (c *Client) CreateWinBackOffer(ctx context.Context, subID string, attrs WinBackOfferAttributes, prices []SubscriptionOfferPrice) (*WinBackOfferResponse, error) {
+	subID = strings.TrimSpace(subID)
+	if subID == "" {
+		return nil, fmt.Errorf("subscription ID is required")
+	}
+	if len(prices) == 0 {
+		return nil, fmt.Errorf("at least one price is required")
+	}
+
+	refs := make([]ResourceData, 0, len(prices))
+	included := make([]WinBackOfferPriceResource, 0, len(prices))
+	for i, price := range prices {
+		territory := strings.ToUpper(strings.TrimSpace(price.Territory))
+		if territory == "" {
+			return nil, fmt.Errorf("price %d requires a territory", i+1)
+		}
+		localID := fmt.Sprintf("${local-price-%d}", i+1)
+		refs = append(refs, ResourceData{
+			Type: ResourceTypeWinBackOfferPrices,
+			ID:   localID,
+		})
+		priceResource := WinBackOfferPriceResource{
+			Type: ResourceTypeWinBackOfferPrices,
+			ID:   localID,
+			Relationships: WinBackOfferPriceRelationships{
+				Territory: Relationship{
+					Data: ResourceData{
+						Type: ResourceTypeTerritories,
+						ID:   territory,
+					},
+				},
+			},
+		}
+		if pricePointID := strings.TrimSpace(price.PricePointID); pricePointID != "" {
+			priceResource.Relationships.SubscriptionPricePoint = &Relationship{
+				Data: ResourceData{
+					Type: ResourceTypeSubscriptionPricePoints,
+					ID:   pricePointID,
+				},
+			}
+		}
+		included = append(included, priceResource)
+	}
+
+	payload := WinBackOfferCreateRequest{
+		Data: WinBackOfferCreateData{
+			Type:       ResourceTypeWinBackOffers,
+			Attributes: attrs,
+			Relationships: WinBackOfferRelationships{
+				Subscription: Relationship{
+					Data: ResourceData{
+						Type: ResourceTypeSubscriptions,
+						ID:   subID,
+					},
+				},
+				Prices: RelationshipList{Data: refs},
+			},
+		},
+		Included: included,
+	}
+
+	body, err := BuildRequestBody(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.do(ctx, http.MethodPost, "/v1/winBackOffers", body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response WinBackOfferResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse win-back offer response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// UpdateWinBackOffer updates a win-back offer.
+func (c *Client) UpdateWinBackOffer(ctx context.Context, offerID string, attrs WinBackOfferUpdateAttributes) (*WinBackOfferResponse, error) {
+	offerID = strings.TrimSpace(offerID)
+	if offerID == "" {
+		return nil, fmt.Errorf("win-back offer ID is required")
+	}
+
+	payload := WinBackOfferUpdateRequest{
+		Data: WinBackOfferUpdateData{
+			Type:       ResourceTypeWinBackOffers,
+			ID:         offerID,
+			Attributes: attrs,
+		},
+	}
+
+	body, err := BuildRequestBody(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.do(ctx, http.MethodPatch, fmt.Sprintf("/v1/winBackOffers/%s", offerID), body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response WinBackOfferResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse win-back offer response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// DeleteWinBackOffer deletes a win-back offer.
+func (c *Client) DeleteWinBackOffer(ctx context.Context, offerID string) error {
+	path := fmt.Sprintf("/v1/winBackOffers/%s", strings.TrimSpace(offerID))
+	_, err := c.do(ctx, http.MethodDelete, path, nil)
+	return err
+}
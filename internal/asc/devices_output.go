@@ -12,6 +12,25 @@ type DeviceLocalUDIDResult struct {
 	Platform string `json:"platform"`
 }
 
+// DeviceRegisterBatchItem describes one device from a bulk registration file.
+type DeviceRegisterBatchItem struct {
+	UDID     string `json:"udid"`
+	Name     string `json:"name"`
+	Platform string `json:"platform"`
+	Status   string `json:"status"`
+	ID       string `json:"id,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+// DeviceRegisterBatchResult represents CLI output for bulk device registration.
+type DeviceRegisterBatchResult struct {
+	File       string                    `json:"file"`
+	Registered int                       `json:"registered"`
+	Skipped    int                       `json:"skipped"`
+	Failed     int                       `json:"failed"`
+	Devices    []DeviceRegisterBatchItem `json:"devices"`
+}
+
 func printDeviceLocalUDIDTable(result *DeviceLocalUDIDResult) error {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 	fmt.Fprintln(w, "UDID\tPlatform")
@@ -29,6 +48,38 @@ func printDeviceLocalUDIDMarkdown(result *DeviceLocalUDIDResult) error {
 	return nil
 }
 
+func printDeviceRegisterBatchResultTable(result *DeviceRegisterBatchResult) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "UDID\tName\tPlatform\tStatus\tID\tError")
+	for _, item := range result.Devices {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			item.UDID,
+			compactWhitespace(item.Name),
+			item.Platform,
+			item.Status,
+			item.ID,
+			compactWhitespace(item.Error),
+		)
+	}
+	return w.Flush()
+}
+
+func printDeviceRegisterBatchResultMarkdown(result *DeviceRegisterBatchResult) error {
+	fmt.Fprintln(os.Stdout, "| UDID | Name | Platform | Status | ID | Error |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- | --- |")
+	for _, item := range result.Devices {
+		fmt.Fprintf(os.Stdout, "| %s | %s | %s | %s | %s | %s |\n",
+			escapeMarkdown(item.UDID),
+			escapeMarkdown(item.Name),
+			escapeMarkdown(item.Platform),
+			escapeMarkdown(item.Status),
+			escapeMarkdown(item.ID),
+			escapeMarkdown(item.Error),
+		)
+	}
+	return nil
+}
+
 func printDevicesTable(resp *DevicesResponse) error {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 	fmt.Fprintln(w, "ID\tName\tUDID\tPlatform\tStatus\tClass\tModel\tAdded")
@@ -0,0 +1,46 @@
+package asc
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+)
+
+// SQLiteExportSource describes one report file or API collection loaded by an export.
+type SQLiteExportSource struct {
+	Source string `json:"source"`
+	Table  string `json:"table"`
+	Rows   int    `json:"rows"`
+	Status string `json:"status"`
+}
+
+// SQLiteExportResult represents CLI output for SQLite exports.
+type SQLiteExportResult struct {
+	Database string               `json:"database"`
+	Sources  []SQLiteExportSource `json:"sources"`
+}
+
+func printSQLiteExportResultTable(result *SQLiteExportResult) error {
+	fmt.Fprintf(os.Stdout, "Database: %s\n\n", result.Database)
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Source\tTable\tRows\tStatus")
+	for _, source := range result.Sources {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%s\n", source.Source, source.Table, source.Rows, source.Status)
+	}
+	return w.Flush()
+}
+
+func printSQLiteExportResultMarkdown(result *SQLiteExportResult) error {
+	fmt.Fprintf(os.Stdout, "**Database:** %s\n\n", escapeMarkdown(result.Database))
+	fmt.Fprintln(os.Stdout, "| Source | Table | Rows | Status |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- |")
+	for _, source := range result.Sources {
+		fmt.Fprintf(os.Stdout, "| %s | %s | %d | %s |\n",
+			escapeMarkdown(source.Source),
+			escapeMarkdown(source.Table),
+			source.Rows,
+			escapeMarkdown(source.Status),
+		)
+	}
+	return nil
+}
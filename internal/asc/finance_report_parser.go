@@ -0,0 +1,187 @@
+package asc
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// FinanceReportRow is a row from a FINANCIAL or FINANCE_DETAIL report.
+// Transaction and settlement dates, order type and region are only present in
+// FINANCE_DETAIL reports; start and end dates only in FINANCIAL reports.
+type FinanceReportRow struct {
+	StartDate             string  `json:"startDate,omitempty"`
+	EndDate               string  `json:"endDate,omitempty"`
+	TransactionDate       string  `json:"transactionDate,omitempty"`
+	SettlementDate        string  `json:"settlementDate,omitempty"`
+	VendorIdentifier      string  `json:"vendorIdentifier"`
+	Quantity              float64 `json:"quantity"`
+	PartnerShare          float64 `json:"partnerShare"`
+	ExtendedPartnerShare  float64 `json:"extendedPartnerShare"`
+	PartnerShareCurrency  string  `json:"partnerShareCurrency"`
+	SalesOrReturn         string  `json:"salesOrReturn,omitempty"`
+	AppleIdentifier       string  `json:"appleIdentifier,omitempty"`
+	Developer             string  `json:"developer,omitempty"`
+	Title                 string  `json:"title"`
+	ProductTypeIdentifier string  `json:"productTypeIdentifier"`
+	CountryOfSale         string  `json:"countryOfSale"`
+	PreOrderFlag          string  `json:"preOrderFlag,omitempty"`
+	PromoCode             string  `json:"promoCode,omitempty"`
+	CustomerPrice         float64 `json:"customerPrice"`
+	CustomerCurrency      string  `json:"customerCurrency,omitempty"`
+	OrderType             string  `json:"orderType,omitempty"`
+	Region                string  `json:"region,omitempty"`
+}
+
+// FinanceExchangeRate is a row from the payment summary at the end of a finance
+// report, giving the rate used to convert a region's proceeds into the bank
+// account currency.
+type FinanceExchangeRate struct {
+	Region              string  `json:"region"`
+	Currency            string  `json:"currency"`
+	TotalOwed           float64 `json:"totalOwed"`
+	ExchangeRate        float64 `json:"exchangeRate"`
+	Proceeds            float64 `json:"proceeds"`
+	BankAccountCurrency string  `json:"bankAccountCurrency"`
+}
+
+// ParsedFinanceReport holds the typed rows and exchange rates of a finance report.
+type ParsedFinanceReport struct {
+	ReportType    FinanceReportType     `json:"reportType"`
+	Rows          []FinanceReportRow    `json:"rows"`
+	ExchangeRates []FinanceExchangeRate `json:"exchangeRates,omitempty"`
+}
+
+// ParseFinanceReport parses a FINANCIAL or FINANCE_DETAIL report in TSV or
+// gzip-compressed TSV form, including the exchange-rate summary when present.
+// An empty reportType detects the layout from the header row.
+func ParseFinanceReport(r io.Reader, reportType FinanceReportType) (*ParsedFinanceReport, error) {
+	table, err := readReportTable(r)
+	if err != nil {
+		return nil, err
+	}
+
+	isHeader := func(columns reportColumns) bool {
+		return columns.has("Quantity", "Extended Partner Share", "Partner Share Currency", "Country Of Sale")
+	}
+	report := &ParsedFinanceReport{ReportType: reportType}
+	found, err := table.eachSectionRow(isHeader, func(columns reportColumns, row []string) error {
+		if report.ReportType == "" {
+			report.ReportType = FinanceReportTypeFinancial
+			if columns.has("Transaction Date") {
+				report.ReportType = FinanceReportTypeFinanceDetail
+			}
+		}
+		parsed, err := parseFinanceReportRow(columns, row)
+		if err != nil {
+			return err
+		}
+		report.Rows = append(report.Rows, parsed)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("finance report header not found")
+	}
+	if report.ReportType == "" {
+		report.ReportType = FinanceReportTypeFinancial
+	}
+
+	isRateHeader := func(columns reportColumns) bool {
+		return columns.has("Exchange Rate", "Bank Account Currency")
+	}
+	if _, err := table.eachSectionRow(isRateHeader, func(columns reportColumns, row []string) error {
+		rate, ok, err := parseFinanceExchangeRate(columns, row)
+		if err != nil || !ok {
+			return err
+		}
+		report.ExchangeRates = append(report.ExchangeRates, rate)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+func parseFinanceReportRow(columns reportColumns, row []string) (FinanceReportRow, error) {
+	parsed := FinanceReportRow{
+		StartDate:             columns.value(row, "Start Date"),
+		EndDate:               columns.value(row, "End Date"),
+		TransactionDate:       columns.value(row, "Transaction Date"),
+		SettlementDate:        columns.value(row, "Settlement Date"),
+		VendorIdentifier:      columns.value(row, "Vendor Identifier", "SKU"),
+		PartnerShareCurrency:  columns.value(row, "Partner Share Currency"),
+		SalesOrReturn:         columns.value(row, "Sales or Return"),
+		AppleIdentifier:       columns.value(row, "Apple Identifier"),
+		Developer:             columns.value(row, "Artist/Show/Developer/Author", "Developer Name"),
+		Title:                 columns.value(row, "Title"),
+		ProductTypeIdentifier: columns.value(row, "Product Type Identifier"),
+		CountryOfSale:         columns.value(row, "Country Of Sale"),
+		PreOrderFlag:          columns.value(row, "Pre-order Flag"),
+		PromoCode:             columns.value(row, "Promo Code"),
+		CustomerCurrency:      columns.value(row, "Customer Currency"),
+		OrderType:             columns.value(row, "Order Type"),
+		Region:                columns.value(row, "Region"),
+	}
+	var err error
+	if parsed.Quantity, err = columns.number(row, "Quantity"); err != nil {
+		return FinanceReportRow{}, err
+	}
+	if parsed.PartnerShare, err = columns.number(row, "Partner Share"); err != nil {
+		return FinanceReportRow{}, err
+	}
+	if parsed.ExtendedPartnerShare, err = columns.number(row, "Extended Partner Share"); err != nil {
+		return FinanceReportRow{}, err
+	}
+	if parsed.CustomerPrice, err = columns.number(row, "Customer Price"); err != nil {
+		return FinanceReportRow{}, err
+	}
+	return parsed, nil
+}
+
+// parseFinanceExchangeRate reads a payment summary row. The region column holds
+// values like "Americas (USD)"; rows without a rate are skipped.
+func parseFinanceExchangeRate(columns reportColumns, row []string) (FinanceExchangeRate, bool, error) {
+	regionValue := columns.value(row, "Country or Region (Currency)", "Region (Currency)")
+	if regionValue == "" && len(row) > 0 {
+		regionValue = strings.TrimSpace(row[0])
+	}
+	region, currency := splitFinanceRegionCurrency(regionValue)
+	if explicit := columns.value(row, "Partner Share Currency", "Currency"); explicit != "" {
+		currency = explicit
+	}
+	if columns.value(row, "Exchange Rate") == "" || currency == "" {
+		return FinanceExchangeRate{}, false, nil
+	}
+
+	rate := FinanceExchangeRate{
+		Region:              region,
+		Currency:            strings.ToUpper(currency),
+		BankAccountCurrency: strings.ToUpper(columns.value(row, "Bank Account Currency")),
+	}
+	var err error
+	if rate.ExchangeRate, err = columns.number(row, "Exchange Rate"); err != nil {
+		return FinanceExchangeRate{}, false, err
+	}
+	if rate.TotalOwed, err = columns.number(row, "Total Owed"); err != nil {
+		return FinanceExchangeRate{}, false, err
+	}
+	if rate.Proceeds, err = columns.number(row, "Proceeds"); err != nil {
+		return FinanceExchangeRate{}, false, err
+	}
+	if rate.ExchangeRate <= 0 {
+		return FinanceExchangeRate{}, false, nil
+	}
+	return rate, true, nil
+}
+
+func splitFinanceRegionCurrency(value string) (string, string) {
+	open := strings.LastIndex(value, "(")
+	closing := strings.LastIndex(value, ")")
+	if open < 0 || closing < open {
+		return value, ""
+	}
+	return strings.TrimSpace(value[:open]), strings.TrimSpace(value[open+1 : closing])
+}
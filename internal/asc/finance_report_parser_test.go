@@ -0,0 +1,141 @@
+package asc
+
+import (
+	"strings"
+	"testing"
+)
+
+const testFinancialReport = "Start Date\tEnd Date\tUPC\tISRC/ISBN\tVendor Identifier\tQuantity\tPartner Share\tExtended Partner Share\tPartner Share Currency\tSales or Return\tApple Identifier\tArtist/Show/Developer/Author\tTitle\tLabel/Studio/Network/Developer/Publisher\tGrid\tProduct Type Identifier\tISAN/Other Identifier\tCountry Of Sale\tPre-order Flag\tPromo Code\tCustomer Price\tCustomer Currency\n" +
+	"11/30/2025\t01/03/2026\t\t\tcom.example.app\t10\t0.70\t7.00\tUSD\tS\t123\tExample Inc\tExample\t\t\t1F\t\tUS\t\t\t0.99\tUSD\n" +
+	"11/30/2025\t01/03/2026\t\t\tcom.example.app\t4\t0.85\t3.40\tEUR\tS\t123\tExample Inc\tExample\t\t\t1F\t\tDE\t\t\t0.99\tEUR\n" +
+	"11/30/2025\t01/03/2026\t\t\tcom.example.pro\t2\t150\t300\tJPY\tS\t456\tExample Inc\tExample Pro\t\t\tIA1\t\tJP\t\t\t200\tJPY\n" +
+	"11/30/2025\t01/03/2026\t\t\tcom.example.pro\t1\t1.00\t1.00\tCHF\tS\t456\tExample Inc\tExample Pro\t\t\tIA1\t\tCH\t\t\t1.50\tCHF\n" +
+	"Total_Rows\t4\n" +
+	"Total_Amount\t311.40\n" +
+	"Total_Units\t17\n" +
+	"\n" +
+	"Country or Region (Currency)\tBeginning Balance\tEarned\tPre-Tax Subtotal\tInput Tax\tAdjustments\tWithholding Tax\tTotal Owed\tExchange Rate\tProceeds\tBank Account Currency\n" +
+	"Americas (USD)\t0\t7.00\t7.00\t0\t0\t0\t7.00\t1.00000\t7.00\tUSD\n" +
+	"Euro-Zone (EUR)\t0\t3.40\t3.40\t0\t0\t0\t3.40\t1.10000\t3.74\tUSD\n" +
+	"Japan (JPY)\t0\t300\t300\t0\t0\t0\t300\t0.00700\t2.10\tUSD\n"
+
+func TestParseFinanceReport_Financial(t *testing.T) {
+	report, err := ParseFinanceReport(strings.NewReader(testFinancialReport), "")
+	if err != nil {
+		t.Fatalf("ParseFinanceReport() error: %v", err)
+	}
+	if report.ReportType != FinanceReportTypeFinancial {
+		t.Fatalf("expected FINANCIAL, got %s", report.ReportType)
+	}
+	if len(report.Rows) != 4 {
+		t.Fatalf("expected 4 rows (totals excluded), got %d", len(report.Rows))
+	}
+	row := report.Rows[2]
+	if row.VendorIdentifier != "com.example.pro" || row.Quantity != 2 || row.ExtendedPartnerShare != 300 || row.PartnerShareCurrency != "JPY" || row.CountryOfSale != "JP" || row.Developer != "Example Inc" {
+		t.Fatalf("unexpected row: %+v", row)
+	}
+	if len(report.ExchangeRates) != 3 {
+		t.Fatalf("expected 3 exchange rates, got %+v", report.ExchangeRates)
+	}
+	if rate := report.ExchangeRates[1]; rate.Region != "Euro-Zone" || rate.Currency != "EUR" || rate.ExchangeRate != 1.1 || rate.BankAccountCurrency != "USD" || rate.Proceeds != 3.74 {
+		t.Fatalf("unexpected rate: %+v", rate)
+	}
+}
+
+func TestParseFinanceReport_FinanceDetail(t *testing.T) {
+	report := "iTunes Connect - Payments and Financial Reports\t(December, 2025)\n" +
+		"\n" +
+		"Transaction Date\tSettlement Date\tApple Identifier\tSKU\tTitle\tDeveloper Name\tProduct Type Identifier\tCountry of Sale\tQuantity\tPartner Share\tExtended Partner Share\tPartner Share Currency\tCustomer Price\tCustomer Currency\tSales or Return\tPromo Code\tOrder Type\tRegion\n" +
+		"12/01/2025\t12/03/2025\t123\tcom.example.app\tExample\tExample Inc\t1F\tUS\t1\t0.70\t0.70\tUSD\t0.99\tUSD\tS\t\t\tAmericas\n" +
+		"12/02/2025\t12/03/2025\t123\tcom.example.app\tExample\tExample Inc\t1F\tGB\t1\t0.66\t0.66\tGBP\t0.99\tGBP\tS\t\t\tUnited Kingdom\n"
+
+	parsed, err := ParseFinanceReport(strings.NewReader(report), "")
+	if err != nil {
+		t.Fatalf("ParseFinanceReport() error: %v", err)
+	}
+	if parsed.ReportType != FinanceReportTypeFinanceDetail {
+		t.Fatalf("expected FINANCE_DETAIL, got %s", parsed.ReportType)
+	}
+	if len(parsed.Rows) != 2 || parsed.Rows[1].TransactionDate != "12/02/2025" || parsed.Rows[1].VendorIdentifier != "com.example.app" || parsed.Rows[1].Region != "United Kingdom" {
+		t.Fatalf("unexpected rows: %+v", parsed.Rows)
+	}
+	if len(parsed.ExchangeRates) != 0 {
+		t.Fatalf("expected no exchange rates, got %+v", parsed.ExchangeRates)
+	}
+}
+
+func TestParseFinanceReport_MissingHeader(t *testing.T) {
+	if _, err := ParseFinanceReport(strings.NewReader("SKU\tUnits\n"), ""); err == nil || !strings.Contains(err.Error(), "finance report header not found") {
+		t.Fatalf("expected header error, got %v", err)
+	}
+}
+
+func TestReportSummary_FinanceConvertsWithReportRates(t *testing.T) {
+	report, err := ParseFinanceReport(strings.NewReader(testFinancialReport), "")
+	if err != nil {
+		t.Fatalf("ParseFinanceReport() error: %v", err)
+	}
+	builder := NewReportSummaryBuilder(string(report.ReportType), "")
+	builder.AddFinanceReport(report)
+	summary := builder.Summary()
+
+	if summary.ReportingCurrency != "USD" {
+		t.Fatalf("expected bank account currency, got %q", summary.ReportingCurrency)
+	}
+	if len(summary.UnconvertedCurrencies) != 1 || summary.UnconvertedCurrencies[0] != "CHF" {
+		t.Fatalf("expected CHF to stay unconverted, got %v", summary.UnconvertedCurrencies)
+	}
+	// 7.00 + 3.40*1.1 + 300*0.007 = 12.84 USD, plus 1 CHF unconverted.
+	if len(summary.Totals) != 2 || summary.Totals[0].Currency != "USD" || summary.Totals[0].Proceeds != 12.84 || summary.Totals[1].Currency != "CHF" {
+		t.Fatalf("unexpected totals: %+v", summary.Totals)
+	}
+	if got := summary.BySKU[0]; got.Key != "com.example.app" || got.Currency != "USD" || got.Proceeds != 10.74 || got.Units != 14 {
+		t.Fatalf("unexpected SKU aggregate: %+v", got)
+	}
+	var jpy *ReportSummaryGroup
+	for i := range summary.ByCurrency {
+		if summary.ByCurrency[i].Key == "JPY" {
+			jpy = &summary.ByCurrency[i]
+		}
+	}
+	if jpy == nil || jpy.Currency != "JPY" || jpy.Proceeds != 300 {
+		t.Fatalf("expected native JPY currency aggregate, got %+v", summary.ByCurrency)
+	}
+}
+
+func TestReportSummary_FinanceCrossConvertsToReportCurrency(t *testing.T) {
+	report, err := ParseFinanceReport(strings.NewReader(testFinancialReport), "")
+	if err != nil {
+		t.Fatalf("ParseFinanceReport() error: %v", err)
+	}
+	builder := NewReportSummaryBuilder(string(report.ReportType), "eur")
+	builder.AddFinanceReport(report)
+	summary := builder.Summary()
+
+	if summary.ReportingCurrency != "EUR" {
+		t.Fatalf("expected EUR, got %q", summary.ReportingCurrency)
+	}
+	// 7.00/1.1 + 3.40 + 2.10/1.1 = 11.67 EUR.
+	if summary.Totals[0].Currency != "EUR" || summary.Totals[0].Proceeds != 11.67 {
+		t.Fatalf("unexpected totals: %+v", summary.Totals)
+	}
+}
+
+func TestPrintReportSummaryTableAndMarkdown(t *testing.T) {
+	report, err := ParseFinanceReport(strings.NewReader(testFinancialReport), "")
+	if err != nil {
+		t.Fatalf("ParseFinanceReport() error: %v", err)
+	}
+	builder := NewReportSummaryBuilder(string(report.ReportType), "")
+	builder.AddFinanceReport(report)
+	summary := builder.Summary()
+
+	table := captureStdout(t, func() error { return PrintTable(summary) })
+	if !strings.Contains(table, "Reporting currency: USD") || !strings.Contains(table, "Unconverted currencies: CHF") || !strings.Contains(table, "Dimension") {
+		t.Fatalf("unexpected table output: %s", table)
+	}
+	markdown := captureStdout(t, func() error { return PrintMarkdown(summary) })
+	if !strings.Contains(markdown, "| Dimension | Key | Name | Currency | Units | Proceeds | Rows |") || !strings.Contains(markdown, "| territory | JP |") {
+		t.Fatalf("unexpected markdown output: %s", markdown)
+	}
+}
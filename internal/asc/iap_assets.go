@@ -0,0 +1,129 @@
+package asc
+
+// InAppPurchaseReviewScreenshotAttributes describes an IAP App Store review screenshot.
+type InAppPurchaseReviewScreenshotAttributes struct {
+	FileSize           int64               `json:"fileSize"`
+	FileName           string              `json:"fileName"`
+	SourceFileChecksum string              `json:"sourceFileChecksum,omitempty"`
+	ImageAsset         *ImageAsset         `json:"imageAsset,omitempty"`
+	AssetToken         string              `json:"assetToken,omitempty"`
+	AssetType          string              `json:"assetType,omitempty"`
+	UploadOperations   []UploadOperation   `json:"uploadOperations,omitempty"`
+	AssetDeliveryState *AssetDeliveryState `json:"assetDeliveryState,omitempty"`
+}
+
+// InAppPurchaseImageAttributes describes an IAP promotional image.
+type InAppPurchaseImageAttributes struct {
+	FileSize           int64               `json:"fileSize"`
+	FileName           string              `json:"fileName"`
+	SourceFileChecksum string              `json:"sourceFileChecksum,omitempty"`
+	AssetToken         string              `json:"assetToken,omitempty"`
+	ImageAsset         *ImageAsset         `json:"imageAsset,omitempty"`
+	UploadOperations   []UploadOperation   `json:"uploadOperations,omitempty"`
+	AssetDeliveryState *AssetDeliveryState `json:"assetDeliveryState,omitempty"`
+	State              string              `json:"state,omitempty"`
+}
+
+// InAppPurchaseContentAttributes describes hosted content for an IAP.
+type InAppPurchaseContentAttributes struct {
+	FileName         string `json:"fileName,omitempty"`
+	FileSize         int64  `json:"fileSize,omitempty"`
+	URL              string `json:"url,omitempty"`
+	LastModifiedDate string `json:"lastModifiedDate,omitempty"`
+}
+
+// InAppPurchaseSubmissionAttributes describes an IAP submission resource.
+type InAppPurchaseSubmissionAttributes struct{}
+
+// Response types
+type (
+	InAppPurchaseReviewScreenshotResponse = SingleResponse[InAppPurchaseReviewScreenshotAttributes]
+	InAppPurchaseImagesResponse           = Response[InAppPurchaseImageAttributes]
+	InAppPurchaseImageResponse            = SingleResponse[InAppPurchaseImageAttributes]
+	InAppPurchaseContentResponse          = SingleResponse[InAppPurchaseContentAttributes]
+	InAppPurchaseSubmissionResponse       = SingleResponse[InAppPurchaseSubmissionAttributes]
+)
+
+// InAppPurchaseAssetCreateAttributes describes the reservation for an IAP asset upload.
+type InAppPurchaseAssetCreateAttributes struct {
+	FileName string `json:"fileName"`
+	FileSize int64  `json:"fileSize"`
+}
+
+// InAppPurchaseReviewScreenshotRelationships describes relationships for review screenshots.
+type InAppPurchaseReviewScreenshotRelationships struct {
+	InAppPurchaseV2 Relationship `json:"inAppPurchaseV2"`
+}
+
+// InAppPurchaseReviewScreenshotCreateData is the data portion of a review screenshot create request.
+type InAppPurchaseReviewScreenshotCreateData struct {
+	Type          ResourceType                               `json:"type"`
+	Attributes    InAppPurchaseAssetCreateAttributes         `json:"attributes"`
+	Relationships InAppPurchaseReviewScreenshotRelationships `json:"relationships"`
+}
+
+// InAppPurchaseReviewScreenshotCreateRequest is a request to reserve a review screenshot upload.
+type InAppPurchaseReviewScreenshotCreateRequest struct {
+	Data InAppPurchaseReviewScreenshotCreateData `json:"data"`
+}
+
+// InAppPurchaseImageRelationships describes relationships for IAP images.
+type InAppPurchaseImageRelationships struct {
+	InAppPurchase Relationship `json:"inAppPurchase"`
+}
+
+// InAppPurchaseImageCreateData is the data portion of an IAP image create request.
+type InAppPurchaseImageCreateData struct {
+	Type          ResourceType                       `json:"type"`
+	Attributes    InAppPurchaseAssetCreateAttributes `json:"attributes"`
+	Relationships InAppPurchaseImageRelationships    `json:"relationships"`
+}
+
+// InAppPurchaseImageCreateRequest is a request to reserve an IAP image upload.
+type InAppPurchaseImageCreateRequest struct {
+	Data InAppPurchaseImageCreateData `json:"data"`
+}
+
+// InAppPurchaseAssetUpdateAttributes describes the commit step of an IAP asset upload.
+type InAppPurchaseAssetUpdateAttributes struct {
+	SourceFileChecksum *string `json:"sourceFileChecksum,omitempty"`
+	Uploaded           *bool   `json:"uploaded,omitempty"`
+}
+
+// InAppPurchaseAssetUpdateData is the data portion of an IAP asset update request.
+type InAppPurchaseAssetUpdateData struct {
+	Type       ResourceType                        `json:"type"`
+	ID         string                              `json:"id"`
+	Attributes *InAppPurchaseAssetUpdateAttributes `json:"attributes,omitempty"`
+}
+
+// InAppPurchaseAssetUpdateRequest is a request to commit an IAP asset upload.
+type InAppPurchaseAssetUpdateRequest struct {
+	Data InAppPurchaseAssetUpdateData `json:"data"`
+}
+
+// InAppPurchaseSubmissionRelationships describes relationships for IAP submissions.
+type InAppPurchaseSubmissionRelationships struct {
+	InAppPurchaseV2 Relationship `json:"inAppPurchaseV2"`
+}
+
+// InAppPurchaseSubmissionCreateData is the data portion of an IAP submission create request.
+type InAppPurchaseSubmissionCreateData struct {
+	Type          ResourceType                         `json:"type"`
+	Relationships InAppPurchaseSubmissionRelationships `json:"relationships"`
+}
+
+// InAppPurchaseSubmissionCreateRequest is a request to submit an IAP for review.
+type InAppPurchaseSubmissionCreateRequest struct {
+	Data InAppPurchaseSubmissionCreateData `json:"data"`
+}
+
+// InAppPurchaseAssetUploadResult represents CLI output for IAP asset uploads.
+type InAppPurchaseAssetUploadResult struct {
+	IAPID    string `json:"iapId"`
+	AssetID  string `json:"assetId"`
+	FileName string `json:"fileName"`
+	FilePath string `json:"filePath"`
+	FileSize int64  `json:"fileSize"`
+	State    string `json:"state,omitempty"`
+}
@@ -0,0 +1,106 @@
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestCreateInAppPurchaseReviewScreenshot(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) {
+		assertAuthorized(t, req)
+		if req.Method != http.MethodPost || req.URL.Path != "/v1/inAppPurchaseAppStoreReviewScreenshots" {
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+		var payload InAppPurchaseReviewScreenshotCreateRequest
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if payload.Data.Type != ResourceTypeInAppPurchaseReviewScreenshots {
+			t.Fatalf("unexpected type %q", payload.Data.Type)
+		}
+		if payload.Data.Attributes.FileName != "review.png" || payload.Data.Attributes.FileSize != 42 {
+			t.Fatalf("unexpected attributes %+v", payload.Data.Attributes)
+		}
+		if payload.Data.Relationships.InAppPurchaseV2.Data.ID != "iap-1" {
+			t.Fatalf("expected iap-1, got %q", payload.Data.Relationships.InAppPurchaseV2.Data.ID)
+		}
+	}, jsonResponse(http.StatusCreated, `{"data":{"type":"inAppPurchaseAppStoreReviewScreenshots","id":"shot-1","attributes":{"uploadOperations":[{"method":"PUT","url":"https://upload.example.com","length":42,"offset":0}]}}}`))
+
+	resp, err := client.CreateInAppPurchaseReviewScreenshot(context.Background(), "iap-1", "review.png", 42)
+	if err != nil {
+		t.Fatalf("CreateInAppPurchaseReviewScreenshot() error: %v", err)
+	}
+	if resp.Data.ID != "shot-1" || len(resp.Data.Attributes.UploadOperations) != 1 {
+		t.Fatalf("unexpected response %+v", resp.Data)
+	}
+}
+
+func TestUpdateInAppPurchaseImage_CommitsUpload(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) {
+		assertAuthorized(t, req)
+		if req.Method != http.MethodPatch || req.URL.Path != "/v1/inAppPurchaseImages/img-1" {
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+		var payload InAppPurchaseAssetUpdateRequest
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if payload.Data.Type != ResourceTypeInAppPurchaseImages {
+			t.Fatalf("unexpected type %q", payload.Data.Type)
+		}
+		attrs := payload.Data.Attributes
+		if attrs == nil || attrs.Uploaded == nil || !*attrs.Uploaded || attrs.SourceFileChecksum == nil || *attrs.SourceFileChecksum != "abc" {
+			t.Fatalf("unexpected attributes %+v", attrs)
+		}
+	}, jsonResponse(http.StatusOK, `{"data":{"type":"inAppPurchaseImages","id":"img-1"}}`))
+
+	if _, err := client.UpdateInAppPurchaseImage(context.Background(), "img-1", true, "abc"); err != nil {
+		t.Fatalf("UpdateInAppPurchaseImage() error: %v", err)
+	}
+}
+
+func TestGetInAppPurchaseContent(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) {
+		assertAuthorized(t, req)
+		if req.URL.Path != "/v2/inAppPurchases/iap-1/content" {
+			t.Fatalf("unexpected path %s", req.URL.Path)
+		}
+	}, jsonResponse(http.StatusOK, `{"data":{"type":"inAppPurchaseContents","id":"content-1","attributes":{"fileName":"pack.zip","fileSize":1024}}}`))
+
+	resp, err := client.GetInAppPurchaseContent(context.Background(), "iap-1")
+	if err != nil {
+		t.Fatalf("GetInAppPurchaseContent() error: %v", err)
+	}
+	if resp.Data.Attributes.FileName != "pack.zip" || resp.Data.Attributes.FileSize != 1024 {
+		t.Fatalf("unexpected content %+v", resp.Data.Attributes)
+	}
+}
+
+func TestCreateInAppPurchaseSubmission(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) {
+		assertAuthorized(t, req)
+		if req.Method != http.MethodPost || req.URL.Path != "/v1/inAppPurchaseSubmissions" {
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+		var payload InAppPurchaseSubmissionCreateRequest
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if payload.Data.Type != ResourceTypeInAppPurchaseSubmissions {
+			t.Fatalf("unexpected type %q", payload.Data.Type)
+		}
+		if payload.Data.Relationships.InAppPurchaseV2.Data.ID != "iap-1" {
+			t.Fatalf("expected iap-1, got %q", payload.Data.Relationships.InAppPurchaseV2.Data.ID)
+		}
+	}, jsonResponse(http.StatusCreated, `{"data":{"type":"inAppPurchaseSubmissions","id":"sub-1"}}`))
+
+	resp, err := client.CreateInAppPurchaseSubmission(context.Background(), "iap-1")
+	if err != nil {
+		t.Fatalf("CreateInAppPurchaseSubmission() error: %v", err)
+	}
+	if resp.Data.ID != "sub-1" {
+		t.Fatalf("expected sub-1, got %q", resp.Data.ID)
+	}
+}
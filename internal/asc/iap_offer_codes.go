@@ -0,0 +1,79 @@
+package asc
+
+// Valid customer eligibilities for in-app purchase offer codes.
+var ValidInAppPurchaseOfferCodeCustomerEligibilities = []string{
+	"NON_SPENDER",
+	"ACTIVE_SPENDER",
+	"CHURNED_SPENDER",
+}
+
+// InAppPurchaseOfferCodeAttributes describes an in-app purchase offer code.
+type InAppPurchaseOfferCodeAttributes struct {
+	Name                  string   `json:"name,omitempty"`
+	CustomerEligibilities []string `json:"customerEligibilities,omitempty"`
+	ProductionCodeCount   int      `json:"productionCodeCount,omitempty"`
+	SandboxCodeCount      int      `json:"sandboxCodeCount,omitempty"`
+	Active                bool     `json:"active,omitempty"`
+}
+
+// Response types
+type (
+	InAppPurchaseOfferCodesResponse           = Response[InAppPurchaseOfferCodeAttributes]
+	InAppPurchaseOfferCodeResponse            = SingleResponse[InAppPurchaseOfferCodeAttributes]
+	InAppPurchaseOfferCodeCustomCodesResponse = Response[OfferCodeCustomCodeAttributes]
+	InAppPurchaseOfferCodeCustomCodeResponse  = SingleResponse[OfferCodeCustomCodeAttributes]
+)
+
+// InAppPurchaseOfferCodeCreateAttributes describes attributes for creating an in-app purchase offer code.
+type InAppPurchaseOfferCodeCreateAttributes struct {
+	Name                  string   `json:"name"`
+	CustomerEligibilities []string `json:"customerEligibilities"`
+}
+
+// InAppPurchaseOfferCodeRelationships describes relationships for in-app purchase offer codes.
+type InAppPurchaseOfferCodeRelationships struct {
+	InAppPurchase Relationship     `json:"inAppPurchase"`
+	Prices        RelationshipList `json:"prices"`
+}
+
+// InAppPurchaseOfferCodeCreateData is the data portion of an in-app purchase offer code create request.
+type InAppPurchaseOfferCodeCreateData struct {
+	Type          ResourceType                           `json:"type"`
+	Attributes    InAppPurchaseOfferCodeCreateAttributes `json:"attributes"`
+	Relationships InAppPurchaseOfferCodeRelationships    `json:"relationships"`
+}
+
+// InAppPurchaseOfferCodeCreateRequest is a request to create an in-app purchase offer code.
+type InAppPurchaseOfferCodeCreateRequest struct {
+	Data     InAppPurchaseOfferCodeCreateData  `json:"data"`
+	Included []InAppPurchaseOfferPriceResource `json:"included"`
+}
+
+// InAppPurchaseOfferPriceResource is an inline in-app purchase offer price.
+type InAppPurchaseOfferPriceResource struct {
+	Type          ResourceType                         `json:"type"`
+	ID            string                               `json:"id"`
+	Relationships InAppPurchaseOfferPriceRelationships `json:"relationships"`
+}
+
+// InAppPurchaseOfferPriceRelationships describes relationships for in-app purchase offer prices.
+type InAppPurchaseOfferPriceRelationships struct {
+	Territory  Relationship `json:"territory"`
+	PricePoint Relationship `json:"pricePoint"`
+}
+
+// InAppPurchaseOfferPrice pairs a territory with an in-app purchase price point.
+type InAppPurchaseOfferPrice struct {
+	Territory    string `json:"territory"`
+	PricePointID string `json:"pricePointId"`
+}
+
+// IsValidInAppPurchaseOfferCodeCustomerEligibility checks if a customer eligibility is supported.
+func IsValidInAppPurchaseOfferCodeCustomerEligibility(value string) bool {
+	for _, item := range ValidInAppPurchaseOfferCodeCustomerEligibilities {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
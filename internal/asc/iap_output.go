@@ -3,6 +3,7 @@ package asc
 import (
 	"fmt"
 	"os"
+	"strings"
 	"text/tabwriter"
 )
 
@@ -86,3 +87,194 @@ func printInAppPurchaseDeleteResultMarkdown(result *InAppPurchaseDeleteResult) e
 	)
 	return nil
 }
+
+func printInAppPurchasePricePointsTable(resp *InAppPurchasePricePointsResponse) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tCustomer Price\tProceeds")
+	for _, item := range resp.Data {
+		fmt.Fprintf(w, "%s\t%s\t%s\n",
+			item.ID,
+			item.Attributes.CustomerPrice,
+			item.Attributes.Proceeds,
+		)
+	}
+	return w.Flush()
+}
+
+func printInAppPurchasePricePointsMarkdown(resp *InAppPurchasePricePointsResponse) error {
+	fmt.Fprintln(os.Stdout, "| ID | Customer Price | Proceeds |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- |")
+	for _, item := range resp.Data {
+		fmt.Fprintf(os.Stdout, "| %s | %s | %s |\n",
+			escapeMarkdown(item.ID),
+			escapeMarkdown(item.Attributes.CustomerPrice),
+			escapeMarkdown(item.Attributes.Proceeds),
+		)
+	}
+	return nil
+}
+
+func printInAppPurchasePriceScheduleTable(resp *InAppPurchasePriceScheduleResponse) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID")
+	fmt.Fprintf(w, "%s\n", resp.Data.ID)
+	return w.Flush()
+}
+
+func printInAppPurchasePriceScheduleMarkdown(resp *InAppPurchasePriceScheduleResponse) error {
+	fmt.Fprintln(os.Stdout, "| ID |")
+	fmt.Fprintln(os.Stdout, "| --- |")
+	fmt.Fprintf(os.Stdout, "| %s |\n", escapeMarkdown(resp.Data.ID))
+	return nil
+}
+
+func inAppPurchasePricingRows(result *InAppPurchasePricingResult) []InAppPurchasePriceSummary {
+	rows := make([]InAppPurchasePriceSummary, 0, len(result.ManualPrices)+len(result.AutomaticPrices))
+	rows = append(rows, result.ManualPrices...)
+	rows = append(rows, result.AutomaticPrices...)
+	return rows
+}
+
+func printInAppPurchasePricingResultTable(result *InAppPurchasePricingResult) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Territory\tCustomer Price\tProceeds\tStart Date\tEnd Date\tManual\tBase")
+	for _, row := range inAppPurchasePricingRows(result) {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%t\t%t\n",
+			row.Territory,
+			row.CustomerPrice,
+			row.Proceeds,
+			row.StartDate,
+			row.EndDate,
+			row.Manual,
+			row.Territory == result.BaseTerritory,
+		)
+	}
+	return w.Flush()
+}
+
+func printInAppPurchasePricingResultMarkdown(result *InAppPurchasePricingResult) error {
+	fmt.Fprintln(os.Stdout, "| Territory | Customer Price | Proceeds | Start Date | End Date | Manual | Base |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- | --- | --- |")
+	for _, row := range inAppPurchasePricingRows(result) {
+		fmt.Fprintf(os.Stdout, "| %s | %s | %s | %s | %s | %t | %t |\n",
+			escapeMarkdown(row.Territory),
+			escapeMarkdown(row.CustomerPrice),
+			escapeMarkdown(row.Proceeds),
+			escapeMarkdown(row.StartDate),
+			escapeMarkdown(row.EndDate),
+			row.Manual,
+			row.Territory == result.BaseTerritory,
+		)
+	}
+	return nil
+}
+
+func printInAppPurchaseAvailabilityTable(resp *InAppPurchaseAvailabilityResponse) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tAvailable In New Territories")
+	fmt.Fprintf(w, "%s\t%t\n",
+		resp.Data.ID,
+		resp.Data.Attributes.AvailableInNewTerritories,
+	)
+	return w.Flush()
+}
+
+func printInAppPurchaseAvailabilityMarkdown(resp *InAppPurchaseAvailabilityResponse) error {
+	fmt.Fprintln(os.Stdout, "| ID | Available In New Territories |")
+	fmt.Fprintln(os.Stdout, "| --- | --- |")
+	fmt.Fprintf(os.Stdout, "| %s | %t |\n",
+		escapeMarkdown(resp.Data.ID),
+		resp.Data.Attributes.AvailableInNewTerritories,
+	)
+	return nil
+}
+
+func printInAppPurchaseAvailabilityResultTable(result *InAppPurchaseAvailabilityResult) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Availability ID\tAvailable In New Territories\tTerritories")
+	fmt.Fprintf(w, "%s\t%t\t%s\n",
+		result.AvailabilityID,
+		result.AvailableInNewTerritories,
+		strings.Join(result.Territories, ","),
+	)
+	return w.Flush()
+}
+
+func printInAppPurchaseAvailabilityResultMarkdown(result *InAppPurchaseAvailabilityResult) error {
+	fmt.Fprintln(os.Stdout, "| Availability ID | Available In New Territories | Territories |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- |")
+	fmt.Fprintf(os.Stdout, "| %s | %t | %s |\n",
+		escapeMarkdown(result.AvailabilityID),
+		result.AvailableInNewTerritories,
+		escapeMarkdown(strings.Join(result.Territories, ",")),
+	)
+	return nil
+}
+
+func printInAppPurchaseAssetUploadResultTable(result *InAppPurchaseAssetUploadResult) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "IAP ID\tAsset ID\tFile Name\tFile Size\tState")
+	fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\n",
+		result.IAPID,
+		result.AssetID,
+		result.FileName,
+		result.FileSize,
+		result.State,
+	)
+	return w.Flush()
+}
+
+func printInAppPurchaseAssetUploadResultMarkdown(result *InAppPurchaseAssetUploadResult) error {
+	fmt.Fprintln(os.Stdout, "| IAP ID | Asset ID | File Name | File Size | State |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- |")
+	fmt.Fprintf(os.Stdout, "| %s | %s | %s | %d | %s |\n",
+		escapeMarkdown(result.IAPID),
+		escapeMarkdown(result.AssetID),
+		escapeMarkdown(result.FileName),
+		result.FileSize,
+		escapeMarkdown(result.State),
+	)
+	return nil
+}
+
+func printInAppPurchaseContentTable(resp *InAppPurchaseContentResponse) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tFile Name\tFile Size\tLast Modified\tURL")
+	attrs := resp.Data.Attributes
+	fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\n",
+		resp.Data.ID,
+		attrs.FileName,
+		attrs.FileSize,
+		attrs.LastModifiedDate,
+		attrs.URL,
+	)
+	return w.Flush()
+}
+
+func printInAppPurchaseContentMarkdown(resp *InAppPurchaseContentResponse) error {
+	fmt.Fprintln(os.Stdout, "| ID | File Name | File Size | Last Modified | URL |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- |")
+	attrs := resp.Data.Attributes
+	fmt.Fprintf(os.Stdout, "| %s | %s | %d | %s | %s |\n",
+		escapeMarkdown(resp.Data.ID),
+		escapeMarkdown(attrs.FileName),
+		attrs.FileSize,
+		escapeMarkdown(attrs.LastModifiedDate),
+		escapeMarkdown(attrs.URL),
+	)
+	return nil
+}
+
+func printInAppPurchaseSubmissionTable(resp *InAppPurchaseSubmissionResponse) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Submission ID")
+	fmt.Fprintf(w, "%s\n", resp.Data.ID)
+	return w.Flush()
+}
+
+func printInAppPurchaseSubmissionMarkdown(resp *InAppPurchaseSubmissionResponse) error {
+	fmt.Fprintln(os.Stdout, "| Submission ID |")
+	fmt.Fprintln(os.Stdout, "| --- |")
+	fmt.Fprintf(os.Stdout, "| %s |\n", escapeMarkdown(resp.Data.ID))
+	return nil
+}
@@ -0,0 +1,234 @@
+package asc
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// InAppPurchasePricePointAttributes describes an in-app purchase price point.
+type InAppPurchasePricePointAttributes struct {
+	CustomerPrice string `json:"customerPrice,omitempty"`
+	Proceeds      string `json:"proceeds,omitempty"`
+}
+
+// InAppPurchasePriceScheduleAttributes describes an in-app purchase price schedule.
+type InAppPurchasePriceScheduleAttributes struct {
+	// Usually empty - data is in relationships.
+}
+
+// InAppPurchasePriceAttributes describes an in-app purchase price schedule entry.
+type InAppPurchasePriceAttributes struct {
+	StartDate string `json:"startDate,omitempty"`
+	EndDate   string `json:"endDate,omitempty"`
+	Manual    bool   `json:"manual,omitempty"`
+}
+
+// InAppPurchaseAvailabilityAttributes describes in-app purchase availability.
+type InAppPurchaseAvailabilityAttributes struct {
+	AvailableInNewTerritories bool `json:"availableInNewTerritories"`
+}
+
+// Response types
+type (
+	InAppPurchasePricePointsResponse   = Response[InAppPurchasePricePointAttributes]
+	InAppPurchasePriceScheduleResponse = SingleResponse[InAppPurchasePriceScheduleAttributes]
+	InAppPurchasePricesResponse        = Response[InAppPurchasePriceAttributes]
+	InAppPurchaseAvailabilityResponse  = SingleResponse[InAppPurchaseAvailabilityAttributes]
+)
+
+// InAppPurchasePriceScheduleCreateAttributes defines inputs for creating an IAP price schedule.
+// An empty StartDate makes the price effective immediately.
+type InAppPurchasePriceScheduleCreateAttributes struct {
+	PricePointID    string `json:"-"`
+	StartDate       string `json:"-"`
+	BaseTerritoryID string `json:"-"`
+}
+
+// InAppPurchasePriceScheduleCreateRequest is a request to create an IAP price schedule.
+type InAppPurchasePriceScheduleCreateRequest struct {
+	Data     InAppPurchasePriceScheduleCreateData `json:"data"`
+	Included []InAppPurchasePriceCreateResource   `json:"included,omitempty"`
+}
+
+// InAppPurchasePriceScheduleCreateData is the data portion of a schedule create request.
+type InAppPurchasePriceScheduleCreateData struct {
+	Type          ResourceType                                  `json:"type"`
+	Relationships InAppPurchasePriceScheduleCreateRelationships `json:"relationships"`
+}
+
+// InAppPurchasePriceScheduleCreateRelationships describes schedule relationships.
+type InAppPurchasePriceScheduleCreateRelationships struct {
+	InAppPurchase Relationship     `json:"inAppPurchase"`
+	BaseTerritory Relationship     `json:"baseTerritory"`
+	ManualPrices  RelationshipList `json:"manualPrices"`
+}
+
+// InAppPurchasePriceCreateResource represents an IAP price resource for schedule creation.
+type InAppPurchasePriceCreateResource struct {
+	Type          ResourceType                    `json:"type"`
+	ID            string                          `json:"id,omitempty"`
+	Attributes    InAppPurchasePriceAttributes    `json:"attributes"`
+	Relationships InAppPurchasePriceRelationships `json:"relationships"`
+}
+
+// InAppPurchasePriceRelationships describes relationships for IAP prices.
+type InAppPurchasePriceRelationships struct {
+	InAppPurchaseV2         Relationship `json:"inAppPurchaseV2"`
+	InAppPurchasePricePoint Relationship `json:"inAppPurchasePricePoint"`
+}
+
+// InAppPurchaseAvailabilityCreateRequest is a request to set IAP availability.
+type InAppPurchaseAvailabilityCreateRequest struct {
+	Data InAppPurchaseAvailabilityCreateData `json:"data"`
+}
+
+// InAppPurchaseAvailabilityCreateData is the data portion of availability create requests.
+type InAppPurchaseAvailabilityCreateData struct {
+	Type          ResourceType                           `json:"type"`
+	Attributes    InAppPurchaseAvailabilityAttributes    `json:"attributes"`
+	Relationships InAppPurchaseAvailabilityRelationships `json:"relationships"`
+}
+
+// InAppPurchaseAvailabilityRelationships describes relationships for availability.
+type InAppPurchaseAvailabilityRelationships struct {
+	InAppPurchase        Relationship     `json:"inAppPurchase"`
+	AvailableTerritories RelationshipList `json:"availableTerritories"`
+}
+
+// InAppPurchasePriceSummary is a flattened IAP price with its territory and amounts.
+type InAppPurchasePriceSummary struct {
+	ID            string `json:"id"`
+	Territory     string `json:"territory"`
+	PricePointID  string `json:"pricePointId,omitempty"`
+	CustomerPrice string `json:"customerPrice,omitempty"`
+	Proceeds      string `json:"proceeds,omitempty"`
+	StartDate     string `json:"startDate,omitempty"`
+	EndDate       string `json:"endDate,omitempty"`
+	Manual        bool   `json:"manual"`
+}
+
+// InAppPurchasePricingResult represents CLI output for IAP pricing.
+type InAppPurchasePricingResult struct {
+	IAPID           string                      `json:"iapId"`
+	ScheduleID      string                      `json:"scheduleId"`
+	BaseTerritory   string                      `json:"baseTerritory"`
+	ManualPrices    []InAppPurchasePriceSummary `json:"manualPrices"`
+	AutomaticPrices []InAppPurchasePriceSummary `json:"automaticPrices,omitempty"`
+}
+
+// InAppPurchaseAvailabilityResult represents CLI output for IAP availability.
+type InAppPurchaseAvailabilityResult struct {
+	IAPID                     string   `json:"iapId"`
+	AvailabilityID            string   `json:"availabilityId"`
+	AvailableInNewTerritories bool     `json:"availableInNewTerritories"`
+	Territories               []string `json:"territories"`
+}
+
+type iapPriceRelationships struct {
+	InAppPurchasePricePoint *Relationship `json:"inAppPurchasePricePoint"`
+	Territory               *Relationship `json:"territory"`
+}
+
+// SummarizeInAppPurchasePrices flattens IAP prices using included price points
+// and territories.
+func SummarizeInAppPurchasePrices(resp *InAppPurchasePricesResponse) ([]InAppPurchasePriceSummary, error) {
+	if resp == nil {
+		return nil, nil
+	}
+
+	pricePoints := make(map[string]InAppPurchasePricePointAttributes)
+	if len(resp.Included) > 0 {
+		var included []struct {
+			Type       ResourceType    `json:"type"`
+			ID         string          `json:"id"`
+			Attributes json.RawMessage `json:"attributes"`
+		}
+		if err := json.Unmarshal(resp.Included, &included); err != nil {
+			return nil, fmt.Errorf("failed to parse included resources: %w", err)
+		}
+		for _, item := range included {
+			if item.Type != ResourceTypeInAppPurchasePricePoints || len(item.Attributes) == 0 {
+				continue
+			}
+			var attrs InAppPurchasePricePointAttributes
+			if err := json.Unmarshal(item.Attributes, &attrs); err != nil {
+				return nil, fmt.Errorf("failed to parse price point %q: %w", item.ID, err)
+			}
+			pricePoints[item.ID] = attrs
+		}
+	}
+
+	summaries := make([]InAppPurchasePriceSummary, 0, len(resp.Data))
+	for _, item := range resp.Data {
+		summary := InAppPurchasePriceSummary{
+			ID:        item.ID,
+			StartDate: item.Attributes.StartDate,
+			EndDate:   item.Attributes.EndDate,
+			Manual:    item.Attributes.Manual,
+		}
+		if len(item.Relationships) > 0 {
+			var rels iapPriceRelationships
+			if err := json.Unmarshal(item.Relationships, &rels); err != nil {
+				return nil, fmt.Errorf("failed to parse price %q relationships: %w", item.ID, err)
+			}
+			if rels.Territory != nil {
+				summary.Territory = strings.ToUpper(rels.Territory.Data.ID)
+			}
+			if rels.InAppPurchasePricePoint != nil {
+				summary.PricePointID = rels.InAppPurchasePricePoint.Data.ID
+				if attrs, ok := pricePoints[summary.PricePointID]; ok {
+					summary.CustomerPrice = attrs.CustomerPrice
+					summary.Proceeds = attrs.Proceeds
+				}
+			}
+		}
+		summaries = append(summaries, summary)
+	}
+	return summaries, nil
+}
+
+// IAPPricesOption is a functional option for IAP price schedule price lists.
+type IAPPricesOption func(*iapPricesQuery)
+
+type iapPricesQuery struct {
+	listQuery
+	territories []string
+}
+
+// WithIAPPricesLimit sets the max number of prices to return.
+func WithIAPPricesLimit(limit int) IAPPricesOption {
+	return func(q *iapPricesQuery) {
+		if limit > 0 {
+			q.limit = limit
+		}
+	}
+}
+
+// WithIAPPricesNextURL uses a next page URL directly.
+func WithIAPPricesNextURL(next string) IAPPricesOption {
+	return func(q *iapPricesQuery) {
+		if strings.TrimSpace(next) != "" {
+			q.nextURL = strings.TrimSpace(next)
+		}
+	}
+}
+
+// WithIAPPricesTerritories filters prices by territory.
+func WithIAPPricesTerritories(territories []string) IAPPricesOption {
+	return func(q *iapPricesQuery) {
+		q.territories = normalizeUpperList(territories)
+	}
+}
+
+func buildIAPPricesQuery(query *iapPricesQuery) string {
+	values := url.Values{}
+	values.Set("include", "inAppPurchasePricePoint,territory")
+	values.Set("fields[inAppPurchasePricePoints]", "customerPrice,proceeds,territory")
+	if len(query.territories) > 0 {
+		values.Set("filter[territory]", strings.Join(query.territories, ","))
+	}
+	addLimit(values, query.limit)
+	return values.Encode()
+}
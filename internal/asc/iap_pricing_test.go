@@ -0,0 +1,199 @@
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestGetInAppPurchasePricePoints_WithTerritory(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) {
+		assertAuthorized(t, req)
+		if req.URL.Path != "/v2/inAppPurchases/iap-1/pricePoints" {
+			t.Fatalf("expected path /v2/inAppPurchases/iap-1/pricePoints, got %s", req.URL.Path)
+		}
+		values := req.URL.Query()
+		if values.Get("filter[territory]") != "USA" {
+			t.Fatalf("expected territory filter USA, got %q", values.Get("filter[territory]"))
+		}
+		if values.Get("limit") != "200" {
+			t.Fatalf("expected limit=200, got %q", values.Get("limit"))
+		}
+	}, jsonResponse(http.StatusOK, `{"data":[{"type":"inAppPurchasePricePoints","id":"pp-1","attributes":{"customerPrice":"4.99","proceeds":"3.49"}}]}`))
+
+	resp, err := client.GetInAppPurchasePricePoints(context.Background(), "iap-1",
+		WithPricePointsTerritory("usa"),
+		WithPricePointsLimit(200),
+	)
+	if err != nil {
+		t.Fatalf("GetInAppPurchasePricePoints() error: %v", err)
+	}
+	if len(resp.Data) != 1 || resp.Data[0].Attributes.CustomerPrice != "4.99" {
+		t.Fatalf("unexpected price points: %+v", resp.Data)
+	}
+}
+
+func TestGetInAppPurchasePriceSchedule(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) {
+		assertAuthorized(t, req)
+		if req.URL.Path != "/v2/inAppPurchases/iap-1/iapPriceSchedule" {
+			t.Fatalf("expected path /v2/inAppPurchases/iap-1/iapPriceSchedule, got %s", req.URL.Path)
+		}
+	}, jsonResponse(http.StatusOK, `{"data":{"type":"inAppPurchasePriceSchedules","id":"schedule-1"}}`))
+
+	resp, err := client.GetInAppPurchasePriceSchedule(context.Background(), "iap-1")
+	if err != nil {
+		t.Fatalf("GetInAppPurchasePriceSchedule() error: %v", err)
+	}
+	if resp.Data.ID != "schedule-1" {
+		t.Fatalf("expected schedule-1, got %q", resp.Data.ID)
+	}
+}
+
+func TestGetInAppPurchasePriceScheduleManualPrices_IncludesPricePoints(t *testing.T) {
+	body := `{
+		"data":[{"type":"inAppPurchasePrices","id":"price-1","attributes":{"startDate":"2026-01-01","manual":true},
+			"relationships":{"inAppPurchasePricePoint":{"data":{"type":"inAppPurchasePricePoints","id":"pp-1"}},"territory":{"data":{"type":"territories","id":"USA"}}}}],
+		"included":[
+			{"type":"inAppPurchasePricePoints","id":"pp-1","attributes":{"customerPrice":"4.99","proceeds":"3.49"}},
+			{"type":"territories","id":"USA","attributes":{"currency":"USD"}}
+		]
+	}`
+	client := newTestClient(t, func(req *http.Request) {
+		assertAuthorized(t, req)
+		if req.URL.Path != "/v1/inAppPurchasePriceSchedules/schedule-1/manualPrices" {
+			t.Fatalf("expected manualPrices path, got %s", req.URL.Path)
+		}
+		values := req.URL.Query()
+		if values.Get("include") != "inAppPurchasePricePoint,territory" {
+			t.Fatalf("unexpected include %q", values.Get("include"))
+		}
+		if values.Get("filter[territory]") != "USA,GBR" {
+			t.Fatalf("expected territory filter USA,GBR, got %q", values.Get("filter[territory]"))
+		}
+	}, jsonResponse(http.StatusOK, body))
+
+	resp, err := client.GetInAppPurchasePriceScheduleManualPrices(context.Background(), "schedule-1",
+		WithIAPPricesTerritories([]string{"usa", "gbr"}),
+	)
+	if err != nil {
+		t.Fatalf("GetInAppPurchasePriceScheduleManualPrices() error: %v", err)
+	}
+
+	summaries, err := SummarizeInAppPurchasePrices(resp)
+	if err != nil {
+		t.Fatalf("SummarizeInAppPurchasePrices() error: %v", err)
+	}
+	if len(summaries) != 1 {
+		t.Fatalf("expected 1 summary, got %d", len(summaries))
+	}
+	got := summaries[0]
+	if got.Territory != "USA" || got.CustomerPrice != "4.99" || got.Proceeds != "3.49" || got.PricePointID != "pp-1" || !got.Manual {
+		t.Fatalf("unexpected summary: %+v", got)
+	}
+}
+
+func TestCreateInAppPurchasePriceSchedule(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) {
+		assertAuthorized(t, req)
+		if req.Method != http.MethodPost {
+			t.Fatalf("expected POST, got %s", req.Method)
+		}
+		if req.URL.Path != "/v1/inAppPurchasePriceSchedules" {
+			t.Fatalf("expected path /v1/inAppPurchasePriceSchedules, got %s", req.URL.Path)
+		}
+
+		var payload InAppPurchasePriceScheduleCreateRequest
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if payload.Data.Type != ResourceTypeInAppPurchasePriceSchedules {
+			t.Fatalf("unexpected type %q", payload.Data.Type)
+		}
+		if payload.Data.Relationships.InAppPurchase.Data.ID != "iap-1" {
+			t.Fatalf("expected iap-1, got %q", payload.Data.Relationships.InAppPurchase.Data.ID)
+		}
+		if payload.Data.Relationships.BaseTerritory.Data.ID != "USA" {
+			t.Fatalf("expected base territory USA, got %q", payload.Data.Relationships.BaseTerritory.Data.ID)
+		}
+		if len(payload.Included) != 1 || len(payload.Data.Relationships.ManualPrices.Data) != 1 {
+			t.Fatalf("expected one manual price, got %+v", payload)
+		}
+		included := payload.Included[0]
+		if included.ID != payload.Data.Relationships.ManualPrices.Data[0].ID {
+			t.Fatal("expected manual price relationship to match included id")
+		}
+		if included.Relationships.InAppPurchasePricePoint.Data.ID != "pp-1" {
+			t.Fatalf("expected price point pp-1, got %q", included.Relationships.InAppPurchasePricePoint.Data.ID)
+		}
+		if included.Relationships.InAppPurchaseV2.Data.ID != "iap-1" {
+			t.Fatalf("expected inAppPurchaseV2 iap-1, got %q", included.Relationships.InAppPurchaseV2.Data.ID)
+		}
+		if included.Attributes.StartDate != "" {
+			t.Fatalf("expected empty start date, got %q", included.Attributes.StartDate)
+		}
+	}, jsonResponse(http.StatusCreated, `{"data":{"type":"inAppPurchasePriceSchedules","id":"schedule-2"}}`))
+
+	_, err := client.CreateInAppPurchasePriceSchedule(context.Background(), "iap-1", InAppPurchasePriceScheduleCreateAttributes{
+		PricePointID:    "pp-1",
+		BaseTerritoryID: "usa",
+	})
+	if err != nil {
+		t.Fatalf("CreateInAppPurchasePriceSchedule() error: %v", err)
+	}
+}
+
+func TestCreateInAppPurchasePriceSchedule_RequiresFields(t *testing.T) {
+	client := newTestClient(t, nil, nil)
+	if _, err := client.CreateInAppPurchasePriceSchedule(context.Background(), "iap-1", InAppPurchasePriceScheduleCreateAttributes{PricePointID: "pp-1"}); err == nil {
+		t.Fatal("expected error when base territory is missing")
+	}
+}
+
+func TestCreateInAppPurchaseAvailability(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) {
+		assertAuthorized(t, req)
+		if req.Method != http.MethodPost || req.URL.Path != "/v1/inAppPurchaseAvailabilities" {
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+		var payload InAppPurchaseAvailabilityCreateRequest
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if payload.Data.Relationships.InAppPurchase.Data.ID != "iap-1" {
+			t.Fatalf("expected iap-1, got %q", payload.Data.Relationships.InAppPurchase.Data.ID)
+		}
+		territories := payload.Data.Relationships.AvailableTerritories.Data
+		if len(territories) != 2 || territories[0].ID != "USA" || territories[1].ID != "GBR" {
+			t.Fatalf("unexpected territories %+v", territories)
+		}
+		if !payload.Data.Attributes.AvailableInNewTerritories {
+			t.Fatal("expected availableInNewTerritories true")
+		}
+	}, jsonResponse(http.StatusCreated, `{"data":{"type":"inAppPurchaseAvailabilities","id":"avail-1","attributes":{"availableInNewTerritories":true}}}`))
+
+	_, err := client.CreateInAppPurchaseAvailability(context.Background(), "iap-1", []string{"usa", " gbr "}, InAppPurchaseAvailabilityAttributes{
+		AvailableInNewTerritories: true,
+	})
+	if err != nil {
+		t.Fatalf("CreateInAppPurchaseAvailability() error: %v", err)
+	}
+}
+
+func TestGetInAppPurchaseAvailabilityTerritories(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) {
+		assertAuthorized(t, req)
+		if req.URL.Path != "/v1/inAppPurchaseAvailabilities/avail-1/availableTerritories" {
+			t.Fatalf("unexpected path %s", req.URL.Path)
+		}
+	}, jsonResponse(http.StatusOK, `{"data":[{"type":"territories","id":"USA"}]}`))
+
+	resp, err := client.GetInAppPurchaseAvailabilityTerritories(context.Background(), "avail-1")
+	if err != nil {
+		t.Fatalf("GetInAppPurchaseAvailabilityTerritories() error: %v", err)
+	}
+	if len(resp.Data) != 1 || resp.Data[0].ID != "USA" {
+		t.Fatalf("unexpected territories %+v", resp.Data)
+	}
+}
@@ -3,6 +3,7 @@ package asc
 import (
 	"fmt"
 	"os"
+	"strings"
 	"text/tabwriter"
 )
 
@@ -37,3 +38,107 @@ func printOfferCodesMarkdown(resp *SubscriptionOfferCodeOneTimeUseCodesResponse)
 	}
 	return nil
 }
+
+func printSubscriptionOfferCodesTable(resp *SubscriptionOfferCodesResponse) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tName\tEligibility\tMode\tDuration\tPeriods\tActive")
+	for _, item := range resp.Data {
+		attrs := item.Attributes
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%d\t%t\n",
+			sanitizeTerminal(item.ID),
+			compactWhitespace(attrs.Name),
+			sanitizeTerminal(strings.Join(attrs.CustomerEligibilities, ",")),
+			sanitizeTerminal(attrs.OfferMode),
+			sanitizeTerminal(attrs.Duration),
+			attrs.NumberOfPeriods,
+			attrs.Active,
+		)
+	}
+	return w.Flush()
+}
+
+func printSubscriptionOfferCodesMarkdown(resp *SubscriptionOfferCodesResponse) error {
+	fmt.Fprintln(os.Stdout, "| ID | Name | Eligibility | Mode | Duration | Periods | Active |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- | --- | --- |")
+	for _, item := range resp.Data {
+		attrs := item.Attributes
+		fmt.Fprintf(os.Stdout, "| %s | %s | %s | %s | %s | %d | %t |\n",
+			escapeMarkdown(item.ID),
+			escapeMarkdown(attrs.Name),
+			escapeMarkdown(strings.Join(attrs.CustomerEligibilities, ",")),
+			escapeMarkdown(attrs.OfferMode),
+			escapeMarkdown(attrs.Duration),
+			attrs.NumberOfPeriods,
+			attrs.Active,
+		)
+	}
+	return nil
+}
+
+func printOfferCodeCustomCodesTable(resp *SubscriptionOfferCodeCustomCodesResponse) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tCode\tCodes\tExpires\tCreated\tActive")
+	for _, item := range resp.Data {
+		attrs := item.Attributes
+		fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\t%t\n",
+			sanitizeTerminal(item.ID),
+			sanitizeTerminal(attrs.CustomCode),
+			attrs.NumberOfCodes,
+			sanitizeTerminal(attrs.ExpirationDate),
+			sanitizeTerminal(attrs.CreatedDate),
+			attrs.Active,
+		)
+	}
+	return w.Flush()
+}
+
+func printOfferCodeCustomCodesMarkdown(resp *SubscriptionOfferCodeCustomCodesResponse) error {
+	fmt.Fprintln(os.Stdout, "| ID | Code | Codes | Expires | Created | Active |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- | --- |")
+	for _, item := range resp.Data {
+		attrs := item.Attributes
+		fmt.Fprintf(os.Stdout, "| %s | %s | %d | %s | %s | %t |\n",
+			escapeMarkdown(item.ID),
+			escapeMarkdown(attrs.CustomCode),
+			attrs.NumberOfCodes,
+			escapeMarkdown(attrs.ExpirationDate),
+			escapeMarkdown(attrs.CreatedDate),
+			attrs.Active,
+		)
+	}
+	return nil
+}
+
+func printInAppPurchaseOfferCodesTable(resp *InAppPurchaseOfferCodesResponse) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tName\tEligibility\tProduction Codes\tSandbox Codes\tActive")
+	for _, item := range resp.Data {
+		attrs := item.Attributes
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%d\t%t\n",
+			sanitizeTerminal(item.ID),
+			compactWhitespace(attrs.Name),
+			sanitizeTerminal(strings.Join(attrs.CustomerEligibilities, ",")),
+			attrs.ProductionCodeCount,
+			attrs.SandboxCodeCount,
+			attrs.Active,
+		)
+	}
+	return w.Flush()
+}
+
+func printInAppPurchaseOfferCodesMarkdown(resp *InAppPurchaseOfferCodesResponse) error {
+	fmt.Fprintln(os.Stdout, "| ID | Name | Eligibility | Production Codes | Sandbox Codes | Active |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- | --- |")
+	for _, item := range resp.Data {
+		attrs := item.Attributes
+		fmt.Fprintf(os.Stdout, "| %s | %s | %s | %d | %d | %t |\n",
+			escapeMarkdown(item.ID),
+			escapeMarkdown(attrs.Name),
+			escapeMarkdown(strings.Join(attrs.CustomerEligibilities, ",")),
+			attrs.ProductionCodeCount,
+			attrs.SandboxCodeCount,
+			attrs.Active,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestCreateSubscriptionOfferCode_FreeTrialPricesOmitPricePoint(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) {
+		assertAuthorized(t, req)
+		if req.Method != http.MethodPost || req.URL.Path != "/v1/subscriptionOfferCodes" {
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+		var payload SubscriptionOfferCodeCreateRequest
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if payload.Data.Relationships.Subscription.Data.ID != "sub-1" {
+			t.Fatalf("expected subscription sub-1, got %+v", payload.Data.Relationships.Subscription)
+		}
+		if len(payload.Included) != 2 {
+			t.Fatalf("expected 2 included prices, got %d", len(payload.Included))
+		}
+		if payload.Included[0].Relationships.SubscriptionPricePoint != nil {
+			t.Fatalf("expected free price without price point, got %+v", payload.Included[0].Relationships.SubscriptionPricePoint)
+		}
+		if payload.Included[1].Relationships.Territory.Data.ID != "GBR" {
+			t.Fatalf("expected GBR, got %+v", payload.Included[1].Relationships.Territory)
+		}
+		if payload.Data.Attributes.OfferEligibility != "STACK_WITH_INTRO_OFFERS" || len(payload.Data.Attributes.CustomerEligibilities) != 1 {
+			t.Fatalf("unexpected attributes %+v", payload.Data.Attributes)
+		}
+	}, jsonResponse(http.StatusCreated, `{"data":{"type":"subscriptionOfferCodes","id":"code-1"}}`))
+
+	_, err := client.CreateSubscriptionOfferCode(context.Background(), "sub-1", SubscriptionOfferCodeCreateAttributes{
+		Name:                  "Free month",
+		CustomerEligibilities: []string{"NEW"},
+		OfferEligibility:      "STACK_WITH_INTRO_OFFERS",
+		Duration:              "ONE_MONTH",
+		OfferMode:             "FREE_TRIAL",
+		NumberOfPeriods:       1,
+	}, []SubscriptionOfferPrice{{Territory: "usa"}, {Territory: "GBR"}})
+	if err != nil {
+		t.Fatalf("CreateSubscriptionOfferCode() error: %v", err)
+	}
+}
+
+func TestCreateInAppPurchaseOfferCode_InlinesPrices(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) {
+		assertAuthorized(t, req)
+		if req.Method != http.MethodPost || req.URL.Path != "/v1/inAppPurchaseOfferCodes" {
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+		var payload InAppPurchaseOfferCodeCreateRequest
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if payload.Data.Relationships.InAppPurchase.Data.Type != ResourceTypeInAppPurchases || payload.Data.Relationships.InAppPurchase.Data.ID != "iap-1" {
+			t.Fatalf("unexpected in-app purchase relationship %+v", payload.Data.Relationships.InAppPurchase)
+		}
+		refs := payload.Data.Relationships.Prices.Data
+		if len(refs) != 1 || len(payload.Included) != 1 || refs[0].ID != payload.Included[0].ID {
+			t.Fatalf("expected matching price reference, got %+v and %+v", refs, payload.Included)
+		}
+		if payload.Included[0].Relationships.PricePoint.Data.Type != ResourceTypeInAppPurchasePricePoints {
+			t.Fatalf("unexpected price point type %q", payload.Included[0].Relationships.PricePoint.Data.Type)
+		}
+	}, jsonResponse(http.StatusCreated, `{"data":{"type":"inAppPurchaseOfferCodes","id":"code-1"}}`))
+
+	_, err := client.CreateInAppPurchaseOfferCode(context.Background(), "iap-1", InAppPurchaseOfferCodeCreateAttributes{
+		Name:                  "Launch",
+		CustomerEligibilities: []string{"NON_SPENDER"},
+	}, []InAppPurchaseOfferPrice{{Territory: "USA", PricePointID: "pp-usa"}})
+	if err != nil {
+		t.Fatalf("CreateInAppPurchaseOfferCode() error: %v", err)
+	}
+}
+
+func TestCreateSubscriptionOfferCodeCustomCode(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) {
+		assertAuthorized(t, req)
+		if req.Method != http.MethodPost || req.URL.Path != "/v1/subscriptionOfferCodeCustomCodes" {
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+		var payload OfferCodeCustomCodeCreateRequest
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if payload.Data.Type != ResourceTypeSubscriptionOfferCodeCustomCodes {
+			t.Fatalf("unexpected type %q", payload.Data.Type)
+		}
+		if payload.Data.Relationships.OfferCode.Data.Type != ResourceTypeSubscriptionOfferCodes || payload.Data.Relationships.OfferCode.Data.ID != "code-1" {
+			t.Fatalf("unexpected offer code relationship %+v", payload.Data.Relationships.OfferCode)
+		}
+		if payload.Data.Attributes.CustomCode != "SPRING26" || payload.Data.Attributes.NumberOfCodes != 100 {
+			t.Fatalf("unexpected attributes %+v", payload.Data.Attributes)
+		}
+	}, jsonResponse(http.StatusCreated, `{"data":{"type":"subscriptionOfferCodeCustomCodes","id":"custom-1"}}`))
+
+	_, err := client.CreateSubscriptionOfferCodeCustomCode(context.Background(), "code-1", OfferCodeCustomCodeCreateAttributes{
+		CustomCode:    "SPRING26",
+		NumberOfCodes: 100,
+	})
+	if err != nil {
+		t.Fatalf("CreateSubscriptionOfferCodeCustomCode() error: %v", err)
+	}
+}
+
+func TestUpdateInAppPurchaseOfferCodeCustomCodeActive_SendsFalse(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) {
+		assertAuthorized(t, req)
+		if req.Method != http.MethodPatch || req.URL.Path != "/v1/inAppPurchaseOfferCodeCustomCodes/custom-1" {
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+		var payload map[string]any
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		attrs := payload["data"].(map[string]any)["attributes"].(map[string]any)
+		if active, ok := attrs["active"]; !ok || active != false {
+			t.Fatalf("expected active=false in payload, got %v", attrs)
+		}
+	}, jsonResponse(http.StatusOK, `{"data":{"type":"inAppPurchaseOfferCodeCustomCodes","id":"custom-1","attributes":{"active":false}}}`))
+
+	if _, err := client.UpdateInAppPurchaseOfferCodeCustomCodeActive(context.Background(), "custom-1", false); err != nil {
+		t.Fatalf("UpdateInAppPurchaseOfferCodeCustomCodeActive() error: %v", err)
+	}
+}
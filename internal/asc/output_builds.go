@@ -55,6 +55,28 @@ type BuildExpireAllResult struct {
 	Failures            []BuildExpireAllFailure `json:"failures,omitempty"`
 }
 
+// BuildValidateIssue is a single problem found by builds validate.
+type BuildValidateIssue struct {
+	Severity string `json:"severity"`
+	Code     string `json:"code"`
+	Message  string `json:"message"`
+	Path     string `json:"path,omitempty"`
+}
+
+// BuildValidateResult represents CLI output for IPA pre-flight validation.
+type BuildValidateResult struct {
+	File        string               `json:"file"`
+	AppID       string               `json:"appId,omitempty"`
+	BundleID    string               `json:"bundleId"`
+	Version     string               `json:"version"`
+	BuildNumber string               `json:"buildNumber"`
+	Platform    string               `json:"platform"`
+	Valid       bool                 `json:"valid"`
+	ErrorCount  int                  `json:"errorCount"`
+	WarnCount   int                  `json:"warnCount"`
+	Issues      []BuildValidateIssue `json:"issues"`
+}
+
 func printBuildsTable(resp *BuildsResponse) error {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 	fmt.Fprintln(w, "Version\tUploaded\tProcessing\tExpired")
@@ -226,6 +248,67 @@ func printBuildExpireAllResultMarkdown(result *BuildExpireAllResult) error {
 	return nil
 }
 
+func printBuildValidateResultTable(result *BuildValidateResult) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Bundle ID\tVersion\tBuild\tPlatform\tValid\tErrors\tWarnings")
+	fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%t\t%d\t%d\n",
+		result.BundleID,
+		result.Version,
+		result.BuildNumber,
+		result.Platform,
+		result.Valid,
+		result.ErrorCount,
+		result.WarnCount,
+	)
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if len(result.Issues) == 0 {
+		return nil
+	}
+	fmt.Fprintln(os.Stdout, "\nIssues")
+	issuesWriter := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(issuesWriter, "Severity\tCode\tPath\tMessage")
+	for _, issue := range result.Issues {
+		fmt.Fprintf(issuesWriter, "%s\t%s\t%s\t%s\n",
+			issue.Severity,
+			issue.Code,
+			issue.Path,
+			compactWhitespace(issue.Message),
+		)
+	}
+	return issuesWriter.Flush()
+}
+
+func printBuildValidateResultMarkdown(result *BuildValidateResult) error {
+	fmt.Fprintln(os.Stdout, "| Bundle ID | Version | Build | Platform | Valid | Errors | Warnings |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- | --- | --- |")
+	fmt.Fprintf(os.Stdout, "| %s | %s | %s | %s | %t | %d | %d |\n",
+		escapeMarkdown(result.BundleID),
+		escapeMarkdown(result.Version),
+		escapeMarkdown(result.BuildNumber),
+		escapeMarkdown(result.Platform),
+		result.Valid,
+		result.ErrorCount,
+		result.WarnCount,
+	)
+	if len(result.Issues) == 0 {
+		return nil
+	}
+	fmt.Fprintln(os.Stdout, "\nIssues")
+	fmt.Fprintln(os.Stdout, "| Severity | Code | Path | Message |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- |")
+	for _, issue := range result.Issues {
+		fmt.Fprintf(os.Stdout, "| %s | %s | %s | %s |\n",
+			escapeMarkdown(issue.Severity),
+			escapeMarkdown(issue.Code),
+			escapeMarkdown(issue.Path),
+			escapeMarkdown(compactWhitespace(issue.Message)),
+		)
+	}
+	return nil
+}
+
 func printBuildBetaGroupsUpdateTable(result *BuildBetaGroupsUpdateResult) error {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 	fmt.Fprintln(w, "Build ID\tGroup IDs\tAction")
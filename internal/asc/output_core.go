@@ -87,6 +87,22 @@ func PrintMarkdown(data interface{}) error {
 		return printInAppPurchasesMarkdown(&InAppPurchasesV2Response{Data: []Resource[InAppPurchaseV2Attributes]{v.Data}})
 	case *InAppPurchaseLocalizationsResponse:
 		return printInAppPurchaseLocalizationsMarkdown(v)
+	case *InAppPurchasePricePointsResponse:
+		return printInAppPurchasePricePointsMarkdown(v)
+	case *InAppPurchasePriceScheduleResponse:
+		return printInAppPurchasePriceScheduleMarkdown(v)
+	case *InAppPurchasePricingResult:
+		return printInAppPurchasePricingResultMarkdown(v)
+	case *InAppPurchaseAvailabilityResponse:
+		return printInAppPurchaseAvailabilityMarkdown(v)
+	case *InAppPurchaseAvailabilityResult:
+		return printInAppPurchaseAvailabilityResultMarkdown(v)
+	case *InAppPurchaseAssetUploadResult:
+		return printInAppPurchaseAssetUploadResultMarkdown(v)
+	case *InAppPurchaseContentResponse:
+		return printInAppPurchaseContentMarkdown(v)
+	case *InAppPurchaseSubmissionResponse:
+		return printInAppPurchaseSubmissionMarkdown(v)
 	case *SubscriptionGroupsResponse:
 		return printSubscriptionGroupsMarkdown(v)
 	case *SubscriptionGroupResponse:
@@ -99,6 +115,58 @@ func PrintMarkdown(data interface{}) error {
 		return printSubscriptionPriceMarkdown(v)
 	case *SubscriptionAvailabilityResponse:
 		return printSubscriptionAvailabilityMarkdown(v)
+	case *SubscriptionPricePointsResponse:
+		return printSubscriptionPricePointsMarkdown(v)
+	case *SubscriptionIntroductoryOffersResponse:
+		return printSubscriptionIntroductoryOffersMarkdown(v)
+	case *SubscriptionIntroductoryOfferResponse:
+		return printSubscriptionIntroductoryOffersMarkdown(&SubscriptionIntroductoryOffersResponse{Data: []Resource[SubscriptionIntroductoryOfferAttributes]{v.Data}})
+	case *SubscriptionPromotionalOffersResponse:
+		return printSubscriptionPromotionalOffersMarkdown(v)
+	case *SubscriptionPromotionalOfferResponse:
+		return printSubscriptionPromotionalOffersMarkdown(&SubscriptionPromotionalOffersResponse{Data: []Resource[SubscriptionPromotionalOfferAttributes]{v.Data}})
+	case *WinBackOffersResponse:
+		return printWinBackOffersMarkdown(v)
+	case *WinBackOfferResponse:
+		return printWinBackOffersMarkdown(&WinBackOffersResponse{Data: []Resource[WinBackOfferAttributes]{v.Data}})
+	case *SubscriptionLocalizationsResponse:
+		return printSubscriptionLocalizationsMarkdown(v)
+	case *SubscriptionLocalizationResponse:
+		return printSubscriptionLocalizationsMarkdown(&SubscriptionLocalizationsResponse{Data: []Resource[SubscriptionLocalizationAttributes]{v.Data}})
+	case *SubscriptionGroupLocalizationsResponse:
+		return printSubscriptionGroupLocalizationsMarkdown(v)
+	case *SubscriptionGroupLocalizationResponse:
+		return printSubscriptionGroupLocalizationsMarkdown(&SubscriptionGroupLocalizationsResponse{Data: []Resource[SubscriptionGroupLocalizationAttributes]{v.Data}})
+	case *SubscriptionLocalizationDeleteResult:
+		return printSubscriptionLocalizationDeleteResultMarkdown(v)
+	case *SubscriptionImagesResponse:
+		return printSubscriptionImagesMarkdown(v)
+	case *SubscriptionImageResponse:
+		return printSubscriptionImagesMarkdown(&SubscriptionImagesResponse{Data: []Resource[SubscriptionImageAttributes]{v.Data}})
+	case *SubscriptionReviewScreenshotResponse:
+		return printSubscriptionReviewScreenshotMarkdown(v)
+	case *SubscriptionAssetUploadResult:
+		return printSubscriptionAssetUploadResultMarkdown(v)
+	case *SubscriptionAssetDeleteResult:
+		return printSubscriptionAssetDeleteResultMarkdown(v)
+	case *SubscriptionSubmissionResponse:
+		return printSubscriptionSubmissionMarkdown(v.Data.ID)
+	case *SubscriptionGroupSubmissionResponse:
+		return printSubscriptionSubmissionMarkdown(v.Data.ID)
+	case *SubscriptionGracePeriodResponse:
+		return printSubscriptionGracePeriodMarkdown(v)
+	case *SubscriptionOfferCodesResponse:
+		return printSubscriptionOfferCodesMarkdown(v)
+	case *SubscriptionOfferCodeResponse:
+		return printSubscriptionOfferCodesMarkdown(&SubscriptionOfferCodesResponse{Data: []Resource[SubscriptionOfferCodeAttributes]{v.Data}})
+	case *SubscriptionOfferCodeCustomCodesResponse:
+		return printOfferCodeCustomCodesMarkdown(v)
+	case *SubscriptionOfferCodeCustomCodeResponse:
+		return printOfferCodeCustomCodesMarkdown(&SubscriptionOfferCodeCustomCodesResponse{Data: []Resource[OfferCodeCustomCodeAttributes]{v.Data}})
+	case *InAppPurchaseOfferCodesResponse:
+		return printInAppPurchaseOfferCodesMarkdown(v)
+	case *InAppPurchaseOfferCodeResponse:
+		return printInAppPurchaseOfferCodesMarkdown(&InAppPurchaseOfferCodesResponse{Data: []Resource[InAppPurchaseOfferCodeAttributes]{v.Data}})
 	case *TerritoriesResponse:
 		return printTerritoriesMarkdown(v)
 	case *AppPricePointsV3Response:
@@ -181,6 +249,8 @@ func PrintMarkdown(data interface{}) error {
 		return printDevicesMarkdown(v)
 	case *DeviceLocalUDIDResult:
 		return printDeviceLocalUDIDMarkdown(v)
+	case *DeviceRegisterBatchResult:
+		return printDeviceRegisterBatchResultMarkdown(v)
 	case *DeviceResponse:
 		return printDevicesMarkdown(&DevicesResponse{Data: []Resource[DeviceAttributes]{v.Data}})
 	case *UserInvitationsResponse:
@@ -245,6 +315,8 @@ func PrintMarkdown(data interface{}) error {
 		return printBuildUploadResultMarkdown(v)
 	case *BuildExpireAllResult:
 		return printBuildExpireAllResultMarkdown(v)
+	case *BuildValidateResult:
+		return printBuildValidateResultMarkdown(v)
 	case *AppScreenshotListResult:
 		return printAppScreenshotListResultMarkdown(v)
 	case *AppPreviewListResult:
@@ -263,6 +335,12 @@ func PrintMarkdown(data interface{}) error {
 		return printSalesReportResultMarkdown(v)
 	case *FinanceReportResult:
 		return printFinanceReportResultMarkdown(v)
+	case *ReportSummary:
+		return printReportSummaryMarkdown(v)
+	case *ReportBackfillResult:
+		return printReportBackfillResultMarkdown(v)
+	case *SQLiteExportResult:
+		return printSQLiteExportResultMarkdown(v)
 	case *FinanceRegionsResult:
 		return printFinanceRegionsMarkdown(v)
 	case *AnalyticsReportRequestResult:
@@ -275,6 +353,12 @@ func PrintMarkdown(data interface{}) error {
 		return printAnalyticsReportDownloadResultMarkdown(v)
 	case *AnalyticsReportGetResult:
 		return printAnalyticsReportGetResultMarkdown(v)
+	case *AnalyticsFetchResult:
+		return printAnalyticsFetchResultMarkdown(v)
+	case *ReviewDigest:
+		return printReviewDigestMarkdown(v)
+	case *ReviewResponseBatchResult:
+		return printReviewResponseBatchResultMarkdown(v)
 	case *AppStoreVersionSubmissionResult:
 		return printAppStoreVersionSubmissionMarkdown(v)
 	case *AppStoreVersionSubmissionCreateResult:
@@ -379,6 +463,8 @@ func PrintMarkdown(data interface{}) error {
 		return printSubscriptionGroupDeleteResultMarkdown(v)
 	case *SubscriptionDeleteResult:
 		return printSubscriptionDeleteResultMarkdown(v)
+	case *SubscriptionOfferDeleteResult:
+		return printSubscriptionOfferDeleteResultMarkdown(v)
 	case *BetaTesterDeleteResult:
 		return printBetaTesterDeleteResultMarkdown(v)
 	case *BetaTesterGroupsUpdateResult:
@@ -397,12 +483,24 @@ func PrintMarkdown(data interface{}) error {
 		return printBundleIDCapabilityDeleteResultMarkdown(v)
 	case *CertificateRevokeResult:
 		return printCertificateRevokeResultMarkdown(v)
+	case *CertificateP12Result:
+		return printCertificateP12ResultMarkdown(v)
 	case *ProfileDeleteResult:
 		return printProfileDeleteResultMarkdown(v)
 	case *ProfileDownloadResult:
 		return printProfileDownloadResultMarkdown(v)
+	case *ProfileRefreshResult:
+		return printProfileRefreshResultMarkdown(v)
+	case *ProfileInspectResult:
+		return printProfileInspectResultMarkdown(v)
+	case *CertificateInspectResult:
+		return printCertificateInspectResultMarkdown(v)
 	case *SigningFetchResult:
 		return printSigningFetchResultMarkdown(v)
+	case *SigningStoreResult:
+		return printSigningStoreResultMarkdown(v)
+	case *SigningRenewResult:
+		return printSigningRenewResultMarkdown(v)
 	case *XcodeCloudRunResult:
 		return printXcodeCloudRunResultMarkdown(v)
 	case *XcodeCloudStatusResult:
@@ -447,6 +545,10 @@ func PrintMarkdown(data interface{}) error {
 		return printCiIssueMarkdown(v)
 	case *CiArtifactDownloadResult:
 		return printCiArtifactDownloadResultMarkdown(v)
+	case *CiTestResultsExportResult:
+		return printCiTestResultsExportResultMarkdown(v)
+	case *CiWorkflowImportResult:
+		return printCiWorkflowImportResultMarkdown(v)
 	case *CiWorkflowDeleteResult:
 		return printCiWorkflowDeleteResultMarkdown(v)
 	case *CiProductDeleteResult:
@@ -527,6 +629,22 @@ func PrintTable(data interface{}) error {
 		return printInAppPurchasesTable(&InAppPurchasesV2Response{Data: []Resource[InAppPurchaseV2Attributes]{v.Data}})
 	case *InAppPurchaseLocalizationsResponse:
 		return printInAppPurchaseLocalizationsTable(v)
+	case *InAppPurchasePricePointsResponse:
+		return printInAppPurchasePricePointsTable(v)
+	case *InAppPurchasePriceScheduleResponse:
+		return printInAppPurchasePriceScheduleTable(v)
+	case *InAppPurchasePricingResult:
+		return printInAppPurchasePricingResultTable(v)
+	case *InAppPurchaseAvailabilityResponse:
+		return printInAppPurchaseAvailabilityTable(v)
+	case *InAppPurchaseAvailabilityResult:
+		return printInAppPurchaseAvailabilityResultTable(v)
+	case *InAppPurchaseAssetUploadResult:
+		return printInAppPurchaseAssetUploadResultTable(v)
+	case *InAppPurchaseContentResponse:
+		return printInAppPurchaseContentTable(v)
+	case *InAppPurchaseSubmissionResponse:
+		return printInAppPurchaseSubmissionTable(v)
 	case *SubscriptionGroupsResponse:
 		return printSubscriptionGroupsTable(v)
 	case *SubscriptionGroupResponse:
@@ -539,6 +657,58 @@ func PrintTable(data interface{}) error {
 		return printSubscriptionPriceTable(v)
 	case *SubscriptionAvailabilityResponse:
 		return printSubscriptionAvailabilityTable(v)
+	case *SubscriptionPricePointsResponse:
+		return printSubscriptionPricePointsTable(v)
+	case *SubscriptionIntroductoryOffersResponse:
+		return printSubscriptionIntroductoryOffersTable(v)
+	case *SubscriptionIntroductoryOfferResponse:
+		return printSubscriptionIntroductoryOffersTable(&SubscriptionIntroductoryOffersResponse{Data: []Resource[SubscriptionIntroductoryOfferAttributes]{v.Data}})
+	case *SubscriptionPromotionalOffersResponse:
+		return printSubscriptionPromotionalOffersTable(v)
+	case *SubscriptionPromotionalOfferResponse:
+		return printSubscriptionPromotionalOffersTable(&SubscriptionPromotionalOffersResponse{Data: []Resource[SubscriptionPromotionalOfferAttributes]{v.Data}})
+	case *WinBackOffersResponse:
+		return printWinBackOffersTable(v)
+	case *WinBackOfferResponse:
+		return printWinBackOffersTable(&WinBackOffersResponse{Data: []Resource[WinBackOfferAttributes]{v.Data}})
+	case *SubscriptionLocalizationsResponse:
+		return printSubscriptionLocalizationsTable(v)
+	case *SubscriptionLocalizationResponse:
+		return printSubscriptionLocalizationsTable(&SubscriptionLocalizationsResponse{Data: []Resource[SubscriptionLocalizationAttributes]{v.Data}})
+	case *SubscriptionGroupLocalizationsResponse:
+		return printSubscriptionGroupLocalizationsTable(v)
+	case *SubscriptionGroupLocalizationResponse:
+		return printSubscriptionGroupLocalizationsTable(&SubscriptionGroupLocalizationsResponse{Data: []Resource[SubscriptionGroupLocalizationAttributes]{v.Data}})
+	case *SubscriptionLocalizationDeleteResult:
+		return printSubscriptionLocalizationDeleteResultTable(v)
+	case *SubscriptionImagesResponse:
+		return printSubscriptionImagesTable(v)
+	case *SubscriptionImageResponse:
+		return printSubscriptionImagesTable(&SubscriptionImagesResponse{Data: []Resource[SubscriptionImageAttributes]{v.Data}})
+	case *SubscriptionReviewScreenshotResponse:
+		return printSubscriptionReviewScreenshotTable(v)
+	case *SubscriptionAssetUploadResult:
+		return printSubscriptionAssetUploadResultTable(v)
+	case *SubscriptionAssetDeleteResult:
+		return printSubscriptionAssetDeleteResultTable(v)
+	case *SubscriptionSubmissionResponse:
+		return printSubscriptionSubmissionTable(v.Data.ID)
+	case *SubscriptionGroupSubmissionResponse:
+		return printSubscriptionSubmissionTable(v.Data.ID)
+	case *SubscriptionGracePeriodResponse:
+		return printSubscriptionGracePeriodTable(v)
+	case *SubscriptionOfferCodesResponse:
+		return printSubscriptionOfferCodesTable(v)
+	case *SubscriptionOfferCodeResponse:
+		return printSubscriptionOfferCodesTable(&SubscriptionOfferCodesResponse{Data: []Resource[SubscriptionOfferCodeAttributes]{v.Data}})
+	case *SubscriptionOfferCodeCustomCodesResponse:
+		return printOfferCodeCustomCodesTable(v)
+	case *SubscriptionOfferCodeCustomCodeResponse:
+		return printOfferCodeCustomCodesTable(&SubscriptionOfferCodeCustomCodesResponse{Data: []Resource[OfferCodeCustomCodeAttributes]{v.Data}})
+	case *InAppPurchaseOfferCodesResponse:
+		return printInAppPurchaseOfferCodesTable(v)
+	case *InAppPurchaseOfferCodeResponse:
+		return printInAppPurchaseOfferCodesTable(&InAppPurchaseOfferCodesResponse{Data: []Resource[InAppPurchaseOfferCodeAttributes]{v.Data}})
 	case *TerritoriesResponse:
 		return printTerritoriesTable(v)
 	case *AppPricePointsV3Response:
@@ -621,6 +791,8 @@ func PrintTable(data interface{}) error {
 		return printDevicesTable(v)
 	case *DeviceLocalUDIDResult:
 		return printDeviceLocalUDIDTable(v)
+	case *DeviceRegisterBatchResult:
+		return printDeviceRegisterBatchResultTable(v)
 	case *DeviceResponse:
 		return printDevicesTable(&DevicesResponse{Data: []Resource[DeviceAttributes]{v.Data}})
 	case *UserInvitationsResponse:
@@ -685,6 +857,8 @@ func PrintTable(data interface{}) error {
 		return printBuildUploadResultTable(v)
 	case *BuildExpireAllResult:
 		return printBuildExpireAllResultTable(v)
+	case *BuildValidateResult:
+		return printBuildValidateResultTable(v)
 	case *AppScreenshotListResult:
 		return printAppScreenshotListResultTable(v)
 	case *AppPreviewListResult:
@@ -703,6 +877,12 @@ func PrintTable(data interface{}) error {
 		return printSalesReportResultTable(v)
 	case *FinanceReportResult:
 		return printFinanceReportResultTable(v)
+	case *ReportSummary:
+		return printReportSummaryTable(v)
+	case *ReportBackfillResult:
+		return printReportBackfillResultTable(v)
+	case *SQLiteExportResult:
+		return printSQLiteExportResultTable(v)
 	case *FinanceRegionsResult:
 		return printFinanceRegionsTable(v)
 	case *AnalyticsReportRequestResult:
@@ -715,6 +895,12 @@ func PrintTable(data interface{}) error {
 		return printAnalyticsReportDownloadResultTable(v)
 	case *AnalyticsReportGetResult:
 		return printAnalyticsReportGetResultTable(v)
+	case *AnalyticsFetchResult:
+		return printAnalyticsFetchResultTable(v)
+	case *ReviewDigest:
+		return printReviewDigestTable(v)
+	case *ReviewResponseBatchResult:
+		return printReviewResponseBatchResultTable(v)
 	case *AppStoreVersionSubmissionResult:
 		return printAppStoreVersionSubmissionTable(v)
 	case *AppStoreVersionSubmissionCreateResult:
@@ -819,6 +1005,8 @@ func PrintTable(data interface{}) error {
 		return printSubscriptionGroupDeleteResultTable(v)
 	case *SubscriptionDeleteResult:
 		return printSubscriptionDeleteResultTable(v)
+	case *SubscriptionOfferDeleteResult:
+		return printSubscriptionOfferDeleteResultTable(v)
 	case *BetaTesterDeleteResult:
 		return printBetaTesterDeleteResultTable(v)
 	case *BetaTesterGroupsUpdateResult:
@@ -837,6 +1025,8 @@ func PrintTable(data interface{}) error {
 		return printBundleIDCapabilityDeleteResultTable(v)
 	case *CertificateRevokeResult:
 		return printCertificateRevokeResultTable(v)
+	case *CertificateP12Result:
+		return printCertificateP12ResultTable(v)
 	case *ProfileDeleteResult:
 		return printProfileDeleteResultTable(v)
 	case *EndUserLicenseAgreementResponse:
@@ -845,8 +1035,18 @@ func PrintTable(data interface{}) error {
 		return printEndUserLicenseAgreementDeleteResultTable(v)
 	case *ProfileDownloadResult:
 		return printProfileDownloadResultTable(v)
+	case *ProfileRefreshResult:
+		return printProfileRefreshResultTable(v)
+	case *ProfileInspectResult:
+		return printProfileInspectResultTable(v)
+	case *CertificateInspectResult:
+		return printCertificateInspectResultTable(v)
 	case *SigningFetchResult:
 		return printSigningFetchResultTable(v)
+	case *SigningStoreResult:
+		return printSigningStoreResultTable(v)
+	case *SigningRenewResult:
+		return printSigningRenewResultTable(v)
 	case *XcodeCloudRunResult:
 		return printXcodeCloudRunResultTable(v)
 	case *XcodeCloudStatusResult:
@@ -891,6 +1091,10 @@ func PrintTable(data interface{}) error {
 		return printCiIssueTable(v)
 	case *CiArtifactDownloadResult:
 		return printCiArtifactDownloadResultTable(v)
+	case *CiTestResultsExportResult:
+		return printCiTestResultsExportResultTable(v)
+	case *CiWorkflowImportResult:
+		return printCiWorkflowImportResultTable(v)
 	case *CiWorkflowDeleteResult:
 		return printCiWorkflowDeleteResultTable(v)
 	case *CiProductDeleteResult:
@@ -2179,6 +2179,84 @@ func TestPrintMarkdown_FinanceReportResult(t *testing.T) {
 	}
 }
 
+func TestPrintTable_ReportBackfillResult(t *testing.T) {
+	result := &ReportBackfillResult{
+		Dir:         "reports",
+		Manifest:    "reports/manifest.json",
+		Requested:   3,
+		Downloaded:  1,
+		Unavailable: 1,
+		Failed:      1,
+		Failures: []ReportBackfillFailure{
+			{Period: "2024-01-03", File: "sales_report_2024-01-03_SALES_SUMMARY_DAILY.tsv.gz", Error: "forbidden"},
+		},
+	}
+
+	output := captureStdout(t, func() error {
+		return PrintTable(result)
+	})
+
+	if !strings.Contains(output, "Unavailable") {
+		t.Fatalf("expected unavailable header in output, got: %s", output)
+	}
+	if !strings.Contains(output, "sales_report_2024-01-03_SALES_SUMMARY_DAILY.tsv.gz") {
+		t.Fatalf("expected failed file in output, got: %s", output)
+	}
+}
+
+func TestPrintMarkdown_ReportBackfillResult(t *testing.T) {
+	result := &ReportBackfillResult{
+		Dir:        "finance",
+		Manifest:   "finance/manifest.json",
+		Requested:  2,
+		Downloaded: 2,
+	}
+
+	output := captureStdout(t, func() error {
+		return PrintMarkdown(result)
+	})
+
+	if !strings.Contains(output, "| Dir | Manifest | Requested |") {
+		t.Fatalf("expected markdown header, got: %s", output)
+	}
+	if strings.Contains(output, "| Period |") {
+		t.Fatalf("expected no failures table, got: %s", output)
+	}
+}
+
+func TestPrintTable_SQLiteExportResult(t *testing.T) {
+	result := &SQLiteExportResult{
+		Database: "asc.db",
+		Sources: []SQLiteExportSource{
+			{Source: "reports/day1.tsv.gz", Table: "sales_report_rows", Rows: 12, Status: "loaded"},
+			{Source: "reviews", Table: "reviews", Rows: 3, Status: "synced"},
+		},
+	}
+
+	output := captureStdout(t, func() error {
+		return PrintTable(result)
+	})
+
+	if !strings.Contains(output, "Database: asc.db") || !strings.Contains(output, "sales_report_rows") {
+		t.Fatalf("expected database and table in output, got: %s", output)
+	}
+}
+
+func TestPrintMarkdown_SQLiteExportResult(t *testing.T) {
+	result := &SQLiteExportResult{
+		Database: "asc.db",
+		Sources:  []SQLiteExportSource{{Source: "builds", Table: "builds", Rows: 7, Status: "synced"}},
+	}
+
+	output := captureStdout(t, func() error {
+		return PrintMarkdown(result)
+	})
+
+	if !strings.Contains(output, "| Source | Table | Rows | Status |") || !strings.Contains(output, "| builds | builds | 7 | synced |") {
+		t.Fatalf("expected markdown table, got: %s", output)
+	}
+}
+
 func TestPrintTable_FinanceRegionsResult(t *testing.T) {
 	result := &FinanceRegionsResult{
 		Regions: []FinanceRegion{
@@ -0,0 +1,52 @@
+package asc
+
+// ProfileInspectCertificate describes a developer certificate embedded in a local profile.
+type ProfileInspectCertificate struct {
+	CommonName     string `json:"commonName,omitempty"`
+	SerialNumber   string `json:"serialNumber,omitempty"`
+	SHA1           string `json:"sha1"`
+	SHA256         string `json:"sha256"`
+	ExpirationDate string `json:"expirationDate,omitempty"`
+	Expired        bool   `json:"expired,omitempty"`
+	ASCID          string `json:"ascId,omitempty"`
+	ASCStatus      string `json:"ascStatus,omitempty"`
+}
+
+// ProfileInspectRemote is the App Store Connect view of an inspected profile.
+type ProfileInspectRemote struct {
+	Found          bool     `json:"found"`
+	ID             string   `json:"id,omitempty"`
+	Name           string   `json:"name,omitempty"`
+	ProfileType    string   `json:"profileType,omitempty"`
+	State          string   `json:"state,omitempty"`
+	ExpirationDate string   `json:"expirationDate,omitempty"`
+	Issues         []string `json:"issues,omitempty"`
+}
+
+// ProfileInspectResult represents CLI output for profiles inspect.
+type ProfileInspectResult struct {
+	File                  string                      `json:"file"`
+	Name                  string                      `json:"name"`
+	UUID                  string                      `json:"uuid"`
+	TeamID                string                      `json:"teamId,omitempty"`
+	TeamName              string                      `json:"teamName,omitempty"`
+	AppIDName             string                      `json:"appIdName,omitempty"`
+	ApplicationIdentifier string                      `json:"applicationIdentifier,omitempty"`
+	Platforms             []string                    `json:"platforms,omitempty"`
+	CreationDate          string                      `json:"creationDate,omitempty"`
+	ExpirationDate        string                      `json:"expirationDate,omitempty"`
+	Expired               bool                        `json:"expired"`
+	ProvisionsAllDevices  bool                        `json:"provisionsAllDevices,omitempty"`
+	Devices               []string                    `json:"devices"`
+	Entitlements          map[string]interface{}      `json:"entitlements"`
+	Certificates          []ProfileInspectCertificate `json:"certificates"`
+	AppStoreConnect       *ProfileInspectRemote       `json:"appStoreConnect,omitempty"`
+}
+
+// CertificateInspectResult represents CLI output for profiles inspect on a
+// certificate file.
+type CertificateInspectResult struct {
+	File string `json:"file"`
+	ProfileInspectCertificate
+	Issues []string `json:"issues,omitempty"`
+}
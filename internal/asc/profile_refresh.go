@@ -0,0 +1,24 @@
+package asc
+
+// ProfileRefreshItem describes a single profile handled by profiles refresh-devices.
+type ProfileRefreshItem struct {
+	ID              string   `json:"id"`
+	Name            string   `json:"name"`
+	Status          string   `json:"status"`
+	NewID           string   `json:"newId,omitempty"`
+	CertificateIDs  []string `json:"certificateIds"`
+	PreviousDevices int      `json:"previousDevices"`
+	Devices         int      `json:"devices"`
+	OutputFile      string   `json:"outputFile,omitempty"`
+	Error           string   `json:"error,omitempty"`
+}
+
+// ProfileRefreshResult represents CLI output for profiles refresh-devices.
+type ProfileRefreshResult struct {
+	BundleID    string               `json:"bundleId"`
+	ProfileType string               `json:"profileType"`
+	Platform    string               `json:"platform"`
+	DeviceCount int                  `json:"deviceCount"`
+	DryRun      bool                 `json:"dryRun,omitempty"`
+	Profiles    []ProfileRefreshItem `json:"profiles"`
+}
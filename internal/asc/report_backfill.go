@@ -0,0 +1,86 @@
+package asc
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+)
+
+// ReportBackfillFailure describes a report that could not be fetched.
+type ReportBackfillFailure struct {
+	Period string `json:"period"`
+	File   string `json:"file"`
+	Error  string `json:"error"`
+}
+
+// ReportBackfillResult represents CLI output for sales and finance backfills.
+type ReportBackfillResult struct {
+	Dir         string                  `json:"dir"`
+	Manifest    string                  `json:"manifest"`
+	Requested   int                     `json:"requested"`
+	Downloaded  int                     `json:"downloaded"`
+	Skipped     int                     `json:"skipped"`
+	Unavailable int                     `json:"unavailable"`
+	Pending     int                     `json:"pending"`
+	Failed      int                     `json:"failed"`
+	Failures    []ReportBackfillFailure `json:"failures,omitempty"`
+}
+
+func printReportBackfillResultTable(result *ReportBackfillResult) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Dir\tManifest\tRequested\tDownloaded\tSkipped\tUnavailable\tPending\tFailed")
+	fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\t%d\t%d\t%d\n",
+		result.Dir,
+		result.Manifest,
+		result.Requested,
+		result.Downloaded,
+		result.Skipped,
+		result.Unavailable,
+		result.Pending,
+		result.Failed,
+	)
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if len(result.Failures) == 0 {
+		return nil
+	}
+
+	fmt.Fprintln(os.Stdout)
+	w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Period\tFile\tError")
+	for _, failure := range result.Failures {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", failure.Period, failure.File, compactWhitespace(failure.Error))
+	}
+	return w.Flush()
+}
+
+func printReportBackfillResultMarkdown(result *ReportBackfillResult) error {
+	fmt.Fprintln(os.Stdout, "| Dir | Manifest | Requested | Downloaded | Skipped | Unavailable | Pending | Failed |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- | --- | --- | --- |")
+	fmt.Fprintf(os.Stdout, "| %s | %s | %d | %d | %d | %d | %d | %d |\n",
+		escapeMarkdown(result.Dir),
+		escapeMarkdown(result.Manifest),
+		result.Requested,
+		result.Downloaded,
+		result.Skipped,
+		result.Unavailable,
+		result.Pending,
+		result.Failed,
+	)
+	if len(result.Failures) == 0 {
+		return nil
+	}
+
+	fmt.Fprintln(os.Stdout)
+	fmt.Fprintln(os.Stdout, "| Period | File | Error |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- |")
+	for _, failure := range result.Failures {
+		fmt.Fprintf(os.Stdout, "| %s | %s | %s |\n",
+			escapeMarkdown(failure.Period),
+			escapeMarkdown(failure.File),
+			escapeMarkdown(failure.Error),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+package asc
+
+import (
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const maxReportLineSize = 1 << 20
+
+// reportTable is a tab-separated report split into rows of cells.
+type reportTable struct {
+	rows [][]string
+}
+
+// readReportTable reads a tab-separated report, inflating gzip input when the
+// stream starts with the gzip magic bytes.
+func readReportTable(r io.Reader) (*reportTable, error) {
+	reader, closeReader, err := openReportStream(r)
+	if err != nil {
+		return nil, err
+	}
+	defer closeReader()
+
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 64*1024), maxReportLineSize)
+	table := &reportTable{}
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if len(table.rows) == 0 {
+			line = strings.TrimPrefix(line, "\ufeff")
+		}
+		table.rows = append(table.rows, strings.Split(line, "\t"))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read report: %w", err)
+	}
+	return table, nil
+}
+
+// openReportStream returns a reader over the report contents, transparently
+// inflating gzip input detected by its magic bytes.
+func openReportStream(r io.Reader) (io.Reader, func(), error) {
+	buffered := bufio.NewReader(r)
+	magic, err := buffered.Peek(2)
+	if err != nil && err != io.EOF {
+		return nil, nil, fmt.Errorf("read report: %w", err)
+	}
+	if len(magic) == 2 && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(buffered)
+		if err != nil {
+			return nil, nil, fmt.Errorf("read report: %w", err)
+		}
+		return gz, func() { gz.Close() }, nil
+	}
+	return buffered, func() {}, nil
+}
+
+// reportColumns maps normalized header names to cell indexes.
+type reportColumns map[string]int
+
+func newReportColumns(header []string) reportColumns {
+	columns := make(reportColumns, len(header))
+	for i, name := range header {
+		key := normalizeReportColumn(name)
+		if _, exists := columns[key]; !exists && key != "" {
+			columns[key] = i
+		}
+	}
+	return columns
+}
+
+func normalizeReportColumn(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
+
+func (c reportColumns) has(names ...string) bool {
+	for _, name := range names {
+		if _, ok := c[normalizeReportColumn(name)]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// value returns the cell for the first of names present in the header.
+func (c reportColumns) value(row []string, names ...string) string {
+	for _, name := range names {
+		index, ok := c[normalizeReportColumn(name)]
+		if !ok {
+			continue
+		}
+		if index < len(row) {
+			return strings.TrimSpace(row[index])
+		}
+		return ""
+	}
+	return ""
+}
+
+func (c reportColumns) number(row []string, names ...string) (float64, error) {
+	value := c.value(row, names...)
+	parsed, err := parseReportNumber(value)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", names[0], err)
+	}
+	return parsed, nil
+}
+
+func parseReportNumber(value string) (float64, error) {
+	value = strings.ReplaceAll(strings.TrimSpace(value), ",", "")
+	if value == "" {
+		return 0, nil
+	}
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid number %q", value)
+	}
+	return parsed, nil
+}
+
+func isBlankReportRow(row []string) bool {
+	for _, cell := range row {
+		if strings.TrimSpace(cell) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// isReportTotalsRow matches the Total_Rows/Total_Amount/Total_Units trailer of
+// finance reports.
+func isReportTotalsRow(row []string) bool {
+	return len(row) > 0 && strings.HasPrefix(strings.TrimSpace(row[0]), "Total_")
+}
+
+// eachSectionRow calls fn for every row that follows a header row accepted by
+// isHeader. A blank or totals row ends the section. It reports whether any
+// matching header was found.
+func (t *reportTable) eachSectionRow(isHeader func(reportColumns) bool, fn func(columns reportColumns, row []string) error) (bool, error) {
+	var columns reportColumns
+	found := false
+	for i, row := range t.rows {
+		if isBlankReportRow(row) || isReportTotalsRow(row) {
+			columns = nil
+			continue
+		}
+		if candidate := newReportColumns(row); isHeader(candidate) {
+			columns = candidate
+			found = true
+			continue
+		}
+		if columns == nil {
+			continue
+		}
+		if err := fn(columns, row); err != nil {
+			return found, fmt.Errorf("line %d: %w", i+1, err)
+		}
+	}
+	return found, nil
+}
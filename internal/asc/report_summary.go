@@ -0,0 +1,349 @@
+package asc
+
+import (
+	"encoding/csv"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// ReportSummaryGroup aggregates report rows sharing one value of a dimension.
+type ReportSummaryGroup struct {
+	Key      string  `json:"key"`
+	Name     string  `json:"name,omitempty"`
+	Currency string  `json:"currency,omitempty"`
+	Units    float64 `json:"units"`
+	Proceeds float64 `json:"proceeds"`
+	Rows     int     `json:"rows"`
+}
+
+// ReportSummary aggregates sales or finance report rows by SKU, territory,
+// currency and product type. When ReportingCurrency is set, proceeds outside
+// ByCurrency are converted into it; rows in UnconvertedCurrencies keep their
+// own currency.
+type ReportSummary struct {
+	ReportType            string               `json:"reportType"`
+	Files                 []string             `json:"files,omitempty"`
+	Rows                  int                  `json:"rows"`
+	ReportingCurrency     string               `json:"reportingCurrency,omitempty"`
+	UnconvertedCurrencies []string             `json:"unconvertedCurrencies,omitempty"`
+	Totals                []ReportSummaryGroup `json:"totals"`
+	BySKU                 []ReportSummaryGroup `json:"bySku"`
+	ByTerritory           []ReportSummaryGroup `json:"byTerritory"`
+	ByCurrency            []ReportSummaryGroup `json:"byCurrency"`
+	ByProductType         []ReportSummaryGroup `json:"byProductType"`
+}
+
+// reportSummaryLine is one report row reduced to the summary dimensions.
+type reportSummaryLine struct {
+	SKU         string
+	Name        string
+	Territory   string
+	Currency    string
+	ProductType string
+	Units       float64
+	Proceeds    float64
+}
+
+type reportSummaryDimension struct {
+	groups map[string]*ReportSummaryGroup
+}
+
+func (d *reportSummaryDimension) add(key, name, currency string, units, proceeds float64) {
+	if d.groups == nil {
+		d.groups = make(map[string]*ReportSummaryGroup)
+	}
+	id := key + "\x00" + currency
+	group, ok := d.groups[id]
+	if !ok {
+		group = &ReportSummaryGroup{Key: key, Name: name, Currency: currency}
+		d.groups[id] = group
+	}
+	group.Units += units
+	group.Proceeds += proceeds
+	group.Rows++
+}
+
+func (d *reportSummaryDimension) sorted() []ReportSummaryGroup {
+	groups := make([]ReportSummaryGroup, 0, len(d.groups))
+	for _, group := range d.groups {
+		rounded := *group
+		rounded.Units = roundReportValue(rounded.Units, 4)
+		rounded.Proceeds = roundReportValue(rounded.Proceeds, 2)
+		groups = append(groups, rounded)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		if groups[i].Proceeds != groups[j].Proceeds {
+			return groups[i].Proceeds > groups[j].Proceeds
+		}
+		if groups[i].Units != groups[j].Units {
+			return groups[i].Units > groups[j].Units
+		}
+		if groups[i].Key != groups[j].Key {
+			return groups[i].Key < groups[j].Key
+		}
+		return groups[i].Currency < groups[j].Currency
+	})
+	return groups
+}
+
+func roundReportValue(value float64, places int) float64 {
+	scale := math.Pow(10, float64(places))
+	return math.Round(value*scale) / scale
+}
+
+// ReportSummaryBuilder accumulates parsed reports into a ReportSummary.
+type ReportSummaryBuilder struct {
+	reportType        string
+	reportingCurrency string
+	converted         bool
+	files             []string
+	rows              int
+	unconverted       map[string]bool
+	totals            reportSummaryDimension
+	bySKU             reportSummaryDimension
+	byTerritory       reportSummaryDimension
+	byCurrency        reportSummaryDimension
+	byProductType     reportSummaryDimension
+}
+
+// NewReportSummaryBuilder returns a builder for the given report type. For
+// finance reports, reportingCurrency selects the conversion target; empty uses
+// the bank account currency from the first report with exchange rates.
+func NewReportSummaryBuilder(reportType, reportingCurrency string) *ReportSummaryBuilder {
+	return &ReportSummaryBuilder{
+		reportType:        reportType,
+		reportingCurrency: strings.ToUpper(strings.TrimSpace(reportingCurrency)),
+		unconverted:       make(map[string]bool),
+	}
+}
+
+// AddFile records a source file name for the summary.
+func (b *ReportSummaryBuilder) AddFile(path string) {
+	b.files = append(b.files, path)
+}
+
+// AddSalesReport adds a parsed sales report. SALES proceeds are units times the
+// per-unit developer proceeds. SUBSCRIPTION rows count subscribers as units and
+// estimate proceeds from paid active subscriptions, grouping product type by
+// subscription duration. SUBSCRIPTION_EVENT rows count event quantities and
+// group product type by event.
+func (b *ReportSummaryBuilder) AddSalesReport(report *ParsedSalesReport) {
+	for _, row := range report.Sales {
+		b.add(reportSummaryLine{
+			SKU:         row.SKU,
+			Name:        row.Title,
+			Territory:   row.CountryCode,
+			Currency:    row.CurrencyOfProceeds,
+			ProductType: row.ProductTypeIdentifier,
+			Units:       row.Units,
+			Proceeds:    row.Units * row.DeveloperProceeds,
+		}, nil)
+	}
+	for _, row := range report.Subscriptions {
+		paid := row.ActiveStandardPriceSubscriptions + row.ActivePayUpFrontIntroductoryOfferSubscriptions + row.ActivePayAsYouGoIntroductoryOfferSubscriptions
+		b.add(reportSummaryLine{
+			SKU:         row.SubscriptionAppleID,
+			Name:        row.SubscriptionName,
+			Territory:   row.Country,
+			Currency:    row.ProceedsCurrency,
+			ProductType: row.StandardSubscriptionDuration,
+			Units:       row.Subscribers,
+			Proceeds:    paid * row.DeveloperProceeds,
+		}, nil)
+	}
+	for _, row := range report.SubscriptionEvents {
+		b.add(reportSummaryLine{
+			SKU:         row.SubscriptionAppleID,
+			Name:        row.SubscriptionName,
+			Territory:   row.Country,
+			ProductType: row.Event,
+			Units:       row.Quantity,
+		}, nil)
+	}
+}
+
+// AddFinanceReport adds a parsed finance report, converting extended partner
+// share into the reporting currency with the report's own exchange rates.
+func (b *ReportSummaryBuilder) AddFinanceReport(report *ParsedFinanceReport) {
+	if b.reportingCurrency == "" {
+		for _, rate := range report.ExchangeRates {
+			if rate.BankAccountCurrency != "" {
+				b.reportingCurrency = rate.BankAccountCurrency
+				break
+			}
+		}
+	}
+	converter := newFinanceCurrencyConverter(report.ExchangeRates, b.reportingCurrency)
+	b.converted = b.converted || converter != nil
+	for _, row := range report.Rows {
+		b.add(reportSummaryLine{
+			SKU:         row.VendorIdentifier,
+			Name:        row.Title,
+			Territory:   row.CountryOfSale,
+			Currency:    row.PartnerShareCurrency,
+			ProductType: row.ProductTypeIdentifier,
+			Units:       row.Quantity,
+			Proceeds:    row.ExtendedPartnerShare,
+		}, converter)
+	}
+}
+
+func (b *ReportSummaryBuilder) add(line reportSummaryLine, converter *financeCurrencyConverter) {
+	b.rows++
+	line.Currency = strings.ToUpper(strings.TrimSpace(line.Currency))
+	b.byCurrency.add(line.Currency, "", line.Currency, line.Units, line.Proceeds)
+
+	currency, proceeds := line.Currency, line.Proceeds
+	if converter != nil && line.Currency != "" {
+		if converted, ok := converter.convert(line.Proceeds, line.Currency); ok {
+			currency, proceeds = converter.target, converted
+		} else {
+			b.unconverted[line.Currency] = true
+		}
+	}
+	b.totals.add("TOTAL", "", currency, line.Units, proceeds)
+	b.bySKU.add(line.SKU, line.Name, currency, line.Units, proceeds)
+	b.byTerritory.add(line.Territory, "", currency, line.Units, proceeds)
+	b.byProductType.add(line.ProductType, "", currency, line.Units, proceeds)
+}
+
+// Summary returns the aggregates with groups sorted by proceeds, then units.
+func (b *ReportSummaryBuilder) Summary() *ReportSummary {
+	summary := &ReportSummary{
+		ReportType:    b.reportType,
+		Files:         b.files,
+		Rows:          b.rows,
+		Totals:        b.totals.sorted(),
+		BySKU:         b.bySKU.sorted(),
+		ByTerritory:   b.byTerritory.sorted(),
+		ByCurrency:    b.byCurrency.sorted(),
+		ByProductType: b.byProductType.sorted(),
+	}
+	if b.converted {
+		summary.ReportingCurrency = b.reportingCurrency
+	}
+	for currency := range b.unconverted {
+		summary.UnconvertedCurrencies = append(summary.UnconvertedCurrencies, currency)
+	}
+	sort.Strings(summary.UnconvertedCurrencies)
+	return summary
+}
+
+// financeCurrencyConverter converts partner share amounts using the rates from
+// a finance report, which convert each currency into the bank account currency.
+type financeCurrencyConverter struct {
+	target string
+	bank   string
+	rates  map[string]float64
+}
+
+func newFinanceCurrencyConverter(exchangeRates []FinanceExchangeRate, target string) *financeCurrencyConverter {
+	if target == "" {
+		return nil
+	}
+	converter := &financeCurrencyConverter{target: target, rates: make(map[string]float64)}
+	for _, rate := range exchangeRates {
+		if converter.bank == "" {
+			converter.bank = rate.BankAccountCurrency
+		}
+		if _, exists := converter.rates[rate.Currency]; !exists {
+			converter.rates[rate.Currency] = rate.ExchangeRate
+		}
+	}
+	return converter
+}
+
+func (c *financeCurrencyConverter) convert(amount float64, currency string) (float64, bool) {
+	if currency == c.target {
+		return amount, true
+	}
+	rate, ok := c.rates[currency]
+	if !ok {
+		return 0, false
+	}
+	if c.target == c.bank {
+		return amount * rate, true
+	}
+	// Cross-convert through the bank account currency.
+	targetRate, ok := c.rates[c.target]
+	if !ok || targetRate == 0 {
+		return 0, false
+	}
+	return amount * rate / targetRate, true
+}
+
+func reportSummaryRecords(summary *ReportSummary) [][]string {
+	records := [][]string{{"Dimension", "Key", "Name", "Currency", "Units", "Proceeds", "Rows"}}
+	sections := []struct {
+		name   string
+		groups []ReportSummaryGroup
+	}{
+		{"total", summary.Totals},
+		{"sku", summary.BySKU},
+		{"territory", summary.ByTerritory},
+		{"currency", summary.ByCurrency},
+		{"product_type", summary.ByProductType},
+	}
+	for _, section := range sections {
+		for _, group := range section.groups {
+			records = append(records, []string{
+				section.name,
+				group.Key,
+				group.Name,
+				group.Currency,
+				strconv.FormatFloat(group.Units, 'f', -1, 64),
+				strconv.FormatFloat(group.Proceeds, 'f', 2, 64),
+				strconv.Itoa(group.Rows),
+			})
+		}
+	}
+	return records
+}
+
+// PrintReportSummaryCSV prints report summary aggregates as CSV.
+func PrintReportSummaryCSV(summary *ReportSummary) error {
+	writer := csv.NewWriter(os.Stdout)
+	if err := writer.WriteAll(reportSummaryRecords(summary)); err != nil {
+		return fmt.Errorf("write csv: %w", err)
+	}
+	return nil
+}
+
+func printReportSummaryTable(summary *ReportSummary) error {
+	if summary.ReportingCurrency != "" {
+		fmt.Fprintf(os.Stdout, "Reporting currency: %s\n", summary.ReportingCurrency)
+	}
+	if len(summary.UnconvertedCurrencies) > 0 {
+		fmt.Fprintf(os.Stdout, "Unconverted currencies: %s\n", strings.Join(summary.UnconvertedCurrencies, ", "))
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for _, record := range reportSummaryRecords(summary) {
+		record[2] = compactWhitespace(record[2])
+		fmt.Fprintln(w, strings.Join(record, "\t"))
+	}
+	return w.Flush()
+}
+
+func printReportSummaryMarkdown(summary *ReportSummary) error {
+	if summary.ReportingCurrency != "" {
+		fmt.Fprintf(os.Stdout, "**Reporting currency:** %s\n\n", escapeMarkdown(summary.ReportingCurrency))
+	}
+	if len(summary.UnconvertedCurrencies) > 0 {
+		fmt.Fprintf(os.Stdout, "**Unconverted currencies:** %s\n\n", escapeMarkdown(strings.Join(summary.UnconvertedCurrencies, ", ")))
+	}
+	records := reportSummaryRecords(summary)
+	fmt.Fprintf(os.Stdout, "| %s |\n", strings.Join(records[0], " | "))
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- | --- | --- |")
+	for _, record := range records[1:] {
+		for i := range record {
+			record[i] = escapeMarkdown(record[i])
+		}
+		fmt.Fprintf(os.Stdout, "| %s |\n", strings.Join(record, " | "))
+	}
+	return nil
+}
@@ -0,0 +1,205 @@
+package asc
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+)
+
+// ReviewDigest summarizes customer reviews over a time window.
+type ReviewDigest struct {
+	AppID         string               `json:"appId"`
+	Since         string               `json:"since"`
+	Total         int                  `json:"total"`
+	Responded     int                  `json:"responded"`
+	AverageRating float64              `json:"averageRating"`
+	Ratings       []ReviewDigestGroup  `json:"ratings"`
+	Territories   []ReviewDigestGroup  `json:"territories"`
+	Versions      []ReviewDigestGroup  `json:"versions"`
+	Keywords      []ReviewDigestTerm   `json:"keywords"`
+	Phrases       []ReviewDigestTerm   `json:"phrases"`
+	Reviews       []ReviewDigestReview `json:"reviews"`
+}
+
+// ReviewDigestGroup counts reviews sharing a rating, territory or version.
+type ReviewDigestGroup struct {
+	Key           string  `json:"key"`
+	Count         int     `json:"count"`
+	Responded     int     `json:"responded"`
+	AverageRating float64 `json:"averageRating"`
+}
+
+// ReviewDigestTerm is a keyword or phrase that recurs across reviews. Count is
+// the number of reviews mentioning it, not the number of occurrences.
+type ReviewDigestTerm struct {
+	Term          string  `json:"term"`
+	Count         int     `json:"count"`
+	AverageRating float64 `json:"averageRating"`
+}
+
+// ReviewDigestReview is one review in a digest.
+type ReviewDigestReview struct {
+	ID          string   `json:"id"`
+	Rating      int      `json:"rating"`
+	Title       string   `json:"title,omitempty"`
+	Body        string   `json:"body,omitempty"`
+	Nickname    string   `json:"reviewerNickname,omitempty"`
+	Territory   string   `json:"territory,omitempty"`
+	Version     string   `json:"version,omitempty"`
+	CreatedDate string   `json:"createdDate,omitempty"`
+	Responded   bool     `json:"responded"`
+	ResponseID  string   `json:"responseId,omitempty"`
+	Keywords    []string `json:"keywords,omitempty"`
+}
+
+// ReviewResponseBatchItem is the outcome for one review in a batch response run.
+type ReviewResponseBatchItem struct {
+	ReviewID   string `json:"reviewId"`
+	Rating     int    `json:"rating"`
+	Territory  string `json:"territory,omitempty"`
+	Rule       string `json:"rule"`
+	Response   string `json:"response"`
+	Status     string `json:"status"`
+	ResponseID string `json:"responseId,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// ReviewResponseBatchResult represents CLI output for batch review responses.
+type ReviewResponseBatchResult struct {
+	AppID            string                    `json:"appId"`
+	Since            string                    `json:"since"`
+	DryRun           bool                      `json:"dryRun"`
+	Reviews          int                       `json:"reviews"`
+	AlreadyResponded int                       `json:"alreadyResponded"`
+	Unmatched        int                       `json:"unmatched"`
+	Responded        int                       `json:"responded"`
+	Failed           int                       `json:"failed"`
+	Items            []ReviewResponseBatchItem `json:"items"`
+}
+
+func printReviewDigestTable(digest *ReviewDigest) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Since\tReviews\tResponded\tAverage Rating")
+	fmt.Fprintf(w, "%s\t%d\t%d\t%.2f\n", digest.Since, digest.Total, digest.Responded, digest.AverageRating)
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(os.Stdout)
+	w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Dimension\tKey\tReviews\tResponded\tAverage Rating")
+	for _, section := range reviewDigestGroupSections(digest) {
+		for _, group := range section.groups {
+			fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%.2f\n", section.name, group.Key, group.Count, group.Responded, group.AverageRating)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if len(digest.Keywords)+len(digest.Phrases) == 0 {
+		return nil
+	}
+	fmt.Fprintln(os.Stdout)
+	w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Type\tTerm\tReviews\tAverage Rating")
+	for _, section := range reviewDigestTermSections(digest) {
+		for _, term := range section.terms {
+			fmt.Fprintf(w, "%s\t%s\t%d\t%.2f\n", section.name, term.Term, term.Count, term.AverageRating)
+		}
+	}
+	return w.Flush()
+}
+
+func printReviewDigestMarkdown(digest *ReviewDigest) error {
+	fmt.Fprintln(os.Stdout, "| Since | Reviews | Responded | Average Rating |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- |")
+	fmt.Fprintf(os.Stdout, "| %s | %d | %d | %.2f |\n", escapeMarkdown(digest.Since), digest.Total, digest.Responded, digest.AverageRating)
+
+	fmt.Fprintln(os.Stdout)
+	fmt.Fprintln(os.Stdout, "| Dimension | Key | Reviews | Responded | Average Rating |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- |")
+	for _, section := range reviewDigestGroupSections(digest) {
+		for _, group := range section.groups {
+			fmt.Fprintf(os.Stdout, "| %s | %s | %d | %d | %.2f |\n", section.name, escapeMarkdown(group.Key), group.Count, group.Responded, group.AverageRating)
+		}
+	}
+
+	if len(digest.Keywords)+len(digest.Phrases) == 0 {
+		return nil
+	}
+	fmt.Fprintln(os.Stdout)
+	fmt.Fprintln(os.Stdout, "| Type | Term | Reviews | Average Rating |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- |")
+	for _, section := range reviewDigestTermSections(digest) {
+		for _, term := range section.terms {
+			fmt.Fprintf(os.Stdout, "| %s | %s | %d | %.2f |\n", section.name, escapeMarkdown(term.Term), term.Count, term.AverageRating)
+		}
+	}
+	return nil
+}
+
+type reviewDigestGroupSection struct {
+	name   string
+	groups []ReviewDigestGroup
+}
+
+func reviewDigestGroupSections(digest *ReviewDigest) []reviewDigestGroupSection {
+	return []reviewDigestGroupSection{
+		{name: "rating", groups: digest.Ratings},
+		{name: "territory", groups: digest.Territories},
+		{name: "version", groups: digest.Versions},
+	}
+}
+
+type reviewDigestTermSection struct {
+	name  string
+	terms []ReviewDigestTerm
+}
+
+func reviewDigestTermSections(digest *ReviewDigest) []reviewDigestTermSection {
+	return []reviewDigestTermSection{
+		{name: "keyword", terms: digest.Keywords},
+		{name: "phrase", terms: digest.Phrases},
+	}
+}
+
+func printReviewResponseBatchResultTable(result *ReviewResponseBatchResult) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Review ID\tRating\tTerritory\tRule\tStatus\tResponse")
+	for _, item := range result.Items {
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\t%s\n",
+			item.ReviewID,
+			item.Rating,
+			item.Territory,
+			item.Rule,
+			reviewResponseBatchStatus(item),
+			compactWhitespace(item.Response),
+		)
+	}
+	return w.Flush()
+}
+
+func printReviewResponseBatchResultMarkdown(result *ReviewResponseBatchResult) error {
+	fmt.Fprintln(os.Stdout, "| Review ID | Rating | Territory | Rule | Status | Response |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- | --- |")
+	for _, item := range result.Items {
+		fmt.Fprintf(os.Stdout, "| %s | %d | %s | %s | %s | %s |\n",
+			escapeMarkdown(item.ReviewID),
+			item.Rating,
+			escapeMarkdown(item.Territory),
+			escapeMarkdown(item.Rule),
+			escapeMarkdown(reviewResponseBatchStatus(item)),
+			escapeMarkdown(item.Response),
+		)
+	}
+	return nil
+}
+
+func reviewResponseBatchStatus(item ReviewResponseBatchItem) string {
+	if item.Error == "" {
+		return item.Status
+	}
+	return strings.TrimSpace(item.Status + ": " + compactWhitespace(item.Error))
+}
@@ -167,3 +167,24 @@ func (c *Client) GetCustomerReviewResponseForReview(ctx context.Context, reviewI
 
 	return &response, nil
 }
+
+// ReviewResponseID returns the ID of the developer response linked to a
+// review, or "" when it has none. The link is only present when the review
+// was listed with WithReviewInclude([]string{"response"}).
+func ReviewResponseID(review Resource[ReviewAttributes]) string {
+	if len(review.Relationships) == 0 {
+		return ""
+	}
+	var relationships struct {
+		Response *struct {
+			Data *ResourceData `json:"data"`
+		} `json:"response"`
+	}
+	if err := json.Unmarshal(review.Relationships, &relationships); err != nil {
+		return ""
+	}
+	if relationships.Response == nil || relationships.Response.Data == nil {
+		return ""
+	}
+	return relationships.Response.Data.ID
+}
@@ -0,0 +1,318 @@
+package asc
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// SalesReportRow is a row from a SALES SUMMARY report. Developer proceeds and
+// customer price are per unit.
+type SalesReportRow struct {
+	Provider              string  `json:"provider,omitempty"`
+	ProviderCountry       string  `json:"providerCountry,omitempty"`
+	SKU                   string  `json:"sku"`
+	Developer             string  `json:"developer,omitempty"`
+	Title                 string  `json:"title"`
+	Version               string  `json:"version,omitempty"`
+	ProductTypeIdentifier string  `json:"productTypeIdentifier"`
+	Units                 float64 `json:"units"`
+	DeveloperProceeds     float64 `json:"developerProceeds"`
+	BeginDate             string  `json:"beginDate,omitempty"`
+	EndDate               string  `json:"endDate,omitempty"`
+	CustomerCurrency      string  `json:"customerCurrency,omitempty"`
+	CountryCode           string  `json:"countryCode"`
+	CurrencyOfProceeds    string  `json:"currencyOfProceeds"`
+	AppleIdentifier       string  `json:"appleIdentifier,omitempty"`
+	CustomerPrice         float64 `json:"customerPrice"`
+	PromoCode             string  `json:"promoCode,omitempty"`
+	ParentIdentifier      string  `json:"parentIdentifier,omitempty"`
+	Subscription          string  `json:"subscription,omitempty"`
+	Period                string  `json:"period,omitempty"`
+	Category              string  `json:"category,omitempty"`
+	CMB                   string  `json:"cmb,omitempty"`
+	Device                string  `json:"device,omitempty"`
+	SupportedPlatforms    string  `json:"supportedPlatforms,omitempty"`
+	ProceedsReason        string  `json:"proceedsReason,omitempty"`
+	PreservedPricing      string  `json:"preservedPricing,omitempty"`
+	Client                string  `json:"client,omitempty"`
+	OrderType             string  `json:"orderType,omitempty"`
+}
+
+// SubscriptionReportRow is a row from a SUBSCRIPTION report: a daily snapshot
+// of active subscriptions at one price point.
+type SubscriptionReportRow struct {
+	AppName                                        string  `json:"appName"`
+	AppAppleID                                     string  `json:"appAppleId"`
+	SubscriptionName                               string  `json:"subscriptionName"`
+	SubscriptionAppleID                            string  `json:"subscriptionAppleId"`
+	SubscriptionGroupID                            string  `json:"subscriptionGroupId,omitempty"`
+	StandardSubscriptionDuration                   string  `json:"standardSubscriptionDuration"`
+	SubscriptionOfferName                          string  `json:"subscriptionOfferName,omitempty"`
+	PromotionalOfferID                             string  `json:"promotionalOfferId,omitempty"`
+	CustomerPrice                                  float64 `json:"customerPrice"`
+	CustomerCurrency                               string  `json:"customerCurrency"`
+	DeveloperProceeds                              float64 `json:"developerProceeds"`
+	ProceedsCurrency                               string  `json:"proceedsCurrency"`
+	PreservedPricing                               string  `json:"preservedPricing,omitempty"`
+	ProceedsReason                                 string  `json:"proceedsReason,omitempty"`
+	Client                                         string  `json:"client,omitempty"`
+	Device                                         string  `json:"device,omitempty"`
+	State                                          string  `json:"state,omitempty"`
+	Country                                        string  `json:"country"`
+	ActiveStandardPriceSubscriptions               float64 `json:"activeStandardPriceSubscriptions"`
+	ActiveFreeTrialIntroductoryOfferSubscriptions  float64 `json:"activeFreeTrialIntroductoryOfferSubscriptions"`
+	ActivePayUpFrontIntroductoryOfferSubscriptions float64 `json:"activePayUpFrontIntroductoryOfferSubscriptions"`
+	ActivePayAsYouGoIntroductoryOfferSubscriptions float64 `json:"activePayAsYouGoIntroductoryOfferSubscriptions"`
+	MarketingOptIns                                float64 `json:"marketingOptIns"`
+	BillingRetry                                   float64 `json:"billingRetry"`
+	GracePeriod                                    float64 `json:"gracePeriod"`
+	Subscribers                                    float64 `json:"subscribers"`
+}
+
+// SubscriptionEventReportRow is a row from a SUBSCRIPTION_EVENT report.
+type SubscriptionEventReportRow struct {
+	EventDate                    string  `json:"eventDate"`
+	Event                        string  `json:"event"`
+	AppName                      string  `json:"appName"`
+	AppAppleID                   string  `json:"appAppleId"`
+	SubscriptionName             string  `json:"subscriptionName"`
+	SubscriptionAppleID          string  `json:"subscriptionAppleId"`
+	SubscriptionGroupID          string  `json:"subscriptionGroupId,omitempty"`
+	StandardSubscriptionDuration string  `json:"standardSubscriptionDuration"`
+	SubscriptionOfferType        string  `json:"subscriptionOfferType,omitempty"`
+	SubscriptionOfferDuration    string  `json:"subscriptionOfferDuration,omitempty"`
+	MarketingOptIn               string  `json:"marketingOptIn,omitempty"`
+	MarketingOptInDuration       string  `json:"marketingOptInDuration,omitempty"`
+	PreservedPricing             string  `json:"preservedPricing,omitempty"`
+	ProceedsReason               string  `json:"proceedsReason,omitempty"`
+	PromotionalOfferName         string  `json:"promotionalOfferName,omitempty"`
+	PromotionalOfferID           string  `json:"promotionalOfferId,omitempty"`
+	ConsecutivePaidPeriods       string  `json:"consecutivePaidPeriods,omitempty"`
+	OriginalStartDate            string  `json:"originalStartDate,omitempty"`
+	Device                       string  `json:"device,omitempty"`
+	Client                       string  `json:"client,omitempty"`
+	State                        string  `json:"state,omitempty"`
+	Country                      string  `json:"country"`
+	PreviousSubscriptionName     string  `json:"previousSubscriptionName,omitempty"`
+	PreviousSubscriptionAppleID  string  `json:"previousSubscriptionAppleId,omitempty"`
+	DaysBeforeCanceling          string  `json:"daysBeforeCanceling,omitempty"`
+	CancellationReason           string  `json:"cancellationReason,omitempty"`
+	DaysCanceled                 string  `json:"daysCanceled,omitempty"`
+	Quantity                     float64 `json:"quantity"`
+}
+
+// ParsedSalesReport holds the typed rows of a sales and trends report. Only the
+// slice matching ReportType is populated.
+type ParsedSalesReport struct {
+	ReportType         SalesReportType              `json:"reportType"`
+	Sales              []SalesReportRow             `json:"sales,omitempty"`
+	Subscriptions      []SubscriptionReportRow      `json:"subscriptions,omitempty"`
+	SubscriptionEvents []SubscriptionEventReportRow `json:"subscriptionEvents,omitempty"`
+}
+
+var salesReportHeaders = map[SalesReportType][]string{
+	SalesReportTypeSales:             {"SKU", "Units", "Developer Proceeds"},
+	SalesReportTypeSubscription:      {"Subscription Apple ID", "Developer Proceeds", "Subscribers"},
+	SalesReportTypeSubscriptionEvent: {"Event Date", "Event", "Quantity"},
+}
+
+// ParseSalesReport parses a SALES (SUMMARY), SUBSCRIPTION or SUBSCRIPTION_EVENT
+// report in TSV or gzip-compressed TSV form. Columns are matched by header name
+// so report format versions with added columns parse as well. An empty
+// reportType detects the layout from the header row.
+func ParseSalesReport(r io.Reader, reportType SalesReportType) (*ParsedSalesReport, error) {
+	table, err := readReportTable(r)
+	if err != nil {
+		return nil, err
+	}
+	if reportType == "" {
+		reportType, err = detectSalesReportType(table)
+		if err != nil {
+			return nil, err
+		}
+	}
+	required, ok := salesReportHeaders[reportType]
+	if !ok {
+		return nil, fmt.Errorf("unsupported sales report type %q (supported: SALES, SUBSCRIPTION, SUBSCRIPTION_EVENT)", reportType)
+	}
+
+	report := &ParsedSalesReport{ReportType: reportType}
+	isHeader := func(columns reportColumns) bool { return columns.has(required...) }
+	var parseRow func(columns reportColumns, row []string) error
+	switch reportType {
+	case SalesReportTypeSales:
+		parseRow = func(columns reportColumns, row []string) error {
+			parsed, err := parseSalesReportRow(columns, row)
+			if err != nil {
+				return err
+			}
+			report.Sales = append(report.Sales, parsed)
+			return nil
+		}
+	case SalesReportTypeSubscription:
+		parseRow = func(columns reportColumns, row []string) error {
+			parsed, err := parseSubscriptionReportRow(columns, row)
+			if err != nil {
+				return err
+			}
+			report.Subscriptions = append(report.Subscriptions, parsed)
+			return nil
+		}
+	case SalesReportTypeSubscriptionEvent:
+		parseRow = func(columns reportColumns, row []string) error {
+			parsed, err := parseSubscriptionEventReportRow(columns, row)
+			if err != nil {
+				return err
+			}
+			report.SubscriptionEvents = append(report.SubscriptionEvents, parsed)
+			return nil
+		}
+	}
+
+	found, err := table.eachSectionRow(isHeader, parseRow)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("%s report header not found (expected columns: %s)", reportType, strings.Join(required, ", "))
+	}
+	return report, nil
+}
+
+func detectSalesReportType(table *reportTable) (SalesReportType, error) {
+	// SUBSCRIPTION_EVENT is checked first because its header is the most specific.
+	order := []SalesReportType{SalesReportTypeSubscriptionEvent, SalesReportTypeSubscription, SalesReportTypeSales}
+	for _, row := range table.rows {
+		columns := newReportColumns(row)
+		for _, reportType := range order {
+			if columns.has(salesReportHeaders[reportType]...) {
+				return reportType, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("unrecognized sales report layout")
+}
+
+func parseSalesReportRow(columns reportColumns, row []string) (SalesReportRow, error) {
+	parsed := SalesReportRow{
+		Provider:              columns.value(row, "Provider"),
+		ProviderCountry:       columns.value(row, "Provider Country"),
+		SKU:                   columns.value(row, "SKU"),
+		Developer:             columns.value(row, "Developer"),
+		Title:                 columns.value(row, "Title"),
+		Version:               columns.value(row, "Version"),
+		ProductTypeIdentifier: columns.value(row, "Product Type Identifier"),
+		BeginDate:             columns.value(row, "Begin Date"),
+		EndDate:               columns.value(row, "End Date"),
+		CustomerCurrency:      columns.value(row, "Customer Currency"),
+		CountryCode:           columns.value(row, "Country Code"),
+		CurrencyOfProceeds:    columns.value(row, "Currency of Proceeds"),
+		AppleIdentifier:       columns.value(row, "Apple Identifier"),
+		PromoCode:             columns.value(row, "Promo Code"),
+		ParentIdentifier:      columns.value(row, "Parent Identifier"),
+		Subscription:          columns.value(row, "Subscription"),
+		Period:                columns.value(row, "Period"),
+		Category:              columns.value(row, "Category"),
+		CMB:                   columns.value(row, "CMB"),
+		Device:                columns.value(row, "Device"),
+		SupportedPlatforms:    columns.value(row, "Supported Platforms"),
+		ProceedsReason:        columns.value(row, "Proceeds Reason"),
+		PreservedPricing:      columns.value(row, "Preserved Pricing"),
+		Client:                columns.value(row, "Client"),
+		OrderType:             columns.value(row, "Order Type"),
+	}
+	var err error
+	if parsed.Units, err = columns.number(row, "Units"); err != nil {
+		return SalesReportRow{}, err
+	}
+	if parsed.DeveloperProceeds, err = columns.number(row, "Developer Proceeds"); err != nil {
+		return SalesReportRow{}, err
+	}
+	if parsed.CustomerPrice, err = columns.number(row, "Customer Price"); err != nil {
+		return SalesReportRow{}, err
+	}
+	return parsed, nil
+}
+
+func parseSubscriptionReportRow(columns reportColumns, row []string) (SubscriptionReportRow, error) {
+	parsed := SubscriptionReportRow{
+		AppName:                      columns.value(row, "App Name"),
+		AppAppleID:                   columns.value(row, "App Apple ID"),
+		SubscriptionName:             columns.value(row, "Subscription Name"),
+		SubscriptionAppleID:          columns.value(row, "Subscription Apple ID"),
+		SubscriptionGroupID:          columns.value(row, "Subscription Group ID"),
+		StandardSubscriptionDuration: columns.value(row, "Standard Subscription Duration"),
+		SubscriptionOfferName:        columns.value(row, "Subscription Offer Name"),
+		PromotionalOfferID:           columns.value(row, "Promotional Offer ID"),
+		CustomerCurrency:             columns.value(row, "Customer Currency"),
+		ProceedsCurrency:             columns.value(row, "Proceeds Currency"),
+		PreservedPricing:             columns.value(row, "Preserved Pricing"),
+		ProceedsReason:               columns.value(row, "Proceeds Reason"),
+		Client:                       columns.value(row, "Client"),
+		Device:                       columns.value(row, "Device"),
+		State:                        columns.value(row, "State"),
+		Country:                      columns.value(row, "Country"),
+	}
+	numbers := []struct {
+		column string
+		target *float64
+	}{
+		{"Customer Price", &parsed.CustomerPrice},
+		{"Developer Proceeds", &parsed.DeveloperProceeds},
+		{"Active Standard Price Subscriptions", &parsed.ActiveStandardPriceSubscriptions},
+		{"Active Free Trial Introductory Offer Subscriptions", &parsed.ActiveFreeTrialIntroductoryOfferSubscriptions},
+		{"Active Pay Up Front Introductory Offer Subscriptions", &parsed.ActivePayUpFrontIntroductoryOfferSubscriptions},
+		{"Active Pay As You Go Introductory Offer Subscriptions", &parsed.ActivePayAsYouGoIntroductoryOfferSubscriptions},
+		{"Marketing Opt-Ins", &parsed.MarketingOptIns},
+		{"Billing Retry", &parsed.BillingRetry},
+		{"Grace Period", &parsed.GracePeriod},
+		{"Subscribers", &parsed.Subscribers},
+	}
+	for _, number := range numbers {
+		value, err := columns.number(row, number.column)
+		if err != nil {
+			return SubscriptionReportRow{}, err
+		}
+		*number.target = value
+	}
+	return parsed, nil
+}
+
+func parseSubscriptionEventReportRow(columns reportColumns, row []string) (SubscriptionEventReportRow, error) {
+	parsed := SubscriptionEventReportRow{
+		EventDate:                    columns.value(row, "Event Date"),
+		Event:                        columns.value(row, "Event"),
+		AppName:                      columns.value(row, "App Name"),
+		AppAppleID:                   columns.value(row, "App Apple ID"),
+		SubscriptionName:             columns.value(row, "Subscription Name"),
+		SubscriptionAppleID:          columns.value(row, "Subscription Apple ID"),
+		SubscriptionGroupID:          columns.value(row, "Subscription Group ID"),
+		StandardSubscriptionDuration: columns.value(row, "Standard Subscription Duration"),
+		SubscriptionOfferType:        columns.value(row, "Subscription Offer Type"),
+		SubscriptionOfferDuration:    columns.value(row, "Subscription Offer Duration"),
+		MarketingOptIn:               columns.value(row, "Marketing Opt-In"),
+		MarketingOptInDuration:       columns.value(row, "Marketing Opt-In Duration"),
+		PreservedPricing:             columns.value(row, "Preserved Pricing"),
+		ProceedsReason:               columns.value(row, "Proceeds Reason"),
+		PromotionalOfferName:         columns.value(row, "Promotional Offer Name"),
+		PromotionalOfferID:           columns.value(row, "Promotional Offer ID"),
+		ConsecutivePaidPeriods:       columns.value(row, "Consecutive Paid Periods"),
+		OriginalStartDate:            columns.value(row, "Original Start Date"),
+		Device:                       columns.value(row, "Device"),
+		Client:                       columns.value(row, "Client"),
+		State:                        columns.value(row, "State"),
+		Country:                      columns.value(row, "Country"),
+		PreviousSubscriptionName:     columns.value(row, "Previous Subscription Name"),
+		PreviousSubscriptionAppleID:  columns.value(row, "Previous Subscription Apple ID"),
+		DaysBeforeCanceling:          columns.value(row, "Days Before Canceling"),
+		CancellationReason:           columns.value(row, "Cancellation Reason"),
+		DaysCanceled:                 columns.value(row, "Days Canceled"),
+	}
+	quantity, err := columns.number(row, "Quantity")
+	if err != nil {
+		return SubscriptionEventReportRow{}, err
+	}
+	parsed.Quantity = quantity
+	return parsed, nil
+}
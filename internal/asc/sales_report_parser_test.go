@@ -0,0 +1,140 @@
+package asc
+
+import (
+	"bytes"
+	"compress/gzip"
+	"strings"
+	"testing"
+)
+
+const testSalesSummaryReport = "Provider\tProvider Country\tSKU\tDeveloper\tTitle\tVersion\tProduct Type Identifier\tUnits\tDeveloper Proceeds\tBegin Date\tEnd Date\tCustomer Currency\tCountry Code\tCurrency of Proceeds\tApple Identifier\tCustomer Price\tPromo Code\tParent Identifier\tSubscription\tPeriod\tCategory\tCMB\tDevice\tSupported Platforms\tProceeds Reason\tPreserved Pricing\tClient\tOrder Type\n" +
+	"APPLE\tUS\tcom.example.app\tExample Inc\tExample\t1.0\t1F\t3\t0.70\t01/20/2024\t01/20/2024\tUSD\tUS\tUSD\t123\t0.99\t\t\t\t\tProductivity\t\tiPhone\tiOS\t\t\t\t\n" +
+	"APPLE\tUS\tcom.example.app\tExample Inc\tExample\t1.0\t1F\t-1\t0.70\t01/20/2024\t01/20/2024\tUSD\tUS\tUSD\t123\t0.99\t\t\t\t\tProductivity\t\tiPhone\tiOS\t\t\t\t\n" +
+	"APPLE\tUS\tcom.example.pro\tExample Inc\tExample Pro\t\tIA1\t2\t1.50\t01/20/2024\t01/20/2024\tEUR\tDE\tEUR\t456\t1.99\t\tcom.example.app\t\t\tProductivity\t\tiPad\tiOS\t\t\t\t\n"
+
+func TestParseSalesReport_SalesSummary(t *testing.T) {
+	report, err := ParseSalesReport(strings.NewReader(testSalesSummaryReport), SalesReportTypeSales)
+	if err != nil {
+		t.Fatalf("ParseSalesReport() error: %v", err)
+	}
+	if len(report.Sales) != 3 {
+		t.Fatalf("expected 3 rows, got %d", len(report.Sales))
+	}
+	row := report.Sales[2]
+	if row.SKU != "com.example.pro" || row.ProductTypeIdentifier != "IA1" || row.Units != 2 || row.DeveloperProceeds != 1.5 || row.CountryCode != "DE" || row.CurrencyOfProceeds != "EUR" || row.ParentIdentifier != "com.example.app" {
+		t.Fatalf("unexpected row: %+v", row)
+	}
+}
+
+func TestParseSalesReport_DetectsTypeAndReadsGzip(t *testing.T) {
+	report := "Event Date\tEvent\tApp Name\tApp Apple ID\tSubscription Name\tSubscription Apple ID\tSubscription Group ID\tStandard Subscription Duration\tCountry\tQuantity\n" +
+		"2024-01-20\tSubscribe\tExample\t123\tMonthly\t789\t555\t1 Month\tUS\t4\n" +
+		"2024-01-20\tCancel\tExample\t123\tMonthly\t789\t555\t1 Month\tGB\t1\n"
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	if _, err := gz.Write([]byte(report)); err != nil {
+		t.Fatalf("gzip write: %v", err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatalf("gzip close: %v", err)
+	}
+
+	parsed, err := ParseSalesReport(&buf, "")
+	if err != nil {
+		t.Fatalf("ParseSalesReport() error: %v", err)
+	}
+	if parsed.ReportType != SalesReportTypeSubscriptionEvent {
+		t.Fatalf("expected SUBSCRIPTION_EVENT, got %s", parsed.ReportType)
+	}
+	if len(parsed.SubscriptionEvents) != 2 || parsed.SubscriptionEvents[0].Event != "Subscribe" || parsed.SubscriptionEvents[0].Quantity != 4 {
+		t.Fatalf("unexpected events: %+v", parsed.SubscriptionEvents)
+	}
+}
+
+func TestParseSalesReport_Subscription(t *testing.T) {
+	report := "App Name\tApp Apple ID\tSubscription Name\tSubscription Apple ID\tSubscription Group ID\tStandard Subscription Duration\tCustomer Price\tCustomer Currency\tDeveloper Proceeds\tProceeds Currency\tCountry\tActive Standard Price Subscriptions\tActive Free Trial Introductory Offer Subscriptions\tActive Pay Up Front Introductory Offer Subscriptions\tActive Pay As You Go Introductory Offer Subscriptions\tSubscribers\n" +
+		"Example\t123\tMonthly\t789\t555\t1 Month\t4.99\tUSD\t3.49\tUSD\tUS\t10\t5\t0\t2\t17\n"
+
+	parsed, err := ParseSalesReport(strings.NewReader(report), "")
+	if err != nil {
+		t.Fatalf("ParseSalesReport() error: %v", err)
+	}
+	if parsed.ReportType != SalesReportTypeSubscription || len(parsed.Subscriptions) != 1 {
+		t.Fatalf("unexpected report: %+v", parsed)
+	}
+	row := parsed.Subscriptions[0]
+	if row.Subscribers != 17 || row.ActiveStandardPriceSubscriptions != 10 || row.ActiveFreeTrialIntroductoryOfferSubscriptions != 5 || row.DeveloperProceeds != 3.49 {
+		t.Fatalf("unexpected row: %+v", row)
+	}
+
+	builder := NewReportSummaryBuilder(string(parsed.ReportType), "")
+	builder.AddSalesReport(parsed)
+	summary := builder.Summary()
+	if len(summary.Totals) != 1 || summary.Totals[0].Units != 17 || summary.Totals[0].Proceeds != 41.88 {
+		t.Fatalf("unexpected totals: %+v", summary.Totals)
+	}
+	if summary.ByProductType[0].Key != "1 Month" {
+		t.Fatalf("expected duration as product type, got %+v", summary.ByProductType)
+	}
+}
+
+func TestParseSalesReport_Errors(t *testing.T) {
+	if _, err := ParseSalesReport(strings.NewReader("foo\tbar\n1\t2\n"), ""); err == nil || !strings.Contains(err.Error(), "unrecognized sales report layout") {
+		t.Fatalf("expected layout error, got %v", err)
+	}
+	if _, err := ParseSalesReport(strings.NewReader(testSalesSummaryReport), SalesReportTypeSubscription); err == nil || !strings.Contains(err.Error(), "SUBSCRIPTION report header not found") {
+		t.Fatalf("expected header error, got %v", err)
+	}
+	if _, err := ParseSalesReport(strings.NewReader(testSalesSummaryReport), SalesReportTypePreOrder); err == nil || !strings.Contains(err.Error(), "unsupported sales report type") {
+		t.Fatalf("expected unsupported type error, got %v", err)
+	}
+	bad := "SKU\tUnits\tDeveloper Proceeds\nsku\tmany\t1\n"
+	if _, err := ParseSalesReport(strings.NewReader(bad), ""); err == nil || !strings.Contains(err.Error(), `line 2: Units: invalid number "many"`) {
+		t.Fatalf("expected number error, got %v", err)
+	}
+}
+
+func TestReportSummary_SalesAggregates(t *testing.T) {
+	report, err := ParseSalesReport(strings.NewReader(testSalesSummaryReport), "")
+	if err != nil {
+		t.Fatalf("ParseSalesReport() error: %v", err)
+	}
+	builder := NewReportSummaryBuilder(string(report.ReportType), "")
+	builder.AddSalesReport(report)
+	summary := builder.Summary()
+
+	if summary.Rows != 3 || summary.ReportingCurrency != "" {
+		t.Fatalf("unexpected summary: %+v", summary)
+	}
+	if len(summary.Totals) != 2 {
+		t.Fatalf("expected totals per currency, got %+v", summary.Totals)
+	}
+	if got := summary.BySKU[0]; got.Key != "com.example.pro" || got.Name != "Example Pro" || got.Currency != "EUR" || got.Proceeds != 3 || got.Units != 2 {
+		t.Fatalf("unexpected top SKU: %+v", got)
+	}
+	if got := summary.BySKU[1]; got.Key != "com.example.app" || got.Units != 2 || got.Proceeds != 1.4 || got.Rows != 2 {
+		t.Fatalf("expected returns to net out, got %+v", got)
+	}
+	if len(summary.ByTerritory) != 2 || len(summary.ByProductType) != 2 {
+		t.Fatalf("unexpected groups: %+v %+v", summary.ByTerritory, summary.ByProductType)
+	}
+}
+
+func TestPrintReportSummaryCSV(t *testing.T) {
+	report, err := ParseSalesReport(strings.NewReader(testSalesSummaryReport), "")
+	if err != nil {
+		t.Fatalf("ParseSalesReport() error: %v", err)
+	}
+	builder := NewReportSummaryBuilder(string(report.ReportType), "")
+	builder.AddSalesReport(report)
+
+	output := captureStdout(t, func() error {
+		return PrintReportSummaryCSV(builder.Summary())
+	})
+	if !strings.HasPrefix(output, "Dimension,Key,Name,Currency,Units,Proceeds,Rows\n") {
+		t.Fatalf("expected CSV header, got %q", output)
+	}
+	if !strings.Contains(output, "sku,com.example.pro,Example Pro,EUR,2,3.00,1\n") {
+		t.Fatalf("expected SKU row, got %q", output)
+	}
+}
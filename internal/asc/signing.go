@@ -137,7 +137,8 @@ type CertificateResponse = SingleResponse[CertificateAttributes]
 type ProfileState string
 
 const (
-	ProfileStateActive ProfileState = "ACTIVE"
+	ProfileStateActive  ProfileState = "ACTIVE"
+	ProfileStateInvalid ProfileState = "INVALID"
 )
 
 // ProfileAttributes describes a profile resource.
@@ -183,3 +184,15 @@ type ProfilesResponse = Response[ProfileAttributes]
 
 // ProfileResponse is the response from profile detail endpoint.
 type ProfileResponse = SingleResponse[ProfileAttributes]
+
+// ProfileBundleIDLinkageResponse is the response from the profile bundle ID relationship endpoint.
+type ProfileBundleIDLinkageResponse struct {
+	Data  ResourceData `json:"data"`
+	Links Links        `json:"links,omitempty"`
+}
+
+// ProfileCertificatesLinkagesResponse is the response from the profile certificates relationship endpoint.
+type ProfileCertificatesLinkagesResponse = LinkagesResponse
+
+// ProfileDevicesLinkagesResponse is the response from the profile devices relationship endpoint.
+type ProfileDevicesLinkagesResponse = LinkagesResponse
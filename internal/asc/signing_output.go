@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 	"text/tabwriter"
 )
@@ -26,6 +27,16 @@ type CertificateRevokeResult struct {
 	Revoked bool   `json:"revoked"`
 }
 
+// CertificateP12Result represents CLI output for certificates exported to PKCS#12.
+type CertificateP12Result struct {
+	ID              string `json:"id"`
+	Name            string `json:"name,omitempty"`
+	CertificateType string `json:"certificateType,omitempty"`
+	SerialNumber    string `json:"serialNumber,omitempty"`
+	ExpirationDate  string `json:"expirationDate,omitempty"`
+	P12Path         string `json:"p12Path"`
+}
+
 // ProfileDeleteResult represents CLI output for profile deletions.
 type ProfileDeleteResult struct {
 	ID      string `json:"id"`
@@ -176,6 +187,34 @@ func printCertificateRevokeResultMarkdown(result *CertificateRevokeResult) error
 	return nil
 }
 
+func printCertificateP12ResultTable(result *CertificateP12Result) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tName\tType\tSerial\tExpiration\tP12 Path")
+	fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+		result.ID,
+		compactWhitespace(result.Name),
+		result.CertificateType,
+		result.SerialNumber,
+		result.ExpirationDate,
+		result.P12Path,
+	)
+	return w.Flush()
+}
+
+func printCertificateP12ResultMarkdown(result *CertificateP12Result) error {
+	fmt.Fprintln(os.Stdout, "| ID | Name | Type | Serial | Expiration | P12 Path |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- | --- |")
+	fmt.Fprintf(os.Stdout, "| %s | %s | %s | %s | %s | %s |\n",
+		escapeMarkdown(result.ID),
+		escapeMarkdown(result.Name),
+		escapeMarkdown(result.CertificateType),
+		escapeMarkdown(result.SerialNumber),
+		escapeMarkdown(result.ExpirationDate),
+		escapeMarkdown(result.P12Path),
+	)
+	return nil
+}
+
 func printProfilesTable(resp *ProfilesResponse) error {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 	fmt.Fprintln(w, "ID\tName\tType\tState\tExpiration")
@@ -284,6 +323,309 @@ func printSigningFetchResultMarkdown(result *SigningFetchResult) error {
 	return nil
 }
 
+func printSigningStoreResultTable(result *SigningStoreResult) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Action\tName\tKind\tPath\tStatus")
+	for _, asset := range result.Assets {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+			result.Action,
+			asset.Name,
+			asset.Kind,
+			asset.Path,
+			asset.Status,
+		)
+	}
+	return w.Flush()
+}
+
+func printSigningStoreResultMarkdown(result *SigningStoreResult) error {
+	fmt.Fprintln(os.Stdout, "| Action | Name | Kind | Path | Status |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- |")
+	for _, asset := range result.Assets {
+		fmt.Fprintf(os.Stdout, "| %s | %s | %s | %s | %s |\n",
+			escapeMarkdown(result.Action),
+			escapeMarkdown(asset.Name),
+			escapeMarkdown(asset.Kind),
+			escapeMarkdown(asset.Path),
+			escapeMarkdown(asset.Status),
+		)
+	}
+	return nil
+}
+
+func printSigningRenewResultTable(result *SigningRenewResult) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Kind\tID\tName\tType\tState\tExpiration\tAction\tNew ID\tNew Expiration\tDeleted\tNote")
+	for _, item := range result.Items {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%t\t%s\n",
+			item.Kind,
+			item.ID,
+			compactWhitespace(item.Name),
+			item.Type,
+			item.State,
+			item.ExpirationDate,
+			item.Action,
+			item.NewID,
+			item.NewExpirationDate,
+			item.Deleted,
+			compactWhitespace(item.Note),
+		)
+	}
+	return w.Flush()
+}
+
+func printSigningRenewResultMarkdown(result *SigningRenewResult) error {
+	fmt.Fprintln(os.Stdout, "| Kind | ID | Name | Type | State | Expiration | Action | New ID | New Expiration | Deleted | Note |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- | --- | --- | --- | --- | --- | --- |")
+	for _, item := range result.Items {
+		fmt.Fprintf(os.Stdout, "| %s | %s | %s | %s | %s | %s | %s | %s | %s | %t | %s |\n",
+			escapeMarkdown(item.Kind),
+			escapeMarkdown(item.ID),
+			escapeMarkdown(item.Name),
+			escapeMarkdown(item.Type),
+			escapeMarkdown(item.State),
+			escapeMarkdown(item.ExpirationDate),
+			escapeMarkdown(item.Action),
+			escapeMarkdown(item.NewID),
+			escapeMarkdown(item.NewExpirationDate),
+			item.Deleted,
+			escapeMarkdown(item.Note),
+		)
+	}
+	return nil
+}
+
+func printProfileRefreshResultTable(result *ProfileRefreshResult) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tName\tStatus\tNew ID\tPrevious Devices\tDevices\tOutput File\tError")
+	for _, item := range result.Profiles {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%d\t%s\t%s\n",
+			item.ID,
+			compactWhitespace(item.Name),
+			item.Status,
+			item.NewID,
+			item.PreviousDevices,
+			item.Devices,
+			item.OutputFile,
+			compactWhitespace(item.Error),
+		)
+	}
+	return w.Flush()
+}
+
+func printProfileRefreshResultMarkdown(result *ProfileRefreshResult) error {
+	fmt.Fprintln(os.Stdout, "| ID | Name | Status | New ID | Previous Devices | Devices | Output File | Error |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- | --- | --- | --- |")
+	for _, item := range result.Profiles {
+		fmt.Fprintf(os.Stdout, "| %s | %s | %s | %s | %d | %d | %s | %s |\n",
+			escapeMarkdown(item.ID),
+			escapeMarkdown(item.Name),
+			escapeMarkdown(item.Status),
+			escapeMarkdown(item.NewID),
+			item.PreviousDevices,
+			item.Devices,
+			escapeMarkdown(item.OutputFile),
+			escapeMarkdown(item.Error),
+		)
+	}
+	return nil
+}
+
+func printProfileInspectResultTable(result *ProfileInspectResult) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "UUID\tName\tTeam\tApp ID\tExpiration\tExpired\tDevices\tASC")
+	fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%t\t%s\t%s\n",
+		result.UUID,
+		compactWhitespace(result.Name),
+		result.TeamID,
+		result.ApplicationIdentifier,
+		result.ExpirationDate,
+		result.Expired,
+		profileInspectDeviceSummary(result),
+		profileInspectRemoteSummary(result.AppStoreConnect),
+	)
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if len(result.Certificates) > 0 {
+		fmt.Fprintln(os.Stdout)
+		w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "Certificate\tSerial\tSHA-1\tExpiration\tASC ID\tASC Status")
+		for _, cert := range result.Certificates {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+				compactWhitespace(cert.CommonName),
+				cert.SerialNumber,
+				cert.SHA1,
+				cert.ExpirationDate,
+				cert.ASCID,
+				cert.ASCStatus,
+			)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+
+	if len(result.Entitlements) > 0 {
+		fmt.Fprintln(os.Stdout)
+		w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "Entitlement\tValue")
+		for _, key := range sortedEntitlementKeys(result.Entitlements) {
+			fmt.Fprintf(w, "%s\t%s\n", key, formatEntitlementValue(result.Entitlements[key]))
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+
+	if result.AppStoreConnect != nil {
+		for _, issue := range result.AppStoreConnect.Issues {
+			fmt.Fprintf(os.Stdout, "\nWarning: %s", issue)
+		}
+		if len(result.AppStoreConnect.Issues) > 0 {
+			fmt.Fprintln(os.Stdout)
+		}
+	}
+	return nil
+}
+
+func printProfileInspectResultMarkdown(result *ProfileInspectResult) error {
+	fmt.Fprintln(os.Stdout, "| UUID | Name | Team | App ID | Expiration | Expired | Devices | ASC |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- | --- | --- | --- |")
+	fmt.Fprintf(os.Stdout, "| %s | %s | %s | %s | %s | %t | %s | %s |\n",
+		escapeMarkdown(result.UUID),
+		escapeMarkdown(result.Name),
+		escapeMarkdown(result.TeamID),
+		escapeMarkdown(result.ApplicationIdentifier),
+		escapeMarkdown(result.ExpirationDate),
+		result.Expired,
+		escapeMarkdown(profileInspectDeviceSummary(result)),
+		escapeMarkdown(profileInspectRemoteSummary(result.AppStoreConnect)),
+	)
+
+	if len(result.Certificates) > 0 {
+		fmt.Fprintln(os.Stdout)
+		fmt.Fprintln(os.Stdout, "| Certificate | Serial | SHA-1 | Expiration | ASC ID | ASC Status |")
+		fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- | --- |")
+		for _, cert := range result.Certificates {
+			fmt.Fprintf(os.Stdout, "| %s | %s | %s | %s | %s | %s |\n",
+				escapeMarkdown(cert.CommonName),
+				escapeMarkdown(cert.SerialNumber),
+				escapeMarkdown(cert.SHA1),
+				escapeMarkdown(cert.ExpirationDate),
+				escapeMarkdown(cert.ASCID),
+				escapeMarkdown(cert.ASCStatus),
+			)
+		}
+	}
+
+	if len(result.Entitlements) > 0 {
+		fmt.Fprintln(os.Stdout)
+		fmt.Fprintln(os.Stdout, "| Entitlement | Value |")
+		fmt.Fprintln(os.Stdout, "| --- | --- |")
+		for _, key := range sortedEntitlementKeys(result.Entitlements) {
+			fmt.Fprintf(os.Stdout, "| %s | %s |\n",
+				escapeMarkdown(key),
+				escapeMarkdown(formatEntitlementValue(result.Entitlements[key])),
+			)
+		}
+	}
+
+	if result.AppStoreConnect != nil && len(result.AppStoreConnect.Issues) > 0 {
+		fmt.Fprintln(os.Stdout)
+		for _, issue := range result.AppStoreConnect.Issues {
+			fmt.Fprintf(os.Stdout, "- %s\n", escapeMarkdown(issue))
+		}
+	}
+	return nil
+}
+
+func printCertificateInspectResultTable(result *CertificateInspectResult) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Certificate\tSerial\tSHA-1\tExpiration\tExpired\tASC ID\tASC Status")
+	fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%t\t%s\t%s\n",
+		compactWhitespace(result.CommonName),
+		result.SerialNumber,
+		result.SHA1,
+		result.ExpirationDate,
+		result.Expired,
+		result.ASCID,
+		result.ASCStatus,
+	)
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	for _, issue := range result.Issues {
+		fmt.Fprintf(os.Stdout, "\nWarning: %s", issue)
+	}
+	if len(result.Issues) > 0 {
+		fmt.Fprintln(os.Stdout)
+	}
+	return nil
+}
+
+func printCertificateInspectResultMarkdown(result *CertificateInspectResult) error {
+	fmt.Fprintln(os.Stdout, "| Certificate | Serial | SHA-1 | Expiration | Expired | ASC ID | ASC Status |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- | --- | --- |")
+	fmt.Fprintf(os.Stdout, "| %s | %s | %s | %s | %t | %s | %s |\n",
+		escapeMarkdown(result.CommonName),
+		escapeMarkdown(result.SerialNumber),
+		escapeMarkdown(result.SHA1),
+		escapeMarkdown(result.ExpirationDate),
+		result.Expired,
+		escapeMarkdown(result.ASCID),
+		escapeMarkdown(result.ASCStatus),
+	)
+	if len(result.Issues) > 0 {
+		fmt.Fprintln(os.Stdout)
+		for _, issue := range result.Issues {
+			fmt.Fprintf(os.Stdout, "- %s\n", escapeMarkdown(issue))
+		}
+	}
+	return nil
+}
+
+func profileInspectDeviceSummary(result *ProfileInspectResult) string {
+	if result.ProvisionsAllDevices {
+		return "all"
+	}
+	return fmt.Sprintf("%d", len(result.Devices))
+}
+
+func profileInspectRemoteSummary(remote *ProfileInspectRemote) string {
+	switch {
+	case remote == nil:
+		return ""
+	case !remote.Found:
+		return "not found"
+	case remote.State != "":
+		return fmt.Sprintf("%s (%s)", remote.ID, remote.State)
+	default:
+		return remote.ID
+	}
+}
+
+func sortedEntitlementKeys(entitlements map[string]interface{}) []string {
+	keys := make([]string, 0, len(entitlements))
+	for key := range entitlements {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func formatEntitlementValue(value interface{}) string {
+	if text, ok := value.(string); ok {
+		return text
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
+
 func formatCapabilitySettings(settings []CapabilitySetting) string {
 	if len(settings) == 0 {
 		return ""
@@ -0,0 +1,25 @@
+package asc
+
+// SigningRenewItem describes a certificate or profile considered for renewal.
+type SigningRenewItem struct {
+	Kind              string `json:"kind"`
+	ID                string `json:"id"`
+	Name              string `json:"name,omitempty"`
+	Type              string `json:"type,omitempty"`
+	State             string `json:"state"`
+	ExpirationDate    string `json:"expirationDate,omitempty"`
+	Action            string `json:"action"`
+	NewID             string `json:"newId,omitempty"`
+	NewName           string `json:"newName,omitempty"`
+	NewExpirationDate string `json:"newExpirationDate,omitempty"`
+	Deleted           bool   `json:"deleted,omitempty"`
+	Note              string `json:"note,omitempty"`
+}
+
+// SigningRenewResult represents CLI output for signing renew.
+type SigningRenewResult struct {
+	Within string             `json:"within"`
+	Cutoff string             `json:"cutoff"`
+	DryRun bool               `json:"dryRun,omitempty"`
+	Items  []SigningRenewItem `json:"items"`
+}
@@ -0,0 +1,18 @@
+package asc
+
+// SigningStoreAsset describes a single asset handled by signing store push/pull.
+type SigningStoreAsset struct {
+	Name   string `json:"name"`
+	Kind   string `json:"kind"`
+	Path   string `json:"path"`
+	Status string `json:"status"`
+}
+
+// SigningStoreResult represents CLI output for signing store push/pull.
+type SigningStoreResult struct {
+	Action    string              `json:"action"`
+	Repo      string              `json:"repo"`
+	Directory string              `json:"directory"`
+	Assets    []SigningStoreAsset `json:"assets"`
+	Committed bool                `json:"committed,omitempty"`
+}
@@ -0,0 +1,186 @@
+package asc
+
+// Valid subscription grace period durations.
+var ValidSubscriptionGracePeriodDurations = []string{
+	"THREE_DAYS",
+	"SIXTEEN_DAYS",
+	"TWENTY_EIGHT_DAYS",
+}
+
+// Valid subscription grace period renewal types.
+var ValidSubscriptionGracePeriodRenewalTypes = []string{
+	"ALL_RENEWALS",
+	"PAID_TO_PAID_ONLY",
+}
+
+// SubscriptionImageAttributes describes a subscription promotional image.
+type SubscriptionImageAttributes struct {
+	FileSize           int64               `json:"fileSize"`
+	FileName           string              `json:"fileName"`
+	SourceFileChecksum string              `json:"sourceFileChecksum,omitempty"`
+	AssetToken         string              `json:"assetToken,omitempty"`
+	ImageAsset         *ImageAsset         `json:"imageAsset,omitempty"`
+	UploadOperations   []UploadOperation   `json:"uploadOperations,omitempty"`
+	AssetDeliveryState *AssetDeliveryState `json:"assetDeliveryState,omitempty"`
+	State              string              `json:"state,omitempty"`
+}
+
+// SubscriptionReviewScreenshotAttributes describes a subscription App Store review screenshot.
+type SubscriptionReviewScreenshotAttributes struct {
+	FileSize           int64               `json:"fileSize"`
+	FileName           string              `json:"fileName"`
+	SourceFileChecksum string              `json:"sourceFileChecksum,omitempty"`
+	ImageAsset         *ImageAsset         `json:"imageAsset,omitempty"`
+	AssetToken         string              `json:"assetToken,omitempty"`
+	AssetType          string              `json:"assetType,omitempty"`
+	UploadOperations   []UploadOperation   `json:"uploadOperations,omitempty"`
+	AssetDeliveryState *AssetDeliveryState `json:"assetDeliveryState,omitempty"`
+}
+
+// SubscriptionSubmissionAttributes describes a subscription submission resource.
+type SubscriptionSubmissionAttributes struct{}
+
+// SubscriptionGroupSubmissionAttributes describes a subscription group submission resource.
+type SubscriptionGroupSubmissionAttributes struct{}
+
+// SubscriptionGracePeriodAttributes describes an app's billing grace period settings.
+type SubscriptionGracePeriodAttributes struct {
+	OptIn        bool   `json:"optIn"`
+	SandboxOptIn bool   `json:"sandboxOptIn"`
+	Duration     string `json:"duration,omitempty"`
+	RenewalType  string `json:"renewalType,omitempty"`
+}
+
+// Response types
+type (
+	SubscriptionImagesResponse           = Response[SubscriptionImageAttributes]
+	SubscriptionImageResponse            = SingleResponse[SubscriptionImageAttributes]
+	SubscriptionReviewScreenshotResponse = SingleResponse[SubscriptionReviewScreenshotAttributes]
+	SubscriptionSubmissionResponse       = SingleResponse[SubscriptionSubmissionAttributes]
+	SubscriptionGroupSubmissionResponse  = SingleResponse[SubscriptionGroupSubmissionAttributes]
+	SubscriptionGracePeriodResponse      = SingleResponse[SubscriptionGracePeriodAttributes]
+)
+
+// SubscriptionAssetCreateAttributes describes the reservation for a subscription asset upload.
+type SubscriptionAssetCreateAttributes struct {
+	FileName string `json:"fileName"`
+	FileSize int64  `json:"fileSize"`
+}
+
+// SubscriptionAssetRelationships describes the subscription an asset or submission belongs to.
+type SubscriptionAssetRelationships struct {
+	Subscription Relationship `json:"subscription"`
+}
+
+// SubscriptionAssetCreateData is the data portion of a subscription asset create request.
+type SubscriptionAssetCreateData struct {
+	Type          ResourceType                      `json:"type"`
+	Attributes    SubscriptionAssetCreateAttributes `json:"attributes"`
+	Relationships SubscriptionAssetRelationships    `json:"relationships"`
+}
+
+// SubscriptionAssetCreateRequest is a request to reserve a subscription asset upload.
+type SubscriptionAssetCreateRequest struct {
+	Data SubscriptionAssetCreateData `json:"data"`
+}
+
+// SubscriptionAssetUpdateAttributes describes the commit step of a subscription asset upload.
+type SubscriptionAssetUpdateAttributes struct {
+	SourceFileChecksum *string `json:"sourceFileChecksum,omitempty"`
+	Uploaded           *bool   `json:"uploaded,omitempty"`
+}
+
+// SubscriptionAssetUpdateData is the data portion of a subscription asset update request.
+type SubscriptionAssetUpdateData struct {
+	Type       ResourceType                       `json:"type"`
+	ID         string                             `json:"id"`
+	Attributes *SubscriptionAssetUpdateAttributes `json:"attributes,omitempty"`
+}
+
+// SubscriptionAssetUpdateRequest is a request to commit a subscription asset upload.
+type SubscriptionAssetUpdateRequest struct {
+	Data SubscriptionAssetUpdateData `json:"data"`
+}
+
+// SubscriptionSubmissionCreateData is the data portion of a subscription submission create request.
+type SubscriptionSubmissionCreateData struct {
+	Type          ResourceType                   `json:"type"`
+	Relationships SubscriptionAssetRelationships `json:"relationships"`
+}
+
+// SubscriptionSubmissionCreateRequest is a request to submit a subscription for review.
+type SubscriptionSubmissionCreateRequest struct {
+	Data SubscriptionSubmissionCreateData `json:"data"`
+}
+
+// SubscriptionGroupSubmissionRelationships describes relationships for group submissions.
+type SubscriptionGroupSubmissionRelationships struct {
+	SubscriptionGroup Relationship `json:"subscriptionGroup"`
+}
+
+// SubscriptionGroupSubmissionCreateData is the data portion of a group submission create request.
+type SubscriptionGroupSubmissionCreateData struct {
+	Type          ResourceType                             `json:"type"`
+	Relationships SubscriptionGroupSubmissionRelationships `json:"relationships"`
+}
+
+// SubscriptionGroupSubmissionCreateRequest is a request to submit a subscription group for review.
+type SubscriptionGroupSubmissionCreateRequest struct {
+	Data SubscriptionGroupSubmissionCreateData `json:"data"`
+}
+
+// SubscriptionGracePeriodUpdateAttributes describes editable grace period settings.
+type SubscriptionGracePeriodUpdateAttributes struct {
+	OptIn        *bool   `json:"optIn,omitempty"`
+	SandboxOptIn *bool   `json:"sandboxOptIn,omitempty"`
+	Duration     *string `json:"duration,omitempty"`
+	RenewalType  *string `json:"renewalType,omitempty"`
+}
+
+// SubscriptionGracePeriodUpdateData is the data portion of a grace period update request.
+type SubscriptionGracePeriodUpdateData struct {
+	Type       ResourceType                            `json:"type"`
+	ID         string                                  `json:"id"`
+	Attributes SubscriptionGracePeriodUpdateAttributes `json:"attributes"`
+}
+
+// SubscriptionGracePeriodUpdateRequest is a request to update grace period settings.
+type SubscriptionGracePeriodUpdateRequest struct {
+	Data SubscriptionGracePeriodUpdateData `json:"data"`
+}
+
+// SubscriptionAssetUploadResult represents CLI output for subscription asset uploads.
+type SubscriptionAssetUploadResult struct {
+	SubscriptionID string `json:"subscriptionId"`
+	AssetID        string `json:"assetId"`
+	FileName       string `json:"fileName"`
+	FilePath       string `json:"filePath"`
+	FileSize       int64  `json:"fileSize"`
+	State          string `json:"state,omitempty"`
+}
+
+// SubscriptionAssetDeleteResult represents CLI output for subscription asset deletions.
+type SubscriptionAssetDeleteResult struct {
+	ID      string `json:"id"`
+	Deleted bool   `json:"deleted"`
+}
+
+// IsValidSubscriptionGracePeriodDuration checks if a grace period duration is supported.
+func IsValidSubscriptionGracePeriodDuration(value string) bool {
+	for _, item := range ValidSubscriptionGracePeriodDurations {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValidSubscriptionGracePeriodRenewalType checks if a grace period renewal type is supported.
+func IsValidSubscriptionGracePeriodRenewalType(value string) bool {
+	for _, item := range ValidSubscriptionGracePeriodRenewalTypes {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
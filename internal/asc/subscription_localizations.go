@@ -0,0 +1,151 @@
+package asc
+
+import (
+	"net/url"
+	"strings"
+)
+
+// SubscriptionLocalizationAttributes describes a subscription display name and description.
+type SubscriptionLocalizationAttributes struct {
+	Name        string `json:"name,omitempty"`
+	Locale      string `json:"locale,omitempty"`
+	Description string `json:"description,omitempty"`
+	State       string `json:"state,omitempty"`
+}
+
+// SubscriptionGroupLocalizationAttributes describes a subscription group display name.
+type SubscriptionGroupLocalizationAttributes struct {
+	Name          string `json:"name,omitempty"`
+	CustomAppName string `json:"customAppName,omitempty"`
+	Locale        string `json:"locale,omitempty"`
+	State         string `json:"state,omitempty"`
+}
+
+// Response types
+type (
+	SubscriptionLocalizationsResponse      = Response[SubscriptionLocalizationAttributes]
+	SubscriptionLocalizationResponse       = SingleResponse[SubscriptionLocalizationAttributes]
+	SubscriptionGroupLocalizationsResponse = Response[SubscriptionGroupLocalizationAttributes]
+	SubscriptionGroupLocalizationResponse  = SingleResponse[SubscriptionGroupLocalizationAttributes]
+)
+
+// SubscriptionLocalizationCreateAttributes describes a subscription localization to create.
+type SubscriptionLocalizationCreateAttributes struct {
+	Name        string `json:"name"`
+	Locale      string `json:"locale"`
+	Description string `json:"description,omitempty"`
+}
+
+// SubscriptionLocalizationRelationships describes relationships for subscription localizations.
+type SubscriptionLocalizationRelationships struct {
+	Subscription Relationship `json:"subscription"`
+}
+
+// SubscriptionLocalizationCreateData is the data portion of a subscription localization create request.
+type SubscriptionLocalizationCreateData struct {
+	Type          ResourceType                             `json:"type"`
+	Attributes    SubscriptionLocalizationCreateAttributes `json:"attributes"`
+	Relationships SubscriptionLocalizationRelationships    `json:"relationships"`
+}
+
+// SubscriptionLocalizationCreateRequest is a request to create a subscription localization.
+type SubscriptionLocalizationCreateRequest struct {
+	Data SubscriptionLocalizationCreateData `json:"data"`
+}
+
+// SubscriptionLocalizationUpdateAttributes describes editable subscription localization fields.
+type SubscriptionLocalizationUpdateAttributes struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// SubscriptionLocalizationUpdateData is the data portion of a subscription localization update request.
+type SubscriptionLocalizationUpdateData struct {
+	Type       ResourceType                             `json:"type"`
+	ID         string                                   `json:"id"`
+	Attributes SubscriptionLocalizationUpdateAttributes `json:"attributes"`
+}
+
+// SubscriptionLocalizationUpdateRequest is a request to update a subscription localization.
+type SubscriptionLocalizationUpdateRequest struct {
+	Data SubscriptionLocalizationUpdateData `json:"data"`
+}
+
+// SubscriptionGroupLocalizationCreateAttributes describes a subscription group localization to create.
+type SubscriptionGroupLocalizationCreateAttributes struct {
+	Name          string `json:"name"`
+	Locale        string `json:"locale"`
+	CustomAppName string `json:"customAppName,omitempty"`
+}
+
+// SubscriptionGroupLocalizationRelationships describes relationships for subscription group localizations.
+type SubscriptionGroupLocalizationRelationships struct {
+	SubscriptionGroup Relationship `json:"subscriptionGroup"`
+}
+
+// SubscriptionGroupLocalizationCreateData is the data portion of a group localization create request.
+type SubscriptionGroupLocalizationCreateData struct {
+	Type          ResourceType                                  `json:"type"`
+	Attributes    SubscriptionGroupLocalizationCreateAttributes `json:"attributes"`
+	Relationships SubscriptionGroupLocalizationRelationships    `json:"relationships"`
+}
+
+// SubscriptionGroupLocalizationCreateRequest is a request to create a subscription group localization.
+type SubscriptionGroupLocalizationCreateRequest struct {
+	Data SubscriptionGroupLocalizationCreateData `json:"data"`
+}
+
+// SubscriptionGroupLocalizationUpdateAttributes describes editable group localization fields.
+type SubscriptionGroupLocalizationUpdateAttributes struct {
+	Name          *string `json:"name,omitempty"`
+	CustomAppName *string `json:"customAppName,omitempty"`
+}
+
+// SubscriptionGroupLocalizationUpdateData is the data portion of a group localization update request.
+type SubscriptionGroupLocalizationUpdateData struct {
+	Type       ResourceType                                  `json:"type"`
+	ID         string                                        `json:"id"`
+	Attributes SubscriptionGroupLocalizationUpdateAttributes `json:"attributes"`
+}
+
+// SubscriptionGroupLocalizationUpdateRequest is a request to update a subscription group localization.
+type SubscriptionGroupLocalizationUpdateRequest struct {
+	Data SubscriptionGroupLocalizationUpdateData `json:"data"`
+}
+
+// SubscriptionLocalizationDeleteResult represents CLI output for localization deletions.
+type SubscriptionLocalizationDeleteResult struct {
+	ID      string `json:"id"`
+	Deleted bool   `json:"deleted"`
+}
+
+// SubscriptionLocalizationsOption is a functional option for subscription localization lists.
+type SubscriptionLocalizationsOption func(*subscriptionLocalizationsQuery)
+
+type subscriptionLocalizationsQuery struct {
+	listQuery
+}
+
+// WithSubscriptionLocalizationsLimit sets the max number of localizations to return.
+func WithSubscriptionLocalizationsLimit(limit int) SubscriptionLocalizationsOption {
+	return func(q *subscriptionLocalizationsQuery) {
+		if limit > 0 {
+			q.limit = limit
+		}
+	}
+}
+
+// WithSubscriptionLocalizationsNextURL uses a next page URL directly.
+func WithSubscriptionLocalizationsNextURL(next string) SubscriptionLocalizationsOption {
+	return func(q *subscriptionLocalizationsQuery) {
+		if strings.TrimSpace(next) != "" {
+			q.nextURL = strings.TrimSpace(next)
+		}
+	}
+}
+
+func buildSubscriptionLocalizationsQuery(query *subscriptionLocalizationsQuery) string {
+	values := url.Values{}
+	addLimit(values, query.limit)
+	return values.Encode()
+}
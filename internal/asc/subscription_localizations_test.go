@@ -0,0 +1,84 @@
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestCreateSubscriptionLocalization_SendsSubscriptionRelationship(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) {
+		assertAuthorized(t, req)
+		if req.Method != http.MethodPost || req.URL.Path != "/v1/subscriptionLocalizations" {
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+		var payload SubscriptionLocalizationCreateRequest
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if payload.Data.Type != ResourceTypeSubscriptionLocalizations {
+			t.Fatalf("unexpected type %q", payload.Data.Type)
+		}
+		if payload.Data.Relationships.Subscription.Data.ID != "sub-1" {
+			t.Fatalf("unexpected subscription %+v", payload.Data.Relationships.Subscription)
+		}
+		if payload.Data.Attributes.Locale != "en-US" || payload.Data.Attributes.Name != "Pro" {
+			t.Fatalf("unexpected attributes %+v", payload.Data.Attributes)
+		}
+	}, jsonResponse(http.StatusCreated, `{"data":{"type":"subscriptionLocalizations","id":"loc-1","attributes":{"locale":"en-US","name":"Pro"}}}`))
+
+	resp, err := client.CreateSubscriptionLocalization(context.Background(), "sub-1", SubscriptionLocalizationCreateAttributes{
+		Name:   "Pro",
+		Locale: "en-US",
+	})
+	if err != nil {
+		t.Fatalf("CreateSubscriptionLocalization() error: %v", err)
+	}
+	if resp.Data.ID != "loc-1" {
+		t.Fatalf("unexpected response %+v", resp.Data)
+	}
+}
+
+func TestUpdateSubscriptionGroupLocalization_SendsOnlySetFields(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) {
+		assertAuthorized(t, req)
+		if req.Method != http.MethodPatch || req.URL.Path != "/v1/subscriptionGroupLocalizations/loc-1" {
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+		var payload map[string]any
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		attrs := payload["data"].(map[string]any)["attributes"].(map[string]any)
+		if len(attrs) != 1 || attrs["customAppName"] != "Example Pro" {
+			t.Fatalf("expected only customAppName, got %v", attrs)
+		}
+	}, jsonResponse(http.StatusOK, `{"data":{"type":"subscriptionGroupLocalizations","id":"loc-1"}}`))
+
+	customAppName := "Example Pro"
+	if _, err := client.UpdateSubscriptionGroupLocalization(context.Background(), "loc-1", SubscriptionGroupLocalizationUpdateAttributes{CustomAppName: &customAppName}); err != nil {
+		t.Fatalf("UpdateSubscriptionGroupLocalization() error: %v", err)
+	}
+}
+
+func TestCreateSubscriptionGroupSubmission_SendsGroupRelationship(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) {
+		assertAuthorized(t, req)
+		if req.Method != http.MethodPost || req.URL.Path != "/v1/subscriptionGroupSubmissions" {
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+		var payload SubscriptionGroupSubmissionCreateRequest
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		rel := payload.Data.Relationships.SubscriptionGroup.Data
+		if rel.Type != ResourceTypeSubscriptionGroups || rel.ID != "group-1" {
+			t.Fatalf("unexpected relationship %+v", rel)
+		}
+	}, jsonResponse(http.StatusCreated, `{"data":{"type":"subscriptionGroupSubmissions","id":"sub-sub-1"}}`))
+
+	if _, err := client.CreateSubscriptionGroupSubmission(context.Background(), "group-1"); err != nil {
+		t.Fatalf("CreateSubscriptionGroupSubmission() error: %v", err)
+	}
+}
@@ -0,0 +1,184 @@
+package asc
+
+import (
+	"net/url"
+	"strings"
+)
+
+// Valid customer eligibilities for subscription offer codes.
+var ValidSubscriptionOfferCodeCustomerEligibilities = []string{
+	"NEW",
+	"EXISTING",
+	"EXPIRED",
+}
+
+// Valid offer eligibilities for subscription offer codes.
+var ValidSubscriptionOfferCodeOfferEligibilities = []string{
+	"STACK_WITH_INTRO_OFFERS",
+	"REPLACE_INTRO_OFFERS",
+}
+
+// SubscriptionOfferCodeAttributes describes a subscription offer code.
+type SubscriptionOfferCodeAttributes struct {
+	Name                  string   `json:"name,omitempty"`
+	CustomerEligibilities []string `json:"customerEligibilities,omitempty"`
+	OfferEligibility      string   `json:"offerEligibility,omitempty"`
+	Duration              string   `json:"duration,omitempty"`
+	OfferMode             string   `json:"offerMode,omitempty"`
+	NumberOfPeriods       int      `json:"numberOfPeriods,omitempty"`
+	TotalNumberOfCodes    int      `json:"totalNumberOfCodes,omitempty"`
+	Active                bool     `json:"active,omitempty"`
+}
+
+// OfferCodeCustomCodeAttributes describes a custom code for a subscription or
+// in-app purchase offer code.
+type OfferCodeCustomCodeAttributes struct {
+	CustomCode     string `json:"customCode,omitempty"`
+	NumberOfCodes  int    `json:"numberOfCodes,omitempty"`
+	CreatedDate    string `json:"createdDate,omitempty"`
+	ExpirationDate string `json:"expirationDate,omitempty"`
+	Active         bool   `json:"active,omitempty"`
+}
+
+// Response types
+type (
+	SubscriptionOfferCodesResponse           = Response[SubscriptionOfferCodeAttributes]
+	SubscriptionOfferCodeResponse            = SingleResponse[SubscriptionOfferCodeAttributes]
+	SubscriptionOfferCodeCustomCodesResponse = Response[OfferCodeCustomCodeAttributes]
+	SubscriptionOfferCodeCustomCodeResponse  = SingleResponse[OfferCodeCustomCodeAttributes]
+)
+
+// SubscriptionOfferCodeCreateAttributes describes attributes for creating a subscription offer code.
+type SubscriptionOfferCodeCreateAttributes struct {
+	Name                  string   `json:"name"`
+	CustomerEligibilities []string `json:"customerEligibilities"`
+	OfferEligibility      string   `json:"offerEligibility"`
+	Duration              string   `json:"duration"`
+	OfferMode             string   `json:"offerMode"`
+	NumberOfPeriods       int      `json:"numberOfPeriods"`
+}
+
+// SubscriptionOfferCodeRelationships describes relationships for subscription offer codes.
+type SubscriptionOfferCodeRelationships struct {
+	Subscription Relationship     `json:"subscription"`
+	Prices       RelationshipList `json:"prices"`
+}
+
+// SubscriptionOfferCodeCreateData is the data portion of a subscription offer code create request.
+type SubscriptionOfferCodeCreateData struct {
+	Type          ResourceType                          `json:"type"`
+	Attributes    SubscriptionOfferCodeCreateAttributes `json:"attributes"`
+	Relationships SubscriptionOfferCodeRelationships    `json:"relationships"`
+}
+
+// SubscriptionOfferCodeCreateRequest is a request to create a subscription offer code.
+type SubscriptionOfferCodeCreateRequest struct {
+	Data     SubscriptionOfferCodeCreateData      `json:"data"`
+	Included []SubscriptionOfferCodePriceResource `json:"included"`
+}
+
+// SubscriptionOfferCodePriceResource is an inline subscription offer code price.
+type SubscriptionOfferCodePriceResource struct {
+	Type          ResourceType                            `json:"type"`
+	ID            string                                  `json:"id"`
+	Relationships SubscriptionOfferCodePriceRelationships `json:"relationships"`
+}
+
+// SubscriptionOfferCodePriceRelationships describes relationships for offer code prices.
+// The price point is omitted for free trials.
+type SubscriptionOfferCodePriceRelationships struct {
+	Territory              Relationship  `json:"territory"`
+	SubscriptionPricePoint *Relationship `json:"subscriptionPricePoint,omitempty"`
+}
+
+// OfferCodeCustomCodeCreateAttributes describes attributes for creating a custom code.
+type OfferCodeCustomCodeCreateAttributes struct {
+	CustomCode     string `json:"customCode"`
+	NumberOfCodes  int    `json:"numberOfCodes"`
+	ExpirationDate string `json:"expirationDate,omitempty"`
+}
+
+// OfferCodeCustomCodeRelationships describes relationships for custom codes.
+type OfferCodeCustomCodeRelationships struct {
+	OfferCode Relationship `json:"offerCode"`
+}
+
+// OfferCodeCustomCodeCreateData is the data portion of a custom code create request.
+type OfferCodeCustomCodeCreateData struct {
+	Type          ResourceType                        `json:"type"`
+	Attributes    OfferCodeCustomCodeCreateAttributes `json:"attributes"`
+	Relationships OfferCodeCustomCodeRelationships    `json:"relationships"`
+}
+
+// OfferCodeCustomCodeCreateRequest is a request to create a custom code.
+type OfferCodeCustomCodeCreateRequest struct {
+	Data OfferCodeCustomCodeCreateData `json:"data"`
+}
+
+// OfferCodeActiveUpdateAttributes toggles whether an offer code resource is active.
+type OfferCodeActiveUpdateAttributes struct {
+	Active bool `json:"active"`
+}
+
+// OfferCodeActiveUpdateData is the data portion of an offer code activation update.
+type OfferCodeActiveUpdateData struct {
+	Type       ResourceType                    `json:"type"`
+	ID         string                          `json:"id"`
+	Attributes OfferCodeActiveUpdateAttributes `json:"attributes"`
+}
+
+// OfferCodeActiveUpdateRequest is a request to activate or deactivate an offer code resource.
+type OfferCodeActiveUpdateRequest struct {
+	Data OfferCodeActiveUpdateData `json:"data"`
+}
+
+// IsValidSubscriptionOfferCodeCustomerEligibility checks if a customer eligibility is supported.
+func IsValidSubscriptionOfferCodeCustomerEligibility(value string) bool {
+	for _, item := range ValidSubscriptionOfferCodeCustomerEligibilities {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValidSubscriptionOfferCodeOfferEligibility checks if an offer eligibility is supported.
+func IsValidSubscriptionOfferCodeOfferEligibility(value string) bool {
+	for _, item := range ValidSubscriptionOfferCodeOfferEligibilities {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+// OfferCodesOption is a functional option for offer code and custom code lists.
+type OfferCodesOption func(*offerCodesQuery)
+
+type offerCodesQuery struct {
+	listQuery
+}
+
+// WithOfferCodesLimit sets the max number of offer codes to return.
+func WithOfferCodesLimit(limit int) OfferCodesOption {
+	return func(q *offerCodesQuery) {
+		if limit > 0 {
+			q.limit = limit
+		}
+	}
+}
+
+// WithOfferCodesNextURL uses a next page URL directly.
+func WithOfferCodesNextURL(next string) OfferCodesOption {
+	return func(q *offerCodesQuery) {
+		if strings.TrimSpace(next) != "" {
+			q.nextURL = strings.TrimSpace(next)
+		}
+	}
+}
+
+func buildOfferCodesQuery(query *offerCodesQuery) string {
+	values := url.Values{}
+	addLimit(values, query.limit)
+	return values.Encode()
+}
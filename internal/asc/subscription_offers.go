@@ -0,0 +1,243 @@
+package asc
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+)
+
+// Valid subscription offer durations for introductory and promotional offers.
+var ValidSubscriptionOfferDurations = []string{
+	"THREE_DAYS",
+	"ONE_WEEK",
+	"TWO_WEEKS",
+	"ONE_MONTH",
+	"TWO_MONTHS",
+	"THREE_MONTHS",
+	"SIX_MONTHS",
+	"ONE_YEAR",
+}
+
+// Valid subscription offer modes for introductory and promotional offers.
+var ValidSubscriptionOfferModes = []string{
+	"PAY_AS_YOU_GO",
+	"PAY_UP_FRONT",
+	"FREE_TRIAL",
+}
+
+// SubscriptionPricePointAttributes describes a subscription price point.
+type SubscriptionPricePointAttributes struct {
+	CustomerPrice string `json:"customerPrice,omitempty"`
+	Proceeds      string `json:"proceeds,omitempty"`
+	ProceedsYear2 string `json:"proceedsYear2,omitempty"`
+}
+
+// SubscriptionIntroductoryOfferAttributes describes an introductory offer.
+type SubscriptionIntroductoryOfferAttributes struct {
+	StartDate       string `json:"startDate,omitempty"`
+	EndDate         string `json:"endDate,omitempty"`
+	Duration        string `json:"duration,omitempty"`
+	OfferMode       string `json:"offerMode,omitempty"`
+	NumberOfPeriods int    `json:"numberOfPeriods,omitempty"`
+}
+
+// SubscriptionPromotionalOfferAttributes describes a promotional offer.
+type SubscriptionPromotionalOfferAttributes struct {
+	Name            string `json:"name,omitempty"`
+	OfferCode       string `json:"offerCode,omitempty"`
+	Duration        string `json:"duration,omitempty"`
+	OfferMode       string `json:"offerMode,omitempty"`
+	NumberOfPeriods int    `json:"numberOfPeriods,omitempty"`
+}
+
+// SubscriptionOfferPrice pairs a territory with a subscription price point.
+// PricePointID may be empty for free trials.
+type SubscriptionOfferPrice struct {
+	Territory    string `json:"territory"`
+	PricePointID string `json:"pricePointId,omitempty"`
+}
+
+// Response types
+type (
+	SubscriptionPricePointsResponse        = Response[SubscriptionPricePointAttributes]
+	SubscriptionIntroductoryOffersResponse = Response[SubscriptionIntroductoryOfferAttributes]
+	SubscriptionIntroductoryOfferResponse  = SingleResponse[SubscriptionIntroductoryOfferAttributes]
+	SubscriptionPromotionalOffersResponse  = Response[SubscriptionPromotionalOfferAttributes]
+	SubscriptionPromotionalOfferResponse   = SingleResponse[SubscriptionPromotionalOfferAttributes]
+)
+
+// SubscriptionIntroductoryOfferRelationships describes relationships for introductory offers.
+type SubscriptionIntroductoryOfferRelationships struct {
+	Subscription           Relationship  `json:"subscription"`
+	Territory              *Relationship `json:"territory,omitempty"`
+	SubscriptionPricePoint *Relationship `json:"subscriptionPricePoint,omitempty"`
+}
+
+// SubscriptionIntroductoryOfferCreateData is the data portion of an introductory offer create request.
+type SubscriptionIntroductoryOfferCreateData struct {
+	Type          ResourceType                               `json:"type"`
+	Attributes    SubscriptionIntroductoryOfferAttributes    `json:"attributes"`
+	Relationships SubscriptionIntroductoryOfferRelationships `json:"relationships"`
+}
+
+// SubscriptionIntroductoryOfferCreateRequest is a request to create an introductory offer.
+type SubscriptionIntroductoryOfferCreateRequest struct {
+	Data SubscriptionIntroductoryOfferCreateData `json:"data"`
+}
+
+// SubscriptionPromotionalOfferRelationships describes relationships for promotional offers.
+type SubscriptionPromotionalOfferRelationships struct {
+	Subscription *Relationship    `json:"subscription,omitempty"`
+	Prices       RelationshipList `json:"prices"`
+}
+
+// SubscriptionPromotionalOfferCreateData is the data portion of a promotional offer create request.
+type SubscriptionPromotionalOfferCreateData struct {
+	Type          ResourceType                              `json:"type"`
+	Attributes    SubscriptionPromotionalOfferAttributes    `json:"attributes"`
+	Relationships SubscriptionPromotionalOfferRelationships `json:"relationships"`
+}
+
+// SubscriptionPromotionalOfferCreateRequest is a request to create a promotional offer.
+type SubscriptionPromotionalOfferCreateRequest struct {
+	Data     SubscriptionPromotionalOfferCreateData      `json:"data"`
+	Included []SubscriptionPromotionalOfferPriceResource `json:"included"`
+}
+
+// SubscriptionPromotionalOfferUpdateData is the data portion of a promotional offer update request.
+type SubscriptionPromotionalOfferUpdateData struct {
+	Type          ResourceType                              `json:"type"`
+	ID            string                                    `json:"id"`
+	Relationships SubscriptionPromotionalOfferRelationships `json:"relationships"`
+}
+
+// SubscriptionPromotionalOfferUpdateRequest is a request to replace promotional offer prices.
+type SubscriptionPromotionalOfferUpdateRequest struct {
+	Data     SubscriptionPromotionalOfferUpdateData      `json:"data"`
+	Included []SubscriptionPromotionalOfferPriceResource `json:"included"`
+}
+
+// SubscriptionPromotionalOfferPriceResource is an inline promotional offer price.
+type SubscriptionPromotionalOfferPriceResource struct {
+	Type          ResourceType                                   `json:"type"`
+	ID            string                                         `json:"id"`
+	Relationships SubscriptionPromotionalOfferPriceRelationships `json:"relationships"`
+}
+
+// SubscriptionPromotionalOfferPriceRelationships describes relationships for promotional offer prices.
+type SubscriptionPromotionalOfferPriceRelationships struct {
+	Territory              Relationship `json:"territory"`
+	SubscriptionPricePoint Relationship `json:"subscriptionPricePoint"`
+}
+
+// SubscriptionOfferDeleteResult represents CLI output for offer deletions.
+type SubscriptionOfferDeleteResult struct {
+	ID      string `json:"id"`
+	Deleted bool   `json:"deleted"`
+}
+
+// IsValidSubscriptionOfferDuration checks if an offer duration is supported.
+func IsValidSubscriptionOfferDuration(value string) bool {
+	for _, item := range ValidSubscriptionOfferDurations {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValidSubscriptionOfferMode checks if an offer mode is supported.
+func IsValidSubscriptionOfferMode(value string) bool {
+	for _, item := range ValidSubscriptionOfferModes {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+// RelationshipTerritoryID returns the territory ID linked from a resource's
+// relationships, or an empty string when the relationship is absent.
+func RelationshipTerritoryID(relationships json.RawMessage) string {
+	return relationshipID(relationships, "territory")
+}
+
+func relationshipID(relationships json.RawMessage, name string) string {
+	if len(relationships) == 0 {
+		return ""
+	}
+	var rels map[string]json.RawMessage
+	if err := json.Unmarshal(relationships, &rels); err != nil {
+		return ""
+	}
+	raw, ok := rels[name]
+	if !ok {
+		return ""
+	}
+	var rel struct {
+		Data *ResourceData `json:"data"`
+	}
+	if err := json.Unmarshal(raw, &rel); err != nil || rel.Data == nil {
+		return ""
+	}
+	return rel.Data.ID
+}
+
+// SubscriptionOffersOption is a functional option for subscription offer lists.
+type SubscriptionOffersOption func(*subscriptionOffersQuery)
+
+type subscriptionOffersQuery struct {
+	listQuery
+	territories []string
+}
+
+// WithSubscriptionOffersLimit sets the max number of offers to return.
+func WithSubscriptionOffersLimit(limit int) SubscriptionOffersOption {
+	return func(q *subscriptionOffersQuery) {
+		if limit > 0 {
+			q.limit = limit
+		}
+	}
+}
+
+// WithSubscriptionOffersNextURL uses a next page URL directly.
+func WithSubscriptionOffersNextURL(next string) SubscriptionOffersOption {
+	return func(q *subscriptionOffersQuery) {
+		if strings.TrimSpace(next) != "" {
+			q.nextURL = strings.TrimSpace(next)
+		}
+	}
+}
+
+// WithSubscriptionOffersTerritories filters introductory offers by territory.
+func WithSubscriptionOffersTerritories(territories []string) SubscriptionOffersOption {
+	return func(q *subscriptionOffersQuery) {
+		q.territories = normalizeUpperList(territories)
+	}
+}
+
+func buildSubscriptionIntroductoryOffersQuery(query *subscriptionOffersQuery) string {
+	values := url.Values{}
+	values.Set("include", "territory,subscriptionPricePoint")
+	if len(query.territories) > 0 {
+		values.Set("filter[territory]", strings.Join(query.territories, ","))
+	}
+	addLimit(values, query.limit)
+	return values.Encode()
+}
+
+func buildSubscriptionOffersQuery(query *subscriptionOffersQuery) string {
+	values := url.Values{}
+	addLimit(values, query.limit)
+	return values.Encode()
+}
+
+func buildPricePointsWithTerritoryQuery(query *pricePointsQuery) string {
+	values := url.Values{}
+	values.Set("include", "territory")
+	if strings.TrimSpace(query.territory) != "" {
+		values.Set("filter[territory]", strings.TrimSpace(query.territory))
+	}
+	addLimit(values, query.limit)
+	return values.Encode()
+}
@@ -0,0 +1,128 @@
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestGetSubscriptionIntroductoryOffers_WithTerritories(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) {
+		assertAuthorized(t, req)
+		if req.URL.Path != "/v1/subscriptions/sub-1/introductoryOffers" {
+			t.Fatalf("unexpected path %s", req.URL.Path)
+		}
+		values := req.URL.Query()
+		if values.Get("filter[territory]") != "USA,GBR" {
+			t.Fatalf("expected territory filter USA,GBR, got %q", values.Get("filter[territory]"))
+		}
+		if values.Get("include") != "territory,subscriptionPricePoint" {
+			t.Fatalf("unexpected include %q", values.Get("include"))
+		}
+	}, jsonResponse(http.StatusOK, `{"data":[{"type":"subscriptionIntroductoryOffers","id":"offer-1","attributes":{"offerMode":"FREE_TRIAL","duration":"ONE_WEEK","numberOfPeriods":1},"relationships":{"territory":{"data":{"type":"territories","id":"USA"}}}}]}`))
+
+	resp, err := client.GetSubscriptionIntroductoryOffers(context.Background(), "sub-1",
+		WithSubscriptionOffersTerritories([]string{"usa", "gbr"}),
+	)
+	if err != nil {
+		t.Fatalf("GetSubscriptionIntroductoryOffers() error: %v", err)
+	}
+	if len(resp.Data) != 1 || RelationshipTerritoryID(resp.Data[0].Relationships) != "USA" {
+		t.Fatalf("unexpected offers %+v", resp.Data)
+	}
+}
+
+func TestCreateSubscriptionIntroductoryOffer_FreeTrialOmitsPricePoint(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) {
+		assertAuthorized(t, req)
+		if req.Method != http.MethodPost || req.URL.Path != "/v1/subscriptionIntroductoryOffers" {
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+		var payload map[string]any
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		data := payload["data"].(map[string]any)
+		rels := data["relationships"].(map[string]any)
+		if _, ok := rels["subscriptionPricePoint"]; ok {
+			t.Fatal("expected no subscriptionPricePoint for free trial")
+		}
+		territory := rels["territory"].(map[string]any)["data"].(map[string]any)
+		if territory["id"] != "GBR" {
+			t.Fatalf("expected territory GBR, got %v", territory["id"])
+		}
+		attrs := data["attributes"].(map[string]any)
+		if attrs["offerMode"] != "FREE_TRIAL" || attrs["duration"] != "ONE_WEEK" {
+			t.Fatalf("unexpected attributes %v", attrs)
+		}
+	}, jsonResponse(http.StatusCreated, `{"data":{"type":"subscriptionIntroductoryOffers","id":"offer-1"}}`))
+
+	_, err := client.CreateSubscriptionIntroductoryOffer(context.Background(), "sub-1", SubscriptionIntroductoryOfferAttributes{
+		Duration:        "ONE_WEEK",
+		OfferMode:       "FREE_TRIAL",
+		NumberOfPeriods: 1,
+	}, SubscriptionOfferPrice{Territory: "gbr"})
+	if err != nil {
+		t.Fatalf("CreateSubscriptionIntroductoryOffer() error: %v", err)
+	}
+}
+
+func TestCreateSubscriptionPromotionalOffer_InlinesPrices(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) {
+		assertAuthorized(t, req)
+		if req.Method != http.MethodPost || req.URL.Path != "/v1/subscriptionPromotionalOffers" {
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+		var payload SubscriptionPromotionalOfferCreateRequest
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if payload.Data.Relationships.Subscription == nil || payload.Data.Relationships.Subscription.Data.ID != "sub-1" {
+			t.Fatalf("expected subscription sub-1, got %+v", payload.Data.Relationships.Subscription)
+		}
+		refs := payload.Data.Relationships.Prices.Data
+		if len(refs) != 2 || len(payload.Included) != 2 {
+			t.Fatalf("expected 2 prices, got %d refs and %d included", len(refs), len(payload.Included))
+		}
+		for i, included := range payload.Included {
+			if included.ID != refs[i].ID {
+				t.Fatalf("expected included id %q to match relationship %q", included.ID, refs[i].ID)
+			}
+		}
+		if payload.Included[1].Relationships.Territory.Data.ID != "GBR" || payload.Included[1].Relationships.SubscriptionPricePoint.Data.ID != "pp-gbr" {
+			t.Fatalf("unexpected second price %+v", payload.Included[1])
+		}
+	}, jsonResponse(http.StatusCreated, `{"data":{"type":"subscriptionPromotionalOffers","id":"promo-1"}}`))
+
+	_, err := client.CreateSubscriptionPromotionalOffer(context.Background(), "sub-1", SubscriptionPromotionalOfferAttributes{
+		Name:            "Win back",
+		OfferCode:       "WINBACK",
+		Duration:        "ONE_MONTH",
+		OfferMode:       "PAY_AS_YOU_GO",
+		NumberOfPeriods: 3,
+	}, []SubscriptionOfferPrice{
+		{Territory: "USA", PricePointID: "pp-usa"},
+		{Territory: "gbr", PricePointID: "pp-gbr"},
+	})
+	if err != nil {
+		t.Fatalf("CreateSubscriptionPromotionalOffer() error: %v", err)
+	}
+}
+
+func TestCreateSubscriptionPromotionalOffer_RequiresPrices(t *testing.T) {
+	client := newTestClient(t, nil, nil)
+	if _, err := client.CreateSubscriptionPromotionalOffer(context.Background(), "sub-1", SubscriptionPromotionalOfferAttributes{}, nil); err == nil {
+		t.Fatal("expected error when prices are missing")
+	}
+}
+
+func TestRelationshipTerritoryID_IgnoresListRelationships(t *testing.T) {
+	raw := json.RawMessage(`{"prices":{"data":[{"type":"subscriptionPromotionalOfferPrices","id":"p1"}]},"territory":{"data":{"type":"territories","id":"JPN"}}}`)
+	if got := RelationshipTerritoryID(raw); got != "JPN" {
+		t.Fatalf("expected JPN, got %q", got)
+	}
+	if got := RelationshipTerritoryID(nil); got != "" {
+		t.Fatalf("expected empty territory, got %q", got)
+	}
+}
@@ -147,3 +147,375 @@ func printSubscriptionDeleteResultMarkdown(result *SubscriptionDeleteResult) err
 	)
 	return nil
 }
+
+func printSubscriptionPricePointsTable(resp *SubscriptionPricePointsResponse) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tTerritory\tCustomer Price\tProceeds")
+	for _, item := range resp.Data {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
+			item.ID,
+			RelationshipTerritoryID(item.Relationships),
+			item.Attributes.CustomerPrice,
+			item.Attributes.Proceeds,
+		)
+	}
+	return w.Flush()
+}
+
+func printSubscriptionPricePointsMarkdown(resp *SubscriptionPricePointsResponse) error {
+	fmt.Fprintln(os.Stdout, "| ID | Territory | Customer Price | Proceeds |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- |")
+	for _, item := range resp.Data {
+		fmt.Fprintf(os.Stdout, "| %s | %s | %s | %s |\n",
+			escapeMarkdown(item.ID),
+			escapeMarkdown(RelationshipTerritoryID(item.Relationships)),
+			escapeMarkdown(item.Attributes.CustomerPrice),
+			escapeMarkdown(item.Attributes.Proceeds),
+		)
+	}
+	return nil
+}
+
+func printSubscriptionIntroductoryOffersTable(resp *SubscriptionIntroductoryOffersResponse) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tTerritory\tMode\tDuration\tPeriods\tStart Date\tEnd Date")
+	for _, item := range resp.Data {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%s\t%s\n",
+			item.ID,
+			RelationshipTerritoryID(item.Relationships),
+			item.Attributes.OfferMode,
+			item.Attributes.Duration,
+			item.Attributes.NumberOfPeriods,
+			item.Attributes.StartDate,
+			item.Attributes.EndDate,
+		)
+	}
+	return w.Flush()
+}
+
+func printSubscriptionIntroductoryOffersMarkdown(resp *SubscriptionIntroductoryOffersResponse) error {
+	fmt.Fprintln(os.Stdout, "| ID | Territory | Mode | Duration | Periods | Start Date | End Date |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- | --- | --- |")
+	for _, item := range resp.Data {
+		fmt.Fprintf(os.Stdout, "| %s | %s | %s | %s | %d | %s | %s |\n",
+			escapeMarkdown(item.ID),
+			escapeMarkdown(RelationshipTerritoryID(item.Relationships)),
+			escapeMarkdown(item.Attributes.OfferMode),
+			escapeMarkdown(item.Attributes.Duration),
+			item.Attributes.NumberOfPeriods,
+			escapeMarkdown(item.Attributes.StartDate),
+			escapeMarkdown(item.Attributes.EndDate),
+		)
+	}
+	return nil
+}
+
+func printSubscriptionPromotionalOffersTable(resp *SubscriptionPromotionalOffersResponse) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tName\tOffer Code\tMode\tDuration\tPeriods")
+	for _, item := range resp.Data {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%d\n",
+			item.ID,
+			compactWhitespace(item.Attributes.Name),
+			item.Attributes.OfferCode,
+			item.Attributes.OfferMode,
+			item.Attributes.Duration,
+			item.Attributes.NumberOfPeriods,
+		)
+	}
+	return w.Flush()
+}
+
+func printSubscriptionPromotionalOffersMarkdown(resp *SubscriptionPromotionalOffersResponse) error {
+	fmt.Fprintln(os.Stdout, "| ID | Name | Offer Code | Mode | Duration | Periods |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- | --- |")
+	for _, item := range resp.Data {
+		fmt.Fprintf(os.Stdout, "| %s | %s | %s | %s | %s | %d |\n",
+			escapeMarkdown(item.ID),
+			escapeMarkdown(item.Attributes.Name),
+			escapeMarkdown(item.Attributes.OfferCode),
+			escapeMarkdown(item.Attributes.OfferMode),
+			escapeMarkdown(item.Attributes.Duration),
+			item.Attributes.NumberOfPeriods,
+		)
+	}
+	return nil
+}
+
+func printWinBackOffersTable(resp *WinBackOffersResponse) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tName\tOffer ID\tMode\tDuration\tPeriods\tPriority\tStart\tEnd")
+	for _, item := range resp.Data {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%d\t%s\t%s\t%s\n",
+			item.ID,
+			compactWhitespace(item.Attributes.ReferenceName),
+			item.Attributes.OfferID,
+			item.Attributes.OfferMode,
+			item.Attributes.Duration,
+			item.Attributes.PeriodCount,
+			item.Attributes.Priority,
+			item.Attributes.StartDate,
+			item.Attributes.EndDate,
+		)
+	}
+	return w.Flush()
+}
+
+func printWinBackOffersMarkdown(resp *WinBackOffersResponse) error {
+	fmt.Fprintln(os.Stdout, "| ID | Name | Offer ID | Mode | Duration | Periods | Priority | Start | End |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- | --- | --- | --- | --- |")
+	for _, item := range resp.Data {
+		fmt.Fprintf(os.Stdout, "| %s | %s | %s | %s | %s | %d | %s | %s | %s |\n",
+			escapeMarkdown(item.ID),
+			escapeMarkdown(item.Attributes.ReferenceName),
+			escapeMarkdown(item.Attributes.OfferID),
+			escapeMarkdown(item.Attributes.OfferMode),
+			escapeMarkdown(item.Attributes.Duration),
+			item.Attributes.PeriodCount,
+			escapeMarkdown(item.Attributes.Priority),
+			escapeMarkdown(item.Attributes.StartDate),
+			escapeMarkdown(item.Attributes.EndDate),
+		)
+	}
+	return nil
+}
+
+func printSubscriptionOfferDeleteResultTable(result *SubscriptionOfferDeleteResult) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tDeleted")
+	fmt.Fprintf(w, "%s\t%t\n", result.ID, result.Deleted)
+	return w.Flush()
+}
+
+func printSubscriptionOfferDeleteResultMarkdown(result *SubscriptionOfferDeleteResult) error {
+	fmt.Fprintln(os.Stdout, "| ID | Deleted |")
+	fmt.Fprintln(os.Stdout, "| --- | --- |")
+	fmt.Fprintf(os.Stdout, "| %s | %t |\n",
+		escapeMarkdown(result.ID),
+		result.Deleted,
+	)
+	return nil
+}
+
+func printSubscriptionLocalizationsTable(resp *SubscriptionLocalizationsResponse) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tLocale\tName\tDescription\tState")
+	for _, item := range resp.Data {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+			item.ID,
+			item.Attributes.Locale,
+			compactWhitespace(item.Attributes.Name),
+			compactWhitespace(item.Attributes.Description),
+			item.Attributes.State,
+		)
+	}
+	return w.Flush()
+}
+
+func printSubscriptionLocalizationsMarkdown(resp *SubscriptionLocalizationsResponse) error {
+	fmt.Fprintln(os.Stdout, "| ID | Locale | Name | Description | State |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- |")
+	for _, item := range resp.Data {
+		fmt.Fprintf(os.Stdout, "| %s | %s | %s | %s | %s |\n",
+			escapeMarkdown(item.ID),
+			escapeMarkdown(item.Attributes.Locale),
+			escapeMarkdown(item.Attributes.Name),
+			escapeMarkdown(item.Attributes.Description),
+			escapeMarkdown(item.Attributes.State),
+		)
+	}
+	return nil
+}
+
+func printSubscriptionGroupLocalizationsTable(resp *SubscriptionGroupLocalizationsResponse) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tLocale\tName\tCustom App Name\tState")
+	for _, item := range resp.Data {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+			item.ID,
+			item.Attributes.Locale,
+			compactWhitespace(item.Attributes.Name),
+			compactWhitespace(item.Attributes.CustomAppName),
+			item.Attributes.State,
+		)
+	}
+	return w.Flush()
+}
+
+func printSubscriptionGroupLocalizationsMarkdown(resp *SubscriptionGroupLocalizationsResponse) error {
+	fmt.Fprintln(os.Stdout, "| ID | Locale | Name | Custom App Name | State |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- |")
+	for _, item := range resp.Data {
+		fmt.Fprintf(os.Stdout, "| %s | %s | %s | %s | %s |\n",
+			escapeMarkdown(item.ID),
+			escapeMarkdown(item.Attributes.Locale),
+			escapeMarkdown(item.Attributes.Name),
+			escapeMarkdown(item.Attributes.CustomAppName),
+			escapeMarkdown(item.Attributes.State),
+		)
+	}
+	return nil
+}
+
+func printSubscriptionLocalizationDeleteResultTable(result *SubscriptionLocalizationDeleteResult) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tDeleted")
+	fmt.Fprintf(w, "%s\t%t\n", result.ID, result.Deleted)
+	return w.Flush()
+}
+
+func printSubscriptionLocalizationDeleteResultMarkdown(result *SubscriptionLocalizationDeleteResult) error {
+	fmt.Fprintln(os.Stdout, "| ID | Deleted |")
+	fmt.Fprintln(os.Stdout, "| --- | --- |")
+	fmt.Fprintf(os.Stdout, "| %s | %t |\n",
+		escapeMarkdown(result.ID),
+		result.Deleted,
+	)
+	return nil
+}
+
+func printSubscriptionImagesTable(resp *SubscriptionImagesResponse) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tFile Name\tFile Size\tState")
+	for _, item := range resp.Data {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%s\n",
+			item.ID,
+			item.Attributes.FileName,
+			item.Attributes.FileSize,
+			item.Attributes.State,
+		)
+	}
+	return w.Flush()
+}
+
+func printSubscriptionImagesMarkdown(resp *SubscriptionImagesResponse) error {
+	fmt.Fprintln(os.Stdout, "| ID | File Name | File Size | State |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- |")
+	for _, item := range resp.Data {
+		fmt.Fprintf(os.Stdout, "| %s | %s | %d | %s |\n",
+			escapeMarkdown(item.ID),
+			escapeMarkdown(item.Attributes.FileName),
+			item.Attributes.FileSize,
+			escapeMarkdown(item.Attributes.State),
+		)
+	}
+	return nil
+}
+
+func printSubscriptionReviewScreenshotTable(resp *SubscriptionReviewScreenshotResponse) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tFile Name\tFile Size\tDelivery State")
+	attrs := resp.Data.Attributes
+	fmt.Fprintf(w, "%s\t%s\t%d\t%s\n",
+		resp.Data.ID,
+		attrs.FileName,
+		attrs.FileSize,
+		assetDeliveryStateName(attrs.AssetDeliveryState),
+	)
+	return w.Flush()
+}
+
+func printSubscriptionReviewScreenshotMarkdown(resp *SubscriptionReviewScreenshotResponse) error {
+	fmt.Fprintln(os.Stdout, "| ID | File Name | File Size | Delivery State |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- |")
+	attrs := resp.Data.Attributes
+	fmt.Fprintf(os.Stdout, "| %s | %s | %d | %s |\n",
+		escapeMarkdown(resp.Data.ID),
+		escapeMarkdown(attrs.FileName),
+		attrs.FileSize,
+		escapeMarkdown(assetDeliveryStateName(attrs.AssetDeliveryState)),
+	)
+	return nil
+}
+
+func printSubscriptionAssetUploadResultTable(result *SubscriptionAssetUploadResult) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Subscription ID\tAsset ID\tFile Name\tFile Size\tState")
+	fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\n",
+		result.SubscriptionID,
+		result.AssetID,
+		result.FileName,
+		result.FileSize,
+		result.State,
+	)
+	return w.Flush()
+}
+
+func printSubscriptionAssetUploadResultMarkdown(result *SubscriptionAssetUploadResult) error {
+	fmt.Fprintln(os.Stdout, "| Subscription ID | Asset ID | File Name | File Size | State |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- |")
+	fmt.Fprintf(os.Stdout, "| %s | %s | %s | %d | %s |\n",
+		escapeMarkdown(result.SubscriptionID),
+		escapeMarkdown(result.AssetID),
+		escapeMarkdown(result.FileName),
+		result.FileSize,
+		escapeMarkdown(result.State),
+	)
+	return nil
+}
+
+func printSubscriptionAssetDeleteResultTable(result *SubscriptionAssetDeleteResult) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tDeleted")
+	fmt.Fprintf(w, "%s\t%t\n", result.ID, result.Deleted)
+	return w.Flush()
+}
+
+func printSubscriptionAssetDeleteResultMarkdown(result *SubscriptionAssetDeleteResult) error {
+	fmt.Fprintln(os.Stdout, "| ID | Deleted |")
+	fmt.Fprintln(os.Stdout, "| --- | --- |")
+	fmt.Fprintf(os.Stdout, "| %s | %t |\n",
+		escapeMarkdown(result.ID),
+		result.Deleted,
+	)
+	return nil
+}
+
+func printSubscriptionSubmissionTable(id string) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Submission ID")
+	fmt.Fprintf(w, "%s\n", id)
+	return w.Flush()
+}
+
+func printSubscriptionSubmissionMarkdown(id string) error {
+	fmt.Fprintln(os.Stdout, "| Submission ID |")
+	fmt.Fprintln(os.Stdout, "| --- |")
+	fmt.Fprintf(os.Stdout, "| %s |\n", escapeMarkdown(id))
+	return nil
+}
+
+func printSubscriptionGracePeriodTable(resp *SubscriptionGracePeriodResponse) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tOpt In\tSandbox Opt In\tDuration\tRenewal Type")
+	attrs := resp.Data.Attributes
+	fmt.Fprintf(w, "%s\t%t\t%t\t%s\t%s\n",
+		resp.Data.ID,
+		attrs.OptIn,
+		attrs.SandboxOptIn,
+		attrs.Duration,
+		attrs.RenewalType,
+	)
+	return w.Flush()
+}
+
+func printSubscriptionGracePeriodMarkdown(resp *SubscriptionGracePeriodResponse) error {
+	fmt.Fprintln(os.Stdout, "| ID | Opt In | Sandbox Opt In | Duration | Renewal Type |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- |")
+	attrs := resp.Data.Attributes
+	fmt.Fprintf(os.Stdout, "| %s | %t | %t | %s | %s |\n",
+		escapeMarkdown(resp.Data.ID),
+		attrs.OptIn,
+		attrs.SandboxOptIn,
+		escapeMarkdown(attrs.Duration),
+		escapeMarkdown(attrs.RenewalType),
+	)
+	return nil
+}
+
+func assetDeliveryStateName(state *AssetDeliveryState) string {
+	if state == nil {
+		return ""
+	}
+	return state.State
+}
@@ -17,9 +17,24 @@ import (
 
 // UploadOptions configure how upload operations are executed.
 type UploadOptions struct {
-	Concurrency int
-	Client      *http.Client
-	RetryOpts   RetryOptions
+	Concurrency    int
+	Client         *http.Client
+	RetryOpts      RetryOptions
+	SkipParts      map[int]bool
+	OnPartComplete func(index int)
+	OnProgress     func(UploadProgress)
+}
+
+// UploadProgress is a snapshot of upload progress. Parts skipped via
+// WithUploadSkipParts count as already sent.
+type UploadProgress struct {
+	BytesSent      int64
+	TotalBytes     int64
+	ResumedBytes   int64
+	PartsCompleted int
+	TotalParts     int
+	// PartIndex is the operation that just completed, or -1 for byte updates.
+	PartIndex int
 }
 
 // UploadOption configures upload options.
@@ -44,6 +59,98 @@ func WithUploadHTTPClient(client *http.Client) UploadOption {
 	}
 }
 
+// WithUploadSkipParts skips operations that were already uploaded, by index.
+func WithUploadSkipParts(indexes []int) UploadOption {
+	return func(opts *UploadOptions) {
+		if len(indexes) == 0 {
+			return
+		}
+		opts.SkipParts = make(map[int]bool, len(indexes))
+		for _, index := range indexes {
+			opts.SkipParts[index] = true
+		}
+	}
+}
+
+// WithUploadPartComplete registers a callback invoked after each operation succeeds.
+// Calls are serialized.
+func WithUploadPartComplete(fn func(index int)) UploadOption {
+	return func(opts *UploadOptions) {
+		opts.OnPartComplete = fn
+	}
+}
+
+// WithUploadProgress registers a callback for byte and part progress.
+// Calls are serialized.
+func WithUploadProgress(fn func(UploadProgress)) UploadOption {
+	return func(opts *UploadOptions) {
+		opts.OnProgress = fn
+	}
+}
+
+// uploadTracker aggregates progress across workers and serializes callbacks.
+// Part-complete callbacks run under their own lock so slow work there, such as
+// persisting resume state, does not stall byte progress from other workers.
+type uploadTracker struct {
+	mu             sync.Mutex
+	partMu         sync.Mutex
+	progress       UploadProgress
+	onPartComplete func(index int)
+	onProgress     func(UploadProgress)
+}
+
+func (t *uploadTracker) addBytes(n int64) {
+	if n == 0 {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.progress.BytesSent += n
+	if t.onProgress != nil {
+		snapshot := t.progress
+		snapshot.PartIndex = -1
+		t.onProgress(snapshot)
+	}
+}
+
+func (t *uploadTracker) completePart(index int) {
+	if t.onPartComplete != nil {
+		t.partMu.Lock()
+		t.onPartComplete(index)
+		t.partMu.Unlock()
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.progress.PartsCompleted++
+	if t.onProgress != nil {
+		snapshot := t.progress
+		snapshot.PartIndex = index
+		t.onProgress(snapshot)
+	}
+}
+
+// countingReader reports bytes read to the tracker and remembers how many
+// were read so a failed attempt can be rolled back before retrying.
+type countingReader struct {
+	reader  io.Reader
+	tracker *uploadTracker
+	read    int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	if n > 0 {
+		r.read += int64(n)
+		r.tracker.addBytes(int64(n))
+	}
+	return n, err
+}
+
+func (r *countingReader) rollback() {
+	r.tracker.addBytes(-r.read)
+	r.read = 0
+}
+
 // newUploadClient creates a dedicated HTTP client for upload operations
 // with appropriate timeouts and a cloned transport when possible to avoid
 // sharing the connection pool with http.DefaultClient.
@@ -54,7 +161,7 @@ func newUploadClient() *http.Client {
 	}
 	return &http.Client{
 		Timeout:   ResolveUploadTimeout(),
-		Transport: transport,
+		Transport: withCassetteTransport(transport),
 	}
 }
 
@@ -81,9 +188,6 @@ func ExecuteUploadOperations(ctx context.Context, filePath string, operations []
 	if uploadOpts.Client == nil {
 		uploadOpts.Client = newUploadClient()
 	}
-	if uploadOpts.Concurrency > len(operations) {
-		uploadOpts.Concurrency = len(operations)
-	}
 
 	file, err := os.Open(filePath)
 	if err != nil {
@@ -115,6 +219,29 @@ func ExecuteUploadOperations(ctx context.Context, filePath string, operations []
 		}
 	}
 
+	tracker := &uploadTracker{
+		progress:       UploadProgress{TotalParts: len(operations), PartIndex: -1},
+		onPartComplete: uploadOpts.OnPartComplete,
+		onProgress:     uploadOpts.OnProgress,
+	}
+	pending := make([]uploadTask, 0, len(operations))
+	for i, op := range operations {
+		tracker.progress.TotalBytes += op.Length
+		if uploadOpts.SkipParts[i] {
+			tracker.progress.BytesSent += op.Length
+			tracker.progress.ResumedBytes += op.Length
+			tracker.progress.PartsCompleted++
+			continue
+		}
+		pending = append(pending, uploadTask{index: i, op: op})
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+	if uploadOpts.Concurrency > len(pending) {
+		uploadOpts.Concurrency = len(pending)
+	}
+
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -136,10 +263,11 @@ func ExecuteUploadOperations(ctx context.Context, filePath string, operations []
 			if ctx.Err() != nil {
 				return
 			}
-			if err := executeUploadOperation(ctx, file, task, uploadOpts); err != nil {
+			if err := executeUploadOperation(ctx, file, task, uploadOpts, tracker); err != nil {
 				setErr(err)
 				return
 			}
+			tracker.completePart(task.index)
 		}
 	}
 
@@ -149,11 +277,11 @@ func ExecuteUploadOperations(ctx context.Context, filePath string, operations []
 	}
 
 sendLoop:
-	for i, op := range operations {
+	for _, task := range pending {
 		select {
 		case <-ctx.Done():
 			break sendLoop
-		case jobs <- uploadTask{index: i, op: op}:
+		case jobs <- task:
 		}
 	}
 	close(jobs)
@@ -162,14 +290,22 @@ sendLoop:
 	return firstErr
 }
 
-func executeUploadOperation(ctx context.Context, file *os.File, task uploadTask, uploadOpts UploadOptions) error {
+func executeUploadOperation(ctx context.Context, file *os.File, task uploadTask, uploadOpts UploadOptions, tracker *uploadTracker) error {
 	method := strings.ToUpper(strings.TrimSpace(task.op.Method))
 	if method == "" {
 		method = http.MethodPut
 	}
 
-	_, err := WithRetry(ctx, func() (struct{}, error) {
-		reader := io.NewSectionReader(file, task.op.Offset, task.op.Length)
+	_, err := WithRetry(ctx, func() (result struct{}, err error) {
+		reader := &countingReader{
+			reader:  io.NewSectionReader(file, task.op.Offset, task.op.Length),
+			tracker: tracker,
+		}
+		defer func() {
+			if err != nil {
+				reader.rollback()
+			}
+		}()
 		req, err := http.NewRequestWithContext(ctx, method, task.op.URL, reader)
 		if err != nil {
 			return struct{}{}, err
@@ -240,6 +240,144 @@ func (fn roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error)
 	return fn(req)
 }
 
+func TestExecuteUploadOperations_SkipsCompletedPartsAndReportsProgress(t *testing.T) {
+	dir := t.TempDir()
+	filePath := filepath.Join(dir, "app.ipa")
+	if err := os.WriteFile(filePath, []byte("abcdefghij"), 0o600); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	var mu sync.Mutex
+	received := map[string]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		received[r.URL.Path] = string(body)
+		mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	ops := []UploadOperation{
+		{Method: "PUT", URL: server.URL + "/op0", Length: 4, Offset: 0},
+		{Method: "PUT", URL: server.URL + "/op1", Length: 3, Offset: 4},
+		{Method: "PUT", URL: server.URL + "/op2", Length: 3, Offset: 7},
+	}
+
+	var completed []int
+	var last UploadProgress
+	err := ExecuteUploadOperations(context.Background(), filePath, ops,
+		WithUploadConcurrency(2),
+		WithUploadHTTPClient(server.Client()),
+		WithUploadSkipParts([]int{0}),
+		WithUploadPartComplete(func(index int) {
+			completed = append(completed, index)
+		}),
+		WithUploadProgress(func(progress UploadProgress) {
+			if progress.BytesSent < last.BytesSent {
+				t.Errorf("progress went backwards: %+v after %+v", progress, last)
+			}
+			last = progress
+		}),
+	)
+	if err != nil {
+		t.Fatalf("ExecuteUploadOperations() error: %v", err)
+	}
+
+	if _, ok := received["/op0"]; ok {
+		t.Fatalf("expected skipped part not to be uploaded")
+	}
+	if received["/op1"] != "efg" || received["/op2"] != "hij" {
+		t.Fatalf("unexpected uploads %v", received)
+	}
+	if len(completed) != 2 {
+		t.Fatalf("expected 2 completed parts, got %v", completed)
+	}
+	if last.BytesSent != 10 || last.TotalBytes != 10 || last.ResumedBytes != 4 || last.PartsCompleted != 3 || last.TotalParts != 3 {
+		t.Fatalf("unexpected final progress %+v", last)
+	}
+}
+
+func TestExecuteUploadOperations_RollsBackProgressOnRetry(t *testing.T) {
+	dir := t.TempDir()
+	filePath := filepath.Join(dir, "app.ipa")
+	if err := os.WriteFile(filePath, []byte("abcdef"), 0o600); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.ReadAll(r.Body)
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var maxSent int64
+	var last UploadProgress
+	err := ExecuteUploadOperations(context.Background(), filePath,
+		[]UploadOperation{{Method: "PUT", URL: server.URL + "/op0", Length: 6, Offset: 0}},
+		WithUploadHTTPClient(server.Client()),
+		func(opts *UploadOptions) {
+			opts.RetryOpts = RetryOptions{MaxRetries: 2, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+		},
+		WithUploadProgress(func(progress UploadProgress) {
+			if progress.BytesSent > maxSent {
+				maxSent = progress.BytesSent
+			}
+			last = progress
+		}),
+	)
+	if err != nil {
+		t.Fatalf("ExecuteUploadOperations() error: %v", err)
+	}
+	if atomic.LoadInt32(&attempts) != 2 {
+		t.Fatalf("expected a retry, got %d attempts", attempts)
+	}
+	if maxSent != 6 || last.BytesSent != 6 {
+		t.Fatalf("expected progress to be rolled back on retry, max %d last %+v", maxSent, last)
+	}
+}
+
+func TestUploadTracker_PartCompleteDoesNotBlockProgress(t *testing.T) {
+	entered := make(chan struct{})
+	release := make(chan struct{})
+	tracker := &uploadTracker{
+		onPartComplete: func(int) {
+			close(entered)
+			<-release
+		},
+		onProgress: func(UploadProgress) {},
+	}
+
+	completed := make(chan struct{})
+	go func() {
+		tracker.completePart(0)
+		close(completed)
+	}()
+	<-entered
+
+	added := make(chan struct{})
+	go func() {
+		tracker.addBytes(5)
+		close(added)
+	}()
+	select {
+	case <-added:
+	case <-time.After(2 * time.Second):
+		t.Fatal("addBytes blocked while a part-complete callback was running")
+	}
+
+	close(release)
+	<-completed
+	if tracker.progress.BytesSent != 5 || tracker.progress.PartsCompleted != 1 {
+		t.Fatalf("unexpected progress %+v", tracker.progress)
+	}
+}
+
 func TestComputeFileChecksum_MD5(t *testing.T) {
 	dir := t.TempDir()
 	filePath := filepath.Join(dir, "checksum.txt")
@@ -0,0 +1,117 @@
+package asc
+
+// Valid win-back offer priorities.
+var ValidWinBackOfferPriorities = []string{
+	"HIGH",
+	"NORMAL",
+}
+
+// Valid win-back offer promotion intents.
+var ValidWinBackOfferPromotionIntents = []string{
+	"NOT_PROMOTED",
+	"USE_AUTO_GENERATED_ASSETS",
+}
+
+// IntegerRange is an inclusive range of integers.
+type IntegerRange struct {
+	Minimum int `json:"minimum"`
+	Maximum int `json:"maximum"`
+}
+
+// WinBackOfferAttributes describes a subscription win-back offer.
+type WinBackOfferAttributes struct {
+	ReferenceName                                       string        `json:"referenceName,omitempty"`
+	OfferID                                             string        `json:"offerId,omitempty"`
+	Duration                                            string        `json:"duration,omitempty"`
+	OfferMode                                           string        `json:"offerMode,omitempty"`
+	PeriodCount                                         int           `json:"periodCount,omitempty"`
+	CustomerEligibilityPaidSubscriptionDurationInMonths int           `json:"customerEligibilityPaidSubscriptionDurationInMonths,omitempty"`
+	CustomerEligibilityTimeSinceLastSubscribedInMonths  *IntegerRange `json:"customerEligibilityTimeSinceLastSubscribedInMonths,omitempty"`
+	CustomerEligibilityWaitBetweenOffersInMonths        int           `json:"customerEligibilityWaitBetweenOffersInMonths,omitempty"`
+	StartDate                                           string        `json:"startDate,omitempty"`
+	EndDate                                             string        `json:"endDate,omitempty"`
+	Priority                                            string        `json:"priority,omitempty"`
+	PromotionIntent                                     string        `json:"promotionIntent,omitempty"`
+}
+
+// Response types
+type (
+	WinBackOffersResponse = Response[WinBackOfferAttributes]
+	WinBackOfferResponse  = SingleResponse[WinBackOfferAttributes]
+)
+
+// WinBackOfferRelationships describes relationships for win-back offer creation.
+type WinBackOfferRelationships struct {
+	Subscription Relationship     `json:"subscription"`
+	Prices       RelationshipList `json:"prices"`
+}
+
+// WinBackOfferCreateData is the data portion of a win-back offer create request.
+type WinBackOfferCreateData struct {
+	Type          ResourceType              `json:"type"`
+	Attributes    WinBackOfferAttributes    `json:"attributes"`
+	Relationships WinBackOfferRelationships `json:"relationships"`
+}
+
+// WinBackOfferCreateRequest is a request to create a win-back offer.
+type WinBackOfferCreateRequest struct {
+	Data     WinBackOfferCreateData      `json:"data"`
+	Included []WinBackOfferPriceResource `json:"included"`
+}
+
+// WinBackOfferPriceResource is an inline win-back offer price.
+type WinBackOfferPriceResource struct {
+	Type          ResourceType                   `json:"type"`
+	ID            string                         `json:"id"`
+	Relationships WinBackOfferPriceRelationships `json:"relationships"`
+}
+
+// WinBackOfferPriceRelationships describes relationships for win-back offer prices.
+// The price point is omitted for free trials.
+type WinBackOfferPriceRelationships struct {
+	Territory              Relationship  `json:"territory"`
+	SubscriptionPricePoint *Relationship `json:"subscriptionPricePoint,omitempty"`
+}
+
+// WinBackOfferUpdateAttributes describes the editable attributes of a win-back offer.
+type WinBackOfferUpdateAttributes struct {
+	CustomerEligibilityPaidSubscriptionDurationInMonths *int          `json:"customerEligibilityPaidSubscriptionDurationInMonths,omitempty"`
+	CustomerEligibilityTimeSinceLastSubscribedInMonths  *IntegerRange `json:"customerEligibilityTimeSinceLastSubscribedInMonths,omitempty"`
+	CustomerEligibilityWaitBetweenOffersInMonths        *int          `json:"customerEligibilityWaitBetweenOffersInMonths,omitempty"`
+	StartDate                                           *string       `json:"startDate,omitempty"`
+	EndDate                                             *string       `json:"endDate,omitempty"`
+	Priority                                            *string       `json:"priority,omitempty"`
+	PromotionIntent                                     *string       `json:"promotionIntent,omitempty"`
+}
+
+// WinBackOfferUpdateData is the data portion of a win-back offer update request.
+type WinBackOfferUpdateData struct {
+	Type       ResourceType                 `json:"type"`
+	ID         string                       `json:"id"`
+	Attributes WinBackOfferUpdateAttributes `json:"attributes"`
+}
+
+// WinBackOfferUpdateRequest is a request to update a win-back offer.
+type WinBackOfferUpdateRequest struct {
+	Data WinBackOfferUpdateData `json:"data"`
+}
+
+// IsValidWinBackOfferPriority checks if a win-back offer priority is supported.
+func IsValidWinBackOfferPriority(value string) bool {
+	for _, item := range ValidWinBackOfferPriorities {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValidWinBackOfferPromotionIntent checks if a promotion intent is supported.
+func IsValidWinBackOfferPromotionIntent(value string) bool {
+	for _, item := range ValidWinBackOfferPromotionIntents {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,88 @@
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestCreateWinBackOffer_SendsEligibilityAndPrices(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) {
+		assertAuthorized(t, req)
+		if req.Method != http.MethodPost || req.URL.Path != "/v1/winBackOffers" {
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+		var payload map[string]any
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		data := payload["data"].(map[string]any)
+		attrs := data["attributes"].(map[string]any)
+		if attrs["customerEligibilityPaidSubscriptionDurationInMonths"] != float64(6) {
+			t.Fatalf("unexpected paid duration %v", attrs["customerEligibilityPaidSubscriptionDurationInMonths"])
+		}
+		lastSubscribed := attrs["customerEligibilityTimeSinceLastSubscribedInMonths"].(map[string]any)
+		if lastSubscribed["minimum"] != float64(3) || lastSubscribed["maximum"] != float64(12) {
+			t.Fatalf("unexpected last subscribed range %v", lastSubscribed)
+		}
+		if attrs["priority"] != "HIGH" {
+			t.Fatalf("expected priority HIGH, got %v", attrs["priority"])
+		}
+		rels := data["relationships"].(map[string]any)
+		prices := rels["prices"].(map[string]any)["data"].([]any)
+		if len(prices) != 2 {
+			t.Fatalf("expected 2 price refs, got %d", len(prices))
+		}
+		included := payload["included"].([]any)
+		first := included[0].(map[string]any)
+		if first["type"] != "winBackOfferPrices" || first["id"] != "${local-price-1}" {
+			t.Fatalf("unexpected included price %v", first)
+		}
+		pricePoint := first["relationships"].(map[string]any)["subscriptionPricePoint"].(map[string]any)["data"].(map[string]any)
+		if pricePoint["id"] != "pp-usa" {
+			t.Fatalf("expected price point pp-usa, got %v", pricePoint["id"])
+		}
+	}, jsonResponse(http.StatusCreated, `{"data":{"type":"winBackOffers","id":"wb-1"}}`))
+
+	attrs := WinBackOfferAttributes{
+		ReferenceName: "Come back",
+		OfferID:       "WINBACK_1",
+		Duration:      "ONE_MONTH",
+		OfferMode:     "PAY_AS_YOU_GO",
+		PeriodCount:   3,
+		CustomerEligibilityPaidSubscriptionDurationInMonths: 6,
+		CustomerEligibilityTimeSinceLastSubscribedInMonths:  &IntegerRange{Minimum: 3, Maximum: 12},
+		StartDate: "2026-04-01",
+		Priority:  "HIGH",
+	}
+	prices := []SubscriptionOfferPrice{
+		{Territory: "usa", PricePointID: "pp-usa"},
+		{Territory: "GBR", PricePointID: "pp-gbr"},
+	}
+	if _, err := client.CreateWinBackOffer(context.Background(), "sub-1", attrs, prices); err != nil {
+		t.Fatalf("CreateWinBackOffer() error: %v", err)
+	}
+}
+
+func TestUpdateWinBackOffer_SendsOnlySetFields(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) {
+		assertAuthorized(t, req)
+		if req.Method != http.MethodPatch || req.URL.Path != "/v1/winBackOffers/wb-1" {
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+		var payload map[string]any
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		attrs := payload["data"].(map[string]any)["attributes"].(map[string]any)
+		if len(attrs) != 1 || attrs["priority"] != "NORMAL" {
+			t.Fatalf("expected only priority, got %v", attrs)
+		}
+	}, jsonResponse(http.StatusOK, `{"data":{"type":"winBackOffers","id":"wb-1"}}`))
+
+	priority := "NORMAL"
+	if _, err := client.UpdateWinBackOffer(context.Background(), "wb-1", WinBackOfferUpdateAttributes{Priority: &priority}); err != nil {
+		t.Fatalf("UpdateWinBackOffer() error: %v", err)
+	}
+}
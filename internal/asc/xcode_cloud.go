@@ -797,6 +797,72 @@ func (c *Client) DeleteCiWorkflow(ctx context.Context, workflowID string) error
 	return err
 }
 
+// CiWorkflowDefinition is a workflow with its raw attributes and the resources
+// it references. Attributes are kept untyped so fields that CiWorkflowAttributes
+// does not model, such as actions, survive an export and import round trip.
+type CiWorkflowDefinition struct {
+	ID           string
+	Attributes   map[string]interface{}
+	Repository   *ScmRepositoryResource
+	XcodeVersion *CiXcodeVersionResource
+	MacOsVersion *CiMacOsVersionResource
+}
+
+// GetCiWorkflowDefinition retrieves a CI workflow with its repository, Xcode
+// version and macOS version included.
+func (c *Client) GetCiWorkflowDefinition(ctx context.Context, workflowID string) (*CiWorkflowDefinition, error) {
+	workflowID = strings.TrimSpace(workflowID)
+	path := fmt.Sprintf("/v1/ciWorkflows/%s?include=repository,xcodeVersion,macOsVersion", workflowID)
+	data, err := c.do(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Data struct {
+			ID         string                 `json:"id"`
+			Attributes map[string]interface{} `json:"attributes"`
+		} `json:"data"`
+		Included []json.RawMessage `json:"included"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	definition := &CiWorkflowDefinition{
+		ID:         response.Data.ID,
+		Attributes: response.Data.Attributes,
+	}
+	for _, raw := range response.Included {
+		var resource ResourceData
+		if err := json.Unmarshal(raw, &resource); err != nil {
+			return nil, fmt.Errorf("failed to parse included resource: %w", err)
+		}
+		switch resource.Type {
+		case ResourceTypeScmRepositories:
+			var repository ScmRepositoryResource
+			if err := json.Unmarshal(raw, &repository); err != nil {
+				return nil, fmt.Errorf("failed to parse included repository: %w", err)
+			}
+			definition.Repository = &repository
+		case ResourceTypeCiXcodeVersions:
+			var xcodeVersion CiXcodeVersionResource
+			if err := json.Unmarshal(raw, &xcodeVersion); err != nil {
+				return nil, fmt.Errorf("failed to parse included Xcode version: %w", err)
+			}
+			definition.XcodeVersion = &xcodeVersion
+		case ResourceTypeCiMacOsVersions:
+			var macOsVersion CiMacOsVersionResource
+			if err := json.Unmarshal(raw, &macOsVersion); err != nil {
+				return nil, fmt.Errorf("failed to parse included macOS version: %w", err)
+			}
+			definition.MacOsVersion = &macOsVersion
+		}
+	}
+
+	return definition, nil
+}
+
 // GetCiWorkflowRepository retrieves the repository for a CI workflow.
 func (c *Client) GetCiWorkflowRepository(ctx context.Context, workflowID string) (*ScmRepositoryResource, error) {
 	path := fmt.Sprintf("/v1/ciWorkflows/%s/repository", workflowID)
@@ -1284,6 +1350,57 @@ type XcodeCloudStatusResult struct {
 	FinishedDate      string         `json:"finishedDate,omitempty"`
 	SourceCommit      *CiGitRefInfo  `json:"sourceCommit,omitempty"`
 	IssueCounts       *CiIssueCounts `json:"issueCounts,omitempty"`
+	// Actions and JUnitPath are populated when waiting for a run to finish.
+	Actions   []XcodeCloudActionSummary `json:"actions,omitempty"`
+	JUnitPath string                    `json:"junitPath,omitempty"`
+}
+
+// XcodeCloudActionSummary summarizes the issues and test results of a finished build action.
+type XcodeCloudActionSummary struct {
+	ID               string                   `json:"id"`
+	Name             string                   `json:"name,omitempty"`
+	ActionType       string                   `json:"actionType,omitempty"`
+	CompletionStatus string                   `json:"completionStatus,omitempty"`
+	IssueCounts      *CiIssueCounts           `json:"issueCounts,omitempty"`
+	Issues           []XcodeCloudIssueSummary `json:"issues,omitempty"`
+	Tests            *XcodeCloudTestSummary   `json:"tests,omitempty"`
+	LogsPath         string                   `json:"logsPath,omitempty"`
+}
+
+// XcodeCloudIssueSummary is a single CI issue reported by a build action.
+type XcodeCloudIssueSummary struct {
+	IssueType string `json:"issueType"`
+	Message   string `json:"message,omitempty"`
+	File      string `json:"file,omitempty"`
+	Category  string `json:"category,omitempty"`
+}
+
+// XcodeCloudTestSummary counts the test results of a build action.
+type XcodeCloudTestSummary struct {
+	Total    int                     `json:"total"`
+	Passed   int                     `json:"passed"`
+	Failed   int                     `json:"failed"`
+	Skipped  int                     `json:"skipped"`
+	Failures []XcodeCloudTestFailure `json:"failures,omitempty"`
+}
+
+// XcodeCloudTestFailure describes a failed test.
+type XcodeCloudTestFailure struct {
+	ClassName string `json:"className,omitempty"`
+	Name      string `json:"name"`
+	Message   string `json:"message,omitempty"`
+	File      string `json:"file,omitempty"`
+}
+
+// FormatFileLocation renders a file location as path:line.
+func FormatFileLocation(location *FileLocation) string {
+	if location == nil || location.Path == "" {
+		return ""
+	}
+	if location.LineNumber > 0 {
+		return fmt.Sprintf("%s:%d", location.Path, location.LineNumber)
+	}
+	return location.Path
 }
 
 // IsBuildRunComplete returns true if the build run has finished.
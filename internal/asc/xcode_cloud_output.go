@@ -3,6 +3,7 @@ package asc
 import (
 	"fmt"
 	"os"
+	"strings"
 	"text/tabwriter"
 )
 
@@ -16,6 +17,28 @@ type CiArtifactDownloadResult struct {
 	BytesWritten int64  `json:"bytesWritten,omitempty"`
 }
 
+// CiTestResultsExportResult represents CLI output for JUnit test result exports.
+type CiTestResultsExportResult struct {
+	BuildRunID string   `json:"buildRunId,omitempty"`
+	ActionIDs  []string `json:"actionIds"`
+	OutputPath string   `json:"outputPath"`
+	Tests      int      `json:"tests"`
+	Failures   int      `json:"failures"`
+	Skipped    int      `json:"skipped"`
+}
+
+// CiWorkflowImportResult represents CLI output for workflow imports.
+type CiWorkflowImportResult struct {
+	WorkflowID     string `json:"workflowId,omitempty"`
+	Name           string `json:"name"`
+	ProductID      string `json:"productId"`
+	Action         string `json:"action"`
+	RepositoryID   string `json:"repositoryId,omitempty"`
+	XcodeVersionID string `json:"xcodeVersionId"`
+	MacOsVersionID string `json:"macOsVersionId"`
+	DryRun         bool   `json:"dryRun"`
+}
+
 // CiWorkflowDeleteResult represents CLI output for workflow deletions.
 type CiWorkflowDeleteResult struct {
 	ID      string `json:"id"`
@@ -79,7 +102,48 @@ func printXcodeCloudStatusResultTable(result *XcodeCloudStatusResult) error {
 		result.StartedDate,
 		result.FinishedDate,
 	)
-	return w.Flush()
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if len(result.Actions) == 0 {
+		return nil
+	}
+
+	fmt.Fprintln(os.Stdout)
+	w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Action\tType\tStatus\tErrors\tWarnings\tTests\tLogs")
+	for _, action := range result.Actions {
+		errors, warnings := xcodeCloudIssueTotals(action.IssueCounts)
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%d\t%s\t%s\n",
+			action.Name,
+			action.ActionType,
+			action.CompletionStatus,
+			errors,
+			warnings,
+			xcodeCloudTestSummaryText(action.Tests),
+			action.LogsPath,
+		)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	rows := xcodeCloudProblemRows(result.Actions)
+	if len(rows) > 0 {
+		fmt.Fprintln(os.Stdout)
+		w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "Action\tType\tLocation\tMessage")
+		for _, row := range rows {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", row[0], row[1], row[2], compactWhitespace(row[3]))
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+	if result.JUnitPath != "" {
+		fmt.Fprintf(os.Stdout, "\nJUnit report: %s\n", result.JUnitPath)
+	}
+	return nil
 }
 
 func printXcodeCloudStatusResultMarkdown(result *XcodeCloudStatusResult) error {
@@ -97,6 +161,117 @@ func printXcodeCloudStatusResultMarkdown(result *XcodeCloudStatusResult) error {
 		escapeMarkdown(result.StartedDate),
 		escapeMarkdown(result.FinishedDate),
 	)
+	if len(result.Actions) == 0 {
+		return nil
+	}
+
+	fmt.Fprintln(os.Stdout)
+	fmt.Fprintln(os.Stdout, "| Action | Type | Status | Errors | Warnings | Tests | Logs |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- | --- | --- |")
+	for _, action := range result.Actions {
+		errors, warnings := xcodeCloudIssueTotals(action.IssueCounts)
+		fmt.Fprintf(os.Stdout, "| %s | %s | %s | %d | %d | %s | %s |\n",
+			escapeMarkdown(action.Name),
+			escapeMarkdown(action.ActionType),
+			escapeMarkdown(action.CompletionStatus),
+			errors,
+			warnings,
+			escapeMarkdown(xcodeCloudTestSummaryText(action.Tests)),
+			escapeMarkdown(action.LogsPath),
+		)
+	}
+
+	rows := xcodeCloudProblemRows(result.Actions)
+	if len(rows) > 0 {
+		fmt.Fprintln(os.Stdout)
+		fmt.Fprintln(os.Stdout, "| Action | Type | Location | Message |")
+		fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- |")
+		for _, row := range rows {
+			fmt.Fprintf(os.Stdout, "| %s | %s | %s | %s |\n",
+				escapeMarkdown(row[0]),
+				escapeMarkdown(row[1]),
+				escapeMarkdown(row[2]),
+				escapeMarkdown(row[3]),
+			)
+		}
+	}
+	if result.JUnitPath != "" {
+		fmt.Fprintf(os.Stdout, "\nJUnit report: %s\n", escapeMarkdown(result.JUnitPath))
+	}
+	return nil
+}
+
+func xcodeCloudIssueTotals(counts *CiIssueCounts) (int, int) {
+	if counts == nil {
+		return 0, 0
+	}
+	return counts.Errors, counts.Warnings + counts.AnalyzerWarnings
+}
+
+func xcodeCloudTestSummaryText(tests *XcodeCloudTestSummary) string {
+	if tests == nil {
+		return ""
+	}
+	return fmt.Sprintf("%d passed, %d failed, %d skipped", tests.Passed, tests.Failed, tests.Skipped)
+}
+
+// xcodeCloudProblemRows lists errors and test failures; warnings are only counted.
+func xcodeCloudProblemRows(actions []XcodeCloudActionSummary) [][4]string {
+	var rows [][4]string
+	for _, action := range actions {
+		for _, issue := range action.Issues {
+			if strings.Contains(issue.IssueType, "WARNING") {
+				continue
+			}
+			rows = append(rows, [4]string{action.Name, issue.IssueType, issue.File, issue.Message})
+		}
+		if action.Tests == nil {
+			continue
+		}
+		for _, failure := range action.Tests.Failures {
+			name := failure.Name
+			if failure.ClassName != "" {
+				name = failure.ClassName + "." + failure.Name
+			}
+			location := failure.File
+			if location == "" {
+				location = name
+			}
+			message := failure.Message
+			if message == "" {
+				message = name
+			}
+			rows = append(rows, [4]string{action.Name, "TEST_FAILURE", location, message})
+		}
+	}
+	return rows
+}
+
+func printCiTestResultsExportResultTable(result *CiTestResultsExportResult) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Build Run ID\tActions\tOutput Path\tTests\tFailures\tSkipped")
+	fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%d\t%d\n",
+		result.BuildRunID,
+		strings.Join(result.ActionIDs, ","),
+		result.OutputPath,
+		result.Tests,
+		result.Failures,
+		result.Skipped,
+	)
+	return w.Flush()
+}
+
+func printCiTestResultsExportResultMarkdown(result *CiTestResultsExportResult) error {
+	fmt.Fprintln(os.Stdout, "| Build Run ID | Actions | Output Path | Tests | Failures | Skipped |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- | --- |")
+	fmt.Fprintf(os.Stdout, "| %s | %s | %s | %d | %d | %d |\n",
+		escapeMarkdown(result.BuildRunID),
+		escapeMarkdown(strings.Join(result.ActionIDs, ",")),
+		escapeMarkdown(result.OutputPath),
+		result.Tests,
+		result.Failures,
+		result.Skipped,
+	)
 	return nil
 }
 
@@ -470,6 +645,38 @@ func printCiArtifactDownloadResultMarkdown(result *CiArtifactDownloadResult) err
 	return nil
 }
 
+func printCiWorkflowImportResultTable(result *CiWorkflowImportResult) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Workflow ID\tName\tProduct ID\tAction\tRepository ID\tXcode Version ID\tmacOS Version ID\tDry Run")
+	fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%t\n",
+		result.WorkflowID,
+		compactWhitespace(result.Name),
+		result.ProductID,
+		result.Action,
+		result.RepositoryID,
+		result.XcodeVersionID,
+		result.MacOsVersionID,
+		result.DryRun,
+	)
+	return w.Flush()
+}
+
+func printCiWorkflowImportResultMarkdown(result *CiWorkflowImportResult) error {
+	fmt.Fprintln(os.Stdout, "| Workflow ID | Name | Product ID | Action | Repository ID | Xcode Version ID | macOS Version ID | Dry Run |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- | --- | --- | --- |")
+	fmt.Fprintf(os.Stdout, "| %s | %s | %s | %s | %s | %s | %s | %t |\n",
+		escapeMarkdown(result.WorkflowID),
+		escapeMarkdown(result.Name),
+		escapeMarkdown(result.ProductID),
+		escapeMarkdown(result.Action),
+		escapeMarkdown(result.RepositoryID),
+		escapeMarkdown(result.XcodeVersionID),
+		escapeMarkdown(result.MacOsVersionID),
+		result.DryRun,
+	)
+	return nil
+}
+
 func printCiWorkflowDeleteResultTable(result *CiWorkflowDeleteResult) error {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 	fmt.Fprintln(w, "ID\tDeleted")
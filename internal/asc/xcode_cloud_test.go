@@ -694,6 +694,36 @@ func TestPrintMarkdown_CiXcodeVersions(t *testing.T) {
 	}
 }
 
+func TestPrintTable_CiWorkflowImportResult(t *testing.T) {
+	result := &CiWorkflowImportResult{WorkflowID: "wf-1", Name: "CI", ProductID: "prod-1", Action: "update", XcodeVersionID: "xcode-16", MacOsVersionID: "macos-15"}
+
+	output := captureXcodeCloudStdout(t, func() error {
+		return PrintTable(result)
+	})
+
+	if !strings.Contains(output, "Xcode Version ID") {
+		t.Fatalf("expected header in output, got: %s", output)
+	}
+	if !strings.Contains(output, "wf-1") || !strings.Contains(output, "update") {
+		t.Fatalf("expected workflow and action in output, got: %s", output)
+	}
+}
+
+func TestPrintMarkdown_CiWorkflowImportResult(t *testing.T) {
+	result := &CiWorkflowImportResult{Name: "CI", ProductID: "prod-1", Action: "create", RepositoryID: "repo-1", DryRun: true}
+
+	output := captureXcodeCloudStdout(t, func() error {
+		return PrintMarkdown(result)
+	})
+
+	if !strings.Contains(output, "| Workflow ID | Name | Product ID | Action |") {
+		t.Fatalf("expected markdown header in output, got: %s", output)
+	}
+	if !strings.Contains(output, "repo-1") || !strings.Contains(output, "| true |") {
+		t.Fatalf("expected repository and dry run in output, got: %s", output)
+	}
+}
+
 func TestPrintTable_CiWorkflowDeleteResult(t *testing.T) {
 	result := &CiWorkflowDeleteResult{ID: "wf-1", Deleted: true}
 
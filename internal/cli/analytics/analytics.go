@@ -22,7 +22,8 @@ Examples:
   asc analytics request --app "APP_ID" --access-type ONGOING
   asc analytics requests --app "APP_ID"
   asc analytics get --request-id "REQUEST_ID"
-  asc analytics download --request-id "REQUEST_ID" --instance-id "INSTANCE_ID"`,
+  asc analytics download --request-id "REQUEST_ID" --instance-id "INSTANCE_ID"
+  asc analytics fetch --app "APP_ID" --category APP_USAGE --from 2025-01-01 --to 2025-01-31 --dir ./out`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
@@ -31,6 +32,7 @@ Examples:
 			AnalyticsRequestsCommand(),
 			AnalyticsGetCommand(),
 			AnalyticsDownloadCommand(),
+			AnalyticsFetchCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
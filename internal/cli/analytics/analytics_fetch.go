@@ -0,0 +1,390 @@
+package analytics
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// analyticsFetchGroup is one output file: a report's instances at one granularity.
+type analyticsFetchGroup struct {
+	report      asc.Resource[asc.AnalyticsReportAttributes]
+	granularity string
+	instances   []asc.Resource[asc.AnalyticsReportInstanceAttributes]
+}
+
+// AnalyticsFetchCommand downloads analytics reports for a date range in one step.
+func AnalyticsFetchCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("fetch", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	category := fs.String("category", "", "Report category: APP_STORE_ENGAGEMENT, APP_STORE_COMMERCE, APP_USAGE, FRAMEWORK_USAGE, PERFORMANCE")
+	report := fs.String("report", "", "Report name(s), comma-separated (default: every report in the category)")
+	granularity := fs.String("granularity", "", "Only fetch instances of this granularity: DAILY, WEEKLY, MONTHLY")
+	from := fs.String("from", "", "First report date (YYYY-MM-DD)")
+	to := fs.String("to", "", "Last report date, inclusive (YYYY-MM-DD)")
+	dir := fs.String("dir", "", "Directory to write merged CSVs and manifest.json into")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "fetch",
+		ShortUsage: "asc analytics fetch --app APP --from DATE --to DATE --dir DIR [flags]",
+		ShortHelp:  "Download and merge analytics reports for a date range.",
+		LongHelp: `Download and merge analytics reports for a date range.
+
+Uses the app's ONGOING analytics report request, creating one if none exists,
+then walks its reports, instances and segments. Every segment of each report
+instance dated between --from and --to is downloaded, decompressed and merged
+into one CSV per report per granularity in --dir, named after the report,
+granularity and date range (e.g. app_sessions_daily_2025-01-01_2025-01-31.csv),
+alongside a manifest.json listing the instances and segments each file was
+built from. A report that fails to download is recorded under "failures" in
+the manifest and the remaining reports are still fetched.
+
+A newly created request has no data yet; Apple usually generates the first
+reports within a day or two, so re-run the command later.
+
+Examples:
+  asc analytics fetch --app "123456789" --category APP_USAGE --report "App Sessions" --from 2025-01-01 --to 2025-01-31 --dir ./out
+  asc analytics fetch --app "123456789" --category APP_STORE_COMMERCE --granularity DAILY --from 2025-01-01 --to 2025-01-07 --dir ./commerce
+  asc analytics fetch --app "123456789" --report "App Crashes,App Sessions" --from 2025-01-01 --to 2025-03-31 --dir ./out --output table`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID := resolveAppID(*appID)
+			if resolvedAppID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
+				return flag.ErrHelp
+			}
+			if strings.TrimSpace(*from) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --from is required")
+				return flag.ErrHelp
+			}
+			if strings.TrimSpace(*to) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --to is required")
+				return flag.ErrHelp
+			}
+			if strings.TrimSpace(*dir) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --dir is required")
+				return flag.ErrHelp
+			}
+
+			normalizedCategory, err := normalizeAnalyticsCategory(*category)
+			if err != nil {
+				return fmt.Errorf("analytics fetch: %w", err)
+			}
+			normalizedGranularity, err := normalizeAnalyticsGranularity(*granularity)
+			if err != nil {
+				return fmt.Errorf("analytics fetch: %w", err)
+			}
+			fromDate, err := time.Parse("2006-01-02", strings.TrimSpace(*from))
+			if err != nil {
+				return fmt.Errorf("analytics fetch: --from must be in YYYY-MM-DD format")
+			}
+			toDate, err := time.Parse("2006-01-02", strings.TrimSpace(*to))
+			if err != nil {
+				return fmt.Errorf("analytics fetch: --to must be in YYYY-MM-DD format")
+			}
+			if fromDate.After(toDate) {
+				return fmt.Errorf("analytics fetch: --from must not be after --to")
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("analytics fetch: %w", err)
+			}
+
+			result := &asc.AnalyticsFetchResult{
+				AppID:    resolvedAppID,
+				Category: normalizedCategory,
+				From:     fromDate.Format("2006-01-02"),
+				To:       toDate.Format("2006-01-02"),
+				Dir:      *dir,
+				Manifest: filepath.Join(*dir, shared.ReportManifestName),
+				Files:    []asc.AnalyticsFetchFile{},
+			}
+
+			groups, err := func() ([]analyticsFetchGroup, error) {
+				requestID, created, err := resolveOngoingAnalyticsRequest(ctx, client, resolvedAppID)
+				if err != nil {
+					return nil, err
+				}
+				result.RequestID = requestID
+				result.RequestCreated = created
+				if created {
+					fmt.Fprintf(os.Stderr, "Created ONGOING analytics report request %s; reports usually become available within 48 hours.\n", requestID)
+				}
+				return collectAnalyticsFetchGroups(ctx, client, requestID, normalizedCategory, shared.SplitCSV(*report), normalizedGranularity, result.From, result.To, created)
+			}()
+			if err != nil {
+				return fmt.Errorf("analytics fetch: %w", err)
+			}
+
+			if err := os.MkdirAll(*dir, 0o755); err != nil {
+				return fmt.Errorf("analytics fetch: %w", err)
+			}
+			for _, group := range groups {
+				fileName := analyticsFetchFileName(group.report.Attributes.Name, group.granularity, result.From, result.To)
+				file, err := fetchAnalyticsGroup(ctx, client, group, *dir, fileName)
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "%s (%s): failed: %v\n", group.report.Attributes.Name, group.granularity, err)
+					result.Failures = append(result.Failures, asc.AnalyticsFetchFailure{
+						ReportID:    group.report.ID,
+						Name:        group.report.Attributes.Name,
+						Granularity: group.granularity,
+						File:        fileName,
+						Error:       err.Error(),
+					})
+					continue
+				}
+				if file == nil {
+					continue
+				}
+				fmt.Fprintf(os.Stderr, "%s (%s): %d instances, %d rows -> %s\n", file.Name, file.Granularity, len(file.Instances), file.Rows, file.File)
+				result.Files = append(result.Files, *file)
+			}
+			if len(result.Files) == 0 && len(result.Failures) == 0 && !result.RequestCreated {
+				fmt.Fprintf(os.Stderr, "No report instances found between %s and %s.\n", result.From, result.To)
+			}
+
+			result.GeneratedAt = time.Now().UTC().Format(time.RFC3339)
+			manifest, err := json.MarshalIndent(result, "", "  ")
+			if err != nil {
+				return fmt.Errorf("analytics fetch: %w", err)
+			}
+			if err := shared.WriteFileAtomic(result.Manifest, append(manifest, '\n'), 0o644); err != nil {
+				return fmt.Errorf("analytics fetch: failed to write manifest: %w", err)
+			}
+
+			if err := printOutput(result, *output, *pretty); err != nil {
+				return err
+			}
+			if len(result.Failures) > 0 {
+				return shared.NewReportedError(fmt.Errorf("analytics fetch: %d of %d reports failed", len(result.Failures), len(result.Failures)+len(result.Files)))
+			}
+			return nil
+		},
+	}
+}
+
+// resolveOngoingAnalyticsRequest returns the app's active ONGOING request,
+// creating one when the app has none. Each request gets its own timeout.
+func resolveOngoingAnalyticsRequest(ctx context.Context, client *asc.Client, appID string) (string, bool, error) {
+	next := ""
+	seen := make(map[string]bool)
+	for {
+		var resp *asc.AnalyticsReportRequestsResponse
+		var err error
+		if next != "" {
+			if seen[next] {
+				return "", false, fmt.Errorf("detected repeated request pagination URL")
+			}
+			seen[next] = true
+		}
+		pageCtx, cancel := contextWithTimeout(ctx)
+		if next != "" {
+			resp, err = client.GetAnalyticsReportRequests(pageCtx, appID, asc.WithAnalyticsReportRequestsNextURL(next))
+		} else {
+			resp, err = client.GetAnalyticsReportRequests(pageCtx, appID, asc.WithAnalyticsReportRequestsLimit(analyticsMaxLimit))
+		}
+		cancel()
+		if err != nil {
+			return "", false, fmt.Errorf("failed to list requests: %w", err)
+		}
+		for _, request := range resp.Data {
+			stopped := request.Attributes.StoppedDueToInactivity != nil && *request.Attributes.StoppedDueToInactivity
+			if request.Attributes.AccessType == asc.AnalyticsAccessTypeOngoing && !stopped {
+				return request.ID, false, nil
+			}
+		}
+		if resp.Links.Next == "" {
+			break
+		}
+		next = resp.Links.Next
+	}
+
+	createCtx, cancel := contextWithTimeout(ctx)
+	defer cancel()
+
+	created, err := client.CreateAnalyticsReportRequest(createCtx, appID, asc.AnalyticsAccessTypeOngoing)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to create request: %w", err)
+	}
+	return created.Data.ID, true, nil
+}
+
+// collectAnalyticsFetchGroups lists the request's reports matching the
+// filters and groups their in-range instances by granularity. Unknown report
+// names are an error unless the request was just created and has no reports yet.
+func collectAnalyticsFetchGroups(ctx context.Context, client *asc.Client, requestID, category string, names []string, granularity, from, to string, created bool) ([]analyticsFetchGroup, error) {
+	var filters []asc.AnalyticsReportsOption
+	if category != "" {
+		filters = append(filters, asc.WithAnalyticsReportsCategory(category))
+	}
+	reports, _, err := fetchAnalyticsReports(ctx, client, requestID, 0, "", true, filters...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch reports: %w", err)
+	}
+
+	if len(names) > 0 {
+		byName := make(map[string]asc.Resource[asc.AnalyticsReportAttributes], len(reports))
+		available := make([]string, 0, len(reports))
+		for _, report := range reports {
+			byName[strings.ToLower(report.Attributes.Name)] = report
+			available = append(available, report.Attributes.Name)
+		}
+		selected := make([]asc.Resource[asc.AnalyticsReportAttributes], 0, len(names))
+		for _, name := range names {
+			report, ok := byName[strings.ToLower(name)]
+			if !ok {
+				if created && len(reports) == 0 {
+					continue
+				}
+				sort.Strings(available)
+				return nil, fmt.Errorf("report %q not found (available: %s)", name, strings.Join(available, ", "))
+			}
+			selected = append(selected, report)
+		}
+		reports = selected
+	}
+
+	var groups []analyticsFetchGroup
+	for _, report := range reports {
+		instances, err := fetchAnalyticsReportInstances(ctx, client, report.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch instances: %w", err)
+		}
+		byGranularity := make(map[string]*analyticsFetchGroup)
+		var order []string
+		for _, instance := range instances {
+			instanceGranularity := strings.ToUpper(instance.Attributes.Granularity)
+			if granularity != "" && instanceGranularity != granularity {
+				continue
+			}
+			if !analyticsDateInRange(instance.Attributes.ReportDate, from, to) {
+				continue
+			}
+			group, ok := byGranularity[instanceGranularity]
+			if !ok {
+				group = &analyticsFetchGroup{report: report, granularity: instanceGranularity}
+				byGranularity[instanceGranularity] = group
+				order = append(order, instanceGranularity)
+			}
+			group.instances = append(group.instances, instance)
+		}
+		sort.Strings(order)
+		for _, key := range order {
+			group := byGranularity[key]
+			sort.SliceStable(group.instances, func(i, j int) bool {
+				return group.instances[i].Attributes.ReportDate < group.instances[j].Attributes.ReportDate
+			})
+			groups = append(groups, *group)
+		}
+	}
+	return groups, nil
+}
+
+// fetchAnalyticsGroup downloads every segment of a group's instances, in
+// report date order, and streams them into one CSV. Segments are written to
+// a temporary file that only replaces dir/fileName once every segment has
+// been merged. It returns nil when the instances have no segments.
+func fetchAnalyticsGroup(ctx context.Context, client *asc.Client, group analyticsFetchGroup, dir, fileName string) (*asc.AnalyticsFetchFile, error) {
+	file := &asc.AnalyticsFetchFile{
+		ReportID:    group.report.ID,
+		Name:        group.report.Attributes.Name,
+		Category:    group.report.Attributes.Category,
+		Granularity: group.granularity,
+		File:        fileName,
+	}
+
+	tmp, err := os.CreateTemp(dir, ".asc-fetch-*")
+	if err != nil {
+		return nil, err
+	}
+	tmpName := tmp.Name()
+	defer os.Remove(tmpName)
+	defer tmp.Close()
+
+	hash := sha256.New()
+	merger := newAnalyticsCSVMerger(io.MultiWriter(tmp, hash))
+	for _, instance := range group.instances {
+		segments, err := fetchAnalyticsReportSegments(ctx, client, instance.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch segments: %w", err)
+		}
+
+		fetched := asc.AnalyticsFetchInstance{
+			ID:         instance.ID,
+			ReportDate: instance.Attributes.ReportDate,
+			Segments:   []string{},
+		}
+		for _, segment := range segments {
+			table, err := downloadAnalyticsSegment(ctx, client, segment)
+			if err != nil {
+				return nil, fmt.Errorf("segment %s: %w", segment.ID, err)
+			}
+			if err := merger.add(table); err != nil {
+				return nil, fmt.Errorf("segment %s: %w", segment.ID, err)
+			}
+			fetched.Segments = append(fetched.Segments, segment.ID)
+		}
+		file.Instances = append(file.Instances, fetched)
+	}
+	if merger.columns == nil {
+		return nil, nil
+	}
+	if err := merger.flush(); err != nil {
+		return nil, err
+	}
+
+	if err := tmp.Chmod(0o644); err != nil {
+		return nil, err
+	}
+	if err := tmp.Sync(); err != nil {
+		return nil, err
+	}
+	if err := tmp.Close(); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(tmpName, filepath.Join(dir, fileName)); err != nil {
+		return nil, fmt.Errorf("failed to write %s: %w", fileName, err)
+	}
+	file.SHA256 = hex.EncodeToString(hash.Sum(nil))
+	file.Rows = merger.rows
+	return file, nil
+}
+
+func downloadAnalyticsSegment(ctx context.Context, client *asc.Client, segment asc.Resource[asc.AnalyticsReportSegmentAttributes]) (*asc.AnalyticsReportTable, error) {
+	downloadURL := strings.TrimSpace(segment.Attributes.URL)
+	if downloadURL == "" {
+		return nil, fmt.Errorf("segment download URL is empty")
+	}
+
+	requestCtx, cancel := contextWithTimeout(ctx)
+	defer cancel()
+
+	download, err := asc.WithRetry(requestCtx, func() (*asc.ReportDownload, error) {
+		return client.DownloadAnalyticsReport(requestCtx, downloadURL)
+	}, asc.ResolveRetryOptions())
+	if err != nil {
+		return nil, fmt.Errorf("failed to download: %w", err)
+	}
+	defer download.Body.Close()
+
+	return asc.ParseAnalyticsReport(download.Body)
+}
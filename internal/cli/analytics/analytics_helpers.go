@@ -2,7 +2,9 @@ package analytics
 
 import (
 	"context"
+	"encoding/csv"
 	"fmt"
+	"io"
 	"regexp"
 	"strings"
 	"time"
@@ -101,6 +103,26 @@ func normalizeAnalyticsRequestState(value string) (asc.AnalyticsReportRequestSta
 	}
 }
 
+func normalizeAnalyticsCategory(value string) (string, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(value))
+	switch normalized {
+	case "", "APP_STORE_ENGAGEMENT", "APP_STORE_COMMERCE", "APP_USAGE", "FRAMEWORK_USAGE", "PERFORMANCE":
+		return normalized, nil
+	default:
+		return "", fmt.Errorf("--category must be APP_STORE_ENGAGEMENT, APP_STORE_COMMERCE, APP_USAGE, FRAMEWORK_USAGE, or PERFORMANCE")
+	}
+}
+
+func normalizeAnalyticsGranularity(value string) (string, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(value))
+	switch normalized {
+	case "", "DAILY", "WEEKLY", "MONTHLY":
+		return normalized, nil
+	default:
+		return "", fmt.Errorf("--granularity must be DAILY, WEEKLY, or MONTHLY")
+	}
+}
+
 func validateUUIDFlag(flagName, value string) error {
 	if strings.TrimSpace(value) == "" {
 		return fmt.Errorf("%s is required", flagName)
@@ -160,7 +182,10 @@ func matchAnalyticsInstanceDate(attrs asc.AnalyticsReportInstanceAttributes, dat
 	return strings.HasPrefix(attrs.ProcessingDate, date)
 }
 
-func fetchAnalyticsReports(ctx context.Context, client *asc.Client, requestID string, limit int, next string, paginate bool) ([]asc.Resource[asc.AnalyticsReportAttributes], asc.Links, error) {
+// fetchAnalyticsReports lists a request's reports. This and the instance and
+// segment helpers below give each page its own timeout, so long listings do
+// not share a single deadline.
+func fetchAnalyticsReports(ctx context.Context, client *asc.Client, requestID string, limit int, next string, paginate bool, filters ...asc.AnalyticsReportsOption) ([]asc.Resource[asc.AnalyticsReportAttributes], asc.Links, error) {
 	var (
 		all   []asc.Resource[asc.AnalyticsReportAttributes]
 		links asc.Links
@@ -168,7 +193,9 @@ func fetchAnalyticsReports(ctx context.Context, client *asc.Client, requestID st
 	)
 
 	if strings.TrimSpace(next) != "" {
-		resp, err := client.GetAnalyticsReports(ctx, requestID, asc.WithAnalyticsReportsNextURL(next))
+		pageCtx, cancel := contextWithTimeout(ctx)
+		defer cancel()
+		resp, err := client.GetAnalyticsReports(pageCtx, requestID, asc.WithAnalyticsReportsNextURL(next))
 		if err != nil {
 			return nil, asc.Links{}, err
 		}
@@ -180,17 +207,21 @@ func fetchAnalyticsReports(ctx context.Context, client *asc.Client, requestID st
 	}
 	nextURL := ""
 	for {
-		var resp *asc.AnalyticsReportsResponse
-		var err error
 		if nextURL != "" {
 			if seen[nextURL] {
 				return nil, asc.Links{}, fmt.Errorf("analytics get: detected repeated pagination URL")
 			}
 			seen[nextURL] = true
-			resp, err = client.GetAnalyticsReports(ctx, requestID, asc.WithAnalyticsReportsNextURL(nextURL))
+		}
+		var resp *asc.AnalyticsReportsResponse
+		var err error
+		pageCtx, cancel := contextWithTimeout(ctx)
+		if nextURL != "" {
+			resp, err = client.GetAnalyticsReports(pageCtx, requestID, asc.WithAnalyticsReportsNextURL(nextURL))
 		} else {
-			resp, err = client.GetAnalyticsReports(ctx, requestID, asc.WithAnalyticsReportsLimit(limit))
+			resp, err = client.GetAnalyticsReports(pageCtx, requestID, append([]asc.AnalyticsReportsOption{asc.WithAnalyticsReportsLimit(limit)}, filters...)...)
 		}
+		cancel()
 		if err != nil {
 			return nil, asc.Links{}, err
 		}
@@ -214,17 +245,21 @@ func fetchAnalyticsReportInstances(ctx context.Context, client *asc.Client, repo
 		seen = make(map[string]bool)
 	)
 	for {
-		var resp *asc.AnalyticsReportInstancesResponse
-		var err error
 		if next != "" {
 			if seen[next] {
 				return nil, fmt.Errorf("analytics get: detected repeated instance pagination URL")
 			}
 			seen[next] = true
-			resp, err = client.GetAnalyticsReportInstances(ctx, reportID, asc.WithAnalyticsReportInstancesNextURL(next))
+		}
+		var resp *asc.AnalyticsReportInstancesResponse
+		var err error
+		pageCtx, cancel := contextWithTimeout(ctx)
+		if next != "" {
+			resp, err = client.GetAnalyticsReportInstances(pageCtx, reportID, asc.WithAnalyticsReportInstancesNextURL(next))
 		} else {
-			resp, err = client.GetAnalyticsReportInstances(ctx, reportID, asc.WithAnalyticsReportInstancesLimit(analyticsMaxLimit))
+			resp, err = client.GetAnalyticsReportInstances(pageCtx, reportID, asc.WithAnalyticsReportInstancesLimit(analyticsMaxLimit))
 		}
+		cancel()
 		if err != nil {
 			return nil, err
 		}
@@ -244,17 +279,21 @@ func fetchAnalyticsReportSegments(ctx context.Context, client *asc.Client, insta
 		seen = make(map[string]bool)
 	)
 	for {
-		var resp *asc.AnalyticsReportSegmentsResponse
-		var err error
 		if next != "" {
 			if seen[next] {
 				return nil, fmt.Errorf("analytics get: detected repeated segment pagination URL")
 			}
 			seen[next] = true
-			resp, err = client.GetAnalyticsReportSegments(ctx, instanceID, asc.WithAnalyticsReportSegmentsNextURL(next))
+		}
+		var resp *asc.AnalyticsReportSegmentsResponse
+		var err error
+		pageCtx, cancel := contextWithTimeout(ctx)
+		if next != "" {
+			resp, err = client.GetAnalyticsReportSegments(pageCtx, instanceID, asc.WithAnalyticsReportSegmentsNextURL(next))
 		} else {
-			resp, err = client.GetAnalyticsReportSegments(ctx, instanceID, asc.WithAnalyticsReportSegmentsLimit(analyticsMaxLimit))
+			resp, err = client.GetAnalyticsReportSegments(pageCtx, instanceID, asc.WithAnalyticsReportSegmentsLimit(analyticsMaxLimit))
 		}
+		cancel()
 		if err != nil {
 			return nil, err
 		}
@@ -266,3 +305,151 @@ func fetchAnalyticsReportSegments(ctx context.Context, client *asc.Client, insta
 	}
 	return all, nil
 }
+
+// salesReportPeriods lists the report dates between from and to (inclusive)
+// for a frequency. Weekly reports are dated by the Sunday that ends the week,
+// so enumeration starts at the first Sunday on or after from.
+func salesReportPeriods(from, to string, frequency asc.SalesReportFrequency) ([]string, error) {
+	layout, format := "2006-01-02", "YYYY-MM-DD"
+	step := func(t time.Time) time.Time { return t.AddDate(0, 0, 1) }
+	switch frequency {
+	case asc.SalesReportFrequencyWeekly:
+		step = func(t time.Time) time.Time { return t.AddDate(0, 0, 7) }
+	case asc.SalesReportFrequencyMonthly:
+		layout, format = "2006-01", "YYYY-MM"
+		step = func(t time.Time) time.Time { return t.AddDate(0, 1, 0) }
+	case asc.SalesReportFrequencyYearly:
+		layout, format = "2006", "YYYY"
+		step = func(t time.Time) time.Time { return t.AddDate(1, 0, 0) }
+	}
+
+	start, err := time.Parse(layout, strings.TrimSpace(from))
+	if err != nil {
+		return nil, fmt.Errorf("--from must be in %s format for %s reports", format, strings.ToLower(string(frequency)))
+	}
+	end, err := time.Parse(layout, strings.TrimSpace(to))
+	if err != nil {
+		return nil, fmt.Errorf("--to must be in %s format for %s reports", format, strings.ToLower(string(frequency)))
+	}
+	if start.After(end) {
+		return nil, fmt.Errorf("--from must not be after --to")
+	}
+	if frequency == asc.SalesReportFrequencyWeekly {
+		start = start.AddDate(0, 0, (7-int(start.Weekday()))%7)
+	}
+
+	var periods []string
+	for current := start; !current.After(end); current = step(current) {
+		periods = append(periods, current.Format(layout))
+	}
+	if len(periods) == 0 {
+		return nil, fmt.Errorf("no weekly report dates (Sundays) between --from and --to")
+	}
+	return periods, nil
+}
+
+// salesReportPublishedBy estimates when Apple publishes the sales report for
+// a period returned by salesReportPeriods: daily and weekly reports arrive a
+// day or two after the period ends, monthly and yearly ones within a week.
+// It returns the zero time for periods it cannot parse.
+func salesReportPublishedBy(period string, frequency asc.SalesReportFrequency) time.Time {
+	var layout string
+	var end func(t time.Time) time.Time
+	lag := 2 * 24 * time.Hour
+	switch frequency {
+	case asc.SalesReportFrequencyDaily, asc.SalesReportFrequencyWeekly:
+		// Weekly report dates are the Sunday that ends the week.
+		layout, end = "2006-01-02", func(t time.Time) time.Time { return t.AddDate(0, 0, 1) }
+	case asc.SalesReportFrequencyMonthly:
+		layout, end = "2006-01", func(t time.Time) time.Time { return t.AddDate(0, 1, 0) }
+		lag = 7 * 24 * time.Hour
+	case asc.SalesReportFrequencyYearly:
+		layout, end = "2006", func(t time.Time) time.Time { return t.AddDate(1, 0, 0) }
+		lag = 7 * 24 * time.Hour
+	default:
+		return time.Time{}
+	}
+	start, err := time.Parse(layout, period)
+	if err != nil {
+		return time.Time{}
+	}
+	return end(start).Add(lag)
+}
+
+var analyticsFileNameSeparators = regexp.MustCompile(`[^a-z0-9]+`)
+
+// analyticsFetchFileName names the merged CSV for a report at a granularity
+// over a date range, e.g. "App Sessions Standard", DAILY, 2025-01-01 and
+// 2025-01-31 become app_sessions_standard_daily_2025-01-01_2025-01-31.csv.
+func analyticsFetchFileName(reportName, granularity, from, to string) string {
+	base := strings.Trim(analyticsFileNameSeparators.ReplaceAllString(strings.ToLower(reportName), "_"), "_")
+	if base == "" {
+		base = "report"
+	}
+	return fmt.Sprintf("%s_%s_%s_%s.csv", base, strings.ToLower(granularity), from, to)
+}
+
+// analyticsDateInRange reports whether an instance's report date falls within
+// [from, to]. All values are compared as YYYY-MM-DD strings.
+func analyticsDateInRange(reportDate, from, to string) bool {
+	if len(reportDate) < len("2006-01-02") {
+		return false
+	}
+	date := reportDate[:len("2006-01-02")]
+	return date >= from && date <= to
+}
+
+// analyticsCSVMerger appends analytics report segments to one CSV. The first
+// segment fixes the header; later segments are aligned to it by column name,
+// so segments that order columns differently still line up.
+type analyticsCSVMerger struct {
+	writer  *csv.Writer
+	columns []string
+	index   map[string]int
+	rows    int
+}
+
+func newAnalyticsCSVMerger(w io.Writer) *analyticsCSVMerger {
+	return &analyticsCSVMerger{writer: csv.NewWriter(w)}
+}
+
+func (m *analyticsCSVMerger) add(table *asc.AnalyticsReportTable) error {
+	if m.columns == nil {
+		m.columns = table.Columns
+		m.index = make(map[string]int, len(table.Columns))
+		for i, column := range table.Columns {
+			m.index[strings.ToLower(column)] = i
+		}
+		if err := m.writer.Write(m.columns); err != nil {
+			return err
+		}
+	}
+
+	positions := make([]int, len(table.Columns))
+	for i, column := range table.Columns {
+		position, ok := m.index[strings.ToLower(column)]
+		if !ok {
+			return fmt.Errorf("segment column %q is not in earlier segments", column)
+		}
+		positions[i] = position
+	}
+
+	for _, row := range table.Rows {
+		record := make([]string, len(m.columns))
+		for i, value := range row {
+			if i < len(positions) {
+				record[positions[i]] = value
+			}
+		}
+		if err := m.writer.Write(record); err != nil {
+			return err
+		}
+		m.rows++
+	}
+	return nil
+}
+
+func (m *analyticsCSVMerger) flush() error {
+	m.writer.Flush()
+	return m.writer.Error()
+}
@@ -5,6 +5,7 @@ import (
 	"compress/gzip"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
@@ -96,3 +97,129 @@ func TestDecompressGzipFile(t *testing.T) {
 		t.Fatalf("expected decompressed content to be hello, got %q", string(data))
 	}
 }
+
+func TestSalesReportPeriods(t *testing.T) {
+	tests := []struct {
+		name      string
+		from      string
+		to        string
+		frequency asc.SalesReportFrequency
+		want      []string
+	}{
+		{"daily across month", "2024-01-30", "2024-02-02", asc.SalesReportFrequencyDaily, []string{"2024-01-30", "2024-01-31", "2024-02-01", "2024-02-02"}},
+		{"weekly aligns to sunday", "2024-01-02", "2024-01-21", asc.SalesReportFrequencyWeekly, []string{"2024-01-07", "2024-01-14", "2024-01-21"}},
+		{"monthly", "2024-11", "2025-02", asc.SalesReportFrequencyMonthly, []string{"2024-11", "2024-12", "2025-01", "2025-02"}},
+		{"yearly", "2022", "2024", asc.SalesReportFrequencyYearly, []string{"2022", "2023", "2024"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := salesReportPeriods(test.from, test.to, test.frequency)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if strings.Join(got, ",") != strings.Join(test.want, ",") {
+				t.Fatalf("expected %v, got %v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestSalesReportPeriods_Errors(t *testing.T) {
+	if _, err := salesReportPeriods("2024-02-01", "2024-01-01", asc.SalesReportFrequencyDaily); err == nil {
+		t.Fatal("expected error for reversed range")
+	}
+	if _, err := salesReportPeriods("2024-01-01", "2024-02", asc.SalesReportFrequencyMonthly); err == nil {
+		t.Fatal("expected error for invalid monthly --from")
+	}
+	if _, err := salesReportPeriods("2024-01-01", "2024-01-06", asc.SalesReportFrequencyWeekly); err == nil {
+		t.Fatal("expected error when no Sunday falls in range")
+	}
+}
+
+func TestSalesReportPublishedBy(t *testing.T) {
+	tests := []struct {
+		period    string
+		frequency asc.SalesReportFrequency
+		want      string
+	}{
+		{period: "2025-01-31", frequency: asc.SalesReportFrequencyDaily, want: "2025-02-03"},
+		{period: "2025-01-05", frequency: asc.SalesReportFrequencyWeekly, want: "2025-01-08"},
+		{period: "2025-01", frequency: asc.SalesReportFrequencyMonthly, want: "2025-02-08"},
+		{period: "2024", frequency: asc.SalesReportFrequencyYearly, want: "2025-01-08"},
+	}
+	for _, test := range tests {
+		if got := salesReportPublishedBy(test.period, test.frequency).Format("2006-01-02"); got != test.want {
+			t.Fatalf("%s %s: expected %s, got %s", test.frequency, test.period, test.want, got)
+		}
+	}
+	if !salesReportPublishedBy("not-a-date", asc.SalesReportFrequencyDaily).IsZero() {
+		t.Fatal("expected zero time for an unparseable period")
+	}
+}
+
+func TestAnalyticsFetchFileName(t *testing.T) {
+	if got := analyticsFetchFileName("App Sessions (Standard)", "DAILY", "2025-01-01", "2025-01-31"); got != "app_sessions_standard_daily_2025-01-01_2025-01-31.csv" {
+		t.Fatalf("unexpected file name %q", got)
+	}
+	if got := analyticsFetchFileName("  ", "WEEKLY", "2025-01-01", "2025-01-07"); got != "report_weekly_2025-01-01_2025-01-07.csv" {
+		t.Fatalf("unexpected file name for blank report name %q", got)
+	}
+}
+
+func TestAnalyticsDateInRange(t *testing.T) {
+	tests := map[string]bool{
+		"2025-01-01":          true,
+		"2025-01-31T00:00:00": true,
+		"2024-12-31":          false,
+		"2025-02-01":          false,
+		"":                    false,
+	}
+	for date, want := range tests {
+		if got := analyticsDateInRange(date, "2025-01-01", "2025-01-31"); got != want {
+			t.Fatalf("analyticsDateInRange(%q) = %v, want %v", date, got, want)
+		}
+	}
+}
+
+func TestAnalyticsCSVMerger_AlignsColumnsByName(t *testing.T) {
+	var buf bytes.Buffer
+	merger := newAnalyticsCSVMerger(&buf)
+	if err := merger.add(&asc.AnalyticsReportTable{
+		Columns: []string{"Date", "Territory", "Sessions"},
+		Rows:    [][]string{{"2025-01-01", "US", "4"}},
+	}); err != nil {
+		t.Fatalf("add first segment: %v", err)
+	}
+	if err := merger.add(&asc.AnalyticsReportTable{
+		Columns: []string{"sessions", "Date"},
+		Rows:    [][]string{{"7", "2025-01-02"}},
+	}); err != nil {
+		t.Fatalf("add second segment: %v", err)
+	}
+	if err := merger.flush(); err != nil {
+		t.Fatalf("flush: %v", err)
+	}
+
+	want := "Date,Territory,Sessions\n2025-01-01,US,4\n2025-01-02,,7\n"
+	if buf.String() != want {
+		t.Fatalf("expected %q, got %q", want, buf.String())
+	}
+	if merger.rows != 2 {
+		t.Fatalf("expected 2 rows, got %d", merger.rows)
+	}
+
+	err := merger.add(&asc.AnalyticsReportTable{Columns: []string{"Date", "Device"}})
+	if err == nil || !strings.Contains(err.Error(), `"Device"`) {
+		t.Fatalf("expected unknown column error, got %v", err)
+	}
+}
+
+func TestNormalizeAnalyticsCategory(t *testing.T) {
+	got, err := normalizeAnalyticsCategory(" app_usage ")
+	if err != nil || got != "APP_USAGE" {
+		t.Fatalf("expected APP_USAGE, got %q (%v)", got, err)
+	}
+	if _, err := normalizeAnalyticsCategory("SALES"); err == nil {
+		t.Fatal("expected error for unknown category")
+	}
+}
@@ -0,0 +1,147 @@
+package analytics
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// AnalyticsSalesBackfillCommand downloads sales reports for a range of dates.
+func AnalyticsSalesBackfillCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("backfill", flag.ExitOnError)
+
+	vendor := fs.String("vendor", "", "Vendor number (or ASC_VENDOR_NUMBER/ASC_ANALYTICS_VENDOR_NUMBER env)")
+	reportType := fs.String("type", "SALES", "Report type: SALES (default), PRE_ORDER, NEWSSTAND, SUBSCRIPTION, SUBSCRIPTION_EVENT")
+	reportSubType := fs.String("subtype", "SUMMARY", "Report subtype: SUMMARY (default), DETAILED")
+	frequency := fs.String("frequency", "", "Frequency: DAILY, WEEKLY, MONTHLY, YEARLY")
+	from := fs.String("from", "", "First report date: daily/weekly YYYY-MM-DD, monthly YYYY-MM, yearly YYYY")
+	to := fs.String("to", "", "Last report date (inclusive), same format as --from")
+	version := fs.String("version", "1_0", "Report format version: 1_0 (default), 1_1")
+	dir := fs.String("dir", "", "Directory to save reports and manifest.json into")
+	concurrency := fs.Int("concurrency", 4, fmt.Sprintf("Parallel downloads (1-%d)", shared.MaxReportBackfillConcurrency))
+	retryUnavailable := fs.Bool("retry-unavailable", false, "Re-request dates previously recorded as having no report")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "backfill",
+		ShortUsage: "asc analytics sales backfill --frequency DAILY --from DATE --to DATE --dir DIR [flags]",
+		ShortHelp:  "Download sales reports for a range of dates.",
+		LongHelp: `Download sales reports for a range of dates.
+
+Fetches one report per period between --from and --to (inclusive) into --dir,
+several at a time. Weekly reports are dated by the Sunday ending each week.
+Rate-limited requests are retried with backoff.
+
+Progress is tracked in <dir>/manifest.json with a SHA-256 hash of each report,
+so re-running the same command resumes an interrupted backfill: reports whose
+file matches the manifest are skipped, and missing or modified files are
+downloaded again. Periods with no report are recorded as unavailable and
+skipped on later runs unless --retry-unavailable is set. Recent periods that
+Apple may not have published yet are reported as pending instead and asked
+for again on the next run.
+
+Examples:
+  asc analytics sales backfill --vendor "12345678" --frequency DAILY --from 2024-01-01 --to 2024-12-31 --dir ./reports
+  asc analytics sales backfill --vendor "12345678" --type SUBSCRIPTION --subtype SUMMARY --frequency DAILY --from 2024-06-01 --to 2024-06-30 --dir ./subscriptions
+  asc analytics sales backfill --vendor "12345678" --frequency MONTHLY --from 2023-01 --to 2024-12 --dir ./monthly --retry-unavailable --output table`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			vendorNumber := shared.ResolveVendorNumber(*vendor)
+			if vendorNumber == "" {
+				fmt.Fprintln(os.Stderr, "Error: --vendor is required (or set ASC_VENDOR_NUMBER/ASC_ANALYTICS_VENDOR_NUMBER)")
+				return flag.ErrHelp
+			}
+			if strings.TrimSpace(*frequency) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --frequency is required")
+				return flag.ErrHelp
+			}
+			if strings.TrimSpace(*from) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --from is required")
+				return flag.ErrHelp
+			}
+			if strings.TrimSpace(*to) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --to is required")
+				return flag.ErrHelp
+			}
+			if strings.TrimSpace(*dir) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --dir is required")
+				return flag.ErrHelp
+			}
+			if *concurrency < 1 || *concurrency > shared.MaxReportBackfillConcurrency {
+				return fmt.Errorf("analytics sales backfill: --concurrency must be between 1 and %d", shared.MaxReportBackfillConcurrency)
+			}
+
+			salesType, err := normalizeSalesReportType(*reportType)
+			if err != nil {
+				return fmt.Errorf("analytics sales backfill: %w", err)
+			}
+			subType, err := normalizeSalesReportSubType(*reportSubType)
+			if err != nil {
+				return fmt.Errorf("analytics sales backfill: %w", err)
+			}
+			freq, err := normalizeSalesReportFrequency(*frequency)
+			if err != nil {
+				return fmt.Errorf("analytics sales backfill: %w", err)
+			}
+			reportVersion, err := normalizeSalesReportVersion(*version)
+			if err != nil {
+				return fmt.Errorf("analytics sales backfill: %w", err)
+			}
+			periods, err := salesReportPeriods(*from, *to, freq)
+			if err != nil {
+				return fmt.Errorf("analytics sales backfill: %w", err)
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("analytics sales backfill: %w", err)
+			}
+
+			jobs := make([]shared.ReportBackfillJob, 0, len(periods))
+			for _, period := range periods {
+				params := asc.SalesReportParams{
+					VendorNumber:  vendorNumber,
+					ReportType:    salesType,
+					ReportSubType: subType,
+					Frequency:     freq,
+					ReportDate:    period,
+					Version:       reportVersion,
+				}
+				jobs = append(jobs, shared.ReportBackfillJob{
+					Period:      period,
+					FileName:    fmt.Sprintf("sales_report_%s_%s_%s_%s.tsv.gz", period, salesType, subType, freq),
+					PublishedBy: salesReportPublishedBy(period, freq),
+					Download: func(ctx context.Context) (*asc.ReportDownload, error) {
+						return client.GetSalesReport(ctx, params)
+					},
+				})
+			}
+
+			result, err := shared.RunReportBackfill(ctx, jobs, shared.ReportBackfillOptions{
+				Dir:              *dir,
+				Concurrency:      *concurrency,
+				RetryUnavailable: *retryUnavailable,
+				Progress:         os.Stderr,
+			})
+			if err != nil {
+				return fmt.Errorf("analytics sales backfill: %w", err)
+			}
+			if err := printOutput(result, *output, *pretty); err != nil {
+				return err
+			}
+			if result.Failed > 0 {
+				return shared.NewReportedError(fmt.Errorf("analytics sales backfill: %d of %d reports failed", result.Failed, result.Requested))
+			}
+			return nil
+		},
+	}
+}
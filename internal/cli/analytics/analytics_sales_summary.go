@@ -0,0 +1,92 @@
+package analytics
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// AnalyticsSalesSummarizeCommand aggregates downloaded sales reports.
+func AnalyticsSalesSummarizeCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("summarize", flag.ExitOnError)
+
+	files := fs.String("file", "", "Report file(s) to summarize, comma-separated (.tsv or .tsv.gz)")
+	reportType := fs.String("type", "", "Report type: SALES, SUBSCRIPTION, SUBSCRIPTION_EVENT (default: detect from header)")
+	output := fs.String("output", "json", "Output format: json (default), csv, table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "summarize",
+		ShortUsage: "asc analytics sales summarize --file report.tsv.gz [flags]",
+		ShortHelp:  "Summarize sales reports by SKU, territory, currency and product type.",
+		LongHelp: `Summarize sales reports by SKU, territory, currency and product type.
+
+Reads reports saved by 'asc analytics sales' (compressed or not) and
+aggregates units and proceeds. Proceeds are never mixed across currencies.
+
+  SALES               Units and units x developer proceeds, keyed by SKU
+  SUBSCRIPTION        Subscribers, and proceeds of paid active subscriptions,
+                      keyed by subscription Apple ID; product type is the
+                      subscription duration
+  SUBSCRIPTION_EVENT  Event quantities keyed by subscription Apple ID; product
+                      type is the event name
+
+Examples:
+  asc analytics sales summarize --file sales_report_2024-01-20_SALES.tsv.gz
+  asc analytics sales summarize --file "jan.tsv.gz,feb.tsv.gz" --output csv
+  asc analytics sales summarize --file subscriptions.tsv --type SUBSCRIPTION --output table`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			paths := shared.SplitCSV(*files)
+			if len(paths) == 0 {
+				fmt.Fprintln(os.Stderr, "Error: --file is required")
+				return flag.ErrHelp
+			}
+
+			var requestedType asc.SalesReportType
+			if strings.TrimSpace(*reportType) != "" {
+				normalized, err := normalizeSalesReportType(*reportType)
+				if err != nil {
+					return fmt.Errorf("analytics sales summarize: %w", err)
+				}
+				requestedType = normalized
+			}
+			var salesType asc.SalesReportType
+
+			var builder *asc.ReportSummaryBuilder
+			for _, path := range paths {
+				report, err := parseSalesReportFile(path, requestedType)
+				if err != nil {
+					return fmt.Errorf("analytics sales summarize: %s: %w", path, err)
+				}
+				if builder == nil {
+					builder = asc.NewReportSummaryBuilder(string(report.ReportType), "")
+					salesType = report.ReportType
+				} else if report.ReportType != salesType {
+					return fmt.Errorf("analytics sales summarize: %s is a %s report, expected %s", path, report.ReportType, salesType)
+				}
+				builder.AddFile(path)
+				builder.AddSalesReport(report)
+			}
+
+			return shared.PrintReportSummary(builder.Summary(), *output, *pretty)
+		},
+	}
+}
+
+func parseSalesReportFile(path string, reportType asc.SalesReportType) (*asc.ParsedSalesReport, error) {
+	file, err := shared.OpenExistingNoFollow(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return asc.ParseSalesReport(file, reportType)
+}
@@ -6,16 +6,13 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
-	"strings"
 	"time"
 
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
 )
 
-const (
-	assetUploadDefaultTimeout = 10 * time.Minute
-	assetPollInterval         = 2 * time.Second
-)
+const assetUploadDefaultTimeout = 10 * time.Minute
 
 func contextWithAssetUploadTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
 	if ctx == nil {
@@ -64,53 +61,5 @@ func collectAssetFiles(path string) ([]string, error) {
 }
 
 func waitForAssetDeliveryState(ctx context.Context, assetID string, fetch func(context.Context) (*asc.AssetDeliveryState, error)) (string, error) {
-	ticker := time.NewTicker(assetPollInterval)
-	defer ticker.Stop()
-
-	var lastState string
-	for {
-		state, err := fetch(ctx)
-		if err != nil {
-			return lastState, err
-		}
-		if state != nil {
-			lastState = state.State
-			switch strings.ToUpper(state.State) {
-			case "COMPLETE":
-				return state.State, nil
-			case "FAILED":
-				return state.State, fmt.Errorf("asset %s delivery failed: %s", assetID, formatAssetErrors(state.Errors))
-			}
-		}
-
-		select {
-		case <-ctx.Done():
-			return lastState, fmt.Errorf("timed out waiting for asset %s delivery: %w", assetID, ctx.Err())
-		case <-ticker.C:
-		}
-	}
-}
-
-func formatAssetErrors(errors []asc.ErrorDetail) string {
-	if len(errors) == 0 {
-		return "unknown error"
-	}
-	parts := make([]string, 0, len(errors))
-	for _, item := range errors {
-		if item.Code != "" && item.Message != "" {
-			parts = append(parts, fmt.Sprintf("%s: %s", item.Code, item.Message))
-			continue
-		}
-		if item.Message != "" {
-			parts = append(parts, item.Message)
-			continue
-		}
-		if item.Code != "" {
-			parts = append(parts, item.Code)
-		}
-	}
-	if len(parts) == 0 {
-		return "unknown error"
-	}
-	return strings.Join(parts, "; ")
+	return shared.WaitForAssetDeliveryState(ctx, assetID, fetch)
 }
@@ -21,12 +21,15 @@ func BuildsUploadCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("upload", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App Store Connect app ID (required, or ASC_APP_ID env)")
-	ipaPath := fs.String("ipa", "", "Path to .ipa file (required)")
-	version := fs.String("version", "", "CFBundleShortVersionString (e.g., 1.0.0, auto-extracted from IPA if not provided)")
-	buildNumber := fs.String("build-number", "", "CFBundleVersion (e.g., 123, auto-extracted from IPA if not provided)")
-	platform := fs.String("platform", "IOS", "Platform: IOS, MAC_OS, TV_OS, VISION_OS")
+	ipaPath := fs.String("ipa", "", "Path to .ipa file (required unless --pkg)")
+	pkgPath := fs.String("pkg", "", "Path to macOS .pkg product archive (MAC_OS builds)")
+	version := fs.String("version", "", "CFBundleShortVersionString (e.g., 1.0.0, auto-extracted from the IPA or pkg if not provided)")
+	buildNumber := fs.String("build-number", "", "CFBundleVersion (e.g., 123, auto-extracted from the IPA or pkg if not provided)")
+	platform := fs.String("platform", "", "Platform: IOS, MAC_OS, TV_OS, VISION_OS (auto-detected from the IPA or pkg if not provided)")
 	dryRun := fs.Bool("dry-run", false, "Reserve upload operations without uploading the file")
-	concurrency := fs.Int("concurrency", 1, "Upload concurrency (default 1)")
+	concurrency := fs.Int("concurrency", 4, "Number of parts to upload in parallel")
+	resume := fs.Bool("resume", false, "Resume an interrupted upload from the state saved next to the IPA or pkg")
+	progress := fs.String("progress", shared.UploadProgressAuto, "Progress on stderr: auto (text on a terminal), text, json (JSON lines), none")
 	verifyChecksum := fs.Bool("checksum", false, "Verify upload checksums if provided by API")
 	testNotes := fs.String("test-notes", "", "What to Test notes (requires build processing)")
 	locale := fs.String("locale", "", "Locale for --test-notes (e.g., en-US)")
@@ -41,48 +44,57 @@ func BuildsUploadCommand() *ffcli.Command {
 		ShortHelp:  "Upload a build to App Store Connect.",
 		LongHelp: `Upload a build to App Store Connect.
 
-By default, this command uploads the IPA to the presigned URLs and commits
-the file. Use --dry-run to only reserve the upload operations.
+By default, this command uploads the IPA (or macOS .pkg) to the presigned URLs
+and commits the file. Use --dry-run to only reserve the upload operations.
+
+The version, build number and platform are read from the artifact when not
+provided: the top-level app's Info.plist for an IPA, and the Distribution or
+PackageInfo file for a .pkg product archive. A .pkg is always uploaded as
+MAC_OS; an IPA uses CFBundleSupportedPlatforms and falls back to IOS.
+
+Upload state (upload ID, operations and completed parts) is saved to
+<file>.asc-upload.json while uploading. If the upload is interrupted, rerun
+with --resume to skip the parts that already succeeded; expired part URLs
+are re-requested before resuming. The state file is removed once the upload
+is committed.
 
 Examples:
   asc builds upload --app "123456789" --ipa "path/to/app.ipa"
   asc builds upload --ipa "app.ipa" --version "1.0.0" --build-number "123"
+  asc builds upload --app "123456789" --pkg "path/to/app.pkg"
   asc builds upload --app "123456789" --ipa "app.ipa" --dry-run
+  asc builds upload --app "123456789" --ipa "app.ipa" --resume --progress json
   asc builds upload --app "123456789" --ipa "app.ipa" --test-notes "Test flow" --locale "en-US" --wait`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
+			visited := map[string]bool{}
+			fs.Visit(func(f *flag.Flag) {
+				visited[f.Name] = true
+			})
+
 			// Validate required flags
 			resolvedAppID := resolveAppID(*appID)
 			if resolvedAppID == "" {
 				fmt.Fprintf(os.Stderr, "Error: --app is required (or set ASC_APP_ID)\n\n")
 				return flag.ErrHelp
 			}
-			if *ipaPath == "" {
-				fmt.Fprintf(os.Stderr, "Error: --ipa is required\n\n")
-				return flag.ErrHelp
-			}
-
-			// Validate IPA file exists
-			fileInfo, err := os.Stat(*ipaPath)
+			artifact, err := shared.ResolveBuildArtifact(*ipaPath, *pkgPath)
 			if err != nil {
-				return fmt.Errorf("builds upload: failed to stat IPA: %w", err)
-			}
-			if fileInfo.IsDir() {
-				return fmt.Errorf("builds upload: --ipa must be a file")
+				return fmt.Errorf("builds upload: %w", err)
 			}
-
-			// Validate platform
-			platformValue := asc.Platform(strings.ToUpper(*platform))
-			switch platformValue {
-			case asc.PlatformIOS, asc.PlatformMacOS, asc.PlatformTVOS, asc.PlatformVisionOS:
-			default:
-				return fmt.Errorf("builds upload: --platform must be IOS, MAC_OS, TV_OS, or VISION_OS")
+			if artifact == nil {
+				fmt.Fprintf(os.Stderr, "Error: --ipa or --pkg is required\n\n")
+				return flag.ErrHelp
 			}
+			fileInfo := artifact.FileInfo
 			if *dryRun {
-				if *concurrency != 1 {
+				if visited["concurrency"] {
 					return fmt.Errorf("builds upload: --concurrency is not supported with --dry-run")
 				}
+				if *resume {
+					return fmt.Errorf("builds upload: --resume is not supported with --dry-run")
+				}
 				if *verifyChecksum {
 					return fmt.Errorf("builds upload: --checksum is not supported with --dry-run")
 				}
@@ -92,6 +104,10 @@ Examples:
 			} else if *concurrency < 1 {
 				return fmt.Errorf("builds upload: --concurrency must be at least 1")
 			}
+			progressMode, err := shared.ResolveUploadProgressMode(*progress)
+			if err != nil {
+				return fmt.Errorf("builds upload: %w", err)
+			}
 
 			testNotesValue := strings.TrimSpace(*testNotes)
 			localeValue := strings.TrimSpace(*locale)
@@ -115,40 +131,13 @@ Examples:
 				return fmt.Errorf("builds upload: --poll-interval must be greater than 0")
 			}
 
-			versionValue := strings.TrimSpace(*version)
-			buildNumberValue := strings.TrimSpace(*buildNumber)
-			if versionValue == "" || buildNumberValue == "" {
-				info, err := shared.ExtractBundleInfoFromIPA(*ipaPath)
-				if err != nil {
-					missingFlags := make([]string, 0, 2)
-					if versionValue == "" {
-						missingFlags = append(missingFlags, "--version")
-					}
-					if buildNumberValue == "" {
-						missingFlags = append(missingFlags, "--build-number")
-					}
-					return fmt.Errorf("builds upload: %s required (failed to extract from IPA: %w)", strings.Join(missingFlags, " and "), err)
-				}
-				if versionValue == "" {
-					versionValue = info.Version
-				}
-				if buildNumberValue == "" {
-					buildNumberValue = info.BuildNumber
-				}
-			}
-			if versionValue == "" || buildNumberValue == "" {
-				missingFields := make([]string, 0, 2)
-				missingFlags := make([]string, 0, 2)
-				if versionValue == "" {
-					missingFields = append(missingFields, "CFBundleShortVersionString")
-					missingFlags = append(missingFlags, "--version")
-				}
-				if buildNumberValue == "" {
-					missingFields = append(missingFields, "CFBundleVersion")
-					missingFlags = append(missingFlags, "--build-number")
-				}
-				return fmt.Errorf("builds upload: Info.plist missing %s; provide %s", strings.Join(missingFields, " and "), strings.Join(missingFlags, " and "))
+			artifactInfo, err := artifact.ResolveInfo(*version, *buildNumber, *platform)
+			if err != nil {
+				return fmt.Errorf("builds upload: %w", err)
 			}
+			versionValue := artifactInfo.Version
+			buildNumberValue := artifactInfo.BuildNumber
+			platformValue := artifactInfo.Platform
 
 			client, err := getASCClient()
 			if err != nil {
@@ -162,83 +151,158 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeoutDuration(ctx, timeoutValue)
 			defer cancel()
 
-			// Step 1: Create build upload record
-			uploadReq := asc.BuildUploadCreateRequest{
-				Data: asc.BuildUploadCreateData{
-					Type: asc.ResourceTypeBuildUploads,
-					Attributes: asc.BuildUploadAttributes{
-						CFBundleShortVersionString: versionValue,
-						CFBundleVersion:            buildNumberValue,
-						Platform:                   platformValue,
-					},
-					Relationships: &asc.BuildUploadRelationships{
-						App: &asc.Relationship{
-							Data: asc.ResourceData{Type: asc.ResourceTypeApps, ID: resolvedAppID},
-						},
-					},
-				},
+			statePath := buildUploadStatePath(artifact.Path)
+			var state *buildUploadState
+			if *resume {
+				saved, err := loadBuildUploadState(statePath)
+				if err != nil {
+					return fmt.Errorf("builds upload: %w", err)
+				}
+				if saved == nil {
+					fmt.Fprintln(os.Stderr, "No saved upload state found; starting a new upload")
+				} else if err := saved.matches(resolvedAppID, string(platformValue), versionValue, buildNumberValue, fileInfo); err != nil {
+					return fmt.Errorf("builds upload: cannot resume: %w (rerun without --resume to start over)", err)
+				} else {
+					state = saved
+				}
 			}
-
-			uploadResp, err := client.CreateBuildUpload(requestCtx, uploadReq)
-			if err != nil {
-				return fmt.Errorf("builds upload: failed to create upload record: %w", err)
+			if state != nil && state.operationsExpired(time.Now()) {
+				fmt.Fprintln(os.Stderr, "Saved upload URLs have expired; requesting new ones")
+				refreshCtx, refreshCancel := contextWithTimeout(ctx)
+				fileResp, err := client.GetBuildUploadFile(refreshCtx, state.FileID)
+				refreshCancel()
+				if err != nil {
+					return fmt.Errorf("builds upload: failed to refresh upload operations: %w", err)
+				}
+				if err := state.replaceOperations(fileResp.Data.Attributes.UploadOperations); err != nil {
+					return fmt.Errorf("builds upload: cannot resume: %w (rerun without --resume to start over)", err)
+				}
 			}
 
-			// Step 2: Create build upload file reservation
-			fileReq := asc.BuildUploadFileCreateRequest{
-				Data: asc.BuildUploadFileCreateData{
-					Type: asc.ResourceTypeBuildUploadFiles,
-					Attributes: asc.BuildUploadFileAttributes{
-						FileName:  fileInfo.Name(),
-						FileSize:  fileInfo.Size(),
-						UTI:       asc.UTIIPA,
-						AssetType: asc.AssetTypeAsset,
+			var result *asc.BuildUploadResult
+			if state != nil {
+				result = &asc.BuildUploadResult{
+					UploadID:   state.UploadID,
+					FileID:     state.FileID,
+					FileName:   state.FileName,
+					FileSize:   state.FileSize,
+					Operations: state.Operations,
+				}
+			} else {
+				// Step 1: Create build upload record
+				uploadReq := asc.BuildUploadCreateRequest{
+					Data: asc.BuildUploadCreateData{
+						Type: asc.ResourceTypeBuildUploads,
+						Attributes: asc.BuildUploadAttributes{
+							CFBundleShortVersionString: versionValue,
+							CFBundleVersion:            buildNumberValue,
+							Platform:                   platformValue,
+						},
+						Relationships: &asc.BuildUploadRelationships{
+							App: &asc.Relationship{
+								Data: asc.ResourceData{Type: asc.ResourceTypeApps, ID: resolvedAppID},
+							},
+						},
 					},
-					Relationships: &asc.BuildUploadFileRelationships{
-						BuildUpload: &asc.Relationship{
-							Data: asc.ResourceData{Type: asc.ResourceTypeBuildUploads, ID: uploadResp.Data.ID},
+				}
+
+				uploadResp, err := client.CreateBuildUpload(requestCtx, uploadReq)
+				if err != nil {
+					return fmt.Errorf("builds upload: failed to create upload record: %w", err)
+				}
+
+				// Step 2: Create build upload file reservation
+				fileReq := asc.BuildUploadFileCreateRequest{
+					Data: asc.BuildUploadFileCreateData{
+						Type: asc.ResourceTypeBuildUploadFiles,
+						Attributes: asc.BuildUploadFileAttributes{
+							FileName:  fileInfo.Name(),
+							FileSize:  fileInfo.Size(),
+							UTI:       artifact.UTI(),
+							AssetType: asc.AssetTypeAsset,
+						},
+						Relationships: &asc.BuildUploadFileRelationships{
+							BuildUpload: &asc.Relationship{
+								Data: asc.ResourceData{Type: asc.ResourceTypeBuildUploads, ID: uploadResp.Data.ID},
+							},
 						},
 					},
-				},
-			}
+				}
 
-			fileResp, err := client.CreateBuildUploadFile(requestCtx, fileReq)
-			if err != nil {
-				return fmt.Errorf("builds upload: failed to create file reservation: %w", err)
-			}
+				fileResp, err := client.CreateBuildUploadFile(requestCtx, fileReq)
+				if err != nil {
+					return fmt.Errorf("builds upload: failed to create file reservation: %w", err)
+				}
+
+				// Return upload info including presigned URL operations
+				result = &asc.BuildUploadResult{
+					UploadID:   uploadResp.Data.ID,
+					FileID:     fileResp.Data.ID,
+					FileName:   fileResp.Data.Attributes.FileName,
+					FileSize:   fileResp.Data.Attributes.FileSize,
+					Operations: fileResp.Data.Attributes.UploadOperations,
+				}
 
-			// Return upload info including presigned URL operations
-			result := &asc.BuildUploadResult{
-				UploadID:   uploadResp.Data.ID,
-				FileID:     fileResp.Data.ID,
-				FileName:   fileResp.Data.Attributes.FileName,
-				FileSize:   fileResp.Data.Attributes.FileSize,
-				Operations: fileResp.Data.Attributes.UploadOperations,
+				if !*dryRun {
+					state = &buildUploadState{
+						Version:             buildUploadStateVersion,
+						AppID:               resolvedAppID,
+						Platform:            string(platformValue),
+						BundleVersion:       versionValue,
+						BuildNumber:         buildNumberValue,
+						FileName:            fileInfo.Name(),
+						FileSize:            fileInfo.Size(),
+						FileModTime:         fileInfo.ModTime().UTC(),
+						UploadID:            uploadResp.Data.ID,
+						FileID:              fileResp.Data.ID,
+						Operations:          fileResp.Data.Attributes.UploadOperations,
+						SourceFileChecksums: fileResp.Data.Attributes.SourceFileChecksums,
+						path:                statePath,
+					}
+					if len(state.Operations) > 0 {
+						if err := state.save(); err != nil {
+							return fmt.Errorf("builds upload: failed to save upload state: %w", err)
+						}
+					}
+				}
 			}
 
 			if !*dryRun {
-				if len(fileResp.Data.Attributes.UploadOperations) == 0 {
+				if len(state.Operations) == 0 {
 					return fmt.Errorf("builds upload: no upload operations returned")
 				}
 
+				stateWarned := false
 				uploadOpts := []asc.UploadOption{
 					asc.WithUploadConcurrency(*concurrency),
+					asc.WithUploadSkipParts(state.CompletedParts),
+					asc.WithUploadPartComplete(func(index int) {
+						if err := state.markCompleted(index); err != nil && !stateWarned {
+							stateWarned = true
+							fmt.Fprintf(os.Stderr, "Warning: failed to save upload state: %v\n", err)
+						}
+					}),
+				}
+				reporter := shared.NewUploadProgressReporter(progressMode, fileInfo.Name(), os.Stderr)
+				if reporter != nil {
+					uploadOpts = append(uploadOpts, asc.WithUploadProgress(reporter.Report))
 				}
 				uploadCtx, uploadCancel := contextWithUploadTimeout(ctx)
-				err = asc.ExecuteUploadOperations(uploadCtx, *ipaPath, fileResp.Data.Attributes.UploadOperations, uploadOpts...)
+				err = asc.ExecuteUploadOperations(uploadCtx, artifact.Path, state.Operations, uploadOpts...)
 				uploadCancel()
+				reporter.Finish()
 				if err != nil {
-					return fmt.Errorf("builds upload: upload failed: %w", err)
+					return fmt.Errorf("builds upload: upload failed (rerun with --resume to continue): %w", err)
 				}
 
 				var verifiedChecksums *asc.Checksums
 				var checksumVerified *bool
 				if *verifyChecksum {
-					src := fileResp.Data.Attributes.SourceFileChecksums
+					src := state.SourceFileChecksums
 					if src == nil || (src.File == nil && src.Composite == nil) {
 						fmt.Fprintln(os.Stderr, "Warning: --checksum requested but API provided no checksums to verify; skipping")
 					} else {
-						checksums, err := asc.VerifySourceFileChecksums(*ipaPath, src)
+						checksums, err := asc.VerifySourceFileChecksums(artifact.Path, src)
 						if err != nil {
 							return fmt.Errorf("builds upload: checksum verification failed: %w", err)
 						}
@@ -252,7 +316,7 @@ Examples:
 				updateReq := asc.BuildUploadFileUpdateRequest{
 					Data: asc.BuildUploadFileUpdateData{
 						Type: asc.ResourceTypeBuildUploadFiles,
-						ID:   fileResp.Data.ID,
+						ID:   state.FileID,
 						Attributes: &asc.BuildUploadFileUpdateAttributes{
 							Uploaded:            &uploaded,
 							SourceFileChecksums: verifiedChecksums,
@@ -261,7 +325,7 @@ Examples:
 				}
 
 				commitCtx, commitCancel := contextWithUploadTimeout(ctx)
-				commitResp, err := client.UpdateBuildUploadFile(commitCtx, fileResp.Data.ID, updateReq)
+				commitResp, err := client.UpdateBuildUploadFile(commitCtx, state.FileID, updateReq)
 				commitCancel()
 				if err != nil {
 					return fmt.Errorf("builds upload: failed to commit upload: %w", err)
@@ -275,6 +339,9 @@ Examples:
 				result.ChecksumVerified = checksumVerified
 				result.SourceFileChecksums = verifiedChecksums
 				result.Operations = nil
+				if err := state.remove(); err != nil {
+					fmt.Fprintf(os.Stderr, "Warning: failed to remove upload state: %v\n", err)
+				}
 
 				if *wait || testNotesValue != "" {
 					buildResp, err := shared.WaitForBuildByNumber(requestCtx, client, resolvedAppID, versionValue, buildNumberValue, string(platformValue), *pollInterval)
@@ -324,6 +391,7 @@ Examples:
   asc builds info --build "BUILD_ID"
   asc builds expire --build "BUILD_ID"
   asc builds expire-all --app "123456789" --older-than 90d --dry-run
+  asc builds validate --ipa "app.ipa"
   asc builds upload --app "123456789" --ipa "app.ipa"
   asc builds test-notes list --build "BUILD_ID"
   asc builds add-groups --build "BUILD_ID" --group "GROUP_ID"
@@ -336,6 +404,7 @@ Examples:
 			BuildsInfoCommand(),
 			BuildsExpireCommand(),
 			BuildsExpireAllCommand(),
+			BuildsValidateCommand(),
 			BuildsUploadCommand(),
 			BuildsTestNotesCommand(),
 			BuildsAddGroupsCommand(),
@@ -0,0 +1,188 @@
+package builds
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	buildUploadStateVersion = 1
+	buildUploadStateSuffix  = ".asc-upload.json"
+
+	// buildUploadExpiryMargin treats URLs about to expire as expired so a
+	// resumed part does not start with a URL that dies mid-request.
+	buildUploadExpiryMargin = 5 * time.Minute
+)
+
+// buildUploadState is persisted next to the uploaded file so an interrupted
+// upload can be resumed with --resume. It contains presigned URLs, so it is
+// written with owner-only permissions and removed once the upload commits.
+type buildUploadState struct {
+	Version             int                   `json:"version"`
+	AppID               string                `json:"appId"`
+	Platform            string                `json:"platform"`
+	BundleVersion       string                `json:"bundleVersion"`
+	BuildNumber         string                `json:"buildNumber"`
+	FileName            string                `json:"fileName"`
+	FileSize            int64                 `json:"fileSize"`
+	FileModTime         time.Time             `json:"fileModTime"`
+	UploadID            string                `json:"uploadId"`
+	FileID              string                `json:"fileId"`
+	Operations          []asc.UploadOperation `json:"operations"`
+	SourceFileChecksums *asc.Checksums        `json:"sourceFileChecksums,omitempty"`
+	CompletedParts      []int                 `json:"completedParts"`
+	UpdatedAt           time.Time             `json:"updatedAt"`
+
+	mu   sync.Mutex
+	path string
+}
+
+func buildUploadStatePath(filePath string) string {
+	return filePath + buildUploadStateSuffix
+}
+
+// loadBuildUploadState returns nil when no state file exists.
+func loadBuildUploadState(path string) (*buildUploadState, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read upload state: %w", err)
+	}
+	var state buildUploadState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("parse upload state %s: %w", path, err)
+	}
+	if state.Version != buildUploadStateVersion {
+		return nil, fmt.Errorf("upload state %s has unsupported version %d", path, state.Version)
+	}
+	if state.UploadID == "" || state.FileID == "" || len(state.Operations) == 0 {
+		return nil, fmt.Errorf("upload state %s is incomplete", path)
+	}
+	state.path = path
+	return &state, nil
+}
+
+// matches reports why the saved state cannot be used for this upload, if at all.
+func (s *buildUploadState) matches(appID, platform, version, buildNumber string, info os.FileInfo) error {
+	switch {
+	case s.AppID != appID:
+		return fmt.Errorf("saved upload is for app %s", s.AppID)
+	case s.Platform != platform:
+		return fmt.Errorf("saved upload is for platform %s", s.Platform)
+	case s.BundleVersion != version || s.BuildNumber != buildNumber:
+		return fmt.Errorf("saved upload is for version %s build %s", s.BundleVersion, s.BuildNumber)
+	case s.FileSize != info.Size() || !s.FileModTime.Equal(info.ModTime().UTC()):
+		return fmt.Errorf("file changed since the upload started")
+	}
+	return nil
+}
+
+// operationsExpired reports whether any part still to upload has a presigned
+// URL that has expired or is about to.
+func (s *buildUploadState) operationsExpired(now time.Time) bool {
+	completed := make(map[int]bool, len(s.CompletedParts))
+	for _, index := range s.CompletedParts {
+		completed[index] = true
+	}
+	for i, op := range s.Operations {
+		if completed[i] {
+			continue
+		}
+		if expiresAt, ok := uploadOperationExpiry(op); ok && !now.Add(buildUploadExpiryMargin).Before(expiresAt) {
+			return true
+		}
+	}
+	return false
+}
+
+// uploadOperationExpiry reads the expiration attribute, falling back to the
+// X-Amz-Date and X-Amz-Expires parameters of a presigned URL.
+func uploadOperationExpiry(op asc.UploadOperation) (time.Time, bool) {
+	if op.Expiration != nil {
+		if expiresAt, err := time.Parse(time.RFC3339, strings.TrimSpace(*op.Expiration)); err == nil {
+			return expiresAt, true
+		}
+	}
+	parsed, err := url.Parse(op.URL)
+	if err != nil {
+		return time.Time{}, false
+	}
+	query := parsed.Query()
+	signedAt, err := time.Parse("20060102T150405Z", query.Get("X-Amz-Date"))
+	if err != nil {
+		return time.Time{}, false
+	}
+	seconds, err := strconv.Atoi(query.Get("X-Amz-Expires"))
+	if err != nil {
+		return time.Time{}, false
+	}
+	return signedAt.Add(time.Duration(seconds) * time.Second), true
+}
+
+// replaceOperations swaps in freshly issued upload operations. The part
+// layout must match so completed part indexes still refer to the same bytes.
+func (s *buildUploadState) replaceOperations(operations []asc.UploadOperation) error {
+	if len(operations) != len(s.Operations) {
+		return fmt.Errorf("upload now has %d parts, saved state has %d", len(operations), len(s.Operations))
+	}
+	for i, op := range operations {
+		if op.Offset != s.Operations[i].Offset || op.Length != s.Operations[i].Length {
+			return fmt.Errorf("upload part %d no longer covers the same bytes", i)
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.Operations = operations
+	return s.saveLocked()
+}
+
+func (s *buildUploadState) markCompleted(index int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, existing := range s.CompletedParts {
+		if existing == index {
+			return nil
+		}
+	}
+	s.CompletedParts = append(s.CompletedParts, index)
+	sort.Ints(s.CompletedParts)
+	return s.saveLocked()
+}
+
+func (s *buildUploadState) save() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.saveLocked()
+}
+
+func (s *buildUploadState) saveLocked() error {
+	if s.CompletedParts == nil {
+		s.CompletedParts = []int{}
+	}
+	s.UpdatedAt = time.Now().UTC()
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	return shared.WriteFileAtomic(s.path, append(data, '\n'), 0o600)
+}
+
+func (s *buildUploadState) remove() error {
+	if err := os.Remove(s.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
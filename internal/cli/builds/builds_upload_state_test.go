@@ -0,0 +1,61 @@
+package builds
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestUploadOperationExpiry(t *testing.T) {
+	expiration := "2026-01-02T03:04:05Z"
+	tests := []struct {
+		name string
+		op   asc.UploadOperation
+		want time.Time
+		ok   bool
+	}{
+		{
+			name: "expiration attribute",
+			op:   asc.UploadOperation{URL: "https://example.com/part", Expiration: &expiration},
+			want: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+			ok:   true,
+		},
+		{
+			name: "presigned url",
+			op:   asc.UploadOperation{URL: "https://example.com/part?X-Amz-Date=20260102T030405Z&X-Amz-Expires=3600"},
+			want: time.Date(2026, 1, 2, 4, 4, 5, 0, time.UTC),
+			ok:   true,
+		},
+		{
+			name: "unknown",
+			op:   asc.UploadOperation{URL: "https://example.com/part"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, ok := uploadOperationExpiry(test.op)
+			if ok != test.ok || !got.Equal(test.want) {
+				t.Fatalf("uploadOperationExpiry() = %v, %v; want %v, %v", got, ok, test.want, test.ok)
+			}
+		})
+	}
+}
+
+func TestBuildUploadStateOperationsExpiredIgnoresCompletedParts(t *testing.T) {
+	past := "2020-01-01T00:00:00Z"
+	state := &buildUploadState{
+		Operations: []asc.UploadOperation{
+			{URL: "https://example.com/0", Expiration: &past},
+			{URL: "https://example.com/1"},
+		},
+		CompletedParts: []int{0},
+	}
+	if state.operationsExpired(time.Now()) {
+		t.Fatal("expected completed expired parts to be ignored")
+	}
+	state.CompletedParts = nil
+	if !state.operationsExpired(time.Now()) {
+		t.Fatal("expected pending expired part to be reported")
+	}
+}
@@ -0,0 +1,416 @@
+package builds
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	validateSeverityError   = "error"
+	validateSeverityWarning = "warning"
+)
+
+var bundleVersionPattern = regexp.MustCompile(`^[0-9]+(\.[0-9]+){0,2}$`)
+
+// BuildsValidateCommand returns the builds validate subcommand.
+func BuildsValidateCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("validate", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env; default: resolved from the IPA bundle ID)")
+	ipaPath := fs.String("ipa", "", "Path to .ipa file (required)")
+	platform := fs.String("platform", "IOS", "Platform: IOS, TV_OS, VISION_OS")
+	offline := fs.Bool("offline", false, "Only run local checks; skip App Store Connect lookups")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "validate",
+		ShortUsage: "asc builds validate --ipa app.ipa [flags]",
+		ShortHelp:  "Pre-flight check an IPA before uploading.",
+		LongHelp: `Pre-flight check an IPA before uploading.
+
+Local checks:
+  - required Info.plist keys and version/build number format
+  - app executable and app icons (IOS)
+  - nested extension/watch bundle IDs are prefixed by their parent bundle ID
+  - embedded.mobileprovision matches the bundle ID, is not expired and is
+    an App Store distribution profile
+  - ITSAppUsesNonExemptEncryption is set (warning)
+
+App Store Connect checks (skipped with --offline):
+  - the bundle ID is registered
+  - the version/build number pair has not been uploaded already
+
+Issues are reported with a severity; the command exits non-zero when any
+error is found.
+
+Examples:
+  asc builds validate --ipa "app.ipa"
+  asc builds validate --app "123456789" --ipa "app.ipa" --output table
+  asc builds validate --ipa "app.ipa" --offline`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			ipaValue := strings.TrimSpace(*ipaPath)
+			if ipaValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --ipa is required")
+				return flag.ErrHelp
+			}
+			platformValue := strings.ToUpper(strings.TrimSpace(*platform))
+			switch asc.Platform(platformValue) {
+			case asc.PlatformIOS, asc.PlatformTVOS, asc.PlatformVisionOS:
+			default:
+				fmt.Fprintln(os.Stderr, "Error: --platform must be IOS, TV_OS, or VISION_OS")
+				return flag.ErrHelp
+			}
+
+			contents, err := shared.ReadIPAContents(ipaValue)
+			if err != nil {
+				return fmt.Errorf("builds validate: %w", err)
+			}
+
+			result := &asc.BuildValidateResult{
+				File:        ipaValue,
+				BundleID:    shared.PlistString(contents.InfoPlist, "CFBundleIdentifier"),
+				Version:     shared.PlistString(contents.InfoPlist, "CFBundleShortVersionString"),
+				BuildNumber: shared.PlistString(contents.InfoPlist, "CFBundleVersion"),
+				Platform:    platformValue,
+			}
+			issues := validateIPAContents(contents, platformValue, time.Now())
+
+			if !*offline {
+				client, err := getASCClient()
+				if err != nil {
+					return fmt.Errorf("builds validate: %w", err)
+				}
+
+				requestCtx, cancel := contextWithTimeout(ctx)
+				defer cancel()
+
+				result.AppID = resolveAppID(*appID)
+				remoteIssues, resolvedAppID, err := validateIPARemote(requestCtx, client, result.AppID, result.BundleID, result.Version, result.BuildNumber, platformValue)
+				if err != nil {
+					return fmt.Errorf("builds validate: %w", err)
+				}
+				result.AppID = resolvedAppID
+				issues = append(issues, remoteIssues...)
+			}
+
+			result.Issues = issues
+			for _, issue := range issues {
+				if issue.Severity == validateSeverityError {
+					result.ErrorCount++
+				} else {
+					result.WarnCount++
+				}
+			}
+			result.Valid = result.ErrorCount == 0
+
+			if err := printOutput(result, *output, *pretty); err != nil {
+				return err
+			}
+			if result.ErrorCount > 0 {
+				return shared.NewReportedError(fmt.Errorf("builds validate: %d error(s) found", result.ErrorCount))
+			}
+			return nil
+		},
+	}
+}
+
+// validateIPAContents runs the checks that only need the IPA itself.
+func validateIPAContents(contents *shared.IPAContents, platform string, now time.Time) []asc.BuildValidateIssue {
+	issues := []asc.BuildValidateIssue{}
+	add := func(severity, code, filePath, format string, args ...interface{}) {
+		issues = append(issues, asc.BuildValidateIssue{
+			Severity: severity,
+			Code:     code,
+			Message:  fmt.Sprintf(format, args...),
+			Path:     filePath,
+		})
+	}
+
+	info := contents.InfoPlist
+	infoPath := path.Join(contents.AppPath, "Info.plist")
+	requiredKeys := []string{"CFBundleIdentifier", "CFBundleExecutable", "CFBundleShortVersionString", "CFBundleVersion"}
+	if platform == string(asc.PlatformIOS) {
+		requiredKeys = append(requiredKeys, "MinimumOSVersion")
+	}
+	for _, key := range requiredKeys {
+		if shared.PlistString(info, key) == "" {
+			add(validateSeverityError, "missing-info-plist-key", infoPath, "Info.plist is missing %s", key)
+		}
+	}
+	for _, key := range []string{"CFBundleShortVersionString", "CFBundleVersion"} {
+		if value := shared.PlistString(info, key); value != "" && !bundleVersionPattern.MatchString(value) {
+			add(validateSeverityError, "invalid-version", infoPath, "%s %q must be one to three period-separated integers", key, value)
+		}
+	}
+	if executable := shared.PlistString(info, "CFBundleExecutable"); executable != "" && !containsFile(contents.Files, executable) {
+		add(validateSeverityError, "missing-executable", contents.AppPath, "executable %q not found in app bundle", executable)
+	}
+
+	if platform == string(asc.PlatformIOS) {
+		issues = append(issues, validateAppIcons(contents, infoPath)...)
+		_, hasStoryboard := info["UILaunchStoryboardName"]
+		_, hasLaunchScreen := info["UILaunchScreen"]
+		if !hasStoryboard && !hasLaunchScreen {
+			add(validateSeverityWarning, "missing-launch-screen", infoPath, "Info.plist has neither UILaunchStoryboardName nor UILaunchScreen")
+		}
+	}
+
+	if _, ok := info["ITSAppUsesNonExemptEncryption"]; !ok {
+		add(validateSeverityWarning, "missing-encryption-key", infoPath, "ITSAppUsesNonExemptEncryption is not set; export compliance must be answered for every build")
+	}
+
+	issues = append(issues, validateNestedBundles(contents)...)
+	issues = append(issues, validateEmbeddedProfile(contents, now)...)
+	return issues
+}
+
+func validateAppIcons(contents *shared.IPAContents, infoPath string) []asc.BuildValidateIssue {
+	var issues []asc.BuildValidateIssue
+	check := func(key, code, label string) {
+		icons, _ := contents.InfoPlist[key].(map[string]interface{})
+		primary, _ := icons["CFBundlePrimaryIcon"].(map[string]interface{})
+		iconName := shared.PlistString(primary, "CFBundleIconName")
+		iconFiles := plistStrings(primary["CFBundleIconFiles"])
+
+		switch {
+		case iconName == "" && len(iconFiles) == 0:
+			issues = append(issues, asc.BuildValidateIssue{
+				Severity: validateSeverityError,
+				Code:     code,
+				Message:  fmt.Sprintf("Info.plist %s has no CFBundlePrimaryIcon (%s app icon is required)", key, label),
+				Path:     infoPath,
+			})
+		case iconName != "" && !containsFile(contents.Files, "Assets.car"):
+			issues = append(issues, asc.BuildValidateIssue{
+				Severity: validateSeverityError,
+				Code:     code,
+				Message:  fmt.Sprintf("CFBundleIconName %q is set but Assets.car is missing", iconName),
+				Path:     contents.AppPath,
+			})
+		case iconName == "" && !hasIconFile(contents.Files, iconFiles):
+			issues = append(issues, asc.BuildValidateIssue{
+				Severity: validateSeverityError,
+				Code:     code,
+				Message:  fmt.Sprintf("none of the %s icon files %v are in the app bundle", label, iconFiles),
+				Path:     contents.AppPath,
+			})
+		}
+	}
+
+	check("CFBundleIcons", "missing-app-icon", "iPhone")
+	for _, family := range plistStrings(contents.InfoPlist["UIDeviceFamily"]) {
+		if family == "2" {
+			check("CFBundleIcons~ipad", "missing-ipad-icon", "iPad")
+			break
+		}
+	}
+	return issues
+}
+
+// validateNestedBundles checks that every extension or watch app bundle ID is
+// prefixed by the bundle ID of the bundle that contains it.
+func validateNestedBundles(contents *shared.IPAContents) []asc.BuildValidateIssue {
+	var issues []asc.BuildValidateIssue
+	bundleIDs := map[string]string{"": shared.PlistString(contents.InfoPlist, "CFBundleIdentifier")}
+	for _, nested := range contents.NestedBundles {
+		bundleIDs[nested.Path] = shared.PlistString(nested.InfoPlist, "CFBundleIdentifier")
+	}
+	version := shared.PlistString(contents.InfoPlist, "CFBundleShortVersionString")
+	build := shared.PlistString(contents.InfoPlist, "CFBundleVersion")
+
+	for _, nested := range contents.NestedBundles {
+		nestedPath := path.Join(contents.AppPath, nested.Path)
+		bundleID := bundleIDs[nested.Path]
+		if bundleID == "" {
+			issues = append(issues, asc.BuildValidateIssue{
+				Severity: validateSeverityError,
+				Code:     "missing-info-plist-key",
+				Message:  "nested bundle Info.plist is missing CFBundleIdentifier",
+				Path:     nestedPath,
+			})
+			continue
+		}
+
+		parentID := bundleIDs[parentBundlePath(nested.Path, bundleIDs)]
+		if parentID != "" && !strings.HasPrefix(bundleID, parentID+".") {
+			issues = append(issues, asc.BuildValidateIssue{
+				Severity: validateSeverityError,
+				Code:     "invalid-nested-bundle-id",
+				Message:  fmt.Sprintf("bundle ID %q must be prefixed with %q", bundleID, parentID+"."),
+				Path:     nestedPath,
+			})
+		}
+
+		nestedVersion := shared.PlistString(nested.InfoPlist, "CFBundleShortVersionString")
+		nestedBuild := shared.PlistString(nested.InfoPlist, "CFBundleVersion")
+		if (nestedVersion != "" && nestedVersion != version) || (nestedBuild != "" && nestedBuild != build) {
+			issues = append(issues, asc.BuildValidateIssue{
+				Severity: validateSeverityWarning,
+				Code:     "nested-version-mismatch",
+				Message:  fmt.Sprintf("version %s (%s) does not match the app's %s (%s)", nestedVersion, nestedBuild, version, build),
+				Path:     nestedPath,
+			})
+		}
+	}
+	return issues
+}
+
+// parentBundlePath returns the closest enclosing nested bundle, or "" for the main app.
+func parentBundlePath(bundlePath string, bundles map[string]string) string {
+	for dir := path.Dir(bundlePath); dir != "." && dir != "/"; dir = path.Dir(dir) {
+		if _, ok := bundles[dir]; ok {
+			return dir
+		}
+	}
+	return ""
+}
+
+func validateEmbeddedProfile(contents *shared.IPAContents, now time.Time) []asc.BuildValidateIssue {
+	profilePath := path.Join(contents.AppPath, "embedded.mobileprovision")
+	issue := func(code, format string, args ...interface{}) asc.BuildValidateIssue {
+		return asc.BuildValidateIssue{
+			Severity: validateSeverityError,
+			Code:     code,
+			Message:  fmt.Sprintf(format, args...),
+			Path:     profilePath,
+		}
+	}
+
+	if len(contents.EmbeddedProfile) == 0 {
+		return []asc.BuildValidateIssue{issue("missing-provisioning-profile", "embedded.mobileprovision not found in app bundle")}
+	}
+	profile, err := shared.ParseProvisioningProfile(contents.EmbeddedProfile)
+	if err != nil {
+		return []asc.BuildValidateIssue{issue("invalid-provisioning-profile", "%v", err)}
+	}
+
+	var issues []asc.BuildValidateIssue
+	if !profile.ExpirationDate.IsZero() && !profile.ExpirationDate.After(now) {
+		issues = append(issues, issue("expired-provisioning-profile", "profile %q expired on %s", profile.Name, profile.ExpirationDate.UTC().Format(time.RFC3339)))
+	}
+	bundleID := shared.PlistString(contents.InfoPlist, "CFBundleIdentifier")
+	if appID := profile.ApplicationIdentifier(); bundleID != "" && !shared.ApplicationIdentifierMatches(appID, bundleID) {
+		issues = append(issues, issue("profile-bundle-id-mismatch", "profile application-identifier %q does not match bundle ID %q", appID, bundleID))
+	}
+	if profile.Distribution() != shared.ProfileDistributionStore {
+		issues = append(issues, issue("non-distribution-profile", "profile %q is a development, ad hoc or enterprise profile; uploads require an App Store profile", profile.Name))
+	}
+	return issues
+}
+
+// validateIPARemote checks the bundle ID registration and build number
+// uniqueness. It returns the app ID used, resolving it from the bundle ID
+// when none was given.
+func validateIPARemote(ctx context.Context, client *asc.Client, appID, bundleID, version, buildNumber, platform string) ([]asc.BuildValidateIssue, string, error) {
+	var issues []asc.BuildValidateIssue
+	if bundleID == "" {
+		return issues, appID, nil
+	}
+
+	bundleResp, err := client.GetBundleIDs(ctx, asc.WithBundleIDsFilterIdentifier(bundleID))
+	if err != nil {
+		return nil, appID, fmt.Errorf("failed to fetch bundle IDs: %w", err)
+	}
+	registered := false
+	for _, item := range bundleResp.Data {
+		if item.Attributes.Identifier == bundleID {
+			registered = true
+			break
+		}
+	}
+	if !registered {
+		issues = append(issues, asc.BuildValidateIssue{
+			Severity: validateSeverityError,
+			Code:     "bundle-id-not-registered",
+			Message:  fmt.Sprintf("bundle ID %q is not registered (see asc bundle-ids create)", bundleID),
+		})
+	}
+
+	if appID == "" {
+		appsResp, err := client.GetApps(ctx, asc.WithAppsBundleIDs([]string{bundleID}))
+		if err != nil {
+			return nil, appID, fmt.Errorf("failed to fetch apps: %w", err)
+		}
+		for _, app := range appsResp.Data {
+			if app.Attributes.BundleID == bundleID {
+				appID = app.ID
+				break
+			}
+		}
+		if appID == "" {
+			issues = append(issues, asc.BuildValidateIssue{
+				Severity: validateSeverityError,
+				Code:     "app-not-found",
+				Message:  fmt.Sprintf("no App Store Connect app uses bundle ID %q", bundleID),
+			})
+			return issues, appID, nil
+		}
+	}
+
+	if version == "" || buildNumber == "" {
+		return issues, appID, nil
+	}
+	existing, err := shared.FindBuildByNumber(ctx, client, appID, version, buildNumber, platform)
+	if err != nil {
+		return nil, appID, fmt.Errorf("failed to check existing builds: %w", err)
+	}
+	if existing != nil {
+		issues = append(issues, asc.BuildValidateIssue{
+			Severity: validateSeverityError,
+			Code:     "duplicate-build",
+			Message:  fmt.Sprintf("version %s build %s was already uploaded (build %s); increment CFBundleVersion", version, buildNumber, existing.Data.ID),
+		})
+	}
+	return issues, appID, nil
+}
+
+func containsFile(files []string, name string) bool {
+	for _, file := range files {
+		if file == name {
+			return true
+		}
+	}
+	return false
+}
+
+// hasIconFile reports whether any top-level file starts with one of the icon
+// base names (e.g. AppIcon60x60 matches AppIcon60x60@2x.png).
+func hasIconFile(files, iconFiles []string) bool {
+	for _, file := range files {
+		if strings.Contains(file, "/") {
+			continue
+		}
+		for _, icon := range iconFiles {
+			if icon != "" && strings.HasPrefix(file, icon) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func plistStrings(value interface{}) []string {
+	items, ok := value.([]interface{})
+	if !ok {
+		return nil
+	}
+	values := make([]string, 0, len(items))
+	for _, item := range items {
+		values = append(values, strings.TrimSpace(fmt.Sprint(item)))
+	}
+	return values
+}
@@ -0,0 +1,135 @@
+package builds
+
+import (
+	"context"
+	"flag"
+	"testing"
+	"time"
+
+	"howett.net/plist"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+func TestBuildsValidateCommand_MissingIPA(t *testing.T) {
+	cmd := BuildsValidateCommand()
+	if err := cmd.FlagSet.Parse([]string{}); err != nil {
+		t.Fatalf("failed to parse flags: %v", err)
+	}
+	if err := cmd.Exec(context.Background(), []string{}); err != flag.ErrHelp {
+		t.Fatalf("expected flag.ErrHelp, got %v", err)
+	}
+}
+
+func TestValidateIPAContents_Valid(t *testing.T) {
+	contents := validTestIPAContents(t)
+
+	issues := validateIPAContents(contents, "IOS", time.Now())
+	if len(issues) != 0 {
+		t.Fatalf("expected no issues, got %+v", issues)
+	}
+}
+
+func TestValidateIPAContents_ReportsProblems(t *testing.T) {
+	contents := validTestIPAContents(t)
+	delete(contents.InfoPlist, "ITSAppUsesNonExemptEncryption")
+	delete(contents.InfoPlist, "CFBundleIcons")
+	contents.InfoPlist["CFBundleVersion"] = "12a"
+	contents.NestedBundles = append(contents.NestedBundles, shared.IPANestedBundle{
+		Path: "PlugIns/Share.appex",
+		InfoPlist: map[string]interface{}{
+			"CFBundleIdentifier":         "com.other.share",
+			"CFBundleShortVersionString": "1.2.3",
+			"CFBundleVersion":            "12a",
+		},
+	})
+	contents.EmbeddedProfile = testProfilePlist(t, "TEAM123456.com.other.app", time.Now().Add(-time.Hour), []string{"UDID-1"})
+
+	issues := validateIPAContents(contents, "IOS", time.Now())
+	codes := map[string]string{}
+	for _, issue := range issues {
+		codes[issue.Code] = issue.Severity
+	}
+	want := map[string]string{
+		"missing-encryption-key":       "warning",
+		"missing-app-icon":             "error",
+		"invalid-version":              "error",
+		"invalid-nested-bundle-id":     "error",
+		"expired-provisioning-profile": "error",
+		"profile-bundle-id-mismatch":   "error",
+		"non-distribution-profile":     "error",
+	}
+	for code, severity := range want {
+		if codes[code] != severity {
+			t.Fatalf("expected %s %s issue, got %+v", severity, code, issues)
+		}
+	}
+}
+
+func TestValidateNestedBundles_WatchExtensionUsesWatchAppPrefix(t *testing.T) {
+	contents := validTestIPAContents(t)
+	contents.NestedBundles = []shared.IPANestedBundle{
+		{Path: "Watch/Watch.app", InfoPlist: map[string]interface{}{"CFBundleIdentifier": "com.example.app.watchkitapp"}},
+		{Path: "Watch/Watch.app/PlugIns/Ext.appex", InfoPlist: map[string]interface{}{"CFBundleIdentifier": "com.example.app.watchkitapp.ext"}},
+		{Path: "PlugIns/Bad.appex", InfoPlist: map[string]interface{}{"CFBundleIdentifier": "com.example.application.bad"}},
+	}
+
+	issues := validateNestedBundles(contents)
+	if len(issues) != 1 || issues[0].Path != "Payload/Demo.app/PlugIns/Bad.appex" {
+		t.Fatalf("expected one issue for Bad.appex, got %+v", issues)
+	}
+}
+
+func validTestIPAContents(t *testing.T) *shared.IPAContents {
+	t.Helper()
+	return &shared.IPAContents{
+		AppPath: "Payload/Demo.app",
+		InfoPlist: map[string]interface{}{
+			"CFBundleIdentifier":            "com.example.app",
+			"CFBundleExecutable":            "Demo",
+			"CFBundleShortVersionString":    "1.2.3",
+			"CFBundleVersion":               "45",
+			"MinimumOSVersion":              "16.0",
+			"UILaunchStoryboardName":        "LaunchScreen",
+			"ITSAppUsesNonExemptEncryption": false,
+			"CFBundleIcons": map[string]interface{}{
+				"CFBundlePrimaryIcon": map[string]interface{}{
+					"CFBundleIconName":  "AppIcon",
+					"CFBundleIconFiles": []interface{}{"AppIcon60x60"},
+				},
+			},
+		},
+		Files: []string{"Demo", "Info.plist", "Assets.car", "AppIcon60x60@2x.png", "embedded.mobileprovision"},
+		NestedBundles: []shared.IPANestedBundle{
+			{
+				Path: "PlugIns/Widget.appex",
+				InfoPlist: map[string]interface{}{
+					"CFBundleIdentifier":         "com.example.app.widget",
+					"CFBundleShortVersionString": "1.2.3",
+					"CFBundleVersion":            "45",
+				},
+			},
+		},
+		EmbeddedProfile: testProfilePlist(t, "TEAM123456.com.example.app", time.Now().Add(24*time.Hour), nil),
+	}
+}
+
+func testProfilePlist(t *testing.T, applicationIdentifier string, expires time.Time, devices []string) []byte {
+	t.Helper()
+	values := map[string]interface{}{
+		"Name":           "Example App Store",
+		"UUID":           "11111111-2222-3333-4444-555555555555",
+		"ExpirationDate": expires.UTC(),
+		"Entitlements": map[string]interface{}{
+			"application-identifier": applicationIdentifier,
+		},
+	}
+	if len(devices) > 0 {
+		values["ProvisionedDevices"] = devices
+	}
+	data, err := plist.Marshal(values, plist.XMLFormat)
+	if err != nil {
+		t.Fatalf("marshal plist: %v", err)
+	}
+	return data
+}
@@ -29,6 +29,7 @@ Examples:
   asc certificates list
   asc certificates list --certificate-type IOS_DISTRIBUTION
   asc certificates create --certificate-type IOS_DISTRIBUTION --csr "./cert.csr"
+  asc certificates create --certificate-type IOS_DISTRIBUTION --generate-key --p12 "./dist.p12"
   asc certificates revoke --id "CERT_ID" --confirm`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
@@ -125,17 +126,29 @@ func CertificatesCreateCommand() *ffcli.Command {
 
 	certificateType := fs.String("certificate-type", "", "Certificate type (e.g., IOS_DISTRIBUTION)")
 	csrPath := fs.String("csr", "", "CSR file path")
+	generateKey := fs.Bool("generate-key", false, "Generate a private key and CSR locally instead of using --csr")
+	commonName := fs.String("common-name", defaultCommonName, "CSR common name (with --generate-key)")
+	email := fs.String("email", "", "CSR email address (with --generate-key)")
+	keySize := fs.Int("key-size", defaultKeySize, "RSA key size in bits (with --generate-key)")
+	p12Path := fs.String("p12", "", "Output path for the PKCS#12 file (with --generate-key)")
+	p12Password := fs.String("p12-password", "", "PKCS#12 password (or set "+p12PasswordEnvVar+")")
 	output := fs.String("output", "json", "Output format: json (default), table, markdown")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
 		Name:       "create",
-		ShortUsage: "asc certificates create --certificate-type TYPE --csr ./cert.csr",
+		ShortUsage: "asc certificates create --certificate-type TYPE (--csr ./cert.csr | --generate-key --p12 ./cert.p12)",
 		ShortHelp:  "Create a signing certificate.",
 		LongHelp: `Create a signing certificate.
 
+Use --csr to submit an existing certificate signing request, or --generate-key
+to create an RSA private key and CSR locally. With --generate-key the issued
+certificate and private key are written to a password-protected PKCS#12 file.
+
 Examples:
-  asc certificates create --certificate-type IOS_DISTRIBUTION --csr "./cert.csr"`,
+  asc certificates create --certificate-type IOS_DISTRIBUTION --csr "./cert.csr"
+  asc certificates create --certificate-type IOS_DISTRIBUTION --generate-key --p12 "./dist.p12" --p12-password "secret"
+  ASC_P12_PASSWORD=secret asc certificates create --certificate-type DISTRIBUTION --generate-key --p12 "./dist.p12" --common-name "CI"`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -145,8 +158,28 @@ Examples:
 				return flag.ErrHelp
 			}
 			csrValue := strings.TrimSpace(*csrPath)
+			if *generateKey {
+				if csrValue != "" {
+					fmt.Fprintln(os.Stderr, "Error: --csr and --generate-key are mutually exclusive")
+					return flag.ErrHelp
+				}
+				return createCertificateWithGeneratedKey(ctx, generatedCertificateOptions{
+					CertificateType: certificateValue,
+					CommonName:      *commonName,
+					Email:           *email,
+					KeySize:         *keySize,
+					P12Path:         strings.TrimSpace(*p12Path),
+					P12Password:     resolveP12Password(*p12Password),
+					Output:          *output,
+					Pretty:          *pretty,
+				})
+			}
 			if csrValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --csr is required")
+				fmt.Fprintln(os.Stderr, "Error: --csr is required (or use --generate-key)")
+				return flag.ErrHelp
+			}
+			if strings.TrimSpace(*p12Path) != "" {
+				fmt.Fprintln(os.Stderr, "Error: --p12 requires --generate-key")
 				return flag.ErrHelp
 			}
 
@@ -173,6 +206,77 @@ Examples:
 	}
 }
 
+type generatedCertificateOptions struct {
+	CertificateType string
+	CommonName      string
+	Email           string
+	KeySize         int
+	P12Path         string
+	P12Password     string
+	Output          string
+	Pretty          bool
+}
+
+func createCertificateWithGeneratedKey(ctx context.Context, opts generatedCertificateOptions) error {
+	if opts.P12Path == "" {
+		fmt.Fprintln(os.Stderr, "Error: --p12 is required with --generate-key")
+		return flag.ErrHelp
+	}
+	if opts.P12Password == "" {
+		fmt.Fprintf(os.Stderr, "Error: --p12-password is required with --generate-key (or set %s)\n", p12PasswordEnvVar)
+		return flag.ErrHelp
+	}
+	if opts.KeySize < 2048 {
+		return fmt.Errorf("certificates create: --key-size must be at least 2048")
+	}
+	if err := ensureP12Writable(opts.P12Path); err != nil {
+		return fmt.Errorf("certificates create: %w", err)
+	}
+
+	generated, err := generateKeyAndCSR(opts.CommonName, opts.Email, opts.KeySize)
+	if err != nil {
+		return fmt.Errorf("certificates create: %w", err)
+	}
+
+	client, err := getASCClient()
+	if err != nil {
+		return fmt.Errorf("certificates create: %w", err)
+	}
+
+	requestCtx, cancel := contextWithTimeout(ctx)
+	defer cancel()
+
+	resp, err := client.CreateCertificate(requestCtx, generated.CSRContent(), opts.CertificateType)
+	if err != nil {
+		return fmt.Errorf("certificates create: failed to create: %w", err)
+	}
+
+	// The private key only exists in memory at this point, so name the
+	// certificate in any error to let the caller revoke it.
+	certificateDER, err := decodeCertificateContent(resp.Data.Attributes.CertificateContent)
+	if err != nil {
+		return fmt.Errorf("certificates create: certificate %s: %w", resp.Data.ID, err)
+	}
+	p12Data, err := encodeP12(generated.PrivateKey, certificateDER, opts.P12Password)
+	if err != nil {
+		return fmt.Errorf("certificates create: certificate %s: %w", resp.Data.ID, err)
+	}
+	if err := writeP12File(opts.P12Path, p12Data); err != nil {
+		return fmt.Errorf("certificates create: certificate %s: failed to write p12: %w", resp.Data.ID, err)
+	}
+
+	result := &asc.CertificateP12Result{
+		ID:              resp.Data.ID,
+		Name:            resp.Data.Attributes.Name,
+		CertificateType: resp.Data.Attributes.CertificateType,
+		SerialNumber:    resp.Data.Attributes.SerialNumber,
+		ExpirationDate:  resp.Data.Attributes.ExpirationDate,
+		P12Path:         opts.P12Path,
+	}
+
+	return printOutput(result, opts.Output, opts.Pretty)
+}
+
 // CertificatesRevokeCommand returns the certificates revoke subcommand.
 func CertificatesRevokeCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("revoke", flag.ExitOnError)
@@ -0,0 +1,131 @@
+package certificates
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	pkcs12 "software.sslmate.com/src/go-pkcs12"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	defaultKeySize    = 2048
+	defaultCommonName = "App Store Connect CLI"
+	p12PasswordEnvVar = "ASC_P12_PASSWORD"
+)
+
+// generatedKey holds a locally generated private key and its CSR.
+type generatedKey struct {
+	PrivateKey *rsa.PrivateKey
+	CSR        []byte
+}
+
+// CSRContent returns the base64-encoded DER CSR expected by the API.
+func (g *generatedKey) CSRContent() string {
+	return base64.StdEncoding.EncodeToString(g.CSR)
+}
+
+// generateKeyAndCSR creates an RSA private key and a PKCS#10 CSR signed by it.
+func generateKeyAndCSR(commonName, email string, keySize int) (*generatedKey, error) {
+	if keySize < 2048 {
+		return nil, fmt.Errorf("key size must be at least 2048 bits")
+	}
+	commonName = strings.TrimSpace(commonName)
+	if commonName == "" {
+		commonName = defaultCommonName
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, keySize)
+	if err != nil {
+		return nil, fmt.Errorf("generate private key: %w", err)
+	}
+
+	template := &x509.CertificateRequest{
+		Subject:            pkix.Name{CommonName: commonName},
+		SignatureAlgorithm: x509.SHA256WithRSA,
+	}
+	if email = strings.TrimSpace(email); email != "" {
+		template.EmailAddresses = []string{email}
+	}
+
+	csr, err := x509.CreateCertificateRequest(rand.Reader, template, key)
+	if err != nil {
+		return nil, fmt.Errorf("create CSR: %w", err)
+	}
+
+	return &generatedKey{PrivateKey: key, CSR: csr}, nil
+}
+
+// resolveP12Password returns the flag value or falls back to ASC_P12_PASSWORD.
+func resolveP12Password(value string) string {
+	if value != "" {
+		return value
+	}
+	return os.Getenv(p12PasswordEnvVar)
+}
+
+// encodeP12 bundles the certificate and private key into a PKCS#12 archive.
+// The legacy 3DES encoding is used so the file imports into older macOS
+// keychains as well as OpenSSL and Java.
+func encodeP12(key *rsa.PrivateKey, certificateDER []byte, password string) ([]byte, error) {
+	cert, err := x509.ParseCertificate(certificateDER)
+	if err != nil {
+		return nil, fmt.Errorf("parse certificate: %w", err)
+	}
+	if !key.PublicKey.Equal(cert.PublicKey) {
+		return nil, fmt.Errorf("certificate public key does not match generated private key")
+	}
+
+	data, err := pkcs12.LegacyDES.Encode(key, cert, nil, password)
+	if err != nil {
+		return nil, fmt.Errorf("encode PKCS#12: %w", err)
+	}
+	return data, nil
+}
+
+func decodeCertificateContent(content string) ([]byte, error) {
+	trimmed := strings.TrimSpace(content)
+	if trimmed == "" {
+		return nil, fmt.Errorf("certificate content is empty")
+	}
+	data, err := base64.StdEncoding.DecodeString(trimmed)
+	if err != nil {
+		return nil, fmt.Errorf("decode certificate content: %w", err)
+	}
+	return data, nil
+}
+
+// ensureP12Writable fails early, before a certificate is created, when the
+// output path already exists.
+func ensureP12Writable(path string) error {
+	if _, err := os.Lstat(path); err == nil {
+		return fmt.Errorf("output file already exists: %s", path)
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func writeP12File(path string, data []byte) error {
+	file, err := shared.OpenNewFileNoFollow(path, 0o600)
+	if err != nil {
+		if errors.Is(err, os.ErrExist) {
+			return fmt.Errorf("output file already exists: %w", err)
+		}
+		return err
+	}
+	defer file.Close()
+
+	if _, err := file.Write(data); err != nil {
+		return err
+	}
+	return file.Sync()
+}
@@ -2,8 +2,20 @@ package certificates
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
 	"flag"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
+
+	pkcs12 "software.sslmate.com/src/go-pkcs12"
 )
 
 func TestCertificatesCreateCommand_MissingType(t *testing.T) {
@@ -53,3 +65,138 @@ func TestCertificatesRevokeCommand_MissingConfirm(t *testing.T) {
 		t.Fatalf("expected flag.ErrHelp when --confirm is missing, got %v", err)
 	}
 }
+
+func TestCertificatesCreateCommand_CSRAndGenerateKeyConflict(t *testing.T) {
+	cmd := CertificatesCreateCommand()
+
+	if err := cmd.FlagSet.Parse([]string{"--certificate-type", "IOS_DISTRIBUTION", "--csr", "./cert.csr", "--generate-key"}); err != nil {
+		t.Fatalf("failed to parse flags: %v", err)
+	}
+
+	if err := cmd.Exec(context.Background(), []string{}); err != flag.ErrHelp {
+		t.Fatalf("expected flag.ErrHelp when --csr and --generate-key are both set, got %v", err)
+	}
+}
+
+func TestCertificatesCreateCommand_GenerateKeyMissingP12(t *testing.T) {
+	cmd := CertificatesCreateCommand()
+
+	if err := cmd.FlagSet.Parse([]string{"--certificate-type", "IOS_DISTRIBUTION", "--generate-key", "--p12-password", "secret"}); err != nil {
+		t.Fatalf("failed to parse flags: %v", err)
+	}
+
+	if err := cmd.Exec(context.Background(), []string{}); err != flag.ErrHelp {
+		t.Fatalf("expected flag.ErrHelp when --p12 is missing, got %v", err)
+	}
+}
+
+func TestCertificatesCreateCommand_GenerateKeyMissingPassword(t *testing.T) {
+	t.Setenv(p12PasswordEnvVar, "")
+	cmd := CertificatesCreateCommand()
+
+	if err := cmd.FlagSet.Parse([]string{"--certificate-type", "IOS_DISTRIBUTION", "--generate-key", "--p12", filepath.Join(t.TempDir(), "cert.p12")}); err != nil {
+		t.Fatalf("failed to parse flags: %v", err)
+	}
+
+	if err := cmd.Exec(context.Background(), []string{}); err != flag.ErrHelp {
+		t.Fatalf("expected flag.ErrHelp when --p12-password is missing, got %v", err)
+	}
+}
+
+func TestCertificatesCreateCommand_GenerateKeyExistingP12(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cert.p12")
+	if err := os.WriteFile(path, []byte("existing"), 0o600); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+	cmd := CertificatesCreateCommand()
+
+	if err := cmd.FlagSet.Parse([]string{"--certificate-type", "IOS_DISTRIBUTION", "--generate-key", "--p12", path, "--p12-password", "secret"}); err != nil {
+		t.Fatalf("failed to parse flags: %v", err)
+	}
+
+	err := cmd.Exec(context.Background(), []string{})
+	if err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Fatalf("expected existing file error, got %v", err)
+	}
+}
+
+func TestGenerateKeyAndCSR(t *testing.T) {
+	generated, err := generateKeyAndCSR("CI Signing", "ci@example.com", 2048)
+	if err != nil {
+		t.Fatalf("generateKeyAndCSR() error: %v", err)
+	}
+
+	csr, err := x509.ParseCertificateRequest(generated.CSR)
+	if err != nil {
+		t.Fatalf("parse CSR: %v", err)
+	}
+	if err := csr.CheckSignature(); err != nil {
+		t.Fatalf("CSR signature invalid: %v", err)
+	}
+	if csr.Subject.CommonName != "CI Signing" {
+		t.Fatalf("expected common name %q, got %q", "CI Signing", csr.Subject.CommonName)
+	}
+	if len(csr.EmailAddresses) != 1 || csr.EmailAddresses[0] != "ci@example.com" {
+		t.Fatalf("unexpected email addresses %v", csr.EmailAddresses)
+	}
+	if !generated.PrivateKey.PublicKey.Equal(csr.PublicKey) {
+		t.Fatal("CSR public key does not match private key")
+	}
+	if _, err := base64.StdEncoding.DecodeString(generated.CSRContent()); err != nil {
+		t.Fatalf("CSR content is not base64: %v", err)
+	}
+
+	if _, err := generateKeyAndCSR("", "", 1024); err == nil {
+		t.Fatal("expected error for key size below 2048")
+	}
+}
+
+func TestEncodeP12RoundTrip(t *testing.T) {
+	generated, err := generateKeyAndCSR("", "", 2048)
+	if err != nil {
+		t.Fatalf("generateKeyAndCSR() error: %v", err)
+	}
+	certDER := selfSignedCertificate(t, generated.PrivateKey)
+
+	data, err := encodeP12(generated.PrivateKey, certDER, "secret")
+	if err != nil {
+		t.Fatalf("encodeP12() error: %v", err)
+	}
+
+	key, cert, err := pkcs12.Decode(data, "secret")
+	if err != nil {
+		t.Fatalf("decode p12: %v", err)
+	}
+	if !generated.PrivateKey.Equal(key) {
+		t.Fatal("decoded key does not match")
+	}
+	if cert.Subject.CommonName != "Test Certificate" {
+		t.Fatalf("unexpected certificate subject %q", cert.Subject.CommonName)
+	}
+	if _, _, err := pkcs12.Decode(data, "wrong"); err == nil {
+		t.Fatal("expected error decoding with wrong password")
+	}
+
+	other, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	if _, err := encodeP12(other, certDER, "secret"); err == nil {
+		t.Fatal("expected error for mismatched key")
+	}
+}
+
+func selfSignedCertificate(t *testing.T, key *rsa.PrivateKey) []byte {
+	t.Helper()
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "Test Certificate"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create certificate: %v", err)
+	}
+	return der
+}
@@ -0,0 +1,358 @@
+package cmdtest
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+type analyticsFetchRoundTripper func(*http.Request) (*http.Response, error)
+
+func (f analyticsFetchRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// installAnalyticsFetchTransport serves API and segment download requests from
+// routes keyed by "METHOD host/path". Segment URLs must be on an allowed
+// Apple host over HTTPS, so they cannot be served by httptest.
+func installAnalyticsFetchTransport(t *testing.T, routes map[string]func(*http.Request) (int, []byte)) *[]string {
+	t.Helper()
+	var calls []string
+	original := http.DefaultTransport
+	http.DefaultTransport = analyticsFetchRoundTripper(func(req *http.Request) (*http.Response, error) {
+		key := req.Method + " " + req.URL.Host + req.URL.Path
+		calls = append(calls, key)
+		status, body := http.StatusNotFound, []byte(`{"errors":[{"status":"404","code":"NOT_FOUND","title":"Not found"}]}`)
+		if handler, ok := routes[key]; ok {
+			status, body = handler(req)
+		}
+		return &http.Response{
+			StatusCode: status,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(bytes.NewReader(body)),
+			Request:    req,
+		}, nil
+	})
+	t.Cleanup(func() { http.DefaultTransport = original })
+	return &calls
+}
+
+func jsonRoute(body string) func(*http.Request) (int, []byte) {
+	return func(*http.Request) (int, []byte) { return http.StatusOK, []byte(body) }
+}
+
+func gzipRoute(t *testing.T, content string) func(*http.Request) (int, []byte) {
+	t.Helper()
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err := writer.Write([]byte(content)); err != nil {
+		t.Fatalf("gzip write: %v", err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("gzip close: %v", err)
+	}
+	return func(*http.Request) (int, []byte) { return http.StatusOK, buf.Bytes() }
+}
+
+type analyticsFetchOutput struct {
+	RequestID      string `json:"requestId"`
+	RequestCreated bool   `json:"requestCreated"`
+	Manifest       string `json:"manifest"`
+	Files          []struct {
+		Name        string `json:"name"`
+		Granularity string `json:"granularity"`
+		File        string `json:"file"`
+		Rows        int    `json:"rows"`
+		SHA256      string `json:"sha256"`
+		Instances   []struct {
+			ID       string   `json:"id"`
+			Segments []string `json:"segments"`
+		} `json:"instances"`
+	} `json:"files"`
+	Failures []struct {
+		Name  string `json:"name"`
+		File  string `json:"file"`
+		Error string `json:"error"`
+	} `json:"failures"`
+}
+
+func runAnalyticsFetch(t *testing.T, args ...string) (analyticsFetchOutput, string) {
+	t.Helper()
+	root := RootCommand("1.2.3")
+	var runErr error
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse(append([]string{"analytics", "fetch"}, args...)); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr != nil {
+		t.Fatalf("run error: %v", runErr)
+	}
+	var result analyticsFetchOutput
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	return result, stderr
+}
+
+func TestAnalyticsFetchMergesSegmentsPerGranularity(t *testing.T) {
+	setupServerEnv(t, "https://api.asc.test")
+	header := "Date\tApp Name\tTerritory\tSessions\n"
+	installAnalyticsFetchTransport(t, map[string]func(*http.Request) (int, []byte){
+		"GET api.asc.test/v1/apps/app-1/analyticsReportRequests": jsonRoute(`{"data":[
+			{"type":"analyticsReportRequests","id":"req-snapshot","attributes":{"accessType":"ONE_TIME_SNAPSHOT"}},
+			{"type":"analyticsReportRequests","id":"req-ongoing","attributes":{"accessType":"ONGOING"}}]}`),
+		"GET api.asc.test/v1/analyticsReportRequests/req-ongoing/reports": func(req *http.Request) (int, []byte) {
+			if got := req.URL.Query().Get("filter[category]"); got != "APP_USAGE" {
+				t.Errorf("expected category filter APP_USAGE, got %q", got)
+			}
+			return http.StatusOK, []byte(`{"data":[
+				{"type":"analyticsReports","id":"rep-sessions","attributes":{"name":"App Sessions","category":"APP_USAGE"}},
+				{"type":"analyticsReports","id":"rep-crashes","attributes":{"name":"App Crashes","category":"APP_USAGE"}}]}`)
+		},
+		"GET api.asc.test/v1/analyticsReports/rep-sessions/instances": jsonRoute(`{"data":[
+			{"type":"analyticsReportInstances","id":"inst-jan2","attributes":{"granularity":"DAILY","reportDate":"2025-01-02"}},
+			{"type":"analyticsReportInstances","id":"inst-jan1","attributes":{"granularity":"DAILY","reportDate":"2025-01-01"}},
+			{"type":"analyticsReportInstances","id":"inst-dec","attributes":{"granularity":"DAILY","reportDate":"2024-12-31"}},
+			{"type":"analyticsReportInstances","id":"inst-week","attributes":{"granularity":"WEEKLY","reportDate":"2025-01-06"}}]}`),
+		"GET api.asc.test/v1/analyticsReportInstances/inst-jan1/segments": jsonRoute(`{"data":[
+			{"type":"analyticsReportSegments","id":"seg-1a","attributes":{"url":"https://mzstatic.com/seg-1a.gz"}},
+			{"type":"analyticsReportSegments","id":"seg-1b","attributes":{"url":"https://mzstatic.com/seg-1b.gz"}}]}`),
+		"GET api.asc.test/v1/analyticsReportInstances/inst-jan2/segments": jsonRoute(`{"data":[
+			{"type":"analyticsReportSegments","id":"seg-2","attributes":{"url":"https://mzstatic.com/seg-2.gz"}}]}`),
+		"GET api.asc.test/v1/analyticsReportInstances/inst-week/segments": jsonRoute(`{"data":[
+			{"type":"analyticsReportSegments","id":"seg-w","attributes":{"url":"https://mzstatic.com/seg-w.gz"}}]}`),
+		"GET mzstatic.com/seg-1a.gz": gzipRoute(t, header+"2025-01-01\tExample\tUS\t10\n"),
+		"GET mzstatic.com/seg-1b.gz": gzipRoute(t, header+"2025-01-01\tExample\tGB\t3\n"),
+		"GET mzstatic.com/seg-2.gz":  gzipRoute(t, header+"2025-01-02\tExample\tUS\t12\n"),
+		"GET mzstatic.com/seg-w.gz":  gzipRoute(t, header+"2025-01-06\tExample\tUS\t70\n"),
+	})
+
+	dir := filepath.Join(t.TempDir(), "out")
+	result, stderr := runAnalyticsFetch(t, "--app", "app-1", "--category", "app_usage", "--report", "app sessions",
+		"--from", "2025-01-01", "--to", "2025-01-31", "--dir", dir)
+
+	if result.RequestID != "req-ongoing" || result.RequestCreated {
+		t.Fatalf("expected existing ongoing request, got %+v", result)
+	}
+	if len(result.Files) != 2 {
+		t.Fatalf("expected daily and weekly files, got %+v", result.Files)
+	}
+	daily := result.Files[0]
+	if daily.Granularity != "DAILY" || daily.File != "app_sessions_daily_2025-01-01_2025-01-31.csv" || daily.Rows != 3 {
+		t.Fatalf("unexpected daily file: %+v", daily)
+	}
+	if len(daily.Instances) != 2 || daily.Instances[0].ID != "inst-jan1" || strings.Join(daily.Instances[0].Segments, ",") != "seg-1a,seg-1b" {
+		t.Fatalf("expected instances in date order excluding December, got %+v", daily.Instances)
+	}
+	if result.Files[1].File != "app_sessions_weekly_2025-01-01_2025-01-31.csv" || result.Files[1].Rows != 1 {
+		t.Fatalf("unexpected weekly file: %+v", result.Files[1])
+	}
+	if !strings.Contains(stderr, "App Sessions (DAILY): 2 instances, 3 rows") {
+		t.Fatalf("expected progress on stderr, got %q", stderr)
+	}
+
+	merged, err := os.ReadFile(filepath.Join(dir, "app_sessions_daily_2025-01-01_2025-01-31.csv"))
+	if err != nil {
+		t.Fatalf("read merged csv: %v", err)
+	}
+	want := "Date,App Name,Territory,Sessions\n2025-01-01,Example,US,10\n2025-01-01,Example,GB,3\n2025-01-02,Example,US,12\n"
+	if string(merged) != want {
+		t.Fatalf("expected merged csv %q, got %q", want, merged)
+	}
+
+	manifestData, err := os.ReadFile(filepath.Join(dir, "manifest.json"))
+	if err != nil {
+		t.Fatalf("read manifest: %v", err)
+	}
+	var manifest analyticsFetchOutput
+	if err := json.Unmarshal(manifestData, &manifest); err != nil {
+		t.Fatalf("parse manifest: %v", err)
+	}
+	if len(manifest.Files) != 2 || manifest.Files[0].SHA256 == "" || manifest.Files[0].SHA256 != daily.SHA256 {
+		t.Fatalf("expected manifest to match output, got %+v", manifest.Files)
+	}
+}
+
+func TestAnalyticsFetchRecordsFailedReportsInManifest(t *testing.T) {
+	setupServerEnv(t, "https://api.asc.test")
+	header := "Date\tApp Name\tSessions\n"
+	installAnalyticsFetchTransport(t, map[string]func(*http.Request) (int, []byte){
+		"GET api.asc.test/v1/apps/app-1/analyticsReportRequests": jsonRoute(`{"data":[
+			{"type":"analyticsReportRequests","id":"req-ongoing","attributes":{"accessType":"ONGOING"}}]}`),
+		"GET api.asc.test/v1/analyticsReportRequests/req-ongoing/reports": jsonRoute(`{"data":[
+			{"type":"analyticsReports","id":"rep-crashes","attributes":{"name":"App Crashes","category":"APP_USAGE"}},
+			{"type":"analyticsReports","id":"rep-sessions","attributes":{"name":"App Sessions","category":"APP_USAGE"}}]}`),
+		"GET api.asc.test/v1/analyticsReports/rep-crashes/instances": jsonRoute(`{"data":[
+			{"type":"analyticsReportInstances","id":"inst-crashes","attributes":{"granularity":"DAILY","reportDate":"2025-01-01"}}]}`),
+		"GET api.asc.test/v1/analyticsReports/rep-sessions/instances": jsonRoute(`{"data":[
+			{"type":"analyticsReportInstances","id":"inst-sessions","attributes":{"granularity":"DAILY","reportDate":"2025-01-01"}}]}`),
+		"GET api.asc.test/v1/analyticsReportInstances/inst-crashes/segments": jsonRoute(`{"data":[
+			{"type":"analyticsReportSegments","id":"seg-ok","attributes":{"url":"https://mzstatic.com/seg-ok.gz"}},
+			{"type":"analyticsReportSegments","id":"seg-missing","attributes":{"url":"https://mzstatic.com/seg-missing.gz"}}]}`),
+		"GET api.asc.test/v1/analyticsReportInstances/inst-sessions/segments": jsonRoute(`{"data":[
+			{"type":"analyticsReportSegments","id":"seg-sessions","attributes":{"url":"https://mzstatic.com/seg-sessions.gz"}}]}`),
+		"GET mzstatic.com/seg-ok.gz":       gzipRoute(t, header+"2025-01-01\tExample\t1\n"),
+		"GET mzstatic.com/seg-sessions.gz": gzipRoute(t, header+"2025-01-01\tExample\t9\n"),
+	})
+
+	dir := t.TempDir()
+	root := RootCommand("1.2.3")
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"analytics", "fetch", "--app", "app-1", "--from", "2025-01-01", "--to", "2025-01-01", "--dir", dir}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	var reported ReportedError
+	if !errors.As(runErr, &reported) {
+		t.Fatalf("expected reported error, got %v", runErr)
+	}
+	var result analyticsFetchOutput
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if len(result.Files) != 1 || result.Files[0].Name != "App Sessions" {
+		t.Fatalf("expected only the sessions file, got %+v", result.Files)
+	}
+	if len(result.Failures) != 1 || result.Failures[0].Name != "App Crashes" || !strings.Contains(result.Failures[0].Error, "seg-missing") {
+		t.Fatalf("expected crashes failure, got %+v", result.Failures)
+	}
+
+	manifestData, err := os.ReadFile(filepath.Join(dir, "manifest.json"))
+	if err != nil {
+		t.Fatalf("read manifest: %v", err)
+	}
+	if !strings.Contains(string(manifestData), `"failures"`) {
+		t.Fatalf("expected failures in manifest, got %s", manifestData)
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("read dir: %v", err)
+	}
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	if strings.Join(names, ",") != "app_sessions_daily_2025-01-01_2025-01-01.csv,manifest.json" {
+		t.Fatalf("expected no partial or temporary files, got %v", names)
+	}
+}
+
+func TestAnalyticsFetchCreatesOngoingRequest(t *testing.T) {
+	setupServerEnv(t, "https://api.asc.test")
+	calls := installAnalyticsFetchTransport(t, map[string]func(*http.Request) (int, []byte){
+		"GET api.asc.test/v1/apps/app-1/analyticsReportRequests": jsonRoute(`{"data":[
+			{"type":"analyticsReportRequests","id":"req-stopped","attributes":{"accessType":"ONGOING","stoppedDueToInactivity":true}}]}`),
+		"POST api.asc.test/v1/analyticsReportRequests": func(req *http.Request) (int, []byte) {
+			body, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(body), `"accessType":"ONGOING"`) || !strings.Contains(string(body), `"id":"app-1"`) {
+				t.Errorf("unexpected create body: %s", body)
+			}
+			return http.StatusCreated, []byte(`{"data":{"type":"analyticsReportRequests","id":"req-new","attributes":{"accessType":"ONGOING"}}}`)
+		},
+		"GET api.asc.test/v1/analyticsReportRequests/req-new/reports": jsonRoute(`{"data":[]}`),
+	})
+
+	dir := t.TempDir()
+	result, stderr := runAnalyticsFetch(t, "--app", "app-1", "--report", "App Sessions",
+		"--from", "2025-01-01", "--to", "2025-01-31", "--dir", dir)
+
+	if result.RequestID != "req-new" || !result.RequestCreated || len(result.Files) != 0 {
+		t.Fatalf("expected new request and no files, got %+v", result)
+	}
+	if !strings.Contains(stderr, "Created ONGOING analytics report request req-new") {
+		t.Fatalf("expected creation notice, got %q", stderr)
+	}
+	if !strings.Contains(strings.Join(*calls, "\n"), "POST api.asc.test/v1/analyticsReportRequests") {
+		t.Fatalf("expected request creation, got calls %v", *calls)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "manifest.json")); err != nil {
+		t.Fatalf("expected manifest to be written: %v", err)
+	}
+}
+
+func TestAnalyticsFetchUnknownReport(t *testing.T) {
+	setupServerEnv(t, "https://api.asc.test")
+	installAnalyticsFetchTransport(t, map[string]func(*http.Request) (int, []byte){
+		"GET api.asc.test/v1/apps/app-1/analyticsReportRequests": jsonRoute(`{"data":[
+			{"type":"analyticsReportRequests","id":"req-ongoing","attributes":{"accessType":"ONGOING"}}]}`),
+		"GET api.asc.test/v1/analyticsReportRequests/req-ongoing/reports": jsonRoute(`{"data":[
+			{"type":"analyticsReports","id":"rep-crashes","attributes":{"name":"App Crashes"}}]}`),
+	})
+
+	root := RootCommand("1.2.3")
+	var runErr error
+	_, _ = captureOutput(t, func() {
+		if err := root.Parse([]string{"analytics", "fetch", "--app", "app-1", "--report", "App Sessions",
+			"--from", "2025-01-01", "--to", "2025-01-31", "--dir", t.TempDir()}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr == nil || !strings.Contains(runErr.Error(), `report "App Sessions" not found (available: App Crashes)`) {
+		t.Fatalf("expected unknown report error, got %v", runErr)
+	}
+}
+
+func TestAnalyticsFetchValidationErrors(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing app",
+			args:    []string{"analytics", "fetch", "--from", "2025-01-01", "--to", "2025-01-31", "--dir", "out"},
+			wantErr: "--app is required",
+		},
+		{
+			name:    "missing from",
+			args:    []string{"analytics", "fetch", "--app", "app-1", "--to", "2025-01-31", "--dir", "out"},
+			wantErr: "--from is required",
+		},
+		{
+			name:    "missing dir",
+			args:    []string{"analytics", "fetch", "--app", "app-1", "--from", "2025-01-01", "--to", "2025-01-31"},
+			wantErr: "--dir is required",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
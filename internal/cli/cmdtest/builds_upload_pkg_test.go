@@ -0,0 +1,137 @@
+package cmdtest
+
+import (
+	"bytes"
+	"compress/zlib"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestBuildsUploadPkgUsesMacOSAttributes(t *testing.T) {
+	pkgPath := writeUploadTestPkg(t, `<installer-gui-script minSpecVersion="1">
+  <pkg-ref id="com.example.mac"><bundle-version>
+    <bundle CFBundleShortVersionString="2.3.4" CFBundleVersion="567" id="com.example.mac" path="Example.app"/>
+  </bundle-version></pkg-ref>
+</installer-gui-script>`)
+
+	var uploadAttrs, fileAttrs map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		var body struct {
+			Data struct {
+				Attributes map[string]interface{} `json:"attributes"`
+			} `json:"data"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/buildUploads":
+			uploadAttrs = body.Data.Attributes
+			w.WriteHeader(http.StatusCreated)
+			_, _ = io.WriteString(w, `{"data":{"type":"buildUploads","id":"upload-1"}}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/buildUploadFiles":
+			fileAttrs = body.Data.Attributes
+			w.WriteHeader(http.StatusCreated)
+			_, _ = io.WriteString(w, `{"data":{"type":"buildUploadFiles","id":"file-1","attributes":{"fileName":"app.pkg","uploadOperations":[{"method":"PUT","url":"https://example.com/part","length":1,"offset":0}]}}}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.String())
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	setupServerEnv(t, server.URL)
+
+	root := RootCommand("1.2.3")
+	captureOutput(t, func() {
+		if err := root.Parse([]string{"builds", "upload", "--app", "app-1", "--pkg", pkgPath, "--dry-run"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if uploadAttrs["platform"] != "MAC_OS" || uploadAttrs["cfBundleShortVersionString"] != "2.3.4" || uploadAttrs["cfBundleVersion"] != "567" {
+		t.Fatalf("unexpected build upload attributes %v", uploadAttrs)
+	}
+	if fileAttrs["uti"] != "com.apple.pkg" || fileAttrs["fileName"] != "app.pkg" {
+		t.Fatalf("unexpected build upload file attributes %v", fileAttrs)
+	}
+}
+
+func TestBuildsUploadPkgValidationErrors(t *testing.T) {
+	pkgPath := writeUploadTestPkg(t, `<installer-gui-script/>`)
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "ipa and pkg",
+			args:    []string{"builds", "upload", "--app", "app-1", "--ipa", "app.ipa", "--pkg", pkgPath},
+			wantErr: "--ipa and --pkg are mutually exclusive",
+		},
+		{
+			name:    "pkg with iOS platform",
+			args:    []string{"builds", "upload", "--app", "app-1", "--pkg", pkgPath, "--version", "1.0", "--build-number", "1", "--platform", "IOS"},
+			wantErr: "--pkg uploads require --platform MAC_OS",
+		},
+		{
+			name:    "pkg without app bundle",
+			args:    []string{"builds", "upload", "--app", "app-1", "--pkg", pkgPath},
+			wantErr: "failed to extract from pkg",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			var runErr error
+			captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				runErr = root.Run(context.Background())
+			})
+			if runErr == nil || !strings.Contains(runErr.Error(), test.wantErr) {
+				t.Fatalf("expected error %q, got %v", test.wantErr, runErr)
+			}
+		})
+	}
+}
+
+// writeUploadTestPkg writes a xar archive holding only a Distribution file.
+func writeUploadTestPkg(t *testing.T, distribution string) string {
+	t.Helper()
+
+	toc := fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?><xar><toc><file id="1"><name>Distribution</name><type>file</type><data><length>%[1]d</length><offset>0</offset><size>%[1]d</size><encoding style="application/octet-stream"/></data></file></toc></xar>`, len(distribution))
+	var compressed bytes.Buffer
+	writer := zlib.NewWriter(&compressed)
+	_, _ = writer.Write([]byte(toc))
+	if err := writer.Close(); err != nil {
+		t.Fatalf("compress toc: %v", err)
+	}
+
+	header := make([]byte, 28)
+	copy(header, "xar!")
+	binary.BigEndian.PutUint16(header[4:6], 28)
+	binary.BigEndian.PutUint16(header[6:8], 1)
+	binary.BigEndian.PutUint64(header[8:16], uint64(compressed.Len()))
+	binary.BigEndian.PutUint64(header[16:24], uint64(len(toc)))
+
+	pkgPath := filepath.Join(t.TempDir(), "app.pkg")
+	data := append(append(header, compressed.Bytes()...), distribution...)
+	if err := os.WriteFile(pkgPath, data, 0o600); err != nil {
+		t.Fatalf("write pkg: %v", err)
+	}
+	return pkgPath
+}
@@ -0,0 +1,247 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestBuildsUploadResumeSkipsCompletedParts(t *testing.T) {
+	ipaPath := filepath.Join(t.TempDir(), "app.ipa")
+	if err := os.WriteFile(ipaPath, []byte("0123456789"), 0o600); err != nil {
+		t.Fatalf("write ipa: %v", err)
+	}
+
+	var mu sync.Mutex
+	partRequests := map[string]int{}
+	failPart1 := true
+	createdUploads := 0
+	committed := false
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/buildUploads":
+			createdUploads++
+			w.WriteHeader(http.StatusCreated)
+			_, _ = io.WriteString(w, `{"data":{"type":"buildUploads","id":"upload-1"}}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/buildUploadFiles":
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, `{"data":{"type":"buildUploadFiles","id":"file-1","attributes":{"fileName":"app.ipa","fileSize":10,"uploadOperations":[
+				{"method":"PUT","url":"%[1]s/parts/0","length":5,"offset":0},
+				{"method":"PUT","url":"%[1]s/parts/1","length":5,"offset":5}
+			]}}}`, server.URL)
+		case r.Method == http.MethodPut && strings.HasPrefix(r.URL.Path, "/parts/"):
+			_, _ = io.ReadAll(r.Body)
+			partRequests[r.URL.Path]++
+			if r.URL.Path == "/parts/1" && failPart1 {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		case r.Method == http.MethodPatch && r.URL.Path == "/v1/buildUploadFiles/file-1":
+			committed = true
+			_, _ = io.WriteString(w, `{"data":{"type":"buildUploadFiles","id":"file-1","attributes":{"uploaded":true}}}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.String())
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	setupServerEnv(t, server.URL)
+
+	args := []string{"builds", "upload", "--app", "app-1", "--ipa", ipaPath, "--version", "1.0", "--build-number", "7", "--concurrency", "1"}
+
+	root := RootCommand("1.2.3")
+	var runErr error
+	captureOutput(t, func() {
+		if err := root.Parse(args); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr == nil || !strings.Contains(runErr.Error(), "--resume") {
+		t.Fatalf("expected upload failure suggesting --resume, got %v", runErr)
+	}
+
+	statePath := ipaPath + ".asc-upload.json"
+	data, err := os.ReadFile(statePath)
+	if err != nil {
+		t.Fatalf("expected upload state file: %v", err)
+	}
+	var state struct {
+		UploadID       string `json:"uploadId"`
+		CompletedParts []int  `json:"completedParts"`
+	}
+	if err := json.Unmarshal(data, &state); err != nil {
+		t.Fatalf("parse state: %v", err)
+	}
+	if state.UploadID != "upload-1" || len(state.CompletedParts) != 1 || state.CompletedParts[0] != 0 {
+		t.Fatalf("unexpected state %+v", state)
+	}
+	if info, err := os.Stat(statePath); err == nil && info.Mode().Perm() != 0o600 {
+		t.Fatalf("expected state file mode 0600, got %v", info.Mode().Perm())
+	}
+
+	mu.Lock()
+	failPart1 = false
+	mu.Unlock()
+
+	root = RootCommand("1.2.3")
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse(append(args, "--resume", "--progress", "json")); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if createdUploads != 1 {
+		t.Fatalf("expected resume to reuse the upload record, got %d creates", createdUploads)
+	}
+	if partRequests["/parts/0"] != 1 || partRequests["/parts/1"] != 2 {
+		t.Fatalf("expected only the failed part to be retried, got %v", partRequests)
+	}
+	if !committed {
+		t.Fatalf("expected upload to be committed")
+	}
+	if _, err := os.Stat(statePath); !os.IsNotExist(err) {
+		t.Fatalf("expected state file to be removed, got %v", err)
+	}
+	if !strings.Contains(stdout, `"uploaded":true`) {
+		t.Fatalf("unexpected output %q", stdout)
+	}
+	lines := strings.Split(strings.TrimSpace(stderr), "\n")
+	var last struct {
+		Event          string `json:"event"`
+		BytesSent      int64  `json:"bytesSent"`
+		TotalBytes     int64  `json:"totalBytes"`
+		PartsCompleted int    `json:"partsCompleted"`
+	}
+	if err := json.Unmarshal([]byte(lines[len(lines)-1]), &last); err != nil {
+		t.Fatalf("expected JSON progress lines on stderr, got %q", stderr)
+	}
+	if last.BytesSent != 10 || last.TotalBytes != 10 || last.PartsCompleted != 2 {
+		t.Fatalf("unexpected final progress %+v", last)
+	}
+}
+
+func TestBuildsUploadResumeRefreshesExpiredOperations(t *testing.T) {
+	ipaPath := filepath.Join(t.TempDir(), "app.ipa")
+	if err := os.WriteFile(ipaPath, []byte("0123456789"), 0o600); err != nil {
+		t.Fatalf("write ipa: %v", err)
+	}
+
+	var mu sync.Mutex
+	partRequests := map[string]int{}
+	refreshed := 0
+	committed := false
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/buildUploads":
+			w.WriteHeader(http.StatusCreated)
+			_, _ = io.WriteString(w, `{"data":{"type":"buildUploads","id":"upload-1"}}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/buildUploadFiles":
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, `{"data":{"type":"buildUploadFiles","id":"file-1","attributes":{"fileName":"app.ipa","fileSize":10,"uploadOperations":[
+				{"method":"PUT","url":"%[1]s/parts/0","length":5,"offset":0,"expiration":"2020-01-01T00:00:00Z"},
+				{"method":"PUT","url":"%[1]s/parts/1","length":5,"offset":5,"expiration":"2020-01-01T00:00:00Z"}
+			]}}}`, server.URL)
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/buildUploadFiles/file-1":
+			refreshed++
+			fmt.Fprintf(w, `{"data":{"type":"buildUploadFiles","id":"file-1","attributes":{"fileName":"app.ipa","fileSize":10,"uploadOperations":[
+				{"method":"PUT","url":"%[1]s/fresh/0","length":5,"offset":0},
+				{"method":"PUT","url":"%[1]s/fresh/1","length":5,"offset":5}
+			]}}}`, server.URL)
+		case r.Method == http.MethodPut && r.URL.Path == "/parts/1":
+			_, _ = io.ReadAll(r.Body)
+			partRequests[r.URL.Path]++
+			w.WriteHeader(http.StatusForbidden)
+		case r.Method == http.MethodPut:
+			_, _ = io.ReadAll(r.Body)
+			partRequests[r.URL.Path]++
+			w.WriteHeader(http.StatusOK)
+		case r.Method == http.MethodPatch && r.URL.Path == "/v1/buildUploadFiles/file-1":
+			committed = true
+			_, _ = io.WriteString(w, `{"data":{"type":"buildUploadFiles","id":"file-1","attributes":{"uploaded":true}}}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.String())
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	setupServerEnv(t, server.URL)
+
+	args := []string{"builds", "upload", "--app", "app-1", "--ipa", ipaPath, "--version", "1.0", "--build-number", "7", "--concurrency", "1"}
+
+	root := RootCommand("1.2.3")
+	var runErr error
+	captureOutput(t, func() {
+		if err := root.Parse(args); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr == nil {
+		t.Fatal("expected first upload to fail")
+	}
+
+	root = RootCommand("1.2.3")
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse(append(args, "--resume")); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if refreshed != 1 {
+		t.Fatalf("expected expired operations to be refreshed once, got %d", refreshed)
+	}
+	if partRequests["/parts/1"] != 1 || partRequests["/fresh/0"] != 0 || partRequests["/fresh/1"] != 1 {
+		t.Fatalf("expected only the pending part to use the refreshed URL, got %v", partRequests)
+	}
+	if !committed {
+		t.Fatalf("expected upload to be committed")
+	}
+	if !strings.Contains(stderr, "expired") {
+		t.Fatalf("expected expiry notice on stderr, got %q", stderr)
+	}
+}
+
+func TestBuildsUploadRejectsInvalidProgress(t *testing.T) {
+	ipaPath := filepath.Join(t.TempDir(), "app.ipa")
+	if err := os.WriteFile(ipaPath, []byte("data"), 0o600); err != nil {
+		t.Fatalf("write ipa: %v", err)
+	}
+
+	root := RootCommand("1.2.3")
+	var runErr error
+	captureOutput(t, func() {
+		if err := root.Parse([]string{"builds", "upload", "--app", "app-1", "--ipa", ipaPath, "--version", "1.0", "--build-number", "7", "--progress", "loud"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr == nil || !strings.Contains(runErr.Error(), "--progress must be one of") {
+		t.Fatalf("expected progress validation error, got %v", runErr)
+	}
+}
@@ -0,0 +1,172 @@
+package cmdtest
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"howett.net/plist"
+)
+
+func TestBuildsValidateReportsDuplicateBuild(t *testing.T) {
+	ipaPath := writeValidateTestIPA(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/bundleIds":
+			_, _ = io.WriteString(w, `{"data":[{"type":"bundleIds","id":"bundle-1","attributes":{"identifier":"com.example.app","name":"App","platform":"IOS"}}]}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/apps":
+			if r.URL.Query().Get("filter[bundleId]") != "com.example.app" {
+				t.Errorf("unexpected apps query %q", r.URL.RawQuery)
+			}
+			_, _ = io.WriteString(w, `{"data":[{"type":"apps","id":"app-1","attributes":{"name":"App","bundleId":"com.example.app"}}]}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/preReleaseVersions":
+			_, _ = io.WriteString(w, `{"data":[{"type":"preReleaseVersions","id":"prv-1","attributes":{"version":"1.2.3","platform":"IOS"}}]}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/builds":
+			_, _ = io.WriteString(w, `{"data":[{"type":"builds","id":"build-1","attributes":{"version":"45"}}]}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.String())
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	setupServerEnv(t, server.URL)
+	t.Setenv("ASC_APP_ID", "")
+
+	root := RootCommand("1.2.3")
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"builds", "validate", "--ipa", ipaPath}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	var reported ReportedError
+	if !errors.As(runErr, &reported) || !strings.Contains(runErr.Error(), "1 error(s) found") {
+		t.Fatalf("expected reported validation error, got %v", runErr)
+	}
+
+	var result struct {
+		AppID      string `json:"appId"`
+		BundleID   string `json:"bundleId"`
+		Valid      bool   `json:"valid"`
+		ErrorCount int    `json:"errorCount"`
+		Issues     []struct {
+			Severity string `json:"severity"`
+			Code     string `json:"code"`
+		} `json:"issues"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v (%q)", err, stdout)
+	}
+	if result.AppID != "app-1" || result.BundleID != "com.example.app" || result.Valid {
+		t.Fatalf("unexpected result %+v", result)
+	}
+	if len(result.Issues) != 1 || result.Issues[0].Code != "duplicate-build" || result.Issues[0].Severity != "error" {
+		t.Fatalf("expected only duplicate-build issue, got %+v", result.Issues)
+	}
+}
+
+func TestBuildsValidateOfflinePasses(t *testing.T) {
+	ipaPath := writeValidateTestIPA(t)
+
+	root := RootCommand("1.2.3")
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"builds", "validate", "--ipa", ipaPath, "--offline"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if !strings.Contains(stdout, `"valid":true`) || !strings.Contains(stdout, `"issues":[]`) {
+		t.Fatalf("unexpected output %q", stdout)
+	}
+}
+
+func TestBuildsValidateRejectsInvalidPlatform(t *testing.T) {
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"builds", "validate", "--ipa", "app.ipa", "--platform", "MAC_OS"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected ErrHelp, got %v", err)
+		}
+	})
+	if stdout != "" {
+		t.Fatalf("expected empty stdout, got %q", stdout)
+	}
+	if !strings.Contains(stderr, "Error: --platform must be IOS, TV_OS, or VISION_OS") {
+		t.Fatalf("expected platform error, got %q", stderr)
+	}
+}
+
+func writeValidateTestIPA(t *testing.T) string {
+	t.Helper()
+
+	marshal := func(values map[string]interface{}) []byte {
+		data, err := plist.Marshal(values, plist.XMLFormat)
+		if err != nil {
+			t.Fatalf("marshal plist: %v", err)
+		}
+		return data
+	}
+	files := map[string][]byte{
+		"Payload/Demo.app/Info.plist": marshal(map[string]interface{}{
+			"CFBundleIdentifier":            "com.example.app",
+			"CFBundleExecutable":            "Demo",
+			"CFBundleShortVersionString":    "1.2.3",
+			"CFBundleVersion":               "45",
+			"MinimumOSVersion":              "16.0",
+			"UILaunchStoryboardName":        "LaunchScreen",
+			"ITSAppUsesNonExemptEncryption": false,
+			"CFBundleIcons": map[string]interface{}{
+				"CFBundlePrimaryIcon": map[string]interface{}{"CFBundleIconName": "AppIcon"},
+			},
+		}),
+		"Payload/Demo.app/Demo":       []byte("binary"),
+		"Payload/Demo.app/Assets.car": []byte("assets"),
+		"Payload/Demo.app/embedded.mobileprovision": marshal(map[string]interface{}{
+			"Name":           "App Store",
+			"UUID":           "11111111-2222-3333-4444-555555555555",
+			"ExpirationDate": time.Now().Add(24 * time.Hour).UTC(),
+			"Entitlements":   map[string]interface{}{"application-identifier": "TEAM123456.com.example.app"},
+		}),
+	}
+
+	ipaPath := filepath.Join(t.TempDir(), "app.ipa")
+	file, err := os.Create(ipaPath)
+	if err != nil {
+		t.Fatalf("create IPA: %v", err)
+	}
+	defer file.Close()
+	zipWriter := zip.NewWriter(file)
+	for name, data := range files {
+		entry, err := zipWriter.Create(name)
+		if err != nil {
+			t.Fatalf("create zip entry %q: %v", name, err)
+		}
+		if _, err := entry.Write(data); err != nil {
+			t.Fatalf("write zip entry %q: %v", name, err)
+		}
+	}
+	if err := zipWriter.Close(); err != nil {
+		t.Fatalf("close zip writer: %v", err)
+	}
+	return ipaPath
+}
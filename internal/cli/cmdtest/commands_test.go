@@ -2306,7 +2306,7 @@ func TestBuildsUploadValidationErrors(t *testing.T) {
 		{
 			name:    "missing ipa",
 			args:    []string{"builds", "upload", "--app", "APP_123", "--version", "1.0.0", "--build-number", "123"},
-			wantErr: "Error: --ipa is required",
+			wantErr: "Error: --ipa or --pkg is required",
 		},
 	}
 
@@ -2351,7 +2351,7 @@ func TestPublishValidationErrors(t *testing.T) {
 		{
 			name:    "publish testflight missing ipa",
 			args:    []string{"publish", "testflight", "--app", "APP_123", "--group", "GROUP_ID"},
-			wantErr: "Error: --ipa is required",
+			wantErr: "Error: --ipa or --pkg is required",
 		},
 		{
 			name:    "publish testflight missing group",
@@ -2376,7 +2376,7 @@ func TestPublishValidationErrors(t *testing.T) {
 		{
 			name:    "publish appstore missing ipa",
 			args:    []string{"publish", "appstore", "--app", "APP_123", "--version", "1.0.0"},
-			wantErr: "Error: --ipa is required",
+			wantErr: "Error: --ipa or --pkg is required",
 		},
 		{
 			name:    "publish appstore submit missing confirm",
@@ -3374,6 +3374,16 @@ func TestXcodeCloudValidationErrors(t *testing.T) {
 			args:    []string{"xcode-cloud", "workflows", "delete", "--id", "WF_ID"},
 			wantErr: "--confirm is required",
 		},
+		{
+			name:    "xcode-cloud workflows export missing id",
+			args:    []string{"xcode-cloud", "workflows", "export"},
+			wantErr: "--id is required",
+		},
+		{
+			name:    "xcode-cloud workflows import missing file",
+			args:    []string{"xcode-cloud", "workflows", "import", "--product-id", "PRODUCT_ID"},
+			wantErr: "--file is required",
+		},
 		{
 			name:    "xcode-cloud build-runs missing workflow-id",
 			args:    []string{"xcode-cloud", "build-runs"},
@@ -3552,6 +3562,16 @@ func TestXcodeCloudMutualExclusiveFlags(t *testing.T) {
 			args:    []string{"xcode-cloud", "status", "--run-id", "RUN_ID", "--timeout", "-1s"},
 			wantErr: "--timeout must be greater than or equal to 0",
 		},
+		{
+			name:    "xcode-cloud status junit without wait",
+			args:    []string{"xcode-cloud", "status", "--run-id", "RUN_ID", "--junit", "junit.xml"},
+			wantErr: "--logs-dir and --junit require --wait",
+		},
+		{
+			name:    "xcode-cloud test-results export run-id and action-id are mutually exclusive",
+			args:    []string{"xcode-cloud", "test-results", "export", "--run-id", "RUN_ID", "--action-id", "ACTION_ID", "--path", "junit.xml"},
+			wantErr: "--run-id and --action-id are mutually exclusive",
+		},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,237 @@
+package cmdtest
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	_ "modernc.org/sqlite"
+)
+
+type exportSQLiteOutput struct {
+	Database string `json:"database"`
+	Sources  []struct {
+		Source string `json:"source"`
+		Table  string `json:"table"`
+		Rows   int    `json:"rows"`
+		Status string `json:"status"`
+	} `json:"sources"`
+}
+
+func runExportSQLite(t *testing.T, args ...string) exportSQLiteOutput {
+	t.Helper()
+	root := RootCommand("1.2.3")
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse(append([]string{"export", "sqlite"}, args...)); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr != nil {
+		t.Fatalf("run error: %v", runErr)
+	}
+	var result exportSQLiteOutput
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	return result
+}
+
+func queryInt(t *testing.T, db *sql.DB, query string) int {
+	t.Helper()
+	var value int
+	if err := db.QueryRow(query).Scan(&value); err != nil {
+		t.Fatalf("query %q: %v", query, err)
+	}
+	return value
+}
+
+func TestExportSQLiteLoadsReportsIdempotently(t *testing.T) {
+	dir := t.TempDir()
+	salesDir := filepath.Join(dir, "sales")
+	if err := os.Mkdir(salesDir, 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	header := "Provider\tSKU\tTitle\tProduct Type Identifier\tUnits\tDeveloper Proceeds\tCountry Code\tCurrency of Proceeds\tCustomer Price\n"
+	writeReportFile(t, filepath.Join(salesDir, "sales_report_2024-01-01_SALES_SUMMARY_DAILY.tsv.gz"), header+
+		"APPLE\tcom.example.app\tExample\t1F\t2\t0.70\tUS\tUSD\t0.99\n"+
+		"APPLE\tcom.example.app\tExample\t1F\t1\t0.60\tGB\tGBP\t0.99\n", true)
+	writeReportFile(t, filepath.Join(salesDir, "manifest.json"), `{"entries":{}}`, false)
+	finance := writeReportFile(t, filepath.Join(dir, "finance_report_2025-12_FINANCIAL_ZZ.tsv"),
+		"Start Date\tEnd Date\tVendor Identifier\tQuantity\tPartner Share\tExtended Partner Share\tPartner Share Currency\tTitle\tProduct Type Identifier\tCountry Of Sale\tCustomer Price\n"+
+			"11/30/2025\t01/03/2026\tcom.example.app\t10\t0.70\t7.00\tUSD\tExample\t1F\tUS\t0.99\n"+
+			"Total_Rows\t1\n"+
+			"\n"+
+			"Country or Region (Currency)\tTotal Owed\tExchange Rate\tProceeds\tBank Account Currency\n"+
+			"Americas (USD)\t7.00\t1.0\t7.00\tUSD\n", false)
+	analytics := writeReportFile(t, filepath.Join(dir, "analytics_report_req_inst.csv.gz"),
+		"Date\tApp Name\tApp Apple Identifier\tSessions\n"+
+			"2025-01-01\tExample\t123\t42\n", true)
+	dbPath := filepath.Join(dir, "asc.db")
+
+	args := []string{"--db", dbPath, "--sales", salesDir, "--finance", finance, "--analytics", analytics}
+	first := runExportSQLite(t, args...)
+	if len(first.Sources) != 3 {
+		t.Fatalf("expected 3 sources (manifest skipped), got %+v", first.Sources)
+	}
+	for _, source := range first.Sources {
+		if source.Status != "loaded" {
+			t.Fatalf("expected loaded status, got %+v", source)
+		}
+	}
+	if first.Sources[0].Table != "sales_report_rows" || first.Sources[0].Rows != 2 {
+		t.Fatalf("unexpected sales source: %+v", first.Sources[0])
+	}
+
+	second := runExportSQLite(t, args...)
+	for _, source := range second.Sources {
+		if source.Status != "unchanged" {
+			t.Fatalf("expected unchanged status on re-run, got %+v", source)
+		}
+	}
+
+	db, err := sql.Open("sqlite", dbPath)
+	if err != nil {
+		t.Fatalf("open db: %v", err)
+	}
+	defer db.Close()
+
+	if got := queryInt(t, db, "SELECT COUNT(*) FROM sales_report_rows"); got != 2 {
+		t.Fatalf("expected 2 sales rows, got %d", got)
+	}
+	if got := queryInt(t, db, "SELECT SUM(units) FROM sales_report_rows WHERE country_code = 'US'"); got != 2 {
+		t.Fatalf("expected 2 US units, got %d", got)
+	}
+	if got := queryInt(t, db, "SELECT COUNT(*) FROM finance_exchange_rates WHERE bank_account_currency = 'USD'"); got != 1 {
+		t.Fatalf("expected 1 exchange rate, got %d", got)
+	}
+	if got := queryInt(t, db, "SELECT json_extract(data, '$.Sessions') FROM analytics_report_rows WHERE app_apple_identifier = '123'"); got != 42 {
+		t.Fatalf("expected 42 sessions, got %d", got)
+	}
+	if got := queryInt(t, db, "SELECT COUNT(*) FROM report_files"); got != 3 {
+		t.Fatalf("expected 3 report files, got %d", got)
+	}
+}
+
+func TestExportSQLiteUpsertsReviews(t *testing.T) {
+	var server *httptest.Server
+	title := "Great"
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/apps/app-1/customerReviews" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("cursor") == "" {
+			fmt.Fprintf(w, `{"data":[{"type":"customerReviews","id":"r1","attributes":{"rating":5,"title":%q,"territory":"USA"}}],"links":{"next":"%s/v1/apps/app-1/customerReviews?cursor=2"}}`, title, server.URL)
+			return
+		}
+		_, _ = io.WriteString(w, `{"data":[{"type":"customerReviews","id":"r2","attributes":{"rating":1,"title":"Crashes","territory":"GBR"}}],"links":{}}`)
+	}))
+	defer server.Close()
+	setupServerEnv(t, server.URL)
+
+	dbPath := filepath.Join(t.TempDir(), "asc.db")
+	result := runExportSQLite(t, "--db", dbPath, "--app", "app-1", "--include", "reviews")
+	if len(result.Sources) != 1 || result.Sources[0].Table != "reviews" || result.Sources[0].Rows != 2 || result.Sources[0].Status != "synced" {
+		t.Fatalf("unexpected result: %+v", result.Sources)
+	}
+
+	title = "Great, updated"
+	runExportSQLite(t, "--db", dbPath, "--app", "app-1", "--include", "reviews")
+
+	db, err := sql.Open("sqlite", dbPath)
+	if err != nil {
+		t.Fatalf("open db: %v", err)
+	}
+	defer db.Close()
+
+	if got := queryInt(t, db, "SELECT COUNT(*) FROM reviews WHERE app_id = 'app-1'"); got != 2 {
+		t.Fatalf("expected 2 reviews after re-sync, got %d", got)
+	}
+	var updated string
+	if err := db.QueryRow("SELECT title FROM reviews WHERE id = 'r1'").Scan(&updated); err != nil {
+		t.Fatalf("query title: %v", err)
+	}
+	if updated != "Great, updated" {
+		t.Fatalf("expected upserted title, got %q", updated)
+	}
+}
+
+func TestExportSQLiteRejectsReportOfWrongKind(t *testing.T) {
+	dir := t.TempDir()
+	finance := writeReportFile(t, filepath.Join(dir, "finance.tsv"), "Vendor Identifier\tQuantity\tExtended Partner Share\tPartner Share Currency\tCountry Of Sale\nsku\t1\t1\tUSD\tUS\n", false)
+
+	root := RootCommand("1.2.3")
+	var runErr error
+	_, _ = captureOutput(t, func() {
+		if err := root.Parse([]string{"export", "sqlite", "--db", filepath.Join(dir, "asc.db"), "--sales", finance}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr == nil || !strings.Contains(runErr.Error(), "export sqlite: "+finance) {
+		t.Fatalf("expected parse error for finance file loaded as sales, got %v", runErr)
+	}
+}
+
+func TestExportSQLiteValidationErrors(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing db",
+			args:    []string{"export", "sqlite", "--sales", "reports"},
+			wantErr: "--db is required",
+		},
+		{
+			name:    "no sources",
+			args:    []string{"export", "sqlite", "--db", "asc.db"},
+			wantErr: "provide at least one of --sales, --finance, --analytics or --include",
+		},
+		{
+			name:    "include without app",
+			args:    []string{"export", "sqlite", "--db", "asc.db", "--include", "reviews"},
+			wantErr: "--app is required with --include",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
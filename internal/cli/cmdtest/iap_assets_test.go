@@ -0,0 +1,170 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestIAPAssetsValidationErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "review-screenshot upload missing id",
+			args:    []string{"iap", "review-screenshot", "upload", "--file", "review.png"},
+			wantErr: "--id is required",
+		},
+		{
+			name:    "review-screenshot upload missing file",
+			args:    []string{"iap", "review-screenshot", "upload", "--id", "IAP_ID"},
+			wantErr: "--file is required",
+		},
+		{
+			name:    "images upload missing id",
+			args:    []string{"iap", "images", "upload", "--file", "promo.png"},
+			wantErr: "--id is required",
+		},
+		{
+			name:    "images upload missing file",
+			args:    []string{"iap", "images", "upload", "--id", "IAP_ID"},
+			wantErr: "--file is required",
+		},
+		{
+			name:    "content get missing id",
+			args:    []string{"iap", "content", "get"},
+			wantErr: "--id is required",
+		},
+		{
+			name:    "submit missing id",
+			args:    []string{"iap", "submit", "--confirm"},
+			wantErr: "--id is required",
+		},
+		{
+			name:    "submit missing confirm",
+			args:    []string{"iap", "submit", "--id", "IAP_ID"},
+			wantErr: "--confirm is required",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestIAPImagesUploadReservesUploadsAndCommits(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "promo.png")
+	if err := os.WriteFile(filePath, []byte("png-bytes"), 0o600); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	var uploaded string
+	var committed map[string]any
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/inAppPurchaseImages":
+			w.WriteHeader(http.StatusCreated)
+			_, _ = io.WriteString(w, `{"data":{"type":"inAppPurchaseImages","id":"img-1","attributes":{"uploadOperations":[{"method":"PUT","url":"`+server.URL+`/upload/img-1","offset":0,"length":9}]}}}`)
+		case r.Method == http.MethodPut && r.URL.Path == "/upload/img-1":
+			body, _ := io.ReadAll(r.Body)
+			uploaded = string(body)
+		case r.Method == http.MethodPatch && r.URL.Path == "/v1/inAppPurchaseImages/img-1":
+			if err := json.NewDecoder(r.Body).Decode(&committed); err != nil {
+				t.Errorf("decode body: %v", err)
+			}
+			_, _ = io.WriteString(w, `{"data":{"type":"inAppPurchaseImages","id":"img-1"}}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/inAppPurchaseImages/img-1":
+			_, _ = io.WriteString(w, `{"data":{"type":"inAppPurchaseImages","id":"img-1","attributes":{"assetDeliveryState":{"state":"COMPLETE"}}}}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.String())
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	setupServerEnv(t, server.URL)
+
+	root := RootCommand("1.2.3")
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"iap", "images", "upload", "--id", "iap-1", "--file", filePath}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if uploaded != "png-bytes" {
+		t.Fatalf("expected file bytes to be uploaded, got %q", uploaded)
+	}
+	body, _ := json.Marshal(committed)
+	if !strings.Contains(string(body), `"uploaded":true`) || !strings.Contains(string(body), `"sourceFileChecksum"`) {
+		t.Fatalf("expected commit with checksum, got %s", body)
+	}
+	if !strings.Contains(stdout, `"assetId":"img-1"`) || !strings.Contains(stdout, `"state":"COMPLETE"`) {
+		t.Fatalf("unexpected output %q", stdout)
+	}
+}
+
+func TestIAPReviewScreenshotUploadRequiresReplaceWhenPresent(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "review.png")
+	if err := os.WriteFile(filePath, []byte("png-bytes"), 0o600); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet && r.URL.Path == "/v2/inAppPurchases/iap-1/appStoreReviewScreenshot" {
+			_, _ = io.WriteString(w, `{"data":{"type":"inAppPurchaseAppStoreReviewScreenshots","id":"shot-old"}}`)
+			return
+		}
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.String())
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(server.Close)
+	setupServerEnv(t, server.URL)
+
+	root := RootCommand("1.2.3")
+	var runErr error
+	captureOutput(t, func() {
+		if err := root.Parse([]string{"iap", "review-screenshot", "upload", "--id", "iap-1", "--file", filePath}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if runErr == nil || !strings.Contains(runErr.Error(), "--replace") {
+		t.Fatalf("expected --replace error, got %v", runErr)
+	}
+}
@@ -0,0 +1,127 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestIAPPricingValidationErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "pricing get missing id",
+			args:    []string{"iap", "pricing", "get"},
+			wantErr: "--id is required",
+		},
+		{
+			name:    "pricing set missing id",
+			args:    []string{"iap", "pricing", "set", "--price", "4.99"},
+			wantErr: "--id is required",
+		},
+		{
+			name:    "pricing set missing price",
+			args:    []string{"iap", "pricing", "set", "--id", "IAP_ID"},
+			wantErr: "--price-point or --price is required",
+		},
+		{
+			name:    "pricing price-points missing id",
+			args:    []string{"iap", "pricing", "price-points"},
+			wantErr: "--id is required",
+		},
+		{
+			name:    "availability get missing id",
+			args:    []string{"iap", "availability", "get"},
+			wantErr: "--id is required",
+		},
+		{
+			name:    "availability set missing territories",
+			args:    []string{"iap", "availability", "set", "--id", "IAP_ID"},
+			wantErr: "--territory or --all-territories is required",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestIAPPricingSetResolvesPriceInBaseTerritory(t *testing.T) {
+	var created map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v2/inAppPurchases/iap-1/iapPriceSchedule":
+			_, _ = io.WriteString(w, `{"data":{"type":"inAppPurchasePriceSchedules","id":"schedule-1"}}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/inAppPurchasePriceSchedules/schedule-1/baseTerritory":
+			_, _ = io.WriteString(w, `{"data":{"type":"territories","id":"GBR"}}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/v2/inAppPurchases/iap-1/pricePoints":
+			if got := r.URL.Query().Get("filter[territory]"); got != "GBR" {
+				t.Errorf("expected GBR price points, got %q", got)
+			}
+			_, _ = io.WriteString(w, `{"data":[
+				{"type":"inAppPurchasePricePoints","id":"pp-399","attributes":{"customerPrice":"3.99"}},
+				{"type":"inAppPurchasePricePoints","id":"pp-499","attributes":{"customerPrice":"4.99"}}
+			]}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/inAppPurchasePriceSchedules":
+			if err := json.NewDecoder(r.Body).Decode(&created); err != nil {
+				t.Errorf("decode body: %v", err)
+			}
+			w.WriteHeader(http.StatusCreated)
+			_, _ = io.WriteString(w, `{"data":{"type":"inAppPurchasePriceSchedules","id":"schedule-2"}}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.String())
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	setupServerEnv(t, server.URL)
+
+	root := RootCommand("1.2.3")
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"iap", "pricing", "set", "--id", "iap-1", "--price", "4.99"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if !strings.Contains(stdout, `"id":"schedule-2"`) {
+		t.Fatalf("expected created schedule in stdout, got %q", stdout)
+	}
+	body, _ := json.Marshal(created)
+	if !strings.Contains(string(body), `"pp-499"`) || !strings.Contains(string(body), `"GBR"`) {
+		t.Fatalf("expected pp-499 in GBR, got %s", body)
+	}
+}
@@ -0,0 +1,80 @@
+package cmdtest
+
+import (
+	"context"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/mockserver"
+)
+
+func setupMockServerEnv(t *testing.T) string {
+	t.Helper()
+
+	handler, err := mockserver.New(mockserver.DefaultSeed())
+	if err != nil {
+		t.Fatalf("mockserver.New() error: %v", err)
+	}
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	setupServerEnv(t, server.URL)
+
+	return server.URL
+}
+
+// setupServerEnv points the CLI at a test server with throwaway credentials.
+func setupServerEnv(t *testing.T, baseURL string) {
+	t.Helper()
+
+	keyPath := filepath.Join(t.TempDir(), "AuthKey.p8")
+	writeECDSAPEM(t, keyPath)
+
+	t.Setenv("ASC_BYPASS_KEYCHAIN", "1")
+	t.Setenv("ASC_KEY_ID", "KEY123")
+	t.Setenv("ASC_ISSUER_ID", "ISS456")
+	t.Setenv("ASC_PRIVATE_KEY_PATH", keyPath)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_RECORD", "")
+	t.Setenv("ASC_REPLAY", "")
+	t.Setenv("ASC_BASE_URL", baseURL)
+	t.Setenv("ASC_ALLOW_INSECURE_BASE_URL", "1")
+}
+
+func TestAppsListAgainstMockServer(t *testing.T) {
+	setupMockServerEnv(t)
+
+	root := RootCommand("1.2.3")
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"apps", "list", "--paginate"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if !strings.Contains(stdout, `"name":"Demo App"`) {
+		t.Fatalf("expected seeded app in stdout, got %q", stdout)
+	}
+}
+
+func TestBaseURLRequiresInsecureFlag(t *testing.T) {
+	setupMockServerEnv(t)
+	t.Setenv("ASC_ALLOW_INSECURE_BASE_URL", "")
+
+	root := RootCommand("1.2.3")
+	var runErr error
+	captureOutput(t, func() {
+		if err := root.Parse([]string{"apps", "get", "--id", "app-1"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if runErr == nil || !strings.Contains(runErr.Error(), "ASC_ALLOW_INSECURE_BASE_URL") {
+		t.Fatalf("expected insecure base URL error, got %v", runErr)
+	}
+}
@@ -0,0 +1,162 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestOfferCodesValidationErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "subscriptions offer-codes list missing subscription",
+			args:    []string{"subscriptions", "offer-codes", "list"},
+			wantErr: "--subscription is required",
+		},
+		{
+			name:    "subscriptions offer-codes create missing eligibility",
+			args:    []string{"subscriptions", "offer-codes", "create", "--subscription", "SUB_ID", "--name", "Spring"},
+			wantErr: "--eligibility is required",
+		},
+		{
+			name:    "subscriptions offer-codes create paid missing prices",
+			args:    []string{"subscriptions", "offer-codes", "create", "--subscription", "SUB_ID", "--name", "Spring", "--eligibility", "NEW", "--mode", "PAY_UP_FRONT", "--duration", "ONE_MONTH"},
+			wantErr: "--prices or --prices-file is required",
+		},
+		{
+			name:    "subscriptions offer-codes deactivate missing confirm",
+			args:    []string{"subscriptions", "offer-codes", "deactivate", "--id", "OFFER_CODE_ID"},
+			wantErr: "--confirm is required",
+		},
+		{
+			name:    "subscriptions custom-codes create missing code",
+			args:    []string{"subscriptions", "offer-codes", "custom-codes", "create", "--offer-code", "OFFER_CODE_ID", "--quantity", "10"},
+			wantErr: "--code is required",
+		},
+		{
+			name:    "iap offer-codes create missing iap",
+			args:    []string{"iap", "offer-codes", "create"},
+			wantErr: "--iap is required",
+		},
+		{
+			name:    "iap offer-codes create missing prices",
+			args:    []string{"iap", "offer-codes", "create", "--iap", "IAP_ID", "--name", "Launch", "--eligibility", "NON_SPENDER"},
+			wantErr: "--prices or --prices-file is required",
+		},
+		{
+			name:    "iap custom-codes create missing quantity",
+			args:    []string{"iap", "offer-codes", "custom-codes", "create", "--offer-code", "OFFER_CODE_ID", "--code", "LAUNCH"},
+			wantErr: "--quantity is required",
+		},
+		{
+			name:    "offer-codes deactivate missing confirm",
+			args:    []string{"offer-codes", "deactivate", "--id", "BATCH_ID"},
+			wantErr: "--confirm is required",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestIAPOfferCodesCreateEqualizesPrices(t *testing.T) {
+	var created struct {
+		Included []struct {
+			Relationships struct {
+				Territory struct {
+					Data struct {
+						ID string `json:"id"`
+					} `json:"data"`
+				} `json:"territory"`
+				PricePoint struct {
+					Data struct {
+						ID string `json:"id"`
+					} `json:"data"`
+				} `json:"pricePoint"`
+			} `json:"relationships"`
+		} `json:"included"`
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/inAppPurchasePricePoints/pp-usa/equalizations":
+			if got := r.URL.Query().Get("include"); got != "territory" {
+				t.Errorf("expected include=territory, got %q", got)
+			}
+			_, _ = io.WriteString(w, `{"data":[
+				{"type":"inAppPurchasePricePoints","id":"pp-deu","relationships":{"territory":{"data":{"type":"territories","id":"DEU"}}}}
+			]}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/inAppPurchaseOfferCodes":
+			if err := json.NewDecoder(r.Body).Decode(&created); err != nil {
+				t.Errorf("decode body: %v", err)
+			}
+			w.WriteHeader(http.StatusCreated)
+			_, _ = io.WriteString(w, `{"data":{"type":"inAppPurchaseOfferCodes","id":"code-1"}}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.String())
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	setupServerEnv(t, server.URL)
+
+	root := RootCommand("1.2.3")
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"iap", "offer-codes", "create",
+			"--iap", "iap-1",
+			"--name", "Launch",
+			"--eligibility", "non_spender",
+			"--prices", "USA:pp-usa",
+			"--equalize",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if !strings.Contains(stdout, `"id":"code-1"`) {
+		t.Fatalf("expected created offer code in stdout, got %q", stdout)
+	}
+	if len(created.Included) != 2 {
+		t.Fatalf("expected 2 prices, got %d", len(created.Included))
+	}
+	second := created.Included[1].Relationships
+	if second.Territory.Data.ID != "DEU" || second.PricePoint.Data.ID != "pp-deu" {
+		t.Fatalf("unexpected equalized price %+v", second)
+	}
+}
@@ -0,0 +1,301 @@
+package cmdtest
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"flag"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"howett.net/plist"
+)
+
+type profileInspectOutput struct {
+	UUID                  string   `json:"uuid"`
+	TeamID                string   `json:"teamId"`
+	ApplicationIdentifier string   `json:"applicationIdentifier"`
+	Expired               bool     `json:"expired"`
+	Devices               []string `json:"devices"`
+	Certificates          []struct {
+		SerialNumber string `json:"serialNumber"`
+		SHA1         string `json:"sha1"`
+		ASCID        string `json:"ascId"`
+		ASCStatus    string `json:"ascStatus"`
+	} `json:"certificates"`
+	AppStoreConnect *struct {
+		Found  bool     `json:"found"`
+		ID     string   `json:"id"`
+		State  string   `json:"state"`
+		Issues []string `json:"issues"`
+	} `json:"appStoreConnect"`
+}
+
+type certificateInspectOutput struct {
+	File         string   `json:"file"`
+	CommonName   string   `json:"commonName"`
+	SerialNumber string   `json:"serialNumber"`
+	SHA1         string   `json:"sha1"`
+	Expired      bool     `json:"expired"`
+	ASCID        string   `json:"ascId"`
+	ASCStatus    string   `json:"ascStatus"`
+	Issues       []string `json:"issues"`
+}
+
+func TestProfilesInspectValidationErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing file",
+			args:    []string{"profiles", "inspect"},
+			wantErr: "--file is required",
+		},
+		{
+			name:    "id with offline",
+			args:    []string{"profiles", "inspect", "--file", "profile.mobileprovision", "--id", "PROFILE_ID", "--offline"},
+			wantErr: "--id cannot be used with --offline",
+		},
+		{
+			name:    "id with certificate",
+			args:    []string{"profiles", "inspect", "--file", "distribution.cer", "--id", "PROFILE_ID"},
+			wantErr: "--id cannot be used with a certificate file",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestProfilesInspectOffline(t *testing.T) {
+	path := writeInspectProfile(t, 0x1a2b3c)
+
+	root := RootCommand("1.2.3")
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"profiles", "inspect", "--file", path, "--offline"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result profileInspectOutput
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v (%q)", err, stdout)
+	}
+	if result.UUID != "11111111-2222-3333-4444-555555555555" || result.TeamID != "TEAM123456" {
+		t.Fatalf("unexpected profile %+v", result)
+	}
+	if result.ApplicationIdentifier != "TEAM123456.com.example.app" || result.Expired {
+		t.Fatalf("unexpected profile %+v", result)
+	}
+	if len(result.Devices) != 2 || len(result.Certificates) != 1 {
+		t.Fatalf("unexpected devices/certificates %+v", result)
+	}
+	if result.Certificates[0].SerialNumber != "1A2B3C" || len(result.Certificates[0].SHA1) != 40 {
+		t.Fatalf("unexpected certificate %+v", result.Certificates[0])
+	}
+	if result.AppStoreConnect != nil {
+		t.Fatalf("expected no App Store Connect section offline")
+	}
+}
+
+func TestProfilesInspectCrossChecksAppStoreConnect(t *testing.T) {
+	path := writeInspectProfile(t, 0x1a2b3c)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/profiles":
+			_, _ = io.WriteString(w, `{"data":[
+				{"type":"profiles","id":"prof-other","attributes":{"name":"Other","uuid":"99999999-0000-0000-0000-000000000000"}},
+				{"type":"profiles","id":"prof-1","attributes":{"name":"Example AdHoc","profileType":"IOS_APP_ADHOC","profileState":"INVALID","uuid":"11111111-2222-3333-4444-555555555555","expirationDate":"2099-01-01T00:00:00.000+0000"}}
+			]}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/certificates":
+			_, _ = io.WriteString(w, `{"data":[{"type":"certificates","id":"cert-1","attributes":{"name":"Dist","certificateType":"IOS_DISTRIBUTION","serialNumber":"001A2B3C"}}]}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.String())
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	setupServerEnv(t, server.URL)
+
+	root := RootCommand("1.2.3")
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"profiles", "inspect", "--file", path}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result profileInspectOutput
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v (%q)", err, stdout)
+	}
+	remote := result.AppStoreConnect
+	if remote == nil || !remote.Found || remote.ID != "prof-1" || remote.State != "INVALID" {
+		t.Fatalf("unexpected App Store Connect result %+v", remote)
+	}
+	if len(remote.Issues) != 1 || !strings.Contains(remote.Issues[0], "INVALID") {
+		t.Fatalf("expected only the state issue, got %v", remote.Issues)
+	}
+	if result.Certificates[0].ASCID != "cert-1" || result.Certificates[0].ASCStatus != "active" {
+		t.Fatalf("expected certificate matched by serial, got %+v", result.Certificates[0])
+	}
+}
+
+func TestProfilesInspectCertificateOffline(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "distribution.cer")
+	if err := os.WriteFile(path, buildInspectCertificate(t, 0x1a2b3c), 0o600); err != nil {
+		t.Fatalf("write certificate: %v", err)
+	}
+
+	root := RootCommand("1.2.3")
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"profiles", "inspect", "--file", path, "--offline"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result certificateInspectOutput
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v (%q)", err, stdout)
+	}
+	if result.File != path || result.CommonName != "Apple Distribution: Example (TEAM123456)" {
+		t.Fatalf("unexpected certificate %+v", result)
+	}
+	if result.SerialNumber != "1A2B3C" || len(result.SHA1) != 40 || result.Expired || result.ASCStatus != "" {
+		t.Fatalf("unexpected certificate %+v", result)
+	}
+}
+
+func TestProfilesInspectCertificateCrossChecksAppStoreConnect(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "distribution.pem")
+	data := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: buildInspectCertificate(t, 0x4d5e6f)})
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("write certificate: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet && r.URL.Path == "/v1/certificates" {
+			_, _ = io.WriteString(w, `{"data":[{"type":"certificates","id":"cert-1","attributes":{"name":"Dist","certificateType":"IOS_DISTRIBUTION","serialNumber":"001A2B3C"}}]}`)
+			return
+		}
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.String())
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(server.Close)
+	setupServerEnv(t, server.URL)
+
+	root := RootCommand("1.2.3")
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"profiles", "inspect", "--file", path}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result certificateInspectOutput
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v (%q)", err, stdout)
+	}
+	if result.SerialNumber != "4D5E6F" || result.ASCID != "" || result.ASCStatus != "not-found" {
+		t.Fatalf("unexpected certificate %+v", result)
+	}
+	if len(result.Issues) != 1 || !strings.Contains(result.Issues[0], "4D5E6F") {
+		t.Fatalf("expected a not-found issue, got %v", result.Issues)
+	}
+}
+
+func writeInspectProfile(t *testing.T, serial int64) string {
+	t.Helper()
+
+	certDER := buildInspectCertificate(t, serial)
+	data, err := plist.Marshal(map[string]interface{}{
+		"Name":                  "Example AdHoc",
+		"UUID":                  "11111111-2222-3333-4444-555555555555",
+		"TeamName":              "Example Inc",
+		"TeamIdentifier":        []string{"TEAM123456"},
+		"ExpirationDate":        time.Date(2099, 1, 1, 0, 0, 0, 0, time.UTC),
+		"ProvisionedDevices":    []string{"UDID-1", "UDID-2"},
+		"DeveloperCertificates": [][]byte{certDER},
+		"Entitlements": map[string]interface{}{
+			"application-identifier": "TEAM123456.com.example.app",
+		},
+	}, plist.XMLFormat)
+	if err != nil {
+		t.Fatalf("marshal plist: %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "profile.mobileprovision")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("write profile: %v", err)
+	}
+	return path
+}
+
+func buildInspectCertificate(t *testing.T, serial int64) []byte {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "Apple Distribution: Example (TEAM123456)"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(365 * 24 * time.Hour),
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create certificate: %v", err)
+	}
+	return certDER
+}
@@ -0,0 +1,308 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestProfilesRefreshDevicesValidationErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing bundle id",
+			args:    []string{"profiles", "refresh-devices", "--profile-type", "IOS_APP_ADHOC", "--confirm"},
+			wantErr: "--bundle-id is required",
+		},
+		{
+			name:    "missing profile type",
+			args:    []string{"profiles", "refresh-devices", "--bundle-id", "com.example.app", "--confirm"},
+			wantErr: "--profile-type is required",
+		},
+		{
+			name:    "store profile type",
+			args:    []string{"profiles", "refresh-devices", "--bundle-id", "com.example.app", "--profile-type", "IOS_APP_STORE", "--confirm"},
+			wantErr: "development or ad hoc",
+		},
+		{
+			name:    "missing confirm",
+			args:    []string{"profiles", "refresh-devices", "--bundle-id", "com.example.app", "--profile-type", "IOS_APP_ADHOC"},
+			wantErr: "--confirm is required",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestProfilesRefreshDevicesRecreatesStaleProfile(t *testing.T) {
+	outputDir := t.TempDir()
+
+	// The mock enforces unique profile names the way App Store Connect does.
+	profileNames := map[string]string{"prof-1": "AdHoc", "prof-other": "Other"}
+	var createdNames []string
+	var lastCreate map[string]any
+	var deleted []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/bundleIds":
+			_, _ = io.WriteString(w, `{"data":[{"type":"bundleIds","id":"bundle-1","attributes":{"identifier":"com.example.app","name":"App","platform":"IOS"}}]}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/devices":
+			if r.URL.Query().Get("filter[status]") != "ENABLED" || r.URL.Query().Get("filter[platform]") != "IOS" {
+				t.Errorf("unexpected device filters %q", r.URL.RawQuery)
+			}
+			_, _ = io.WriteString(w, `{"data":[{"type":"devices","id":"dev-1"},{"type":"devices","id":"dev-2"}]}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/bundleIds/bundle-1/profiles":
+			_, _ = io.WriteString(w, `{"data":[
+				{"type":"profiles","id":"prof-1","attributes":{"name":"AdHoc","profileType":"IOS_APP_ADHOC"}},
+				{"type":"profiles","id":"prof-other","attributes":{"name":"Other","profileType":"IOS_APP_STORE"}}
+			]}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/profiles/prof-1/relationships/certificates":
+			_, _ = io.WriteString(w, `{"data":[{"type":"certificates","id":"cert-1"}]}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/profiles/prof-1/relationships/devices":
+			_, _ = io.WriteString(w, `{"data":[{"type":"devices","id":"dev-1"}]}`)
+		case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/v1/profiles/"):
+			id := strings.TrimPrefix(r.URL.Path, "/v1/profiles/")
+			delete(profileNames, id)
+			deleted = append(deleted, id)
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/profiles":
+			var payload map[string]any
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Errorf("decode body: %v", err)
+			}
+			name := payload["data"].(map[string]any)["attributes"].(map[string]any)["name"].(string)
+			for _, existing := range profileNames {
+				if existing == name {
+					w.WriteHeader(http.StatusConflict)
+					_, _ = io.WriteString(w, `{"errors":[{"status":"409","code":"ENTITY_ERROR.ATTRIBUTE.INVALID.DUPLICATE","title":"duplicate","detail":"Multiple profiles found with the name"}]}`)
+					return
+				}
+			}
+			id := fmt.Sprintf("prof-new-%d", len(createdNames)+1)
+			profileNames[id] = name
+			createdNames = append(createdNames, name)
+			lastCreate = payload
+			content := base64.StdEncoding.EncodeToString([]byte("profile:" + name))
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, `{"data":{"type":"profiles","id":%q,"attributes":{"name":%q,"profileType":"IOS_APP_ADHOC","profileContent":%q}}}`, id, name, content)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.String())
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	setupServerEnv(t, server.URL)
+
+	root := RootCommand("1.2.3")
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"profiles", "refresh-devices", "--bundle-id", "com.example.app", "--profile-type", "IOS_APP_ADHOC", "--output-dir", outputDir, "--confirm"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if len(createdNames) != 2 || !strings.HasPrefix(createdNames[0], "AdHoc (refreshing ") || createdNames[1] != "AdHoc" {
+		t.Fatalf("expected a temporary profile then the final profile, got %v", createdNames)
+	}
+	if len(deleted) != 2 || deleted[0] != "prof-1" || deleted[1] != "prof-new-1" {
+		t.Fatalf("expected old then temporary profile to be deleted, got %v", deleted)
+	}
+	if len(profileNames) != 2 || profileNames["prof-new-2"] != "AdHoc" {
+		t.Fatalf("unexpected remaining profiles %v", profileNames)
+	}
+
+	body, _ := json.Marshal(lastCreate)
+	for _, want := range []string{`"name":"AdHoc"`, `"id":"cert-1"`, `"id":"dev-1"`, `"id":"dev-2"`, `"id":"bundle-1"`} {
+		if !strings.Contains(string(body), want) {
+			t.Fatalf("expected create body to contain %s, got %s", want, body)
+		}
+	}
+
+	data, err := os.ReadFile(filepath.Join(outputDir, "AdHoc.mobileprovision"))
+	if err != nil {
+		t.Fatalf("read profile: %v", err)
+	}
+	if string(data) != "profile:AdHoc" {
+		t.Fatalf("unexpected profile content %q", data)
+	}
+	if !strings.Contains(stdout, `"status":"refreshed"`) || !strings.Contains(stdout, `"newId":"prof-new-2"`) || strings.Contains(stdout, "prof-other") {
+		t.Fatalf("unexpected output %q", stdout)
+	}
+}
+
+func TestProfilesRefreshDevicesRerunKeepsIdenticalFile(t *testing.T) {
+	outputDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(outputDir, "AdHoc.mobileprovision"), []byte("current-profile"), 0o600); err != nil {
+		t.Fatalf("write profile: %v", err)
+	}
+	content := base64.StdEncoding.EncodeToString([]byte("current-profile"))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/bundleIds":
+			_, _ = io.WriteString(w, `{"data":[{"type":"bundleIds","id":"bundle-1","attributes":{"identifier":"com.example.app","name":"App","platform":"IOS"}}]}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/devices":
+			_, _ = io.WriteString(w, `{"data":[{"type":"devices","id":"dev-1"}]}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/bundleIds/bundle-1/profiles":
+			_, _ = io.WriteString(w, `{"data":[{"type":"profiles","id":"prof-1","attributes":{"name":"AdHoc","profileType":"IOS_APP_ADHOC","profileContent":"`+content+`"}}]}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/profiles/prof-1/relationships/certificates":
+			_, _ = io.WriteString(w, `{"data":[{"type":"certificates","id":"cert-1"}]}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/profiles/prof-1/relationships/devices":
+			_, _ = io.WriteString(w, `{"data":[{"type":"devices","id":"dev-1"}]}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.String())
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	setupServerEnv(t, server.URL)
+
+	root := RootCommand("1.2.3")
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"profiles", "refresh-devices", "--bundle-id", "com.example.app", "--profile-type", "IOS_APP_ADHOC", "--output-dir", outputDir, "--confirm"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if !strings.Contains(stdout, `"status":"unchanged"`) || !strings.Contains(stdout, "AdHoc.mobileprovision") {
+		t.Fatalf("unexpected output %q", stdout)
+	}
+}
+
+func TestProfilesRefreshDevicesCreateFailureKeepsOldProfile(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/bundleIds":
+			_, _ = io.WriteString(w, `{"data":[{"type":"bundleIds","id":"bundle-1","attributes":{"identifier":"com.example.app","name":"App","platform":"IOS"}}]}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/devices":
+			_, _ = io.WriteString(w, `{"data":[{"type":"devices","id":"dev-1"},{"type":"devices","id":"dev-2"}]}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/bundleIds/bundle-1/profiles":
+			_, _ = io.WriteString(w, `{"data":[{"type":"profiles","id":"prof-1","attributes":{"name":"AdHoc","profileType":"IOS_APP_ADHOC"}}]}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/profiles/prof-1/relationships/certificates":
+			_, _ = io.WriteString(w, `{"data":[{"type":"certificates","id":"cert-1"}]}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/profiles/prof-1/relationships/devices":
+			_, _ = io.WriteString(w, `{"data":[{"type":"devices","id":"dev-1"}]}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/profiles":
+			w.WriteHeader(http.StatusConflict)
+			_, _ = io.WriteString(w, `{"errors":[{"status":"409","code":"ENTITY_ERROR","title":"conflict","detail":"certificate revoked"}]}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.String())
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	setupServerEnv(t, server.URL)
+
+	root := RootCommand("1.2.3")
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"profiles", "refresh-devices", "--bundle-id", "com.example.app", "--profile-type", "IOS_APP_ADHOC", "--output-dir", t.TempDir(), "--confirm"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	var reported ReportedError
+	if !errors.As(runErr, &reported) {
+		t.Fatalf("expected reported error, got %v", runErr)
+	}
+	if !strings.Contains(stdout, `"status":"failed"`) || !strings.Contains(stdout, "create profile") {
+		t.Fatalf("unexpected output %q", stdout)
+	}
+}
+
+func TestDevicesRegisterFileSkipsExistingDevices(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "devices.txt")
+	content := "Device ID\tDevice Name\tDevice Platform\nUDID-EXISTING\tOld Phone\tios\nUDID-NEW\tNew Phone\tios\n"
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	var registered []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/devices":
+			_, _ = io.WriteString(w, `{"data":[{"type":"devices","id":"dev-old","attributes":{"name":"Old Phone","udid":"udid-existing","platform":"IOS"}}]}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/devices":
+			var payload struct {
+				Data struct {
+					Attributes struct {
+						UDID string `json:"udid"`
+					} `json:"attributes"`
+				} `json:"data"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Errorf("decode body: %v", err)
+			}
+			registered = append(registered, payload.Data.Attributes.UDID)
+			w.WriteHeader(http.StatusCreated)
+			_, _ = io.WriteString(w, `{"data":{"type":"devices","id":"dev-new","attributes":{"name":"New Phone","udid":"UDID-NEW","platform":"IOS"}}}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.String())
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	setupServerEnv(t, server.URL)
+
+	root := RootCommand("1.2.3")
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"devices", "register", "--file", path}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if len(registered) != 1 || registered[0] != "UDID-NEW" {
+		t.Fatalf("expected only new device to be registered, got %v", registered)
+	}
+	if !strings.Contains(stdout, `"registered":1`) || !strings.Contains(stdout, `"skipped":1`) {
+		t.Fatalf("unexpected output %q", stdout)
+	}
+}
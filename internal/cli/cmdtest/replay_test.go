@@ -0,0 +1,49 @@
+package cmdtest
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestAppsGetReplaysCassette(t *testing.T) {
+	t.Setenv("ASC_BYPASS_KEYCHAIN", "1")
+	t.Setenv("ASC_KEY_ID", "")
+	t.Setenv("ASC_ISSUER_ID", "")
+	t.Setenv("ASC_PRIVATE_KEY_PATH", "")
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_RECORD", "")
+
+	dir := t.TempDir()
+	cassette := `{
+  "request": {"method": "GET", "url": "https://api.appstoreconnect.apple.com/v1/apps/app-1"},
+  "response": {
+    "status": 200,
+    "headers": {"Content-Type": ["application/json"]},
+    "body": "{\"data\":{\"type\":\"apps\",\"id\":\"app-1\",\"attributes\":{\"name\":\"Replayed App\",\"bundleId\":\"com.example.replay\"}}}"
+  }
+}`
+	if err := os.WriteFile(filepath.Join(dir, "0001-get-v1-apps-app-1.json"), []byte(cassette), 0o600); err != nil {
+		t.Fatalf("write cassette: %v", err)
+	}
+	t.Setenv("ASC_REPLAY", dir)
+
+	root := RootCommand("1.2.3")
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"apps", "get", "--id", "app-1"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if !strings.Contains(stdout, `"name":"Replayed App"`) {
+		t.Fatalf("expected replayed app in stdout, got %q", stdout)
+	}
+	if stderr != "" {
+		t.Fatalf("expected empty stderr, got %q", stderr)
+	}
+}
@@ -0,0 +1,305 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type reportBackfillServer struct {
+	mu       sync.Mutex
+	requests map[string]int
+}
+
+func newReportBackfillServer(t *testing.T, handle func(date string, attempt int) (int, string)) *reportBackfillServer {
+	t.Helper()
+	state := &reportBackfillServer{requests: map[string]int{}}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		date := r.URL.Query().Get("filter[reportDate]")
+		state.mu.Lock()
+		state.requests[date]++
+		attempt := state.requests[date]
+		state.mu.Unlock()
+
+		status, body := handle(date, attempt)
+		if status != http.StatusOK {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(status)
+			_, _ = io.WriteString(w, body)
+			return
+		}
+		w.Header().Set("Content-Type", "application/a-gzip")
+		_, _ = io.WriteString(w, body)
+	}))
+	t.Cleanup(server.Close)
+	setupServerEnv(t, server.URL)
+	t.Setenv("ASC_BASE_DELAY", "1ms")
+	t.Setenv("ASC_MAX_DELAY", "5ms")
+	return state
+}
+
+func (s *reportBackfillServer) count(date string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests[date]
+}
+
+const reportNotFoundBody = `{"errors":[{"status":"404","code":"NOT_FOUND","title":"The specified resource does not exist","detail":"There were no sales for the date specified."}]}`
+
+type backfillOutput struct {
+	Requested   int `json:"requested"`
+	Downloaded  int `json:"downloaded"`
+	Skipped     int `json:"skipped"`
+	Unavailable int `json:"unavailable"`
+	Pending     int `json:"pending"`
+	Failed      int `json:"failed"`
+}
+
+func runBackfill(t *testing.T, args []string) (backfillOutput, string, error) {
+	t.Helper()
+	root := RootCommand("1.2.3")
+	var runErr error
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse(args); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	var result backfillOutput
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	return result, stderr, runErr
+}
+
+func TestAnalyticsSalesBackfillResumesFromManifest(t *testing.T) {
+	server := newReportBackfillServer(t, func(date string, attempt int) (int, string) {
+		switch date {
+		case "2024-01-02":
+			return http.StatusNotFound, reportNotFoundBody
+		case "2024-01-03":
+			if attempt == 1 {
+				return http.StatusTooManyRequests, `{"errors":[{"status":"429","code":"RATE_LIMIT_EXCEEDED","title":"Rate limit exceeded"}]}`
+			}
+		}
+		return http.StatusOK, "report " + date
+	})
+	dir := t.TempDir()
+	args := []string{
+		"analytics", "sales", "backfill",
+		"--vendor", "12345678",
+		"--frequency", "DAILY",
+		"--from", "2024-01-01",
+		"--to", "2024-01-03",
+		"--dir", dir,
+		"--concurrency", "2",
+	}
+
+	result, stderr, err := runBackfill(t, args)
+	if err != nil {
+		t.Fatalf("run error: %v", err)
+	}
+	if result.Requested != 3 || result.Downloaded != 2 || result.Unavailable != 1 || result.Failed != 0 {
+		t.Fatalf("unexpected first run result: %+v", result)
+	}
+	if !strings.Contains(stderr, "2024-01-02: unavailable") {
+		t.Fatalf("expected progress for unavailable date, got %q", stderr)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "sales_report_2024-01-03_SALES_SUMMARY_DAILY.tsv.gz"))
+	if err != nil || string(data) != "report 2024-01-03" {
+		t.Fatalf("unexpected report contents %q (%v)", data, err)
+	}
+
+	var manifest struct {
+		Entries map[string]struct {
+			Status string `json:"status"`
+			SHA256 string `json:"sha256"`
+		} `json:"entries"`
+	}
+	manifestData, err := os.ReadFile(filepath.Join(dir, "manifest.json"))
+	if err != nil {
+		t.Fatalf("read manifest: %v", err)
+	}
+	if err := json.Unmarshal(manifestData, &manifest); err != nil {
+		t.Fatalf("parse manifest: %v", err)
+	}
+	if entry := manifest.Entries["sales_report_2024-01-02_SALES_SUMMARY_DAILY.tsv.gz"]; entry.Status != "unavailable" {
+		t.Fatalf("expected unavailable manifest entry, got %+v", manifest.Entries)
+	}
+	if entry := manifest.Entries["sales_report_2024-01-01_SALES_SUMMARY_DAILY.tsv.gz"]; entry.Status != "downloaded" || entry.SHA256 == "" {
+		t.Fatalf("expected downloaded manifest entry with hash, got %+v", manifest.Entries)
+	}
+
+	// Tamper with one report; the second run re-downloads only that file.
+	tampered := filepath.Join(dir, "sales_report_2024-01-01_SALES_SUMMARY_DAILY.tsv.gz")
+	if err := os.WriteFile(tampered, []byte("truncated"), 0o600); err != nil {
+		t.Fatalf("write tampered report: %v", err)
+	}
+
+	result, _, err = runBackfill(t, args)
+	if err != nil {
+		t.Fatalf("second run error: %v", err)
+	}
+	if result.Downloaded != 1 || result.Skipped != 1 || result.Unavailable != 1 {
+		t.Fatalf("unexpected second run result: %+v", result)
+	}
+	if server.count("2024-01-01") != 2 || server.count("2024-01-02") != 1 || server.count("2024-01-03") != 2 {
+		t.Fatalf("unexpected request counts: %+v", server.requests)
+	}
+	data, _ = os.ReadFile(tampered)
+	if string(data) != "report 2024-01-01" {
+		t.Fatalf("expected tampered report to be replaced, got %q", data)
+	}
+
+	// --retry-unavailable asks again for dates recorded without a report.
+	if _, _, err := runBackfill(t, append(args, "--retry-unavailable")); err != nil {
+		t.Fatalf("third run error: %v", err)
+	}
+	if server.count("2024-01-02") != 2 {
+		t.Fatalf("expected unavailable date to be retried, got %d requests", server.count("2024-01-02"))
+	}
+}
+
+func TestAnalyticsSalesBackfillRetriesUnpublishedPeriods(t *testing.T) {
+	server := newReportBackfillServer(t, func(date string, attempt int) (int, string) {
+		return http.StatusNotFound, reportNotFoundBody
+	})
+	dir := t.TempDir()
+	yesterday := time.Now().UTC().AddDate(0, 0, -1).Format("2006-01-02")
+	fileName := "sales_report_" + yesterday + "_SALES_SUMMARY_DAILY.tsv.gz"
+
+	// An entry recorded as unavailable by an older run is still retried.
+	manifest := `{"entries":{"` + fileName + `":{"period":"` + yesterday + `","status":"unavailable","updatedAt":"2025-01-01T00:00:00Z"}}}`
+	if err := os.WriteFile(filepath.Join(dir, "manifest.json"), []byte(manifest), 0o600); err != nil {
+		t.Fatalf("write manifest: %v", err)
+	}
+	args := []string{
+		"analytics", "sales", "backfill",
+		"--vendor", "12345678",
+		"--frequency", "DAILY",
+		"--from", yesterday,
+		"--to", yesterday,
+		"--dir", dir,
+	}
+
+	for run := 1; run <= 2; run++ {
+		result, stderr, err := runBackfill(t, args)
+		if err != nil {
+			t.Fatalf("run %d error: %v", run, err)
+		}
+		if result.Pending != 1 || result.Unavailable != 0 {
+			t.Fatalf("run %d: expected pending period, got %+v", run, result)
+		}
+		if !strings.Contains(stderr, yesterday+": not yet published") {
+			t.Fatalf("run %d: expected pending progress, got %q", run, stderr)
+		}
+	}
+	if server.count(yesterday) != 2 {
+		t.Fatalf("expected unpublished period to be requested on every run, got %d", server.count(yesterday))
+	}
+}
+
+func TestFinanceBackfillReportsFailures(t *testing.T) {
+	newReportBackfillServer(t, func(date string, attempt int) (int, string) {
+		if date == "2025-02" {
+			return http.StatusForbidden, `{"errors":[{"status":"403","code":"FORBIDDEN_ERROR","title":"Forbidden"}]}`
+		}
+		return http.StatusOK, "finance " + date
+	})
+	dir := t.TempDir()
+
+	result, _, err := runBackfill(t, []string{
+		"finance", "backfill",
+		"--vendor", "12345678",
+		"--from", "2025-01",
+		"--to", "2025-03",
+		"--dir", dir,
+	})
+	if err == nil || !strings.Contains(err.Error(), "finance backfill: 1 of 3 reports failed") {
+		t.Fatalf("expected failure error, got %v", err)
+	}
+	if result.Downloaded != 2 || result.Failed != 1 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "finance_report_2025-03_FINANCIAL_ZZ.tsv.gz")); err != nil {
+		t.Fatalf("expected downloaded report: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "finance_report_2025-02_FINANCIAL_ZZ.tsv.gz")); !os.IsNotExist(err) {
+		t.Fatalf("expected no file for failed month, got %v", err)
+	}
+}
+
+func TestReportBackfillValidationErrors(t *testing.T) {
+	t.Setenv("ASC_VENDOR_NUMBER", "")
+	t.Setenv("ASC_ANALYTICS_VENDOR_NUMBER", "")
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "analytics sales backfill missing vendor",
+			args:    []string{"analytics", "sales", "backfill", "--frequency", "DAILY", "--from", "2024-01-01", "--to", "2024-01-02", "--dir", "out"},
+			wantErr: "--vendor is required",
+		},
+		{
+			name:    "analytics sales backfill missing dir",
+			args:    []string{"analytics", "sales", "backfill", "--vendor", "1", "--frequency", "DAILY", "--from", "2024-01-01", "--to", "2024-01-02"},
+			wantErr: "--dir is required",
+		},
+		{
+			name:    "finance backfill missing from",
+			args:    []string{"finance", "backfill", "--vendor", "1", "--to", "2025-01", "--dir", "out"},
+			wantErr: "--from is required",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestReportBackfillRejectsInvalidRange(t *testing.T) {
+	root := RootCommand("1.2.3")
+	var runErr error
+	_, _ = captureOutput(t, func() {
+		if err := root.Parse([]string{"finance", "backfill", "--vendor", "1", "--from", "2025-06", "--to", "2025-01", "--dir", t.TempDir()}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr == nil || !strings.Contains(runErr.Error(), "--from must not be after --to") {
+		t.Fatalf("expected range error, got %v", runErr)
+	}
+}
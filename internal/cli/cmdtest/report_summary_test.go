@@ -0,0 +1,178 @@
+package cmdtest
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestAnalyticsSalesSummarizeAggregatesFiles(t *testing.T) {
+	dir := t.TempDir()
+	header := "Provider\tSKU\tTitle\tProduct Type Identifier\tUnits\tDeveloper Proceeds\tCountry Code\tCurrency of Proceeds\tCustomer Price\n"
+	day1 := writeReportFile(t, filepath.Join(dir, "day1.tsv.gz"), header+
+		"APPLE\tcom.example.app\tExample\t1F\t2\t0.70\tUS\tUSD\t0.99\n", true)
+	day2 := writeReportFile(t, filepath.Join(dir, "day2.tsv"), header+
+		"APPLE\tcom.example.app\tExample\t1F\t3\t0.70\tUS\tUSD\t0.99\n"+
+		"APPLE\tcom.example.app\tExample\t1F\t1\t0.60\tGB\tGBP\t0.99\n", false)
+
+	root := RootCommand("1.2.3")
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"analytics", "sales", "summarize", "--file", day1 + "," + day2}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr != nil {
+		t.Fatalf("run error: %v", runErr)
+	}
+
+	var summary struct {
+		ReportType string   `json:"reportType"`
+		Files      []string `json:"files"`
+		Rows       int      `json:"rows"`
+		Totals     []struct {
+			Currency string  `json:"currency"`
+			Units    float64 `json:"units"`
+			Proceeds float64 `json:"proceeds"`
+		} `json:"totals"`
+		ByTerritory []struct {
+			Key string `json:"key"`
+		} `json:"byTerritory"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &summary); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if summary.ReportType != "SALES" || summary.Rows != 3 || len(summary.Files) != 2 {
+		t.Fatalf("unexpected summary: %+v", summary)
+	}
+	if len(summary.Totals) != 2 || summary.Totals[0].Currency != "USD" || summary.Totals[0].Units != 5 || summary.Totals[0].Proceeds != 3.5 {
+		t.Fatalf("unexpected totals: %+v", summary.Totals)
+	}
+	if len(summary.ByTerritory) != 2 {
+		t.Fatalf("unexpected territories: %+v", summary.ByTerritory)
+	}
+}
+
+func TestAnalyticsSalesSummarizeRejectsMixedReportTypes(t *testing.T) {
+	dir := t.TempDir()
+	sales := writeReportFile(t, filepath.Join(dir, "sales.tsv"), "SKU\tUnits\tDeveloper Proceeds\nsku\t1\t1\n", false)
+	events := writeReportFile(t, filepath.Join(dir, "events.tsv"), "Event Date\tEvent\tSubscription Apple ID\tQuantity\n2024-01-01\tSubscribe\t1\t1\n", false)
+
+	root := RootCommand("1.2.3")
+	var runErr error
+	_, _ = captureOutput(t, func() {
+		if err := root.Parse([]string{"analytics", "sales", "summarize", "--file", sales + "," + events}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr == nil || !strings.Contains(runErr.Error(), "is a SUBSCRIPTION_EVENT report, expected SALES") {
+		t.Fatalf("expected mixed type error, got %v", runErr)
+	}
+}
+
+func TestFinanceSummarizeConvertsToReportingCurrencyCSV(t *testing.T) {
+	path := writeReportFile(t, filepath.Join(t.TempDir(), "finance.tsv.gz"),
+		"Start Date\tEnd Date\tVendor Identifier\tQuantity\tPartner Share\tExtended Partner Share\tPartner Share Currency\tTitle\tProduct Type Identifier\tCountry Of Sale\tCustomer Price\n"+
+			"11/30/2025\t01/03/2026\tcom.example.app\t10\t0.70\t7.00\tUSD\tExample\t1F\tUS\t0.99\n"+
+			"11/30/2025\t01/03/2026\tcom.example.app\t5\t0.80\t4.00\tEUR\tExample\t1F\tFR\t0.99\n"+
+			"Total_Rows\t2\n"+
+			"\n"+
+			"Country or Region (Currency)\tTotal Owed\tExchange Rate\tProceeds\tBank Account Currency\n"+
+			"Americas (USD)\t7.00\t1.0\t7.00\tUSD\n"+
+			"Euro-Zone (EUR)\t4.00\t1.25\t5.00\tUSD\n", true)
+
+	root := RootCommand("1.2.3")
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"finance", "summarize", "--file", path, "--output", "csv"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr != nil {
+		t.Fatalf("run error: %v", runErr)
+	}
+	for _, want := range []string{
+		"Dimension,Key,Name,Currency,Units,Proceeds,Rows\n",
+		"total,TOTAL,,USD,15,12.00,2\n",
+		"sku,com.example.app,Example,USD,15,12.00,2\n",
+		"currency,EUR,,EUR,5,4.00,1\n",
+	} {
+		if !strings.Contains(stdout, want) {
+			t.Fatalf("expected %q in output, got %q", want, stdout)
+		}
+	}
+}
+
+func TestReportSummarizeValidationErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "analytics sales summarize missing file",
+			args:    []string{"analytics", "sales", "summarize"},
+			wantErr: "--file is required",
+		},
+		{
+			name:    "finance summarize missing file",
+			args:    []string{"finance", "summarize"},
+			wantErr: "--file is required",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func writeReportFile(t *testing.T, path, content string, compress bool) string {
+	t.Helper()
+	data := []byte(content)
+	if compress {
+		var buf bytes.Buffer
+		gz := gzip.NewWriter(&buf)
+		if _, err := gz.Write(data); err != nil {
+			t.Fatalf("gzip write: %v", err)
+		}
+		if err := gz.Close(); err != nil {
+			t.Fatalf("gzip close: %v", err)
+		}
+		data = buf.Bytes()
+	}
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("write report: %v", err)
+	}
+	return path
+}
@@ -0,0 +1,386 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+const reviewsDigestFixture = `{"data":[
+	{"type":"customerReviews","id":"r1","attributes":{"rating":1,"title":"Keeps crashing","body":"The app keeps crashing on launch.","reviewerNickname":"sam","createdDate":"2026-01-10T10:00:00Z","territory":"USA"},
+	 "relationships":{"response":{"data":{"type":"customerReviewResponses","id":"resp-1"}}}},
+	{"type":"customerReviews","id":"r2","attributes":{"rating":2,"title":"Crashing again","body":"Still keeps crashing after the update","reviewerNickname":"alex","createdDate":"2026-01-09T10:00:00Z","territory":"GBR"},
+	 "relationships":{"response":{"data":null}}},
+	{"type":"customerReviews","id":"r3","attributes":{"rating":5,"title":"Love it","body":"Great sync between devices","reviewerNickname":"kim","createdDate":"2026-01-08T10:00:00Z","territory":"USA"}},
+	{"type":"customerReviews","id":"r4","attributes":{"rating":3,"title":"Okay","body":"Does the job","reviewerNickname":"lee","createdDate":"2026-01-07T10:00:00Z","territory":"DEU"}},
+	{"type":"customerReviews","id":"r-old","attributes":{"rating":1,"title":"Crashing","body":"keeps crashing","createdDate":"2025-12-01T10:00:00Z","territory":"USA"}}]}`
+
+func newReviewsServer(t *testing.T, extra func(w http.ResponseWriter, r *http.Request) bool) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if extra != nil && extra(w, r) {
+			return
+		}
+		switch r.URL.Path {
+		case "/v1/apps/app-1/customerReviews":
+			query := r.URL.Query()
+			if query.Get("include") != "response" || query.Get("sort") != "-createdDate" {
+				t.Errorf("expected include=response and sort=-createdDate, got %s", r.URL.RawQuery)
+			}
+			_, _ = io.WriteString(w, reviewsDigestFixture)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = io.WriteString(w, `{"errors":[{"status":"404","code":"NOT_FOUND","title":"Not found"}]}`)
+		}
+	}))
+	t.Cleanup(server.Close)
+	setupServerEnv(t, server.URL)
+	return server
+}
+
+type reviewsDigestOutput struct {
+	Total     int `json:"total"`
+	Responded int `json:"responded"`
+	Ratings   []struct {
+		Key       string `json:"key"`
+		Count     int    `json:"count"`
+		Responded int    `json:"responded"`
+	} `json:"ratings"`
+	Territories []struct {
+		Key   string `json:"key"`
+		Count int    `json:"count"`
+	} `json:"territories"`
+	Versions []struct {
+		Key   string `json:"key"`
+		Count int    `json:"count"`
+	} `json:"versions"`
+	Keywords []struct {
+		Term          string  `json:"term"`
+		Count         int     `json:"count"`
+		AverageRating float64 `json:"averageRating"`
+	} `json:"keywords"`
+	Phrases []struct {
+		Term  string `json:"term"`
+		Count int    `json:"count"`
+	} `json:"phrases"`
+	Reviews []struct {
+		ID         string   `json:"id"`
+		Version    string   `json:"version"`
+		Responded  bool     `json:"responded"`
+		ResponseID string   `json:"responseId"`
+		Keywords   []string `json:"keywords"`
+	} `json:"reviews"`
+}
+
+func TestReviewsDigestGroupsReviewsAndFindsRecurringTerms(t *testing.T) {
+	newReviewsServer(t, func(w http.ResponseWriter, r *http.Request) bool {
+		switch r.URL.Path {
+		case "/v1/apps/app-1/appStoreVersions":
+			_, _ = io.WriteString(w, `{"data":[
+				{"type":"appStoreVersions","id":"ver-old","attributes":{"versionString":"1.9","createdDate":"2025-11-01T00:00:00Z"}},
+				{"type":"appStoreVersions","id":"ver-new","attributes":{"versionString":"2.0","createdDate":"2026-01-05T00:00:00Z"}}]}`)
+		case "/v1/appStoreVersions/ver-new/customerReviews":
+			_, _ = io.WriteString(w, `{"data":[
+				{"type":"customerReviews","id":"r1","attributes":{"createdDate":"2026-01-10T10:00:00Z"}},
+				{"type":"customerReviews","id":"r2","attributes":{"createdDate":"2026-01-09T10:00:00Z"}}]}`)
+		case "/v1/appStoreVersions/ver-old/customerReviews":
+			t.Errorf("expected only the most recent version to be checked")
+		default:
+			return false
+		}
+		return true
+	})
+
+	root := RootCommand("1.2.3")
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"reviews", "digest", "--app", "app-1", "--since", "2026-01-01", "--versions", "1"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr != nil {
+		t.Fatalf("run error: %v", runErr)
+	}
+
+	var digest reviewsDigestOutput
+	if err := json.Unmarshal([]byte(stdout), &digest); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if digest.Total != 4 || digest.Responded != 1 {
+		t.Fatalf("expected 4 reviews (old one excluded) with 1 responded, got %+v", digest)
+	}
+	if len(digest.Ratings) != 4 || digest.Ratings[0].Key != "5" || digest.Ratings[3].Key != "1" || digest.Ratings[3].Responded != 1 {
+		t.Fatalf("unexpected rating groups: %+v", digest.Ratings)
+	}
+	if digest.Territories[0].Key != "USA" || digest.Territories[0].Count != 2 {
+		t.Fatalf("unexpected territory groups: %+v", digest.Territories)
+	}
+	if len(digest.Versions) != 2 || digest.Versions[0].Key != "2.0" || digest.Versions[0].Count != 2 || digest.Versions[1].Key != "unknown" {
+		t.Fatalf("unexpected version groups: %+v", digest.Versions)
+	}
+
+	if len(digest.Keywords) != 2 || digest.Keywords[0].Term != "crashing" || digest.Keywords[0].Count != 2 || digest.Keywords[0].AverageRating != 1.5 || digest.Keywords[1].Term != "keeps" {
+		t.Fatalf("expected crashing and keeps as recurring keywords, got %+v", digest.Keywords)
+	}
+	if len(digest.Phrases) != 1 || digest.Phrases[0].Term != "keeps crashing" || digest.Phrases[0].Count != 2 {
+		t.Fatalf("expected recurring phrase, got %+v", digest.Phrases)
+	}
+
+	first := digest.Reviews[0]
+	if first.ID != "r1" || !first.Responded || first.ResponseID != "resp-1" || first.Version != "2.0" || strings.Join(first.Keywords, ",") != "crashing,keeps" {
+		t.Fatalf("unexpected first review: %+v", first)
+	}
+	if digest.Reviews[2].Responded || digest.Reviews[2].Version != "unknown" {
+		t.Fatalf("unexpected third review: %+v", digest.Reviews[2])
+	}
+}
+
+func writeResponseRules(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "responses.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write rules: %v", err)
+	}
+	return path
+}
+
+const reviewResponseRulesFixture = `rules:
+  - name: crashes
+    ratings: [1, 2]
+    keywords: [crash, keeps crashing]
+    response: Sorry about the crashes, {{.Nickname}}. Version 2.1 fixes them.
+  - name: thanks
+    ratings: [5]
+    territories: [usa]
+    response: Thanks for the {{.Rating}}-star review!
+`
+
+type reviewsBatchOutput struct {
+	DryRun           bool `json:"dryRun"`
+	Reviews          int  `json:"reviews"`
+	AlreadyResponded int  `json:"alreadyResponded"`
+	Unmatched        int  `json:"unmatched"`
+	Responded        int  `json:"responded"`
+	Failed           int  `json:"failed"`
+	Items            []struct {
+		ReviewID   string `json:"reviewId"`
+		Rule       string `json:"rule"`
+		Response   string `json:"response"`
+		Status     string `json:"status"`
+		ResponseID string `json:"responseId"`
+		Error      string `json:"error"`
+	} `json:"items"`
+}
+
+func TestReviewsRespondBatchDryRunPostsNothing(t *testing.T) {
+	newReviewsServer(t, func(w http.ResponseWriter, r *http.Request) bool {
+		if r.Method == http.MethodPost {
+			t.Errorf("dry run must not post responses")
+			return true
+		}
+		return false
+	})
+	rules := writeResponseRules(t, reviewResponseRulesFixture)
+
+	root := RootCommand("1.2.3")
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"reviews", "respond", "--app", "app-1", "--batch", rules, "--since", "2026-01-01", "--dry-run"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr != nil {
+		t.Fatalf("run error: %v", runErr)
+	}
+
+	var result reviewsBatchOutput
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if !result.DryRun || result.Reviews != 4 || result.AlreadyResponded != 1 || result.Unmatched != 1 || result.Responded != 0 {
+		t.Fatalf("unexpected summary: %+v", result)
+	}
+	if len(result.Items) != 2 {
+		t.Fatalf("expected 2 planned responses, got %+v", result.Items)
+	}
+	if result.Items[0].ReviewID != "r2" || result.Items[0].Rule != "crashes" || result.Items[0].Status != "planned" ||
+		result.Items[0].Response != "Sorry about the crashes, alex. Version 2.1 fixes them." {
+		t.Fatalf("unexpected first item: %+v", result.Items[0])
+	}
+	if result.Items[1].ReviewID != "r3" || result.Items[1].Response != "Thanks for the 5-star review!" {
+		t.Fatalf("unexpected second item: %+v", result.Items[1])
+	}
+}
+
+func TestReviewsRespondBatchPostsResponsesAndReportsFailures(t *testing.T) {
+	var mu sync.Mutex
+	posted := map[string]string{}
+	newReviewsServer(t, func(w http.ResponseWriter, r *http.Request) bool {
+		if r.Method != http.MethodPost || r.URL.Path != "/v1/customerReviewResponses" {
+			return false
+		}
+		var payload struct {
+			Data struct {
+				Attributes struct {
+					ResponseBody string `json:"responseBody"`
+				} `json:"attributes"`
+				Relationships struct {
+					Review struct {
+						Data struct {
+							ID string `json:"id"`
+						} `json:"data"`
+					} `json:"review"`
+				} `json:"relationships"`
+			} `json:"data"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("decode body: %v", err)
+		}
+		reviewID := payload.Data.Relationships.Review.Data.ID
+		mu.Lock()
+		posted[reviewID] = payload.Data.Attributes.ResponseBody
+		mu.Unlock()
+		if reviewID == "r3" {
+			w.WriteHeader(http.StatusConflict)
+			_, _ = io.WriteString(w, `{"errors":[{"status":"409","code":"STATE_ERROR","title":"Review already has a response"}]}`)
+			return true
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"data":{"type":"customerReviewResponses","id":"resp-%s","attributes":{"responseBody":%q}}}`, reviewID, payload.Data.Attributes.ResponseBody)
+		return true
+	})
+	rules := writeResponseRules(t, reviewResponseRulesFixture)
+
+	root := RootCommand("1.2.3")
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"reviews", "respond", "--app", "app-1", "--batch", rules, "--since", "2026-01-01"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	var reported ReportedError
+	if !errors.As(runErr, &reported) || !strings.Contains(runErr.Error(), "1 of 2 responses failed") {
+		t.Fatalf("expected reported failure, got %v", runErr)
+	}
+
+	var result reviewsBatchOutput
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if result.Responded != 1 || result.Failed != 1 {
+		t.Fatalf("unexpected summary: %+v", result)
+	}
+	if result.Items[0].Status != "responded" || result.Items[0].ResponseID != "resp-r2" {
+		t.Fatalf("unexpected first item: %+v", result.Items[0])
+	}
+	if result.Items[1].Status != "failed" || result.Items[1].Error == "" {
+		t.Fatalf("unexpected second item: %+v", result.Items[1])
+	}
+	if len(posted) != 2 || posted["r2"] != "Sorry about the crashes, alex. Version 2.1 fixes them." {
+		t.Fatalf("unexpected posted responses: %v", posted)
+	}
+	if _, ok := posted["r1"]; ok {
+		t.Fatal("review with an existing response must not be answered")
+	}
+}
+
+func TestReviewsRespondBatchRejectsInvalidRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		rules   string
+		wantErr string
+	}{
+		{name: "no rules", rules: "rules: []\n", wantErr: "defines no rules"},
+		{name: "missing response", rules: "rules:\n  - name: empty\n    ratings: [1]\n", wantErr: "empty: response is required"},
+		{name: "bad rating", rules: "rules:\n  - ratings: [6]\n    response: hi\n", wantErr: "rule 1: ratings must be between 1 and 5"},
+		{name: "unknown template field", rules: "rules:\n  - name: typo\n    response: Hi {{.Name}}\n", wantErr: "typo: invalid response template"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Setenv("ASC_APP_ID", "app-1")
+			rules := writeResponseRules(t, test.rules)
+
+			root := RootCommand("1.2.3")
+			var runErr error
+			_, _ = captureOutput(t, func() {
+				if err := root.Parse([]string{"reviews", "respond", "--batch", rules}); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				runErr = root.Run(context.Background())
+			})
+			if runErr == nil || !strings.Contains(runErr.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, runErr)
+			}
+		})
+	}
+}
+
+func TestReviewsDigestAndBatchValidationErrors(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "digest missing app",
+			args:    []string{"reviews", "digest"},
+			wantErr: "--app is required",
+		},
+		{
+			name:    "batch missing app",
+			args:    []string{"reviews", "respond", "--batch", "responses.yaml"},
+			wantErr: "--app is required with --batch",
+		},
+		{
+			name:    "batch with review id",
+			args:    []string{"reviews", "respond", "--batch", "responses.yaml", "--review-id", "REVIEW_123"},
+			wantErr: "--batch cannot be combined with --review-id or --response",
+		},
+		{
+			name:    "dry run without batch",
+			args:    []string{"reviews", "respond", "--review-id", "REVIEW_123", "--response", "Thanks!", "--dry-run"},
+			wantErr: "--dry-run requires --batch",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
@@ -0,0 +1,308 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSigningRenewValidationErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "invalid within",
+			args:    []string{"signing", "renew", "--within", "soon"},
+			wantErr: "--within must be a duration",
+		},
+		{
+			name:    "delete old without confirm",
+			args:    []string{"signing", "renew", "--delete-old"},
+			wantErr: "--confirm is required",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestSigningRenewRegeneratesExpiringProfile(t *testing.T) {
+	now := time.Now().UTC()
+	soon := now.Add(5 * 24 * time.Hour).Format("2006-01-02T15:04:05.000-0700")
+	later := now.Add(365 * 24 * time.Hour).Format("2006-01-02T15:04:05.000-0700")
+
+	var created map[string]any
+	var deleted string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/certificates":
+			fmt.Fprintf(w, `{"data":[
+				{"type":"certificates","id":"cert-old","attributes":{"name":"Old","certificateType":"IOS_DISTRIBUTION","expirationDate":%q}},
+				{"type":"certificates","id":"cert-new","attributes":{"name":"New","certificateType":"IOS_DISTRIBUTION","expirationDate":%q}}
+			]}`, soon, later)
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/profiles":
+			fmt.Fprintf(w, `{"data":[
+				{"type":"profiles","id":"prof-1","attributes":{"name":"App Store","profileType":"IOS_APP_STORE","profileState":"ACTIVE","expirationDate":%q}},
+				{"type":"profiles","id":"prof-2","attributes":{"name":"Fresh","profileType":"IOS_APP_STORE","profileState":"ACTIVE","expirationDate":%q}}
+			]}`, soon, later)
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/profiles/prof-1/relationships/bundleId":
+			_, _ = io.WriteString(w, `{"data":{"type":"bundleIds","id":"bundle-1"}}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/profiles/prof-1/relationships/certificates":
+			_, _ = io.WriteString(w, `{"data":[{"type":"certificates","id":"cert-old"},{"type":"certificates","id":"cert-new"}]}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/profiles/prof-1/relationships/devices":
+			_, _ = io.WriteString(w, `{"data":[]}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/profiles":
+			if err := json.NewDecoder(r.Body).Decode(&created); err != nil {
+				t.Errorf("decode body: %v", err)
+			}
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, `{"data":{"type":"profiles","id":"prof-3","attributes":{"name":"App Store","profileType":"IOS_APP_STORE","expirationDate":%q}}}`, later)
+		case r.Method == http.MethodDelete && r.URL.Path == "/v1/profiles/prof-1":
+			deleted = "prof-1"
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.String())
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	setupServerEnv(t, server.URL)
+
+	root := RootCommand("1.2.3")
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"signing", "renew", "--within", "30d", "--delete-old", "--confirm"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	body, _ := json.Marshal(created)
+	if !strings.Contains(string(body), `"id":"cert-new"`) || strings.Contains(string(body), "cert-old") {
+		t.Fatalf("expected only the valid certificate in new profile, got %s", body)
+	}
+	if !strings.Contains(string(body), `"id":"bundle-1"`) {
+		t.Fatalf("expected bundle ID relationship, got %s", body)
+	}
+	if !strings.Contains(string(body), "App Store (renewed "+now.Format("2006-01-02")+")") {
+		t.Fatalf("expected renewed profile name, got %s", body)
+	}
+	if deleted != "prof-1" {
+		t.Fatalf("expected old profile to be deleted")
+	}
+
+	var result struct {
+		Items []struct {
+			Kind    string `json:"kind"`
+			ID      string `json:"id"`
+			Action  string `json:"action"`
+			NewID   string `json:"newId"`
+			Deleted bool   `json:"deleted"`
+		} `json:"items"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v (%q)", err, stdout)
+	}
+	if len(result.Items) != 2 {
+		t.Fatalf("expected certificate and profile items, got %+v", result.Items)
+	}
+	if result.Items[0].Kind != "certificate" || result.Items[0].ID != "cert-old" || result.Items[0].Action != "manual" {
+		t.Fatalf("unexpected certificate item %+v", result.Items[0])
+	}
+	profile := result.Items[1]
+	if profile.ID != "prof-1" || profile.Action != "renewed" || profile.NewID != "prof-3" || !profile.Deleted {
+		t.Fatalf("unexpected profile item %+v", profile)
+	}
+}
+
+func TestSigningRenewDryRunMakesNoChanges(t *testing.T) {
+	soon := time.Now().UTC().Add(-time.Hour).Format(time.RFC3339)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/certificates":
+			_, _ = io.WriteString(w, `{"data":[]}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/profiles":
+			fmt.Fprintf(w, `{"data":[{"type":"profiles","id":"prof-1","attributes":{"name":"Dev","profileType":"IOS_APP_DEVELOPMENT","profileState":"INVALID","expirationDate":%q}}]}`, soon)
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/profiles/prof-1/relationships/bundleId":
+			_, _ = io.WriteString(w, `{"data":{"type":"bundleIds","id":"bundle-1"}}`)
+		case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/v1/profiles/prof-1/relationships/"):
+			_, _ = io.WriteString(w, `{"data":[]}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.String())
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	setupServerEnv(t, server.URL)
+
+	root := RootCommand("1.2.3")
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"signing", "renew", "--dry-run"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if !strings.Contains(stdout, `"state":"INVALID"`) || !strings.Contains(stdout, `"action":"skipped"`) {
+		t.Fatalf("expected invalid profile to be skipped without certificates, got %q", stdout)
+	}
+}
+
+func TestSigningRenewReportsFailedProfiles(t *testing.T) {
+	now := time.Now().UTC()
+	soon := now.Add(5 * 24 * time.Hour).Format("2006-01-02T15:04:05.000-0700")
+	later := now.Add(365 * 24 * time.Hour).Format("2006-01-02T15:04:05.000-0700")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/certificates":
+			fmt.Fprintf(w, `{"data":[{"type":"certificates","id":"cert-new","attributes":{"name":"New","certificateType":"IOS_DISTRIBUTION","expirationDate":%q}}]}`, later)
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/profiles":
+			fmt.Fprintf(w, `{"data":[{"type":"profiles","id":"prof-1","attributes":{"name":"App Store","profileType":"IOS_APP_STORE","profileState":"ACTIVE","expirationDate":%q}}]}`, soon)
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/profiles/prof-1/relationships/bundleId":
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = io.WriteString(w, `{"errors":[{"status":"403","code":"FORBIDDEN_ERROR","title":"Forbidden"}]}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.String())
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	setupServerEnv(t, server.URL)
+
+	root := RootCommand("1.2.3")
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"signing", "renew", "--within", "30d", "--confirm"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	var reported ReportedError
+	if !errors.As(runErr, &reported) || !strings.Contains(runErr.Error(), "1 profiles failed to renew") {
+		t.Fatalf("expected reported error, got %v", runErr)
+	}
+	if !strings.Contains(stdout, `"action":"failed"`) {
+		t.Fatalf("expected failed item in output, got %q", stdout)
+	}
+}
+
+func TestSigningRenewRerunSkipsRenewedProfiles(t *testing.T) {
+	now := time.Now().UTC()
+	soon := now.Add(5 * 24 * time.Hour).Format("2006-01-02T15:04:05.000-0700")
+	later := now.Add(365 * 24 * time.Hour).Format("2006-01-02T15:04:05.000-0700")
+
+	profiles := []string{
+		fmt.Sprintf(`{"type":"profiles","id":"prof-1","attributes":{"name":"App Store","profileType":"IOS_APP_STORE","profileState":"ACTIVE","expirationDate":%q}}`, soon),
+	}
+	names := map[string]bool{"App Store": true}
+	creates := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/certificates":
+			fmt.Fprintf(w, `{"data":[{"type":"certificates","id":"cert-1","attributes":{"name":"Dist","certificateType":"IOS_DISTRIBUTION","expirationDate":%q}}]}`, later)
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/profiles":
+			fmt.Fprintf(w, `{"data":[%s]}`, strings.Join(profiles, ","))
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/relationships/bundleId"):
+			_, _ = io.WriteString(w, `{"data":{"type":"bundleIds","id":"bundle-1"}}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/profiles/prof-1/relationships/certificates":
+			_, _ = io.WriteString(w, `{"data":[{"type":"certificates","id":"cert-1"}]}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/profiles/prof-1/relationships/devices":
+			_, _ = io.WriteString(w, `{"data":[]}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/profiles":
+			var payload struct {
+				Data struct {
+					Attributes struct {
+						Name string `json:"name"`
+					} `json:"attributes"`
+				} `json:"data"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Errorf("decode body: %v", err)
+			}
+			name := payload.Data.Attributes.Name
+			if names[name] {
+				w.WriteHeader(http.StatusConflict)
+				_, _ = io.WriteString(w, `{"errors":[{"status":"409","code":"ENTITY_ERROR","title":"duplicate","detail":"Multiple profiles found with the name"}]}`)
+				return
+			}
+			creates++
+			names[name] = true
+			profile := fmt.Sprintf(`{"type":"profiles","id":"prof-new-%d","attributes":{"name":%q,"profileType":"IOS_APP_STORE","profileState":"ACTIVE","expirationDate":%q}}`, creates, name, later)
+			profiles = append(profiles, profile)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, `{"data":%s}`, profile)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.String())
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	setupServerEnv(t, server.URL)
+
+	run := func() string {
+		root := RootCommand("1.2.3")
+		stdout, _ := captureOutput(t, func() {
+			if err := root.Parse([]string{"signing", "renew", "--within", "30d"}); err != nil {
+				t.Fatalf("parse error: %v", err)
+			}
+			if err := root.Run(context.Background()); err != nil {
+				t.Fatalf("run error: %v", err)
+			}
+		})
+		return stdout
+	}
+
+	first := run()
+	if creates != 1 || !strings.Contains(first, `"action":"renewed"`) {
+		t.Fatalf("expected first run to renew the profile, got %d creates and %q", creates, first)
+	}
+
+	second := run()
+	if creates != 1 {
+		t.Fatalf("expected second run not to create another profile, got %d creates", creates)
+	}
+	if !strings.Contains(second, `"action":"skipped"`) || !strings.Contains(second, `"newId":"prof-new-1"`) || !strings.Contains(second, "already renewed") {
+		t.Fatalf("expected second run to skip the renewed profile, got %q", second)
+	}
+}
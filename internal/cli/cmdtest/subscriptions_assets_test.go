@@ -0,0 +1,188 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSubscriptionsAssetsValidationErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "localizations create missing locale",
+			args:    []string{"subscriptions", "localizations", "create", "--subscription", "SUB_ID", "--name", "Pro"},
+			wantErr: "--locale is required",
+		},
+		{
+			name:    "localizations update missing updates",
+			args:    []string{"subscriptions", "localizations", "update", "--id", "LOC_ID"},
+			wantErr: "at least one update flag is required",
+		},
+		{
+			name:    "groups localizations list missing group",
+			args:    []string{"subscriptions", "groups", "localizations", "list"},
+			wantErr: "--group is required",
+		},
+		{
+			name:    "groups localizations create missing name",
+			args:    []string{"subscriptions", "groups", "localizations", "create", "--group", "GROUP_ID", "--locale", "en-US"},
+			wantErr: "--name is required",
+		},
+		{
+			name:    "images upload missing file",
+			args:    []string{"subscriptions", "images", "upload", "--subscription", "SUB_ID"},
+			wantErr: "--file is required",
+		},
+		{
+			name:    "review-screenshot upload missing subscription",
+			args:    []string{"subscriptions", "review-screenshot", "upload", "--file", "review.png"},
+			wantErr: "--subscription is required",
+		},
+		{
+			name:    "submit missing confirm",
+			args:    []string{"subscriptions", "submit", "--id", "SUB_ID"},
+			wantErr: "--confirm is required",
+		},
+		{
+			name:    "groups submit missing confirm",
+			args:    []string{"subscriptions", "groups", "submit", "--id", "GROUP_ID"},
+			wantErr: "--confirm is required",
+		},
+		{
+			name:    "grace-period update missing updates",
+			args:    []string{"subscriptions", "grace-period", "update", "--app", "APP_ID"},
+			wantErr: "at least one update flag is required",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestSubscriptionsReviewScreenshotUploadReplacesExisting(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "review.png")
+	if err := os.WriteFile(filePath, []byte("png-bytes"), 0o600); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	var deleted, uploaded bool
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/subscriptions/sub-1/appStoreReviewScreenshot":
+			_, _ = io.WriteString(w, `{"data":{"type":"subscriptionAppStoreReviewScreenshots","id":"shot-old"}}`)
+		case r.Method == http.MethodDelete && r.URL.Path == "/v1/subscriptionAppStoreReviewScreenshots/shot-old":
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/subscriptionAppStoreReviewScreenshots":
+			if !deleted {
+				t.Error("expected existing screenshot to be deleted before reserving")
+			}
+			w.WriteHeader(http.StatusCreated)
+			_, _ = io.WriteString(w, `{"data":{"type":"subscriptionAppStoreReviewScreenshots","id":"shot-new","attributes":{"uploadOperations":[{"method":"PUT","url":"`+server.URL+`/upload/shot-new","offset":0,"length":9}]}}}`)
+		case r.Method == http.MethodPut && r.URL.Path == "/upload/shot-new":
+			uploaded = true
+		case r.Method == http.MethodPatch && r.URL.Path == "/v1/subscriptionAppStoreReviewScreenshots/shot-new":
+			_, _ = io.WriteString(w, `{"data":{"type":"subscriptionAppStoreReviewScreenshots","id":"shot-new"}}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/subscriptionAppStoreReviewScreenshots/shot-new":
+			_, _ = io.WriteString(w, `{"data":{"type":"subscriptionAppStoreReviewScreenshots","id":"shot-new","attributes":{"assetDeliveryState":{"state":"COMPLETE"}}}}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.String())
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	setupServerEnv(t, server.URL)
+
+	root := RootCommand("1.2.3")
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"subscriptions", "review-screenshot", "upload", "--subscription", "sub-1", "--file", filePath, "--replace"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if !deleted || !uploaded {
+		t.Fatalf("expected delete and upload, got deleted=%t uploaded=%t", deleted, uploaded)
+	}
+	if !strings.Contains(stdout, `"subscriptionId":"sub-1"`) || !strings.Contains(stdout, `"assetId":"shot-new"`) {
+		t.Fatalf("unexpected output %q", stdout)
+	}
+}
+
+func TestSubscriptionsGracePeriodUpdateSendsOnlySetFields(t *testing.T) {
+	var attrs map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/apps/app-1/subscriptionGracePeriod":
+			_, _ = io.WriteString(w, `{"data":{"type":"subscriptionGracePeriods","id":"gp-1","attributes":{"optIn":false}}}`)
+		case r.Method == http.MethodPatch && r.URL.Path == "/v1/subscriptionGracePeriods/gp-1":
+			var payload struct {
+				Data struct {
+					Attributes map[string]any `json:"attributes"`
+				} `json:"data"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Errorf("decode body: %v", err)
+			}
+			attrs = payload.Data.Attributes
+			_, _ = io.WriteString(w, `{"data":{"type":"subscriptionGracePeriods","id":"gp-1","attributes":{"optIn":true,"duration":"SIXTEEN_DAYS"}}}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.String())
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	setupServerEnv(t, server.URL)
+
+	root := RootCommand("1.2.3")
+	captureOutput(t, func() {
+		if err := root.Parse([]string{"subscriptions", "grace-period", "update", "--app", "app-1", "--opt-in", "--duration", "sixteen_days"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if len(attrs) != 2 || attrs["optIn"] != true || attrs["duration"] != "SIXTEEN_DAYS" {
+		t.Fatalf("unexpected attributes %v", attrs)
+	}
+}
@@ -0,0 +1,225 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestSubscriptionsOffersValidationErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "intro-offers list missing subscription",
+			args:    []string{"subscriptions", "intro-offers", "list"},
+			wantErr: "--subscription is required",
+		},
+		{
+			name:    "intro-offers create missing duration",
+			args:    []string{"subscriptions", "intro-offers", "create", "--subscription", "SUB_ID", "--mode", "FREE_TRIAL"},
+			wantErr: "--duration is required",
+		},
+		{
+			name:    "intro-offers create free trial missing territories",
+			args:    []string{"subscriptions", "intro-offers", "create", "--subscription", "SUB_ID", "--mode", "FREE_TRIAL", "--duration", "ONE_WEEK"},
+			wantErr: "--territory or --all-territories is required for FREE_TRIAL",
+		},
+		{
+			name:    "intro-offers create paid missing prices",
+			args:    []string{"subscriptions", "intro-offers", "create", "--subscription", "SUB_ID", "--mode", "PAY_UP_FRONT", "--duration", "ONE_MONTH"},
+			wantErr: "--prices or --prices-file is required",
+		},
+		{
+			name:    "intro-offers delete missing confirm",
+			args:    []string{"subscriptions", "intro-offers", "delete", "--id", "OFFER_ID"},
+			wantErr: "--confirm is required",
+		},
+		{
+			name:    "promo-offers create missing name",
+			args:    []string{"subscriptions", "promo-offers", "create", "--subscription", "SUB_ID"},
+			wantErr: "--name is required",
+		},
+		{
+			name:    "promo-offers update missing prices",
+			args:    []string{"subscriptions", "promo-offers", "update", "--id", "OFFER_ID"},
+			wantErr: "--prices or --prices-file is required",
+		},
+		{
+			name:    "win-back create missing last subscribed",
+			args:    []string{"subscriptions", "win-back", "create", "--subscription", "SUB_ID", "--name", "Come back", "--offer-id", "WB", "--duration", "ONE_MONTH", "--mode", "FREE_TRIAL", "--paid-months", "6"},
+			wantErr: "--last-subscribed is required",
+		},
+		{
+			name:    "win-back create free trial missing territories",
+			args:    []string{"subscriptions", "win-back", "create", "--subscription", "SUB_ID", "--name", "Come back", "--offer-id", "WB", "--duration", "ONE_MONTH", "--mode", "FREE_TRIAL", "--paid-months", "6", "--last-subscribed", "3-12", "--start-date", "2026-04-01"},
+			wantErr: "--territory or --all-territories is required for FREE_TRIAL",
+		},
+		{
+			name:    "win-back update missing updates",
+			args:    []string{"subscriptions", "win-back", "update", "--id", "OFFER_ID"},
+			wantErr: "at least one update flag is required",
+		},
+		{
+			name:    "win-back delete missing confirm",
+			args:    []string{"subscriptions", "win-back", "delete", "--id", "OFFER_ID"},
+			wantErr: "--confirm is required",
+		},
+		{
+			name:    "price-points equalizations missing price point",
+			args:    []string{"subscriptions", "price-points", "equalizations"},
+			wantErr: "--price-point is required",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestSubscriptionsIntroOffersCreateEqualizesPrices(t *testing.T) {
+	var mu sync.Mutex
+	created := map[string]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/subscriptionPricePoints/pp-usa/equalizations":
+			_, _ = io.WriteString(w, `{"data":[
+				{"type":"subscriptionPricePoints","id":"pp-gbr","relationships":{"territory":{"data":{"type":"territories","id":"GBR"}}}},
+				{"type":"subscriptionPricePoints","id":"pp-jpn","relationships":{"territory":{"data":{"type":"territories","id":"JPN"}}}}
+			]}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/subscriptionIntroductoryOffers":
+			var payload struct {
+				Data struct {
+					Relationships struct {
+						Territory struct {
+							Data struct {
+								ID string `json:"id"`
+							} `json:"data"`
+						} `json:"territory"`
+						SubscriptionPricePoint struct {
+							Data struct {
+								ID string `json:"id"`
+							} `json:"data"`
+						} `json:"subscriptionPricePoint"`
+					} `json:"relationships"`
+				} `json:"data"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Errorf("decode body: %v", err)
+			}
+			mu.Lock()
+			created[payload.Data.Relationships.Territory.Data.ID] = payload.Data.Relationships.SubscriptionPricePoint.Data.ID
+			mu.Unlock()
+			w.WriteHeader(http.StatusCreated)
+			_, _ = io.WriteString(w, `{"data":{"type":"subscriptionIntroductoryOffers","id":"offer-`+payload.Data.Relationships.Territory.Data.ID+`"}}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.String())
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	setupServerEnv(t, server.URL)
+
+	root := RootCommand("1.2.3")
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"subscriptions", "intro-offers", "create",
+			"--subscription", "sub-1",
+			"--mode", "PAY_UP_FRONT",
+			"--duration", "ONE_MONTH",
+			"--prices", "usa:pp-usa",
+			"--equalize",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	territories := make([]string, 0, len(created))
+	for territory := range created {
+		territories = append(territories, territory)
+	}
+	sort.Strings(territories)
+	if strings.Join(territories, ",") != "GBR,JPN,USA" {
+		t.Fatalf("expected offers in GBR,JPN,USA, got %v", territories)
+	}
+	if created["USA"] != "pp-usa" || created["JPN"] != "pp-jpn" {
+		t.Fatalf("unexpected price points %v", created)
+	}
+	if !strings.Contains(stdout, `"id":"offer-JPN"`) {
+		t.Fatalf("expected created offers in stdout, got %q", stdout)
+	}
+}
+
+func TestSubscriptionsIntroOffersCreateReportsPartialResult(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method != http.MethodPost || r.URL.Path != "/v1/subscriptionIntroductoryOffers" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.String())
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		if strings.Contains(string(body), `"GBR"`) {
+			w.WriteHeader(http.StatusConflict)
+			_, _ = io.WriteString(w, `{"errors":[{"status":"409","code":"ENTITY_ERROR","title":"conflict","detail":"offer exists"}]}`)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = io.WriteString(w, `{"data":{"type":"subscriptionIntroductoryOffers","id":"offer-usa"}}`)
+	}))
+	t.Cleanup(server.Close)
+
+	setupServerEnv(t, server.URL)
+
+	root := RootCommand("1.2.3")
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"subscriptions", "intro-offers", "create", "--subscription", "SUB_ID", "--mode", "FREE_TRIAL", "--duration", "ONE_WEEK", "--territory", "USA,GBR"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	var reported ReportedError
+	if !errors.As(runErr, &reported) || !strings.Contains(runErr.Error(), "failed for GBR after creating 1 offers") {
+		t.Fatalf("expected reported error for GBR, got %v", runErr)
+	}
+	if !strings.Contains(stdout, `"id":"offer-usa"`) {
+		t.Fatalf("expected created offer in output, got %q", stdout)
+	}
+}
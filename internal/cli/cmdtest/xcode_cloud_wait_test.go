@@ -0,0 +1,157 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestXcodeCloudStatusWaitSummarizesIssuesAndTests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/ciBuildRuns/run-1":
+			_, _ = io.WriteString(w, `{"data":{"type":"ciBuildRuns","id":"run-1","attributes":{"number":42,"executionProgress":"COMPLETE","completionStatus":"FAILED"}}}`)
+		case "/v1/ciBuildRuns/run-1/actions":
+			_, _ = io.WriteString(w, `{"data":[
+				{"type":"ciBuildActions","id":"action-build","attributes":{"name":"Build - iOS","actionType":"BUILD","executionProgress":"COMPLETE","completionStatus":"SUCCEEDED","issueCounts":{"warnings":1}}},
+				{"type":"ciBuildActions","id":"action-test","attributes":{"name":"Test - iOS","actionType":"TEST","executionProgress":"COMPLETE","completionStatus":"FAILED","issueCounts":{"testFailures":1}}}
+			]}`)
+		case "/v1/ciBuildActions/action-build/issues":
+			_, _ = io.WriteString(w, `{"data":[{"type":"ciIssues","id":"issue-1","attributes":{"issueType":"WARNING","message":"Deprecated API","fileSource":{"path":"App/View.swift","lineNumber":12}}}]}`)
+		case "/v1/ciBuildActions/action-test/issues":
+			_, _ = io.WriteString(w, `{"data":[{"type":"ciIssues","id":"issue-2","attributes":{"issueType":"TEST_FAILURE","message":"XCTAssertEqual failed"}}]}`)
+		case "/v1/ciBuildActions/action-test/testResults":
+			_, _ = io.WriteString(w, `{"data":[
+				{"type":"ciTestResults","id":"test-1","attributes":{"className":"AppTests","name":"testLogin()","status":"SUCCESS","destinationTestResults":[{"deviceName":"iPhone 15","osVersion":"17.0","status":"SUCCESS","duration":1.5}]}},
+				{"type":"ciTestResults","id":"test-2","attributes":{"className":"AppTests","name":"testCheckout()","status":"FAILURE","message":"XCTAssertEqual failed","fileSource":{"path":"AppTests/CheckoutTests.swift","lineNumber":30},"destinationTestResults":[{"deviceName":"iPhone 15","osVersion":"17.0","status":"FAILURE","duration":0.25}]}},
+				{"type":"ciTestResults","id":"test-3","attributes":{"className":"AppTests","name":"testSlow()","status":"SKIPPED"}}
+			]}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.String())
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	setupServerEnv(t, server.URL)
+
+	junitPath := filepath.Join(t.TempDir(), "reports", "junit.xml")
+	root := RootCommand("1.2.3")
+	var runErr error
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"xcode-cloud", "status", "--run-id", "run-1", "--wait", "--poll-interval", "10ms", "--junit", junitPath}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr == nil || !strings.Contains(runErr.Error(), "completed with status: FAILED") {
+		t.Fatalf("expected failed build error, got %v", runErr)
+	}
+
+	for _, want := range []string{"Build run 42 (run-1): COMPLETE (FAILED)", "Test - iOS (TEST): COMPLETE (FAILED)"} {
+		if !strings.Contains(stderr, want) {
+			t.Fatalf("expected %q in stderr, got %q", want, stderr)
+		}
+	}
+
+	var result struct {
+		JUnitPath string `json:"junitPath"`
+		Actions   []struct {
+			Name   string `json:"name"`
+			Issues []struct {
+				IssueType string `json:"issueType"`
+				File      string `json:"file"`
+			} `json:"issues"`
+			Tests *struct {
+				Total    int `json:"total"`
+				Passed   int `json:"passed"`
+				Failed   int `json:"failed"`
+				Skipped  int `json:"skipped"`
+				Failures []struct {
+					Name string `json:"name"`
+					File string `json:"file"`
+				} `json:"failures"`
+			} `json:"tests"`
+		} `json:"actions"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v (%q)", err, stdout)
+	}
+	if len(result.Actions) != 2 || result.JUnitPath != junitPath {
+		t.Fatalf("unexpected result %+v", result)
+	}
+	build, test := result.Actions[0], result.Actions[1]
+	if len(build.Issues) != 1 || build.Issues[0].File != "App/View.swift:12" || build.Tests != nil {
+		t.Fatalf("unexpected build action summary %+v", build)
+	}
+	if test.Tests == nil || test.Tests.Total != 3 || test.Tests.Passed != 1 || test.Tests.Failed != 1 || test.Tests.Skipped != 1 {
+		t.Fatalf("unexpected test summary %+v", test.Tests)
+	}
+	if len(test.Tests.Failures) != 1 || test.Tests.Failures[0].File != "AppTests/CheckoutTests.swift:30" {
+		t.Fatalf("unexpected test failures %+v", test.Tests.Failures)
+	}
+
+	data, err := os.ReadFile(junitPath)
+	if err != nil {
+		t.Fatalf("read JUnit report: %v", err)
+	}
+	report := string(data)
+	for _, want := range []string{
+		`<testsuites name="Xcode Cloud build run run-1" tests="3" failures="1" skipped="1"`,
+		`<testsuite name="Test - iOS" tests="3" failures="1" skipped="1" time="1.750">`,
+		`<failure message="XCTAssertEqual failed" type="FAILURE">`,
+		`<skipped></skipped>`,
+	} {
+		if !strings.Contains(report, want) {
+			t.Fatalf("expected %q in JUnit report, got:\n%s", want, report)
+		}
+	}
+}
+
+func TestXcodeCloudTestResultsExportByRun(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/ciBuildRuns/run-1/actions":
+			_, _ = io.WriteString(w, `{"data":[
+				{"type":"ciBuildActions","id":"action-build","attributes":{"name":"Build","actionType":"BUILD"}},
+				{"type":"ciBuildActions","id":"action-test","attributes":{"name":"Test","actionType":"TEST"}}
+			]}`)
+		case "/v1/ciBuildActions/action-test/testResults":
+			_, _ = io.WriteString(w, `{"data":[{"type":"ciTestResults","id":"test-1","attributes":{"className":"AppTests","name":"testA()","status":"MIXED","message":"flaky"}}]}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.String())
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	setupServerEnv(t, server.URL)
+
+	junitPath := filepath.Join(t.TempDir(), "junit.xml")
+	root := RootCommand("1.2.3")
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"xcode-cloud", "test-results", "export", "--run-id", "run-1", "--path", junitPath}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if !strings.Contains(stdout, `"actionIds":["action-test"]`) || !strings.Contains(stdout, `"tests":1`) || !strings.Contains(stdout, `"failures":1`) {
+		t.Fatalf("unexpected output %q", stdout)
+	}
+	data, err := os.ReadFile(junitPath)
+	if err != nil {
+		t.Fatalf("read JUnit report: %v", err)
+	}
+	if !strings.Contains(string(data), `<failure message="flaky" type="MIXED">`) {
+		t.Fatalf("unexpected JUnit report:\n%s", data)
+	}
+}
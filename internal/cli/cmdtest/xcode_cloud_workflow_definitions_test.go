@@ -0,0 +1,336 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+const workflowDefinitionVersionsJSON = `{"data":[
+	{"type":"ciXcodeVersions","id":"xcode-15","attributes":{"name":"Xcode 15.4","version":"15F31d"}},
+	{"type":"ciXcodeVersions","id":"xcode-16","attributes":{"name":"Xcode 16.0","version":"16A242d"}}
+]}`
+
+const workflowDefinitionMacOsVersionsJSON = `{"data":[
+	{"type":"ciMacOsVersions","id":"macos-15","attributes":{"name":"macOS Sequoia 15.0","version":"24A335"}}
+]}`
+
+func TestXcodeCloudWorkflowsExportWritesYAML(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/v1/ciWorkflows/wf-1" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.String())
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if got := r.URL.Query().Get("include"); got != "repository,xcodeVersion,macOsVersion" {
+			t.Errorf("expected include query, got %q", got)
+		}
+		_, _ = io.WriteString(w, `{
+			"data":{"type":"ciWorkflows","id":"wf-1","attributes":{
+				"name":"CI","description":"Main branch","isEnabled":true,"isLockedForEditing":false,"clean":false,
+				"containerFilePath":"App.xcodeproj","lastModifiedDate":"2026-01-01T00:00:00Z",
+				"branchStartCondition":{"source":{"isAllMatch":false,"patterns":[{"pattern":"main","isPrefix":false}]},"autoCancel":true},
+				"actions":[{"name":"Test - iOS","actionType":"TEST","scheme":"App","platform":"IOS","isRequiredToPass":true}]
+			}},
+			"included":[
+				{"type":"scmRepositories","id":"repo-1","attributes":{"ownerName":"acme","repositoryName":"app"}},
+				{"type":"ciXcodeVersions","id":"xcode-16","attributes":{"name":"Xcode 16.0","version":"16A242d"}},
+				{"type":"ciMacOsVersions","id":"macos-15","attributes":{"name":"macOS Sequoia 15.0","version":"24A335"}}
+			]
+		}`)
+	}))
+	t.Cleanup(server.Close)
+	setupServerEnv(t, server.URL)
+
+	root := RootCommand("1.2.3")
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"xcode-cloud", "workflows", "export", "--id", "wf-1"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr != nil {
+		t.Fatalf("run error: %v", runErr)
+	}
+
+	var definition struct {
+		Name         string                 `yaml:"name"`
+		Repository   string                 `yaml:"repository"`
+		XcodeVersion string                 `yaml:"xcodeVersion"`
+		MacOsVersion string                 `yaml:"macOsVersion"`
+		Attributes   map[string]interface{} `yaml:"attributes"`
+	}
+	if err := yaml.Unmarshal([]byte(stdout), &definition); err != nil {
+		t.Fatalf("failed to parse YAML: %v\n%s", err, stdout)
+	}
+	if definition.Name != "CI" || definition.Repository != "acme/app" || definition.XcodeVersion != "Xcode 16.0" || definition.MacOsVersion != "macOS Sequoia 15.0" {
+		t.Fatalf("unexpected definition: %+v", definition)
+	}
+	for _, key := range []string{"name", "isLockedForEditing", "lastModifiedDate"} {
+		if _, ok := definition.Attributes[key]; ok {
+			t.Fatalf("expected %s to be omitted, got %v", key, definition.Attributes)
+		}
+	}
+	if definition.Attributes["clean"] != false || definition.Attributes["isEnabled"] != true {
+		t.Fatalf("expected boolean attributes to be kept, got %v", definition.Attributes)
+	}
+	actions, ok := definition.Attributes["actions"].([]interface{})
+	if !ok || len(actions) != 1 {
+		t.Fatalf("expected actions to be exported, got %v", definition.Attributes["actions"])
+	}
+}
+
+func TestXcodeCloudWorkflowsImportCreatesWorkflow(t *testing.T) {
+	var created map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/ciProducts/prod-1/workflows":
+			_, _ = io.WriteString(w, `{"data":[{"type":"ciWorkflows","id":"wf-other","attributes":{"name":"Nightly"}}]}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/ciXcodeVersions":
+			_, _ = io.WriteString(w, workflowDefinitionVersionsJSON)
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/ciMacOsVersions":
+			_, _ = io.WriteString(w, workflowDefinitionMacOsVersionsJSON)
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/ciProducts/prod-1/primaryRepositories":
+			_, _ = io.WriteString(w, `{"data":[{"type":"scmRepositories","id":"repo-main","attributes":{"ownerName":"acme","repositoryName":"app"}}]}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/ciProducts/prod-1/additionalRepositories":
+			_, _ = io.WriteString(w, `{"data":[{"type":"scmRepositories","id":"repo-shared","attributes":{"ownerName":"acme","repositoryName":"shared","httpCloneUrl":"https://github.com/acme/shared.git"}}]}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/ciWorkflows":
+			body, _ := io.ReadAll(r.Body)
+			if err := json.Unmarshal(body, &created); err != nil {
+				t.Errorf("invalid create payload: %v", err)
+			}
+			w.WriteHeader(http.StatusCreated)
+			_, _ = io.WriteString(w, `{"data":{"type":"ciWorkflows","id":"wf-new","attributes":{"name":"CI"}}}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.String())
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	setupServerEnv(t, server.URL)
+
+	path := writeWorkflowDefinition(t, `name: CI
+repository: https://github.com/acme/shared
+xcodeVersion: 16A242d
+macOsVersion: macOS Sequoia 15.0
+attributes:
+  description: Main branch
+  isEnabled: true
+  clean: false
+  containerFilePath: App.xcodeproj
+  lastModifiedDate: "2026-01-01T00:00:00Z"
+  actions:
+    - name: Test - iOS
+      actionType: TEST
+      scheme: App
+`)
+
+	root := RootCommand("1.2.3")
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"xcode-cloud", "workflows", "import", "--product-id", "prod-1", "--file", path}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr != nil {
+		t.Fatalf("run error: %v", runErr)
+	}
+
+	var result struct {
+		WorkflowID     string `json:"workflowId"`
+		Action         string `json:"action"`
+		RepositoryID   string `json:"repositoryId"`
+		XcodeVersionID string `json:"xcodeVersionId"`
+		MacOsVersionID string `json:"macOsVersionId"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if result.WorkflowID != "wf-new" || result.Action != "create" || result.RepositoryID != "repo-shared" || result.XcodeVersionID != "xcode-16" || result.MacOsVersionID != "macos-15" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+
+	data := created["data"].(map[string]interface{})
+	attributes := data["attributes"].(map[string]interface{})
+	if attributes["name"] != "CI" || attributes["clean"] != false {
+		t.Fatalf("unexpected attributes: %v", attributes)
+	}
+	if _, ok := attributes["lastModifiedDate"]; ok {
+		t.Fatalf("expected read-only attributes to be dropped, got %v", attributes)
+	}
+	relationships := data["relationships"].(map[string]interface{})
+	for name, want := range map[string]string{"product": "prod-1", "repository": "repo-shared", "xcodeVersion": "xcode-16", "macOsVersion": "macos-15"} {
+		relationship := relationships[name].(map[string]interface{})["data"].(map[string]interface{})
+		if relationship["id"] != want {
+			t.Fatalf("expected %s relationship %q, got %v", name, want, relationship)
+		}
+	}
+}
+
+func TestXcodeCloudWorkflowsImportUpdatesWorkflowByName(t *testing.T) {
+	var updated map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/ciProducts/prod-1/workflows":
+			_, _ = io.WriteString(w, `{"data":[{"type":"ciWorkflows","id":"wf-1","attributes":{"name":"ci"}}]}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/ciXcodeVersions":
+			_, _ = io.WriteString(w, workflowDefinitionVersionsJSON)
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/ciMacOsVersions":
+			_, _ = io.WriteString(w, workflowDefinitionMacOsVersionsJSON)
+		case r.Method == http.MethodPatch && r.URL.Path == "/v1/ciWorkflows/wf-1":
+			body, _ := io.ReadAll(r.Body)
+			if err := json.Unmarshal(body, &updated); err != nil {
+				t.Errorf("invalid update payload: %v", err)
+			}
+			_, _ = io.WriteString(w, `{"data":{"type":"ciWorkflows","id":"wf-1","attributes":{"name":"CI"}}}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.String())
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	setupServerEnv(t, server.URL)
+
+	path := writeWorkflowDefinition(t, `name: CI
+repository: acme/app
+xcodeVersion: Xcode 15.4
+macOsVersion: 24A335
+attributes:
+  isEnabled: false
+`)
+
+	root := RootCommand("1.2.3")
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"xcode-cloud", "workflows", "import", "--product-id", "prod-1", "--file", path}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr != nil {
+		t.Fatalf("run error: %v", runErr)
+	}
+	if !strings.Contains(stdout, `"action":"update"`) || !strings.Contains(stdout, `"workflowId":"wf-1"`) {
+		t.Fatalf("unexpected output: %s", stdout)
+	}
+
+	data := updated["data"].(map[string]interface{})
+	if data["id"] != "wf-1" {
+		t.Fatalf("expected update for wf-1, got %v", data)
+	}
+	relationships := data["relationships"].(map[string]interface{})
+	if _, ok := relationships["repository"]; ok {
+		t.Fatalf("expected repository to be left unchanged, got %v", relationships)
+	}
+	xcode := relationships["xcodeVersion"].(map[string]interface{})["data"].(map[string]interface{})
+	if xcode["id"] != "xcode-15" {
+		t.Fatalf("expected xcode-15, got %v", xcode)
+	}
+}
+
+func TestXcodeCloudWorkflowsImportDryRunDoesNotWrite(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/ciProducts/prod-1/workflows":
+			_, _ = io.WriteString(w, `{"data":[]}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/ciXcodeVersions":
+			_, _ = io.WriteString(w, workflowDefinitionVersionsJSON)
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/ciMacOsVersions":
+			_, _ = io.WriteString(w, workflowDefinitionMacOsVersionsJSON)
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/ciProducts/prod-1/primaryRepositories":
+			_, _ = io.WriteString(w, `{"data":[{"type":"scmRepositories","id":"repo-main","attributes":{"ownerName":"acme","repositoryName":"app"}}]}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.String())
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	setupServerEnv(t, server.URL)
+
+	path := writeWorkflowDefinition(t, `name: CI
+xcodeVersion: Xcode 16.0
+macOsVersion: macOS Sequoia 15.0
+attributes: {}
+`)
+
+	root := RootCommand("1.2.3")
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"xcode-cloud", "workflows", "import", "--product-id", "prod-1", "--file", path, "--dry-run"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr != nil {
+		t.Fatalf("run error: %v", runErr)
+	}
+	for _, want := range []string{`"action":"create"`, `"repositoryId":"repo-main"`, `"dryRun":true`} {
+		if !strings.Contains(stdout, want) {
+			t.Fatalf("expected %s in output, got %s", want, stdout)
+		}
+	}
+}
+
+func TestXcodeCloudWorkflowsImportReportsUnknownRepository(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/ciProducts/prod-1/workflows":
+			_, _ = io.WriteString(w, `{"data":[]}`)
+		case "/v1/ciXcodeVersions":
+			_, _ = io.WriteString(w, workflowDefinitionVersionsJSON)
+		case "/v1/ciMacOsVersions":
+			_, _ = io.WriteString(w, workflowDefinitionMacOsVersionsJSON)
+		case "/v1/ciProducts/prod-1/primaryRepositories":
+			_, _ = io.WriteString(w, `{"data":[{"type":"scmRepositories","id":"repo-main","attributes":{"ownerName":"acme","repositoryName":"app"}}]}`)
+		case "/v1/ciProducts/prod-1/additionalRepositories":
+			_, _ = io.WriteString(w, `{"data":[]}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.String())
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	setupServerEnv(t, server.URL)
+
+	path := writeWorkflowDefinition(t, `name: CI
+repository: acme/missing
+xcodeVersion: Xcode 16.0
+macOsVersion: macOS Sequoia 15.0
+`)
+
+	root := RootCommand("1.2.3")
+	var runErr error
+	_, _ = captureOutput(t, func() {
+		if err := root.Parse([]string{"xcode-cloud", "workflows", "import", "--product-id", "prod-1", "--file", path}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr == nil || !strings.Contains(runErr.Error(), `repository "acme/missing" not found in product prod-1 (available: acme/app)`) {
+		t.Fatalf("expected repository error, got %v", runErr)
+	}
+}
+
+func writeWorkflowDefinition(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "workflow.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write definition: %v", err)
+	}
+	return path
+}
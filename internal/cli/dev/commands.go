@@ -0,0 +1,8 @@
+package dev
+
+import "github.com/peterbourgon/ff/v3/ffcli"
+
+// Command returns the dev command group.
+func Command() *ffcli.Command {
+	return DevCommand()
+}
@@ -0,0 +1,180 @@
+package dev
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/mockserver"
+)
+
+// DevCommand returns the dev command with subcommands.
+func DevCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("dev", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "dev",
+		ShortUsage: "asc dev <subcommand> [flags]",
+		ShortHelp:  "Developer tools for testing asc workflows.",
+		LongHelp: `Developer tools for testing asc workflows.
+
+Examples:
+  asc dev mock-server
+  asc dev mock-server --addr 127.0.0.1:9000 --seed ./seed.json`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			DevMockServerCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// DevMockServerCommand returns the dev mock-server subcommand.
+func DevMockServerCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("dev mock-server", flag.ExitOnError)
+
+	addr := fs.String("addr", "127.0.0.1:8787", "Listen address")
+	seed := fs.String("seed", "", "JSON file with resources to preload (array or {\"data\": [...]})")
+	empty := fs.Bool("empty", false, "Start without the built-in demo resources")
+
+	return &ffcli.Command{
+		Name:       "mock-server",
+		ShortUsage: "asc dev mock-server [flags]",
+		ShortHelp:  "Run an in-memory App Store Connect API fake.",
+		LongHelp: `Run an in-memory App Store Connect API fake.
+
+The server speaks JSON:API for apps, builds, betaGroups, betaTesters,
+appStoreVersions, and localizations. State is kept in memory and lost on exit.
+Point asc at it with ASC_BASE_URL and ASC_ALLOW_INSECURE_BASE_URL:
+
+  ASC_BASE_URL=http://127.0.0.1:8787 ASC_ALLOW_INSECURE_BASE_URL=1 asc apps list
+
+Requests must still carry a bearer token, so configure any valid API key.
+
+Examples:
+  asc dev mock-server
+  asc dev mock-server --addr 127.0.0.1:9000
+  asc dev mock-server --seed ./seed.json --empty`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			addrValue := strings.TrimSpace(*addr)
+			if addrValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --addr is required")
+				return flag.ErrHelp
+			}
+
+			resources := make([]mockserver.Resource, 0)
+			if !*empty {
+				resources = append(resources, mockserver.DefaultSeed()...)
+			}
+			if seedPath := strings.TrimSpace(*seed); seedPath != "" {
+				seeded, err := readSeedFile(seedPath)
+				if err != nil {
+					return fmt.Errorf("dev mock-server: %w", err)
+				}
+				resources = append(resources, seeded...)
+			}
+
+			handler, err := mockserver.New(resources)
+			if err != nil {
+				return fmt.Errorf("dev mock-server: %w", err)
+			}
+
+			listener, err := net.Listen("tcp", addrValue)
+			if err != nil {
+				return fmt.Errorf("dev mock-server: %w", err)
+			}
+
+			baseURL := "http://" + listener.Addr().String()
+			fmt.Fprintf(os.Stderr, "Mock App Store Connect API listening on %s\n", baseURL)
+			fmt.Fprintf(os.Stderr, "  export %s=%s\n", asc.BaseURLEnvVar, baseURL)
+			fmt.Fprintf(os.Stderr, "  export %s=1\n", asc.AllowInsecureBaseURLEnvVar)
+
+			runCtx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			return serveUntilDone(runCtx, listener, handler)
+		},
+	}
+}
+
+func serveUntilDone(ctx context.Context, listener net.Listener, handler http.Handler) error {
+	server := &http.Server{
+		Handler:           handler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- server.Serve(listener)
+	}()
+
+	select {
+	case err := <-errCh:
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+		return fmt.Errorf("dev mock-server: %w", err)
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			return fmt.Errorf("dev mock-server: %w", err)
+		}
+		return nil
+	}
+}
+
+func readSeedFile(path string) ([]mockserver.Resource, error) {
+	info, err := os.Lstat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.Mode()&os.ModeSymlink != 0 {
+		return nil, fmt.Errorf("refusing to read symlink %q", path)
+	}
+	if !info.Mode().IsRegular() {
+		return nil, fmt.Errorf("expected regular file: %q", path)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	trimmed := strings.TrimSpace(string(data))
+	if trimmed == "" {
+		return nil, fmt.Errorf("seed file is empty")
+	}
+
+	var resources []mockserver.Resource
+	if strings.HasPrefix(trimmed, "[") {
+		if err := json.Unmarshal(data, &resources); err != nil {
+			return nil, fmt.Errorf("invalid seed JSON: %w", err)
+		}
+		return resources, nil
+	}
+
+	var document struct {
+		Data []mockserver.Resource `json:"data"`
+	}
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, fmt.Errorf("invalid seed JSON: %w", err)
+	}
+	return document.Data, nil
+}
@@ -0,0 +1,11 @@
+package dev
+
+import (
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+func DefaultUsageFunc(c *ffcli.Command) string {
+	return shared.DefaultUsageFunc(c)
+}
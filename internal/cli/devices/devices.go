@@ -12,6 +12,7 @@ import (
 	"github.com/peterbourgon/ff/v3/ffcli"
 
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
 )
 
 // DevicesCommand returns the devices command with subcommands.
@@ -29,6 +30,7 @@ Examples:
   asc devices get --id "DEVICE_ID"
   asc devices local-udid
   asc devices register --name "iPhone 15" --udid "UDID" --platform IOS
+  asc devices register --file "./devices.txt"
   asc devices update --id "DEVICE_ID" --status DISABLED`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
@@ -251,6 +253,7 @@ func DevicesRegisterCommand() *ffcli.Command {
 	udid := fs.String("udid", "", "Device UDID (required unless --udid-from-system)")
 	udidFromSystem := fs.Bool("udid-from-system", false, "Use local macOS hardware UUID as UDID (macOS only)")
 	platform := fs.String("platform", "", "Device platform: "+strings.Join(devicePlatformList(), ", "))
+	filePath := fs.String("file", "", "Register devices from Apple's tab-separated device list file")
 	output := fs.String("output", "json", "Output format: json (default), table, markdown")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
@@ -258,14 +261,29 @@ func DevicesRegisterCommand() *ffcli.Command {
 		Name:       "register",
 		ShortUsage: "asc devices register --name NAME --udid UDID --platform " + strings.Join(devicePlatformList(), "|"),
 		ShortHelp:  "Register a new device.",
-		LongHelp: `Register a new device.
+		LongHelp: `Register a new device, or many devices from a file.
+
+With --file, devices are read from Apple's tab-separated device list format
+(Device ID, Device Name, and an optional Device Platform column of "ios" or
+"mac"). --platform sets the platform for rows without one. Devices that are
+already registered are skipped.
 
 Examples:
   asc devices register --name "iPhone 15" --udid "UDID" --platform IOS
-  asc devices register --name "My Mac" --udid-from-system --platform MAC_OS`,
+  asc devices register --name "My Mac" --udid-from-system --platform MAC_OS
+  asc devices register --file "./devices.txt"
+  asc devices register --file "./devices.txt" --platform IOS --output table`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
+			if fileValue := strings.TrimSpace(*filePath); fileValue != "" {
+				if strings.TrimSpace(*name) != "" || strings.TrimSpace(*udid) != "" || *udidFromSystem {
+					fmt.Fprintln(os.Stderr, "Error: --file cannot be combined with --name, --udid, or --udid-from-system")
+					return flag.ErrHelp
+				}
+				return registerDevicesFile(ctx, fileValue, *platform, *output, *pretty)
+			}
+
 			nameValue := strings.TrimSpace(*name)
 			if nameValue == "" {
 				fmt.Fprintln(os.Stderr, "Error: --name is required")
@@ -331,6 +349,40 @@ Examples:
 	}
 }
 
+func registerDevicesFile(ctx context.Context, path, platform, output string, pretty bool) error {
+	defaultPlatform := ""
+	if strings.TrimSpace(platform) != "" {
+		normalized, err := normalizeDevicePlatform(platform)
+		if err != nil {
+			return fmt.Errorf("devices register: %w", err)
+		}
+		defaultPlatform = normalized
+	}
+
+	entries, err := parseDeviceListFile(path, defaultPlatform)
+	if err != nil {
+		return fmt.Errorf("devices register: %w", err)
+	}
+
+	client, err := getASCClient()
+	if err != nil {
+		return fmt.Errorf("devices register: %w", err)
+	}
+
+	result, err := registerDevicesFromFile(ctx, client, path, entries)
+	if err != nil {
+		return fmt.Errorf("devices register: %w", err)
+	}
+
+	if err := printOutput(result, output, pretty); err != nil {
+		return err
+	}
+	if result.Failed > 0 {
+		return shared.NewReportedError(fmt.Errorf("devices register: %d devices failed to register", result.Failed))
+	}
+	return nil
+}
+
 // DevicesUpdateCommand returns the devices update subcommand.
 func DevicesUpdateCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("update", flag.ExitOnError)
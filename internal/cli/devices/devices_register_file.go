@@ -0,0 +1,181 @@
+package devices
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+const (
+	deviceBatchStatusRegistered = "registered"
+	deviceBatchStatusSkipped    = "skipped"
+	deviceBatchStatusFailed     = "failed"
+)
+
+// deviceListEntry is one row of Apple's tab-separated device list.
+type deviceListEntry struct {
+	UDID     string
+	Name     string
+	Platform string
+}
+
+// parseDeviceListFile reads Apple's device upload format:
+//
+//	Device ID<TAB>Device Name<TAB>Device Platform
+//
+// The platform column is optional (older exports omit it); defaultPlatform
+// is used when it is missing. Header, blank and # comment lines are ignored.
+func parseDeviceListFile(path, defaultPlatform string) ([]deviceListEntry, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var entries []deviceListEntry
+	seen := map[string]int{}
+	scanner := bufio.NewScanner(file)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if lineNumber == 1 {
+			line = strings.TrimPrefix(line, "\ufeff")
+		}
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+
+		fields := strings.Split(line, "\t")
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+		if strings.EqualFold(fields[0], "Device ID") {
+			continue
+		}
+		if len(fields) < 2 || fields[0] == "" || fields[1] == "" {
+			return nil, fmt.Errorf("line %d: expected tab-separated device ID and name", lineNumber)
+		}
+		if len(fields) > 3 {
+			return nil, fmt.Errorf("line %d: expected at most 3 tab-separated columns", lineNumber)
+		}
+
+		platformValue := defaultPlatform
+		if len(fields) == 3 && fields[2] != "" {
+			platformValue = fields[2]
+		}
+		if platformValue == "" {
+			return nil, fmt.Errorf("line %d: device platform is missing (set --platform)", lineNumber)
+		}
+		platform, err := normalizeDeviceListPlatform(platformValue)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+		}
+
+		if previous, ok := seen[strings.ToLower(fields[0])]; ok {
+			return nil, fmt.Errorf("line %d: duplicate device ID %s (first seen on line %d)", lineNumber, fields[0], previous)
+		}
+		seen[strings.ToLower(fields[0])] = lineNumber
+
+		entries = append(entries, deviceListEntry{
+			UDID:     fields[0],
+			Name:     fields[1],
+			Platform: platform,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("no devices found in %s", path)
+	}
+	return entries, nil
+}
+
+// normalizeDeviceListPlatform accepts Apple's lowercase "ios"/"mac" values
+// as well as the API platform names.
+func normalizeDeviceListPlatform(value string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "ios":
+		return "IOS", nil
+	case "mac", "macos":
+		return "MAC_OS", nil
+	}
+	return normalizeDevicePlatform(value)
+}
+
+// registerDevicesFromFile registers every device that is not already known.
+// Failures are recorded per device so the rest of the file is still processed.
+func registerDevicesFromFile(ctx context.Context, client *asc.Client, path string, entries []deviceListEntry) (*asc.DeviceRegisterBatchResult, error) {
+	fetchCtx, cancel := contextWithTimeout(ctx)
+	existing, err := fetchRegisteredUDIDs(fetchCtx, client)
+	cancel()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch devices: %w", err)
+	}
+
+	result := &asc.DeviceRegisterBatchResult{
+		File:    path,
+		Devices: make([]asc.DeviceRegisterBatchItem, 0, len(entries)),
+	}
+	for _, entry := range entries {
+		item := asc.DeviceRegisterBatchItem{
+			UDID:     entry.UDID,
+			Name:     entry.Name,
+			Platform: entry.Platform,
+		}
+		if id, ok := existing[strings.ToLower(entry.UDID)]; ok {
+			item.Status = deviceBatchStatusSkipped
+			item.ID = id
+			result.Skipped++
+			result.Devices = append(result.Devices, item)
+			continue
+		}
+
+		createCtx, cancel := contextWithTimeout(ctx)
+		device, err := client.CreateDevice(createCtx, asc.DeviceCreateAttributes{
+			Name:     entry.Name,
+			UDID:     entry.UDID,
+			Platform: asc.DevicePlatform(entry.Platform),
+		})
+		cancel()
+		if err != nil {
+			item.Status = deviceBatchStatusFailed
+			item.Error = err.Error()
+			result.Failed++
+		} else {
+			item.Status = deviceBatchStatusRegistered
+			item.ID = device.Data.ID
+			result.Registered++
+		}
+		result.Devices = append(result.Devices, item)
+	}
+	return result, nil
+}
+
+func fetchRegisteredUDIDs(ctx context.Context, client *asc.Client) (map[string]string, error) {
+	firstPage, err := client.GetDevices(ctx, asc.WithDevicesLimit(200))
+	if err != nil {
+		return nil, err
+	}
+	all, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetDevices(ctx, asc.WithDevicesNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, err
+	}
+	devices, ok := all.(*asc.DevicesResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected devices response type %T", all)
+	}
+	udids := make(map[string]string, len(devices.Data))
+	for _, device := range devices.Data {
+		udids[strings.ToLower(device.Attributes.UDID)] = device.ID
+	}
+	return udids, nil
+}
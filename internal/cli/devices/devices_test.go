@@ -3,6 +3,9 @@ package devices
 import (
 	"context"
 	"flag"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -77,3 +80,73 @@ func TestDevicesUpdateCommand_MissingStatus(t *testing.T) {
 		t.Fatalf("expected flag.ErrHelp when --status is missing, got %v", err)
 	}
 }
+
+func TestDevicesRegisterCommand_FileConflict(t *testing.T) {
+	cmd := DevicesRegisterCommand()
+
+	if err := cmd.FlagSet.Parse([]string{"--file", "devices.txt", "--udid", "UDID"}); err != nil {
+		t.Fatalf("failed to parse flags: %v", err)
+	}
+
+	if err := cmd.Exec(context.Background(), []string{}); err != flag.ErrHelp {
+		t.Fatalf("expected flag.ErrHelp when --file is combined with --udid, got %v", err)
+	}
+}
+
+func TestParseDeviceListFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "devices.txt")
+	content := "\ufeffDevice ID\tDevice Name\tDevice Platform\r\n" +
+		"00008030-000A\tAlice iPhone\tios\r\n" +
+		"\r\n" +
+		"# lab machines\n" +
+		"MAC-UDID-1\tBuild Mac\tmac\n" +
+		"00008030-000B\tBob iPad\n"
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	entries, err := parseDeviceListFile(path, "IOS")
+	if err != nil {
+		t.Fatalf("parseDeviceListFile() error: %v", err)
+	}
+	want := []deviceListEntry{
+		{UDID: "00008030-000A", Name: "Alice iPhone", Platform: "IOS"},
+		{UDID: "MAC-UDID-1", Name: "Build Mac", Platform: "MAC_OS"},
+		{UDID: "00008030-000B", Name: "Bob iPad", Platform: "IOS"},
+	}
+	if len(entries) != len(want) {
+		t.Fatalf("expected %d entries, got %+v", len(want), entries)
+	}
+	for i := range want {
+		if entries[i] != want[i] {
+			t.Fatalf("entry %d = %+v, want %+v", i, entries[i], want[i])
+		}
+	}
+}
+
+func TestParseDeviceListFile_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{name: "missing platform", content: "UDID1\tPhone\n", wantErr: "platform is missing"},
+		{name: "missing name", content: "UDID1\n", wantErr: "line 1"},
+		{name: "bad platform", content: "UDID1\tPhone\twatch\n", wantErr: "--platform must be one of"},
+		{name: "duplicate", content: "UDID1\tPhone\tios\nudid1\tPhone 2\tios\n", wantErr: "duplicate device ID"},
+		{name: "empty", content: "Device ID\tDevice Name\n", wantErr: "no devices found"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "devices.txt")
+			if err := os.WriteFile(path, []byte(test.content), 0o600); err != nil {
+				t.Fatalf("write file: %v", err)
+			}
+			_, err := parseDeviceListFile(path, "")
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,8 @@
+package export
+
+import "github.com/peterbourgon/ff/v3/ffcli"
+
+// Command returns the export command group.
+func Command() *ffcli.Command {
+	return ExportCommand()
+}
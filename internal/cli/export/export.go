@@ -0,0 +1,32 @@
+package export
+
+import (
+	"context"
+	"flag"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+)
+
+// ExportCommand returns the export command with subcommands.
+func ExportCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "export",
+		ShortUsage: "asc export <subcommand> [flags]",
+		ShortHelp:  "Export App Store Connect data into local databases.",
+		LongHelp: `Export App Store Connect data into local databases.
+
+Examples:
+  asc export sqlite --db asc.db --sales ./reports --finance ./finance
+  asc export sqlite --db asc.db --app "123456789" --include reviews,builds,beta-testers`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			ExportSQLiteCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
@@ -0,0 +1,397 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	reportKindSales     = "sales"
+	reportKindFinance   = "finance"
+	reportKindAnalytics = "analytics"
+
+	exportIncludeReviews     = "reviews"
+	exportIncludeBuilds      = "builds"
+	exportIncludeBetaTesters = "beta-testers"
+
+	exportPageLimit = 200
+)
+
+// ExportSQLiteCommand loads reports and API data into a SQLite database.
+func ExportSQLiteCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("sqlite", flag.ExitOnError)
+
+	dbPath := fs.String("db", "", "SQLite database file (created if missing)")
+	sales := fs.String("sales", "", "Sales report files or directories, comma-separated")
+	finance := fs.String("finance", "", "Finance report files or directories, comma-separated")
+	analytics := fs.String("analytics", "", "Analytics report files or directories, comma-separated")
+	appID := fs.String("app", "", "App Store Connect app ID for --include (or ASC_APP_ID env)")
+	include := fs.String("include", "", "Live data to sync, comma-separated: reviews, builds, beta-testers")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "sqlite",
+		ShortUsage: "asc export sqlite --db FILE [--sales PATHS] [--finance PATHS] [--analytics PATHS] [--app APP --include LIST]",
+		ShortHelp:  "Load reports, reviews, builds and beta testers into SQLite.",
+		LongHelp: `Load reports, reviews, builds and beta testers into SQLite.
+
+Report files saved by 'asc analytics sales', 'asc finance reports',
+'asc analytics download' and the backfill commands are parsed into typed
+tables. Directories are scanned for .tsv, .txt, .csv and .gz files. Each file is
+tracked by absolute path in report_files with a SHA-256 hash: unchanged files are
+skipped, and a file whose contents changed has its rows replaced, so running
+the export again never duplicates data.
+
+With --include, live data for --app is fetched from the API (all pages) and
+upserted by resource ID.
+
+Tables:
+  report_files                    One row per loaded report file
+  sales_report_rows               SALES reports
+  subscription_report_rows        SUBSCRIPTION reports
+  subscription_event_report_rows  SUBSCRIPTION_EVENT reports
+  finance_report_rows             FINANCIAL and FINANCE_DETAIL rows
+  finance_exchange_rates          Payment summary of finance reports
+  analytics_report_rows           Analytics rows; all columns in the JSON "data"
+                                  column, e.g. json_extract(data, '$.Sessions')
+  reviews, builds, beta_testers   Live data, keyed by app_id and id
+
+Report row tables reference report_files through file_id.
+
+Examples:
+  asc export sqlite --db asc.db --sales ./reports
+  asc export sqlite --db asc.db --sales "day1.tsv.gz,day2.tsv.gz" --finance ./finance --analytics ./analytics
+  asc export sqlite --db asc.db --app "123456789" --include reviews,builds,beta-testers
+  sqlite3 asc.db "SELECT country_code, SUM(units) FROM sales_report_rows GROUP BY 1 ORDER BY 2 DESC"`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if strings.TrimSpace(*dbPath) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --db is required")
+				return flag.ErrHelp
+			}
+
+			includes, err := normalizeExportIncludes(*include)
+			if err != nil {
+				return fmt.Errorf("export sqlite: %w", err)
+			}
+			resolvedAppID := resolveAppID(*appID)
+			if len(includes) > 0 && resolvedAppID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --app is required with --include (or set ASC_APP_ID)")
+				return flag.ErrHelp
+			}
+
+			sources := []struct {
+				kind  string
+				paths string
+			}{
+				{reportKindSales, *sales},
+				{reportKindFinance, *finance},
+				{reportKindAnalytics, *analytics},
+			}
+			var files []exportReportPath
+			for _, source := range sources {
+				paths, err := expandReportPaths(shared.SplitCSV(source.paths))
+				if err != nil {
+					return fmt.Errorf("export sqlite: --%s: %w", source.kind, err)
+				}
+				for _, path := range paths {
+					files = append(files, exportReportPath{kind: source.kind, path: path})
+				}
+			}
+			if len(files) == 0 && len(includes) == 0 {
+				fmt.Fprintln(os.Stderr, "Error: provide at least one of --sales, --finance, --analytics or --include")
+				return flag.ErrHelp
+			}
+
+			store, err := openSQLiteStore(ctx, *dbPath)
+			if err != nil {
+				return fmt.Errorf("export sqlite: failed to open database: %w", err)
+			}
+			defer store.Close()
+
+			result := &asc.SQLiteExportResult{Database: *dbPath}
+			for _, file := range files {
+				source, err := loadReportFile(ctx, store, file.kind, file.path)
+				if err != nil {
+					return fmt.Errorf("export sqlite: %s: %w", file.path, err)
+				}
+				result.Sources = append(result.Sources, source)
+			}
+
+			if len(includes) > 0 {
+				client, err := getASCClient()
+				if err != nil {
+					return fmt.Errorf("export sqlite: %w", err)
+				}
+				for _, name := range includes {
+					source, err := syncLiveData(ctx, store, client, resolvedAppID, name)
+					if err != nil {
+						return fmt.Errorf("export sqlite: %s: %w", name, err)
+					}
+					result.Sources = append(result.Sources, source)
+				}
+			}
+
+			return printOutput(result, *output, *pretty)
+		},
+	}
+}
+
+type exportReportPath struct {
+	kind string
+	path string
+}
+
+func normalizeExportIncludes(value string) ([]string, error) {
+	var includes []string
+	seen := map[string]bool{}
+	for _, item := range shared.SplitCSV(value) {
+		name := strings.ToLower(item)
+		switch name {
+		case exportIncludeReviews, exportIncludeBuilds, exportIncludeBetaTesters:
+		default:
+			return nil, fmt.Errorf("--include must contain only %s, %s, or %s", exportIncludeReviews, exportIncludeBuilds, exportIncludeBetaTesters)
+		}
+		if !seen[name] {
+			seen[name] = true
+			includes = append(includes, name)
+		}
+	}
+	return includes, nil
+}
+
+// expandReportPaths replaces directories with the report files they contain,
+// sorted by name. Hidden files and backfill manifests are skipped.
+func expandReportPaths(paths []string) ([]string, error) {
+	var files []string
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return nil, err
+		}
+		var names []string
+		for _, entry := range entries {
+			name := entry.Name()
+			if entry.IsDir() || strings.HasPrefix(name, ".") || !isReportFileName(name) {
+				continue
+			}
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			files = append(files, filepath.Join(path, name))
+		}
+	}
+	return files, nil
+}
+
+func isReportFileName(name string) bool {
+	lower := strings.ToLower(strings.TrimSuffix(name, ".gz"))
+	for _, suffix := range []string{".tsv", ".txt", ".csv"} {
+		if strings.HasSuffix(lower, suffix) {
+			return true
+		}
+	}
+	return strings.HasSuffix(strings.ToLower(name), ".gz")
+}
+
+// loadReportFile parses a report file of the given kind into the store.
+func loadReportFile(ctx context.Context, store *sqliteStore, kind, path string) (asc.SQLiteExportSource, error) {
+	data, err := readReportFile(path)
+	if err != nil {
+		return asc.SQLiteExportSource{}, err
+	}
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return asc.SQLiteExportSource{}, err
+	}
+	sum := sha256.Sum256(data)
+	file := reportFile{
+		name:   filepath.Base(path),
+		path:   absPath,
+		kind:   kind,
+		sha256: hex.EncodeToString(sum[:]),
+	}
+
+	var (
+		table string
+		load  func(tx *sql.Tx, fileID int64) (int, error)
+	)
+	switch kind {
+	case reportKindSales:
+		report, err := asc.ParseSalesReport(bytes.NewReader(data), "")
+		if err != nil {
+			return asc.SQLiteExportSource{}, err
+		}
+		file.reportType = string(report.ReportType)
+		switch report.ReportType {
+		case asc.SalesReportTypeSubscription:
+			table = subscriptionRowsTable.name
+			load = func(tx *sql.Tx, fileID int64) (int, error) {
+				return upsertReportRows(ctx, tx, subscriptionRowsTable, fileID, report.Subscriptions)
+			}
+		case asc.SalesReportTypeSubscriptionEvent:
+			table = subscriptionEventRowsTable.name
+			load = func(tx *sql.Tx, fileID int64) (int, error) {
+				return upsertReportRows(ctx, tx, subscriptionEventRowsTable, fileID, report.SubscriptionEvents)
+			}
+		default:
+			table = salesRowsTable.name
+			load = func(tx *sql.Tx, fileID int64) (int, error) {
+				return upsertReportRows(ctx, tx, salesRowsTable, fileID, report.Sales)
+			}
+		}
+	case reportKindFinance:
+		report, err := asc.ParseFinanceReport(bytes.NewReader(data), "")
+		if err != nil {
+			return asc.SQLiteExportSource{}, err
+		}
+		file.reportType = string(report.ReportType)
+		table = financeRowsTable.name
+		load = func(tx *sql.Tx, fileID int64) (int, error) {
+			if _, err := upsertReportRows(ctx, tx, financeRatesTable, fileID, report.ExchangeRates); err != nil {
+				return 0, err
+			}
+			return upsertReportRows(ctx, tx, financeRowsTable, fileID, report.Rows)
+		}
+	case reportKindAnalytics:
+		report, err := asc.ParseAnalyticsReport(bytes.NewReader(data))
+		if err != nil {
+			return asc.SQLiteExportSource{}, err
+		}
+		table = analyticsRowsTable.name
+		load = func(tx *sql.Tx, fileID int64) (int, error) {
+			return upsertAnalyticsRows(ctx, tx, fileID, report)
+		}
+	default:
+		return asc.SQLiteExportSource{}, fmt.Errorf("unknown report kind %q", kind)
+	}
+
+	status, rows, err := store.loadReport(ctx, file, load)
+	if err != nil {
+		return asc.SQLiteExportSource{}, err
+	}
+	return asc.SQLiteExportSource{Source: path, Table: table, Rows: rows, Status: status}, nil
+}
+
+func readReportFile(path string) ([]byte, error) {
+	file, err := shared.OpenExistingNoFollow(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return io.ReadAll(file)
+}
+
+// syncLiveData fetches every page of a resource collection for an app and
+// upserts it into the store. Each page gets its own timeout so apps with many
+// reviews, builds or testers do not run out a single shared deadline.
+func syncLiveData(ctx context.Context, store *sqliteStore, client *asc.Client, appID, name string) (asc.SQLiteExportSource, error) {
+	var (
+		table sqliteTable
+		count int
+		err   error
+	)
+	switch name {
+	case exportIncludeReviews:
+		table = reviewsTable
+		var firstPage *asc.ReviewsResponse
+		requestCtx, cancel := contextWithTimeout(ctx)
+		firstPage, err = client.GetReviews(requestCtx, appID, asc.WithLimit(exportPageLimit))
+		cancel()
+		if err != nil {
+			return asc.SQLiteExportSource{}, err
+		}
+		var all asc.PaginatedResponse
+		all, err = asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+			pageCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+			return client.GetReviews(pageCtx, appID, asc.WithNextURL(nextURL))
+		})
+		if err != nil {
+			return asc.SQLiteExportSource{}, err
+		}
+		reviews, ok := all.(*asc.ReviewsResponse)
+		if !ok {
+			return asc.SQLiteExportSource{}, fmt.Errorf("unexpected reviews response type %T", all)
+		}
+		count, err = upsertResources(ctx, store, table, appID, reviews.Data)
+	case exportIncludeBuilds:
+		table = buildsTable
+		var firstPage *asc.BuildsResponse
+		requestCtx, cancel := contextWithTimeout(ctx)
+		firstPage, err = client.GetBuilds(requestCtx, appID, asc.WithBuildsLimit(exportPageLimit))
+		cancel()
+		if err != nil {
+			return asc.SQLiteExportSource{}, err
+		}
+		var all asc.PaginatedResponse
+		all, err = asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+			pageCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+			return client.GetBuilds(pageCtx, appID, asc.WithBuildsNextURL(nextURL))
+		})
+		if err != nil {
+			return asc.SQLiteExportSource{}, err
+		}
+		builds, ok := all.(*asc.BuildsResponse)
+		if !ok {
+			return asc.SQLiteExportSource{}, fmt.Errorf("unexpected builds response type %T", all)
+		}
+		count, err = upsertResources(ctx, store, table, appID, builds.Data)
+	case exportIncludeBetaTesters:
+		table = betaTestersTable
+		var firstPage *asc.BetaTestersResponse
+		requestCtx, cancel := contextWithTimeout(ctx)
+		firstPage, err = client.GetBetaTesters(requestCtx, appID, asc.WithBetaTestersLimit(exportPageLimit))
+		cancel()
+		if err != nil {
+			return asc.SQLiteExportSource{}, err
+		}
+		var all asc.PaginatedResponse
+		all, err = asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+			pageCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+			return client.GetBetaTesters(pageCtx, appID, asc.WithBetaTestersNextURL(nextURL))
+		})
+		if err != nil {
+			return asc.SQLiteExportSource{}, err
+		}
+		testers, ok := all.(*asc.BetaTestersResponse)
+		if !ok {
+			return asc.SQLiteExportSource{}, fmt.Errorf("unexpected beta testers response type %T", all)
+		}
+		count, err = upsertResources(ctx, store, table, appID, testers.Data)
+	default:
+		return asc.SQLiteExportSource{}, fmt.Errorf("unknown include %q", name)
+	}
+	if err != nil {
+		return asc.SQLiteExportSource{}, err
+	}
+	return asc.SQLiteExportSource{Source: name, Table: table.name, Rows: count, Status: exportStatusSynced}, nil
+}
@@ -0,0 +1,532 @@
+package export
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"time"
+	"unicode"
+
+	// Pure-Go SQLite driver, so exports work without cgo.
+	_ "modernc.org/sqlite"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+const (
+	exportStatusLoaded    = "loaded"
+	exportStatusReplaced  = "replaced"
+	exportStatusUnchanged = "unchanged"
+	exportStatusSynced    = "synced"
+)
+
+type sqliteColumn struct {
+	name string
+	kind string
+}
+
+// sqliteTable describes a table and the key used to upsert into it.
+type sqliteTable struct {
+	name    string
+	columns []sqliteColumn
+	keys    []string
+	// constraints are appended to the column list, e.g. foreign keys.
+	constraints []string
+}
+
+var reportFileColumns = []sqliteColumn{
+	{name: "file_id", kind: "INTEGER NOT NULL"},
+	{name: "row_number", kind: "INTEGER NOT NULL"},
+}
+
+var resourceColumns = []sqliteColumn{
+	{name: "app_id", kind: "TEXT NOT NULL"},
+	{name: "id", kind: "TEXT NOT NULL"},
+}
+
+var (
+	salesRowsTable             = reportRowsTable("sales_report_rows", asc.SalesReportRow{})
+	subscriptionRowsTable      = reportRowsTable("subscription_report_rows", asc.SubscriptionReportRow{})
+	subscriptionEventRowsTable = reportRowsTable("subscription_event_report_rows", asc.SubscriptionEventReportRow{})
+	financeRowsTable           = reportRowsTable("finance_report_rows", asc.FinanceReportRow{})
+	financeRatesTable          = reportRowsTable("finance_exchange_rates", asc.FinanceExchangeRate{})
+	analyticsRowsTable         = sqliteTable{
+		name: "analytics_report_rows",
+		columns: append(append([]sqliteColumn{}, reportFileColumns...),
+			sqliteColumn{name: "date", kind: "TEXT"},
+			sqliteColumn{name: "app_apple_identifier", kind: "TEXT"},
+			sqliteColumn{name: "data", kind: "TEXT"},
+		),
+		keys:        []string{"file_id", "row_number"},
+		constraints: []string{"FOREIGN KEY (file_id) REFERENCES report_files(id) ON DELETE CASCADE"},
+	}
+	reviewsTable     = resourceTable("reviews", asc.ReviewAttributes{})
+	buildsTable      = resourceTable("builds", asc.BuildAttributes{})
+	betaTestersTable = resourceTable("beta_testers", asc.BetaTesterAttributes{})
+)
+
+const createReportFilesSQL = `CREATE TABLE IF NOT EXISTS report_files (
+	id INTEGER PRIMARY KEY,
+	name TEXT NOT NULL,
+	path TEXT NOT NULL UNIQUE,
+	kind TEXT,
+	report_type TEXT,
+	sha256 TEXT,
+	row_count INTEGER,
+	loaded_at TEXT
+)`
+
+// reportRowsTable builds a table for parsed report rows of type T, keyed by
+// source file and row number.
+func reportRowsTable(name string, sample interface{}) sqliteTable {
+	return sqliteTable{
+		name:        name,
+		columns:     append(append([]sqliteColumn{}, reportFileColumns...), structColumns(sample)...),
+		keys:        []string{"file_id", "row_number"},
+		constraints: []string{"FOREIGN KEY (file_id) REFERENCES report_files(id) ON DELETE CASCADE"},
+	}
+}
+
+// resourceTable builds a table for API resources of one app, keyed by ID.
+func resourceTable(name string, sample interface{}) sqliteTable {
+	columns := append(append([]sqliteColumn{}, resourceColumns...), structColumns(sample)...)
+	columns = append(columns, sqliteColumn{name: "synced_at", kind: "TEXT"})
+	return sqliteTable{name: name, columns: columns, keys: []string{"app_id", "id"}}
+}
+
+func (t sqliteTable) createSQL() string {
+	parts := make([]string, 0, len(t.columns)+1+len(t.constraints))
+	for _, column := range t.columns {
+		parts = append(parts, column.name+" "+column.kind)
+	}
+	parts = append(parts, "PRIMARY KEY ("+strings.Join(t.keys, ", ")+")")
+	parts = append(parts, t.constraints...)
+	return fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (\n\t%s\n)", t.name, strings.Join(parts, ",\n\t"))
+}
+
+func (t sqliteTable) upsertSQL() string {
+	names := make([]string, len(t.columns))
+	placeholders := make([]string, len(t.columns))
+	var updates []string
+	for i, column := range t.columns {
+		names[i] = column.name
+		placeholders[i] = "?"
+		if !containsString(t.keys, column.name) {
+			updates = append(updates, fmt.Sprintf("%s = excluded.%s", column.name, column.name))
+		}
+	}
+	return fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s) ON CONFLICT (%s) DO UPDATE SET %s",
+		t.name,
+		strings.Join(names, ", "),
+		strings.Join(placeholders, ", "),
+		strings.Join(t.keys, ", "),
+		strings.Join(updates, ", "),
+	)
+}
+
+// structColumns maps the fields of a row struct to SQLite columns, named after
+// the snake_cased JSON field names.
+func structColumns(sample interface{}) []sqliteColumn {
+	typ := reflect.TypeOf(sample)
+	columns := make([]sqliteColumn, 0, typ.NumField())
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		name := jsonFieldName(field)
+		if name == "" {
+			continue
+		}
+		kind := "TEXT"
+		switch field.Type.Kind() {
+		case reflect.Float32, reflect.Float64:
+			kind = "REAL"
+		case reflect.Bool, reflect.Int, reflect.Int32, reflect.Int64:
+			kind = "INTEGER"
+		}
+		columns = append(columns, sqliteColumn{name: snakeCase(name), kind: kind})
+	}
+	return columns
+}
+
+// structValues returns the field values of a row struct in structColumns order.
+func structValues(row interface{}) []interface{} {
+	value := reflect.ValueOf(row)
+	typ := value.Type()
+	values := make([]interface{}, 0, typ.NumField())
+	for i := 0; i < typ.NumField(); i++ {
+		if jsonFieldName(typ.Field(i)) == "" {
+			continue
+		}
+		field := value.Field(i)
+		switch field.Kind() {
+		case reflect.String:
+			values = append(values, field.String())
+		case reflect.Float32, reflect.Float64:
+			values = append(values, field.Float())
+		case reflect.Bool:
+			values = append(values, field.Bool())
+		case reflect.Int, reflect.Int32, reflect.Int64:
+			values = append(values, field.Int())
+		default:
+			values = append(values, fmt.Sprint(field.Interface()))
+		}
+	}
+	return values
+}
+
+func jsonFieldName(field reflect.StructField) string {
+	if !field.IsExported() {
+		return ""
+	}
+	name := strings.Split(field.Tag.Get("json"), ",")[0]
+	if name == "-" {
+		return ""
+	}
+	if name == "" {
+		return field.Name
+	}
+	return name
+}
+
+func snakeCase(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func containsString(values []string, value string) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
+
+// sqliteStore writes App Store Connect data into a SQLite database.
+type sqliteStore struct {
+	db  *sql.DB
+	now func() time.Time
+}
+
+func openSQLiteStore(ctx context.Context, path string) (*sqliteStore, error) {
+	dsn := "file:" + path + "?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)"
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, err
+	}
+	// A single connection keeps pragmas and transactions on one handle.
+	db.SetMaxOpenConns(1)
+
+	store := &sqliteStore{db: db, now: time.Now}
+	if err := store.migrate(ctx); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return store, nil
+}
+
+func (s *sqliteStore) Close() error {
+	return s.db.Close()
+}
+
+// migrate creates missing tables and adds columns introduced by newer report
+// layouts to tables created by older versions.
+func (s *sqliteStore) migrate(ctx context.Context) error {
+	if err := s.migrateReportFilesKey(ctx); err != nil {
+		return fmt.Errorf("migrate report_files: %w", err)
+	}
+	if _, err := s.db.ExecContext(ctx, createReportFilesSQL); err != nil {
+		return fmt.Errorf("create report_files: %w", err)
+	}
+	for _, table := range []sqliteTable{
+		salesRowsTable,
+		subscriptionRowsTable,
+		subscriptionEventRowsTable,
+		financeRowsTable,
+		financeRatesTable,
+		analyticsRowsTable,
+		reviewsTable,
+		buildsTable,
+		betaTestersTable,
+	} {
+		if _, err := s.db.ExecContext(ctx, table.createSQL()); err != nil {
+			return fmt.Errorf("create %s: %w", table.name, err)
+		}
+		existing, err := s.tableColumns(ctx, table.name)
+		if err != nil {
+			return err
+		}
+		for _, column := range table.columns {
+			if existing[column.name] {
+				continue
+			}
+			kind := strings.TrimSuffix(column.kind, " NOT NULL")
+			if _, err := s.db.ExecContext(ctx, fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", table.name, column.name, kind)); err != nil {
+				return fmt.Errorf("add column %s.%s: %w", table.name, column.name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// migrateReportFilesKey rebuilds a report_files table created when files were
+// keyed by base name, so same-named files from different directories no
+// longer collide. Stored relative paths are resolved against the working
+// directory, which is where they were loaded from in the common case.
+func (s *sqliteStore) migrateReportFilesKey(ctx context.Context) error {
+	var schema string
+	err := s.db.QueryRowContext(ctx, "SELECT sql FROM sqlite_master WHERE type = 'table' AND name = 'report_files'").Scan(&schema)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !strings.Contains(schema, "name TEXT NOT NULL UNIQUE") {
+		return nil
+	}
+
+	// Dropping the old table must not cascade to the report rows that
+	// reference it, so foreign keys are off while the table is swapped.
+	if _, err := s.db.ExecContext(ctx, "PRAGMA foreign_keys = OFF"); err != nil {
+		return err
+	}
+	defer s.db.ExecContext(ctx, "PRAGMA foreign_keys = ON")
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, "SELECT id, name, COALESCE(path, '') FROM report_files")
+	if err != nil {
+		return err
+	}
+	paths := map[int64]string{}
+	for rows.Next() {
+		var (
+			id         int64
+			name, path string
+		)
+		if err := rows.Scan(&id, &name, &path); err != nil {
+			rows.Close()
+			return err
+		}
+		if path == "" {
+			path = name
+		}
+		if abs, err := filepath.Abs(path); err == nil {
+			path = abs
+		}
+		paths[id] = path
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	statements := []string{
+		strings.Replace(createReportFilesSQL, "report_files", "report_files_new", 1),
+		"INSERT INTO report_files_new (id, name, path, kind, report_type, sha256, row_count, loaded_at) SELECT id, name, COALESCE(path, name), kind, report_type, sha256, row_count, loaded_at FROM report_files",
+		"DROP TABLE report_files",
+		"ALTER TABLE report_files_new RENAME TO report_files",
+	}
+	for _, statement := range statements {
+		if _, err := tx.ExecContext(ctx, statement); err != nil {
+			return err
+		}
+	}
+	for id, path := range paths {
+		if _, err := tx.ExecContext(ctx, "UPDATE report_files SET path = ? WHERE id = ?", path, id); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (s *sqliteStore) tableColumns(ctx context.Context, table string) (map[string]bool, error) {
+	rows, err := s.db.QueryContext(ctx, fmt.Sprintf("PRAGMA table_info(%s)", table))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	columns := map[string]bool{}
+	for rows.Next() {
+		var (
+			cid        int
+			name       string
+			kind       string
+			notNull    int
+			defaultVal sql.NullString
+			primaryKey int
+		)
+		if err := rows.Scan(&cid, &name, &kind, &notNull, &defaultVal, &primaryKey); err != nil {
+			return nil, err
+		}
+		columns[name] = true
+	}
+	return columns, rows.Err()
+}
+
+// reportFile identifies a report file by its absolute path; reloading a file
+// whose contents changed replaces its rows.
+type reportFile struct {
+	name       string
+	path       string
+	kind       string
+	reportType string
+	sha256     string
+}
+
+// loadReport records file in report_files and calls load to insert its rows,
+// all in one transaction. Files already loaded with the same hash are left
+// untouched.
+func (s *sqliteStore) loadReport(ctx context.Context, file reportFile, load func(tx *sql.Tx, fileID int64) (int, error)) (string, int, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return "", 0, err
+	}
+	defer tx.Rollback()
+
+	status := exportStatusLoaded
+	var (
+		existingID   int64
+		existingHash string
+		existingRows int
+	)
+	err = tx.QueryRowContext(ctx, "SELECT id, sha256, row_count FROM report_files WHERE path = ?", file.path).Scan(&existingID, &existingHash, &existingRows)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+	case err != nil:
+		return "", 0, err
+	case existingHash == file.sha256:
+		return exportStatusUnchanged, existingRows, nil
+	default:
+		if _, err := tx.ExecContext(ctx, "DELETE FROM report_files WHERE id = ?", existingID); err != nil {
+			return "", 0, err
+		}
+		status = exportStatusReplaced
+	}
+
+	result, err := tx.ExecContext(ctx,
+		"INSERT INTO report_files (name, path, kind, report_type, sha256, row_count, loaded_at) VALUES (?, ?, ?, ?, ?, 0, ?)",
+		file.name, file.path, file.kind, file.reportType, file.sha256, s.timestamp(),
+	)
+	if err != nil {
+		return "", 0, err
+	}
+	fileID, err := result.LastInsertId()
+	if err != nil {
+		return "", 0, err
+	}
+
+	count, err := load(tx, fileID)
+	if err != nil {
+		return "", 0, err
+	}
+	if _, err := tx.ExecContext(ctx, "UPDATE report_files SET row_count = ? WHERE id = ?", count, fileID); err != nil {
+		return "", 0, err
+	}
+	if err := tx.Commit(); err != nil {
+		return "", 0, err
+	}
+	return status, count, nil
+}
+
+// upsertReportRows writes typed report rows for one file.
+func upsertReportRows[T any](ctx context.Context, tx *sql.Tx, table sqliteTable, fileID int64, rows []T) (int, error) {
+	stmt, err := tx.PrepareContext(ctx, table.upsertSQL())
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", table.name, err)
+	}
+	defer stmt.Close()
+
+	for i, row := range rows {
+		args := append([]interface{}{fileID, i + 1}, structValues(row)...)
+		if _, err := stmt.ExecContext(ctx, args...); err != nil {
+			return 0, fmt.Errorf("%s: %w", table.name, err)
+		}
+	}
+	return len(rows), nil
+}
+
+// upsertAnalyticsRows writes an analytics report with each row stored as a
+// JSON object keyed by column name, plus the columns most reports share.
+func upsertAnalyticsRows(ctx context.Context, tx *sql.Tx, fileID int64, report *asc.AnalyticsReportTable) (int, error) {
+	stmt, err := tx.PrepareContext(ctx, analyticsRowsTable.upsertSQL())
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", analyticsRowsTable.name, err)
+	}
+	defer stmt.Close()
+
+	for i, row := range report.Rows {
+		data := make(map[string]string, len(report.Columns))
+		for j, column := range report.Columns {
+			if j < len(row) {
+				data[column] = row[j]
+			}
+		}
+		encoded, err := json.Marshal(data)
+		if err != nil {
+			return 0, err
+		}
+		if _, err := stmt.ExecContext(ctx,
+			fileID,
+			i+1,
+			report.Value(row, "Date"),
+			report.Value(row, "App Apple Identifier"),
+			string(encoded),
+		); err != nil {
+			return 0, fmt.Errorf("%s: %w", analyticsRowsTable.name, err)
+		}
+	}
+	return len(report.Rows), nil
+}
+
+// upsertResources writes API resources for an app, updating rows that were
+// synced before.
+func upsertResources[T any](ctx context.Context, s *sqliteStore, table sqliteTable, appID string, resources []asc.Resource[T]) (int, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.PrepareContext(ctx, table.upsertSQL())
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", table.name, err)
+	}
+	defer stmt.Close()
+
+	syncedAt := s.timestamp()
+	for _, resource := range resources {
+		args := append([]interface{}{appID, resource.ID}, structValues(resource.Attributes)...)
+		args = append(args, syncedAt)
+		if _, err := stmt.ExecContext(ctx, args...); err != nil {
+			return 0, fmt.Errorf("%s: %w", table.name, err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return len(resources), nil
+}
+
+func (s *sqliteStore) timestamp() string {
+	return s.now().UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,232 @@
+package export
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestStructColumns(t *testing.T) {
+	columns := structColumns(asc.SubscriptionEventReportRow{})
+	byName := map[string]string{}
+	for _, column := range columns {
+		byName[column.name] = column.kind
+	}
+	if byName["app_apple_id"] != "TEXT" || byName["quantity"] != "REAL" || byName["event_date"] != "TEXT" {
+		t.Fatalf("unexpected columns: %+v", columns)
+	}
+	if len(structValues(asc.SubscriptionEventReportRow{})) != len(columns) {
+		t.Fatal("expected one value per column")
+	}
+}
+
+func TestSQLiteTableUpsertSQL(t *testing.T) {
+	table := sqliteTable{
+		name:    "items",
+		columns: []sqliteColumn{{name: "app_id"}, {name: "id"}, {name: "title"}},
+		keys:    []string{"app_id", "id"},
+	}
+	want := "INSERT INTO items (app_id, id, title) VALUES (?, ?, ?) ON CONFLICT (app_id, id) DO UPDATE SET title = excluded.title"
+	if got := table.upsertSQL(); got != want {
+		t.Fatalf("expected %q, got %q", want, got)
+	}
+}
+
+func TestSQLiteStoreLoadReportReplacesChangedFiles(t *testing.T) {
+	ctx := context.Background()
+	store, err := openSQLiteStore(ctx, filepath.Join(t.TempDir(), "asc.db"))
+	if err != nil {
+		t.Fatalf("open store: %v", err)
+	}
+	defer store.Close()
+
+	load := func(rows []asc.SalesReportRow) func(tx *sql.Tx, fileID int64) (int, error) {
+		return func(tx *sql.Tx, fileID int64) (int, error) {
+			return upsertReportRows(ctx, tx, salesRowsTable, fileID, rows)
+		}
+	}
+	file := reportFile{name: "day1.tsv", path: "/reports/day1.tsv", kind: reportKindSales, sha256: "a"}
+	first := []asc.SalesReportRow{{SKU: "one", Units: 1}, {SKU: "two", Units: 2}}
+
+	if status, rows, err := store.loadReport(ctx, file, load(first)); err != nil || status != exportStatusLoaded || rows != 2 {
+		t.Fatalf("first load: status=%s rows=%d err=%v", status, rows, err)
+	}
+	if status, rows, err := store.loadReport(ctx, file, load(first)); err != nil || status != exportStatusUnchanged || rows != 2 {
+		t.Fatalf("second load: status=%s rows=%d err=%v", status, rows, err)
+	}
+
+	file.sha256 = "b"
+	if status, rows, err := store.loadReport(ctx, file, load([]asc.SalesReportRow{{SKU: "one", Units: 5}})); err != nil || status != exportStatusReplaced || rows != 1 {
+		t.Fatalf("changed load: status=%s rows=%d err=%v", status, rows, err)
+	}
+
+	var count int
+	var units float64
+	if err := store.db.QueryRowContext(ctx, "SELECT COUNT(*), SUM(units) FROM sales_report_rows").Scan(&count, &units); err != nil {
+		t.Fatalf("query rows: %v", err)
+	}
+	if count != 1 || units != 5 {
+		t.Fatalf("expected replaced rows only, got count=%d units=%v", count, units)
+	}
+}
+
+func TestLoadReportFileKeepsSameNamedFilesApart(t *testing.T) {
+	ctx := context.Background()
+	store, err := openSQLiteStore(ctx, filepath.Join(t.TempDir(), "asc.db"))
+	if err != nil {
+		t.Fatalf("open store: %v", err)
+	}
+	defer store.Close()
+
+	root := t.TempDir()
+	header := "Provider\tSKU\tTitle\tProduct Type Identifier\tUnits\tDeveloper Proceeds\tCountry Code\tCurrency of Proceeds\tCustomer Price\n"
+	for _, dir := range []string{"app-one", "app-two"} {
+		path := filepath.Join(root, dir, "sales.tsv")
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(header+"APPLE\t"+dir+"\tApp\t1\t1\t0.70\tUS\tUSD\t0.99\n"), 0o600); err != nil {
+			t.Fatalf("write report: %v", err)
+		}
+		source, err := loadReportFile(ctx, store, reportKindSales, path)
+		if err != nil {
+			t.Fatalf("load %s: %v", path, err)
+		}
+		if source.Status != exportStatusLoaded || source.Rows != 1 {
+			t.Fatalf("load %s: status=%s rows=%d", path, source.Status, source.Rows)
+		}
+	}
+
+	var files, rows int
+	if err := store.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM report_files WHERE name = 'sales.tsv'").Scan(&files); err != nil {
+		t.Fatalf("query files: %v", err)
+	}
+	if err := store.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM sales_report_rows").Scan(&rows); err != nil {
+		t.Fatalf("query rows: %v", err)
+	}
+	if files != 2 || rows != 2 {
+		t.Fatalf("expected both files and their rows, got files=%d rows=%d", files, rows)
+	}
+}
+
+func TestSQLiteStoreMigrateRekeysReportFilesByPath(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	path := filepath.Join(dir, "asc.db")
+
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		t.Fatalf("open db: %v", err)
+	}
+	for _, statement := range []string{
+		"CREATE TABLE report_files (id INTEGER PRIMARY KEY, name TEXT NOT NULL UNIQUE, path TEXT, kind TEXT, report_type TEXT, sha256 TEXT, row_count INTEGER, loaded_at TEXT)",
+		"INSERT INTO report_files (id, name, path, kind, sha256, row_count) VALUES (1, 'sales.tsv', '" + filepath.Join(dir, "a", "sales.tsv") + "', 'sales', 'a', 1)",
+		salesRowsTable.createSQL(),
+		"INSERT INTO sales_report_rows (file_id, row_number, sku) VALUES (1, 1, 'one')",
+	} {
+		if _, err := db.Exec(statement); err != nil {
+			t.Fatalf("exec %q: %v", statement, err)
+		}
+	}
+	db.Close()
+
+	store, err := openSQLiteStore(ctx, path)
+	if err != nil {
+		t.Fatalf("open store: %v", err)
+	}
+	defer store.Close()
+
+	var rows int
+	if err := store.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM sales_report_rows WHERE file_id = 1").Scan(&rows); err != nil || rows != 1 {
+		t.Fatalf("expected existing rows to survive migration, got %d (%v)", rows, err)
+	}
+	file := reportFile{name: "sales.tsv", path: filepath.Join(dir, "b", "sales.tsv"), kind: reportKindSales, sha256: "b"}
+	status, _, err := store.loadReport(ctx, file, func(tx *sql.Tx, fileID int64) (int, error) { return 0, nil })
+	if err != nil || status != exportStatusLoaded {
+		t.Fatalf("expected same-named file to load after migration, got status=%s err=%v", status, err)
+	}
+}
+
+func TestSQLiteStoreMigrateAddsMissingColumns(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "asc.db")
+
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		t.Fatalf("open db: %v", err)
+	}
+	if _, err := db.Exec("CREATE TABLE builds (app_id TEXT NOT NULL, id TEXT NOT NULL, version TEXT, PRIMARY KEY (app_id, id))"); err != nil {
+		t.Fatalf("create table: %v", err)
+	}
+	db.Close()
+
+	store, err := openSQLiteStore(ctx, path)
+	if err != nil {
+		t.Fatalf("open store: %v", err)
+	}
+	defer store.Close()
+
+	columns, err := store.tableColumns(ctx, "builds")
+	if err != nil {
+		t.Fatalf("table columns: %v", err)
+	}
+	for _, name := range []string{"processing_state", "expired", "synced_at"} {
+		if !columns[name] {
+			t.Fatalf("expected column %s to be added, got %v", name, columns)
+		}
+	}
+
+	count, err := upsertResources(ctx, store, buildsTable, "app", []asc.Resource[asc.BuildAttributes]{
+		{ID: "b1", Attributes: asc.BuildAttributes{Version: "1", ProcessingState: "VALID"}},
+	})
+	if err != nil || count != 1 {
+		t.Fatalf("upsert builds: count=%d err=%v", count, err)
+	}
+}
+
+func TestExpandReportPaths(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"b.tsv.gz", "a.tsv", "manifest.json", ".asc-report-123", "notes.md"} {
+		writeTestFile(t, filepath.Join(dir, name))
+	}
+	single := filepath.Join(t.TempDir(), "single.txt")
+	writeTestFile(t, single)
+
+	files, err := expandReportPaths([]string{dir, single})
+	if err != nil {
+		t.Fatalf("expandReportPaths() error: %v", err)
+	}
+	want := []string{filepath.Join(dir, "a.tsv"), filepath.Join(dir, "b.tsv.gz"), single}
+	if strings.Join(files, ",") != strings.Join(want, ",") {
+		t.Fatalf("expected %v, got %v", want, files)
+	}
+
+	if _, err := expandReportPaths([]string{filepath.Join(dir, "missing")}); err == nil {
+		t.Fatal("expected error for missing path")
+	}
+}
+
+func TestNormalizeExportIncludes(t *testing.T) {
+	includes, err := normalizeExportIncludes("Reviews, builds,reviews,beta-testers")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Join(includes, ",") != "reviews,builds,beta-testers" {
+		t.Fatalf("unexpected includes: %v", includes)
+	}
+	if _, err := normalizeExportIncludes("crashes"); err == nil {
+		t.Fatal("expected error for unknown include")
+	}
+}
+
+func writeTestFile(t *testing.T, path string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte("x"), 0o600); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+}
@@ -28,11 +28,15 @@ Requires Account Holder, Admin, or Finance role.
 
 Examples:
   asc finance reports --vendor "12345678" --report-type FINANCIAL --region "US" --date "2025-12"
+  asc finance backfill --vendor "12345678" --from "2024-01" --to "2025-06" --dir "./finance"
+  asc finance summarize --file "finance_report_2025-12_FINANCIAL_ZZ.tsv.gz"
   asc finance regions --output table`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			FinanceReportsCommand(),
+			FinanceBackfillCommand(),
+			FinanceSummarizeCommand(),
 			FinanceRegionsCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
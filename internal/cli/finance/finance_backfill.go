@@ -0,0 +1,171 @@
+package finance
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// FinanceBackfillCommand downloads finance reports for a range of months.
+func FinanceBackfillCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("backfill", flag.ExitOnError)
+
+	vendor := fs.String("vendor", "", "Vendor number (or ASC_VENDOR_NUMBER env)")
+	reportType := fs.String("report-type", "FINANCIAL", "Report type: FINANCIAL (default) or FINANCE_DETAIL")
+	region := fs.String("region", "", "Region code (default: ZZ for FINANCIAL, Z1 for FINANCE_DETAIL)")
+	from := fs.String("from", "", "First fiscal month (YYYY-MM)")
+	to := fs.String("to", "", "Last fiscal month, inclusive (YYYY-MM)")
+	dir := fs.String("dir", "", "Directory to save reports and manifest.json into")
+	concurrency := fs.Int("concurrency", 4, fmt.Sprintf("Parallel downloads (1-%d)", shared.MaxReportBackfillConcurrency))
+	retryUnavailable := fs.Bool("retry-unavailable", false, "Re-request months previously recorded as having no report")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "backfill",
+		ShortUsage: "asc finance backfill --from YYYY-MM --to YYYY-MM --dir DIR [flags]",
+		ShortHelp:  "Download finance reports for a range of months.",
+		LongHelp: `Download finance reports for a range of months.
+
+Fetches one report per Apple fiscal month between --from and --to (inclusive)
+into --dir, several at a time. Rate-limited requests are retried with backoff.
+
+Progress is tracked in <dir>/manifest.json with a SHA-256 hash of each report,
+so re-running the same command resumes an interrupted backfill: reports whose
+file matches the manifest are skipped, and missing or modified files are
+downloaded again. Months with no report are recorded as unavailable and
+skipped on later runs unless --retry-unavailable is set. Recent months that
+Apple may not have published yet are reported as pending instead and asked
+for again on the next run.
+
+Examples:
+  asc finance backfill --vendor "12345678" --from 2024-01 --to 2025-06 --dir ./finance
+  asc finance backfill --vendor "12345678" --report-type FINANCIAL --region US --from 2025-01 --to 2025-06 --dir ./finance-us
+  asc finance backfill --vendor "12345678" --report-type FINANCE_DETAIL --from 2025-01 --to 2025-06 --dir ./finance-detail --output table`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			vendorNumber := shared.ResolveVendorNumber(*vendor)
+			if vendorNumber == "" {
+				fmt.Fprintln(os.Stderr, "Error: --vendor is required (or set ASC_VENDOR_NUMBER)")
+				return flag.ErrHelp
+			}
+			if strings.TrimSpace(*from) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --from is required")
+				return flag.ErrHelp
+			}
+			if strings.TrimSpace(*to) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --to is required")
+				return flag.ErrHelp
+			}
+			if strings.TrimSpace(*dir) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --dir is required")
+				return flag.ErrHelp
+			}
+			if *concurrency < 1 || *concurrency > shared.MaxReportBackfillConcurrency {
+				return fmt.Errorf("finance backfill: --concurrency must be between 1 and %d", shared.MaxReportBackfillConcurrency)
+			}
+
+			normalizedReportType, err := normalizeFinanceReportType(*reportType)
+			if err != nil {
+				return fmt.Errorf("finance backfill: %w", err)
+			}
+			regionValue := *region
+			if strings.TrimSpace(regionValue) == "" {
+				regionValue = "ZZ"
+				if normalizedReportType == asc.FinanceReportTypeFinanceDetail {
+					regionValue = "Z1"
+				}
+			}
+			regionCode, err := normalizeFinanceReportRegion(normalizedReportType, regionValue)
+			if err != nil {
+				return fmt.Errorf("finance backfill: %w", err)
+			}
+			months, err := financeReportMonths(*from, *to)
+			if err != nil {
+				return fmt.Errorf("finance backfill: %w", err)
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("finance backfill: %w", err)
+			}
+
+			jobs := make([]shared.ReportBackfillJob, 0, len(months))
+			for _, month := range months {
+				params := asc.FinanceReportParams{
+					VendorNumber: vendorNumber,
+					ReportType:   normalizedReportType,
+					RegionCode:   regionCode,
+					ReportDate:   month,
+				}
+				jobs = append(jobs, shared.ReportBackfillJob{
+					Period:      month,
+					FileName:    fmt.Sprintf("finance_report_%s_%s_%s.tsv.gz", month, normalizedReportType, regionCode),
+					PublishedBy: financeReportPublishedBy(month),
+					Download: func(ctx context.Context) (*asc.ReportDownload, error) {
+						return client.DownloadFinanceReport(ctx, params)
+					},
+				})
+			}
+
+			result, err := shared.RunReportBackfill(ctx, jobs, shared.ReportBackfillOptions{
+				Dir:              *dir,
+				Concurrency:      *concurrency,
+				RetryUnavailable: *retryUnavailable,
+				Progress:         os.Stderr,
+			})
+			if err != nil {
+				return fmt.Errorf("finance backfill: %w", err)
+			}
+			if err := printOutput(result, *output, *pretty); err != nil {
+				return err
+			}
+			if result.Failed > 0 {
+				return shared.NewReportedError(fmt.Errorf("finance backfill: %d of %d reports failed", result.Failed, result.Requested))
+			}
+			return nil
+		},
+	}
+}
+
+// financeReportMonths lists the fiscal months between from and to (inclusive).
+func financeReportMonths(from, to string) ([]string, error) {
+	start, err := time.Parse("2006-01", strings.TrimSpace(from))
+	if err != nil {
+		return nil, fmt.Errorf("--from must be in YYYY-MM format")
+	}
+	end, err := time.Parse("2006-01", strings.TrimSpace(to))
+	if err != nil {
+		return nil, fmt.Errorf("--to must be in YYYY-MM format")
+	}
+	if start.After(end) {
+		return nil, fmt.Errorf("--from must not be after --to")
+	}
+
+	var months []string
+	for current := start; !current.After(end); current = current.AddDate(0, 1, 0) {
+		months = append(months, current.Format("2006-01"))
+	}
+	return months, nil
+}
+
+// financeReportPublishedBy estimates when Apple publishes the finance report
+// for a fiscal month. Fiscal months end up to a week after the calendar month
+// and reports follow about a month later, so this allows 45 days after the
+// calendar month ends.
+func financeReportPublishedBy(month string) time.Time {
+	start, err := time.Parse("2006-01", month)
+	if err != nil {
+		return time.Time{}
+	}
+	return start.AddDate(0, 1, 45)
+}
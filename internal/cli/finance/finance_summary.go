@@ -0,0 +1,91 @@
+package finance
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// FinanceSummarizeCommand aggregates downloaded finance reports.
+func FinanceSummarizeCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("summarize", flag.ExitOnError)
+
+	files := fs.String("file", "", "Report file(s) to summarize, comma-separated (.tsv or .tsv.gz)")
+	reportType := fs.String("report-type", "", "Report type: FINANCIAL or FINANCE_DETAIL (default: detect from header)")
+	currency := fs.String("currency", "", "Reporting currency (default: bank account currency from the report)")
+	output := fs.String("output", "json", "Output format: json (default), csv, table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "summarize",
+		ShortUsage: "asc finance summarize --file report.tsv.gz [flags]",
+		ShortHelp:  "Summarize finance reports by SKU, territory, currency and product type.",
+		LongHelp: `Summarize finance reports by SKU, territory, currency and product type.
+
+Reads reports saved by 'asc finance reports' (compressed or not) and aggregates
+quantity and extended partner share. Proceeds are converted into the reporting
+currency using the exchange rates in each report's payment summary, so every
+month is converted at its own rates. The reporting currency defaults to the
+bank account currency; another currency listed in the report can be used via
+cross rates. Currencies without a rate stay unconverted and are listed in the
+output. The by-currency breakdown always uses the original currencies.
+
+Examples:
+  asc finance summarize --file finance_report_2025-12_FINANCIAL_ZZ.tsv.gz
+  asc finance summarize --file "2025-11.tsv.gz,2025-12.tsv.gz" --output csv
+  asc finance summarize --file finance_report_2025-12_FINANCE_DETAIL_Z1.tsv --currency EUR --output table`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			paths := shared.SplitCSV(*files)
+			if len(paths) == 0 {
+				fmt.Fprintln(os.Stderr, "Error: --file is required")
+				return flag.ErrHelp
+			}
+
+			var requestedType asc.FinanceReportType
+			if strings.TrimSpace(*reportType) != "" {
+				normalized, err := normalizeFinanceReportType(*reportType)
+				if err != nil {
+					return fmt.Errorf("finance summarize: %w", err)
+				}
+				requestedType = normalized
+			}
+			var financeType asc.FinanceReportType
+
+			var builder *asc.ReportSummaryBuilder
+			for _, path := range paths {
+				report, err := parseFinanceReportFile(path, requestedType)
+				if err != nil {
+					return fmt.Errorf("finance summarize: %s: %w", path, err)
+				}
+				if builder == nil {
+					builder = asc.NewReportSummaryBuilder(string(report.ReportType), *currency)
+					financeType = report.ReportType
+				} else if report.ReportType != financeType {
+					return fmt.Errorf("finance summarize: %s is a %s report, expected %s", path, report.ReportType, financeType)
+				}
+				builder.AddFile(path)
+				builder.AddFinanceReport(report)
+			}
+
+			return shared.PrintReportSummary(builder.Summary(), *output, *pretty)
+		},
+	}
+}
+
+func parseFinanceReportFile(path string, reportType asc.FinanceReportType) (*asc.ParsedFinanceReport, error) {
+	file, err := shared.OpenExistingNoFollow(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return asc.ParseFinanceReport(file, reportType)
+}
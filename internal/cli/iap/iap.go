@@ -28,7 +28,13 @@ Examples:
   asc iap create --app "APP_ID" --type CONSUMABLE --ref-name "Pro" --product-id "com.example.pro"
   asc iap update --id "IAP_ID" --ref-name "New Name"
   asc iap delete --id "IAP_ID" --confirm
-  asc iap localizations list --id "IAP_ID"`,
+  asc iap localizations list --id "IAP_ID"
+  asc iap pricing get --id "IAP_ID"
+  asc iap pricing set --id "IAP_ID" --base-territory "USA" --price 4.99
+  asc iap availability set --id "IAP_ID" --territory "USA,GBR"
+  asc iap review-screenshot upload --id "IAP_ID" --file "./review.png"
+  asc iap offer-codes list --iap "IAP_ID"
+  asc iap submit --id "IAP_ID" --confirm`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
@@ -38,6 +44,13 @@ Examples:
 			IAPUpdateCommand(),
 			IAPDeleteCommand(),
 			IAPLocalizationsCommand(),
+			IAPPricingCommand(),
+			IAPAvailabilityCommand(),
+			IAPReviewScreenshotCommand(),
+			IAPImagesCommand(),
+			IAPContentCommand(),
+			IAPOfferCodesCommand(),
+			IAPSubmitCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -0,0 +1,351 @@
+package iap
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// IAPReviewScreenshotCommand returns the iap review-screenshot command group.
+func IAPReviewScreenshotCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("review-screenshot", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "review-screenshot",
+		ShortUsage: "asc iap review-screenshot <subcommand> [flags]",
+		ShortHelp:  "Manage the App Review screenshot for an in-app purchase.",
+		LongHelp: `Manage the App Review screenshot for an in-app purchase.
+
+Examples:
+  asc iap review-screenshot upload --id "IAP_ID" --file "./review.png"
+  asc iap review-screenshot upload --id "IAP_ID" --file "./review.png" --replace`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			IAPReviewScreenshotUploadCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// IAPReviewScreenshotUploadCommand returns the iap review-screenshot upload subcommand.
+func IAPReviewScreenshotUploadCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("review-screenshot upload", flag.ExitOnError)
+
+	iapID := fs.String("id", "", "In-app purchase ID")
+	filePath := fs.String("file", "", "Path to screenshot file")
+	replace := fs.Bool("replace", false, "Delete the existing review screenshot before uploading")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "upload",
+		ShortUsage: "asc iap review-screenshot upload [flags]",
+		ShortHelp:  "Upload the App Review screenshot for an in-app purchase.",
+		LongHelp: `Upload the App Review screenshot for an in-app purchase.
+
+An in-app purchase has at most one review screenshot. Use --replace to delete
+the current screenshot before uploading a new one.
+
+Examples:
+  asc iap review-screenshot upload --id "IAP_ID" --file "./review.png"
+  asc iap review-screenshot upload --id "IAP_ID" --file "./review.png" --replace`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*iapID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+			path := strings.TrimSpace(*filePath)
+			if path == "" {
+				fmt.Fprintln(os.Stderr, "Error: --file is required")
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("iap review-screenshot upload: %w", err)
+			}
+
+			requestCtx, cancel := contextWithUploadTimeout(ctx)
+			defer cancel()
+
+			existing, err := client.GetInAppPurchaseReviewScreenshotForIAP(requestCtx, id)
+			if err != nil && !asc.IsNotFound(err) {
+				return fmt.Errorf("iap review-screenshot upload: failed to check existing screenshot: %w", err)
+			}
+			if err == nil && strings.TrimSpace(existing.Data.ID) != "" {
+				if !*replace {
+					return fmt.Errorf("iap review-screenshot upload: in-app purchase %q already has review screenshot %q (use --replace)", id, existing.Data.ID)
+				}
+				if err := client.DeleteInAppPurchaseReviewScreenshot(requestCtx, existing.Data.ID); err != nil {
+					return fmt.Errorf("iap review-screenshot upload: failed to delete existing screenshot: %w", err)
+				}
+			}
+
+			result, err := uploadIAPAsset(requestCtx, id, path, shared.AssetUploader{
+				Reserve: func(ctx context.Context, fileName string, fileSize int64) (string, []asc.UploadOperation, error) {
+					resp, err := client.CreateInAppPurchaseReviewScreenshot(ctx, id, fileName, fileSize)
+					if err != nil {
+						return "", nil, err
+					}
+					return resp.Data.ID, resp.Data.Attributes.UploadOperations, nil
+				},
+				Commit: func(ctx context.Context, assetID, checksum string) error {
+					_, err := client.UpdateInAppPurchaseReviewScreenshot(ctx, assetID, true, checksum)
+					return err
+				},
+				State: func(ctx context.Context, assetID string) (*asc.AssetDeliveryState, error) {
+					resp, err := client.GetInAppPurchaseReviewScreenshot(ctx, assetID)
+					if err != nil {
+						return nil, err
+					}
+					return resp.Data.Attributes.AssetDeliveryState, nil
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("iap review-screenshot upload: %w", err)
+			}
+
+			return printOutput(result, *output, *pretty)
+		},
+	}
+}
+
+// IAPImagesCommand returns the iap images command group.
+func IAPImagesCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("images", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "images",
+		ShortUsage: "asc iap images <subcommand> [flags]",
+		ShortHelp:  "Manage promotional images for an in-app purchase.",
+		LongHelp: `Manage promotional images for an in-app purchase.
+
+Examples:
+  asc iap images upload --id "IAP_ID" --file "./promo.png"`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			IAPImagesUploadCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// IAPImagesUploadCommand returns the iap images upload subcommand.
+func IAPImagesUploadCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("images upload", flag.ExitOnError)
+
+	iapID := fs.String("id", "", "In-app purchase ID")
+	filePath := fs.String("file", "", "Path to image file (1024x1024)")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "upload",
+		ShortUsage: "asc iap images upload [flags]",
+		ShortHelp:  "Upload a promotional image for an in-app purchase.",
+		LongHelp: `Upload a promotional image for an in-app purchase.
+
+Examples:
+  asc iap images upload --id "IAP_ID" --file "./promo.png"`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*iapID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+			path := strings.TrimSpace(*filePath)
+			if path == "" {
+				fmt.Fprintln(os.Stderr, "Error: --file is required")
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("iap images upload: %w", err)
+			}
+
+			requestCtx, cancel := contextWithUploadTimeout(ctx)
+			defer cancel()
+
+			result, err := uploadIAPAsset(requestCtx, id, path, shared.AssetUploader{
+				Reserve: func(ctx context.Context, fileName string, fileSize int64) (string, []asc.UploadOperation, error) {
+					resp, err := client.CreateInAppPurchaseImage(ctx, id, fileName, fileSize)
+					if err != nil {
+						return "", nil, err
+					}
+					return resp.Data.ID, resp.Data.Attributes.UploadOperations, nil
+				},
+				Commit: func(ctx context.Context, assetID, checksum string) error {
+					_, err := client.UpdateInAppPurchaseImage(ctx, assetID, true, checksum)
+					return err
+				},
+				State: func(ctx context.Context, assetID string) (*asc.AssetDeliveryState, error) {
+					resp, err := client.GetInAppPurchaseImage(ctx, assetID)
+					if err != nil {
+						return nil, err
+					}
+					return resp.Data.Attributes.AssetDeliveryState, nil
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("iap images upload: %w", err)
+			}
+
+			return printOutput(result, *output, *pretty)
+		},
+	}
+}
+
+// IAPContentCommand returns the iap content command group.
+func IAPContentCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("content", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "content",
+		ShortUsage: "asc iap content <subcommand> [flags]",
+		ShortHelp:  "Inspect hosted content for an in-app purchase.",
+		LongHelp: `Inspect hosted content for an in-app purchase.
+
+Examples:
+  asc iap content get --id "IAP_ID"`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			IAPContentGetCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// IAPContentGetCommand returns the iap content get subcommand.
+func IAPContentGetCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("content get", flag.ExitOnError)
+
+	iapID := fs.String("id", "", "In-app purchase ID")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "get",
+		ShortUsage: "asc iap content get [flags]",
+		ShortHelp:  "Show hosted content metadata for an in-app purchase.",
+		LongHelp: `Show hosted content metadata for an in-app purchase.
+
+Examples:
+  asc iap content get --id "IAP_ID"`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*iapID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("iap content get: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			resp, err := client.GetInAppPurchaseContent(requestCtx, id)
+			if err != nil {
+				if asc.IsNotFound(err) {
+					return fmt.Errorf("iap content get: no hosted content for in-app purchase %q", id)
+				}
+				return fmt.Errorf("iap content get: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// IAPSubmitCommand returns the iap submit subcommand.
+func IAPSubmitCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("submit", flag.ExitOnError)
+
+	iapID := fs.String("id", "", "In-app purchase ID")
+	confirm := fs.Bool("confirm", false, "Confirm submission (required)")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "submit",
+		ShortUsage: "asc iap submit [flags]",
+		ShortHelp:  "Submit an in-app purchase for App Review.",
+		LongHelp: `Submit an in-app purchase for App Review.
+
+The in-app purchase needs a localization, a price, and a review screenshot
+before App Store Connect accepts the submission.
+
+Examples:
+  asc iap submit --id "IAP_ID" --confirm`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*iapID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+			if !*confirm {
+				fmt.Fprintln(os.Stderr, "Error: --confirm is required to submit for review")
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("iap submit: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			resp, err := client.CreateInAppPurchaseSubmission(requestCtx, id)
+			if err != nil {
+				return fmt.Errorf("iap submit: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+func uploadIAPAsset(ctx context.Context, iapID, filePath string, uploader shared.AssetUploader) (*asc.InAppPurchaseAssetUploadResult, error) {
+	asset, err := shared.UploadImageAsset(ctx, filePath, uploader)
+	if err != nil {
+		return nil, err
+	}
+
+	return &asc.InAppPurchaseAssetUploadResult{
+		IAPID:    iapID,
+		AssetID:  asset.ID,
+		FileName: asset.FileName,
+		FilePath: filePath,
+		FileSize: asset.FileSize,
+		State:    asset.State,
+	}, nil
+}
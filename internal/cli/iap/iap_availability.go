@@ -0,0 +1,193 @@
+package iap
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// IAPAvailabilityCommand returns the iap availability command group.
+func IAPAvailabilityCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("availability", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "availability",
+		ShortUsage: "asc iap availability <subcommand> [flags]",
+		ShortHelp:  "Manage in-app purchase territory availability.",
+		LongHelp: `Manage in-app purchase territory availability.
+
+Examples:
+  asc iap availability get --id "IAP_ID"
+  asc iap availability set --id "IAP_ID" --territory "USA,GBR,DEU"
+  asc iap availability set --id "IAP_ID" --all-territories --available-in-new-territories`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			IAPAvailabilityGetCommand(),
+			IAPAvailabilitySetCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// IAPAvailabilityGetCommand returns the iap availability get subcommand.
+func IAPAvailabilityGetCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("availability get", flag.ExitOnError)
+
+	iapID := fs.String("id", "", "In-app purchase ID")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "get",
+		ShortUsage: "asc iap availability get [flags]",
+		ShortHelp:  "Show territories where an in-app purchase is available.",
+		LongHelp: `Show territories where an in-app purchase is available.
+
+Examples:
+  asc iap availability get --id "IAP_ID"`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*iapID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("iap availability get: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			availability, err := client.GetInAppPurchaseAvailability(requestCtx, id)
+			if err != nil {
+				if asc.IsNotFound(err) {
+					return fmt.Errorf("iap availability get: availability not found for in-app purchase %q", id)
+				}
+				return fmt.Errorf("iap availability get: %w", err)
+			}
+			availabilityID := strings.TrimSpace(availability.Data.ID)
+			if availabilityID == "" {
+				return fmt.Errorf("iap availability get: availability ID missing from response")
+			}
+
+			firstPage, err := client.GetInAppPurchaseAvailabilityTerritories(requestCtx, availabilityID, asc.WithTerritoriesLimit(200))
+			if err != nil {
+				return fmt.Errorf("iap availability get: failed to fetch territories: %w", err)
+			}
+			all, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				return client.GetInAppPurchaseAvailabilityTerritories(ctx, availabilityID, asc.WithTerritoriesNextURL(nextURL))
+			})
+			if err != nil {
+				return fmt.Errorf("iap availability get: %w", err)
+			}
+
+			result := &asc.InAppPurchaseAvailabilityResult{
+				IAPID:                     id,
+				AvailabilityID:            availabilityID,
+				AvailableInNewTerritories: availability.Data.Attributes.AvailableInNewTerritories,
+				Territories:               territoryIDs(all),
+			}
+
+			return printOutput(result, *output, *pretty)
+		},
+	}
+}
+
+// IAPAvailabilitySetCommand returns the iap availability set subcommand.
+func IAPAvailabilitySetCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("availability set", flag.ExitOnError)
+
+	iapID := fs.String("id", "", "In-app purchase ID")
+	territory := fs.String("territory", "", "Territory IDs (comma-separated, e.g., USA,GBR)")
+	allTerritories := fs.Bool("all-territories", false, "Make available in every App Store territory")
+	availableInNew := fs.Bool("available-in-new-territories", false, "Include new territories automatically")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "set",
+		ShortUsage: "asc iap availability set [flags]",
+		ShortHelp:  "Set territories where an in-app purchase is available.",
+		LongHelp: `Set territories where an in-app purchase is available.
+
+The territory list replaces the current availability; territories not listed
+become unavailable.
+
+Examples:
+  asc iap availability set --id "IAP_ID" --territory "USA,GBR,DEU"
+  asc iap availability set --id "IAP_ID" --all-territories --available-in-new-territories`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*iapID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+			territories := splitCSVUpper(*territory)
+			if len(territories) == 0 && !*allTerritories {
+				fmt.Fprintln(os.Stderr, "Error: --territory or --all-territories is required")
+				return flag.ErrHelp
+			}
+			if len(territories) > 0 && *allTerritories {
+				return fmt.Errorf("iap availability set: --territory and --all-territories are mutually exclusive")
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("iap availability set: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			if *allTerritories {
+				firstPage, err := client.GetTerritories(requestCtx, asc.WithTerritoriesLimit(200))
+				if err != nil {
+					return fmt.Errorf("iap availability set: failed to fetch territories: %w", err)
+				}
+				all, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+					return client.GetTerritories(ctx, asc.WithTerritoriesNextURL(nextURL))
+				})
+				if err != nil {
+					return fmt.Errorf("iap availability set: %w", err)
+				}
+				territories = territoryIDs(all)
+			}
+
+			resp, err := client.CreateInAppPurchaseAvailability(requestCtx, id, territories, asc.InAppPurchaseAvailabilityAttributes{
+				AvailableInNewTerritories: *availableInNew,
+			})
+			if err != nil {
+				return fmt.Errorf("iap availability set: failed to set: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+func territoryIDs(resp asc.PaginatedResponse) []string {
+	territories, ok := resp.(*asc.TerritoriesResponse)
+	if !ok || territories == nil {
+		return []string{}
+	}
+	ids := make([]string, 0, len(territories.Data))
+	for _, item := range territories.Data {
+		ids = append(ids, item.ID)
+	}
+	return ids
+}
@@ -0,0 +1,546 @@
+package iap
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// IAPOfferCodesCommand returns the iap offer-codes command group.
+func IAPOfferCodesCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("offer-codes", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "offer-codes",
+		ShortUsage: "asc iap offer-codes <subcommand> [flags]",
+		ShortHelp:  "Manage in-app purchase offer codes and custom codes.",
+		LongHelp: `Manage in-app purchase offer codes and custom codes.
+
+Examples:
+  asc iap offer-codes list --iap "IAP_ID"
+  asc iap offer-codes create --iap "IAP_ID" --name "Launch" --eligibility NON_SPENDER --prices "USA:PP_ID" --equalize
+  asc iap offer-codes custom-codes create --offer-code "OFFER_CODE_ID" --code "LAUNCH26" --quantity 500
+  asc iap offer-codes deactivate --id "OFFER_CODE_ID" --confirm`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			IAPOfferCodesListCommand(),
+			IAPOfferCodesGetCommand(),
+			IAPOfferCodesCreateCommand(),
+			IAPOfferCodesDeactivateCommand(),
+			IAPOfferCodesCustomCodesCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// IAPOfferCodesListCommand returns the offer-codes list subcommand.
+func IAPOfferCodesListCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("offer-codes list", flag.ExitOnError)
+
+	iapID := fs.String("iap", "", "In-app purchase ID")
+	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
+	next := fs.String("next", "", "Fetch next page using a links.next URL")
+	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "list",
+		ShortUsage: "asc iap offer-codes list [flags]",
+		ShortHelp:  "List offer codes for an in-app purchase.",
+		LongHelp: `List offer codes for an in-app purchase.
+
+Examples:
+  asc iap offer-codes list --iap "IAP_ID"
+  asc iap offer-codes list --iap "IAP_ID" --paginate --output table`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if *limit != 0 && (*limit < 1 || *limit > 200) {
+				return fmt.Errorf("iap offer-codes list: --limit must be between 1 and 200")
+			}
+			if err := validateNextURL(*next); err != nil {
+				return fmt.Errorf("iap offer-codes list: %w", err)
+			}
+
+			id := strings.TrimSpace(*iapID)
+			if id == "" && strings.TrimSpace(*next) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --iap is required")
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("iap offer-codes list: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			opts := []asc.OfferCodesOption{
+				asc.WithOfferCodesLimit(*limit),
+				asc.WithOfferCodesNextURL(*next),
+			}
+
+			if *paginate {
+				paginateOpts := append(opts, asc.WithOfferCodesLimit(200))
+				firstPage, err := client.GetInAppPurchaseOfferCodes(requestCtx, id, paginateOpts...)
+				if err != nil {
+					return fmt.Errorf("iap offer-codes list: failed to fetch: %w", err)
+				}
+
+				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+					return client.GetInAppPurchaseOfferCodes(ctx, id, asc.WithOfferCodesNextURL(nextURL))
+				})
+				if err != nil {
+					return fmt.Errorf("iap offer-codes list: %w", err)
+				}
+
+				return printOutput(resp, *output, *pretty)
+			}
+
+			resp, err := client.GetInAppPurchaseOfferCodes(requestCtx, id, opts...)
+			if err != nil {
+				return fmt.Errorf("iap offer-codes list: failed to fetch: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// IAPOfferCodesGetCommand returns the offer-codes get subcommand.
+func IAPOfferCodesGetCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("offer-codes get", flag.ExitOnError)
+
+	offerCodeID := fs.String("id", "", "In-app purchase offer code ID")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "get",
+		ShortUsage: "asc iap offer-codes get --id \"OFFER_CODE_ID\"",
+		ShortHelp:  "Get an in-app purchase offer code.",
+		LongHelp: `Get an in-app purchase offer code.
+
+Examples:
+  asc iap offer-codes get --id "OFFER_CODE_ID"`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*offerCodeID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("iap offer-codes get: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			resp, err := client.GetInAppPurchaseOfferCode(requestCtx, id)
+			if err != nil {
+				return fmt.Errorf("iap offer-codes get: failed to fetch: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// IAPOfferCodesCreateCommand returns the offer-codes create subcommand.
+func IAPOfferCodesCreateCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("offer-codes create", flag.ExitOnError)
+
+	iapID := fs.String("iap", "", "In-app purchase ID")
+	name := fs.String("name", "", "Offer code reference name")
+	eligibility := fs.String("eligibility", "", "Customer eligibilities (comma-separated): "+strings.Join(asc.ValidInAppPurchaseOfferCodeCustomerEligibilities, ", "))
+	prices := fs.String("prices", "", "Per-territory prices as TERRITORY:PRICE_POINT_ID (comma-separated)")
+	pricesFile := fs.String("prices-file", "", "File with one TERRITORY,PRICE_POINT_ID per line")
+	equalize := fs.Bool("equalize", false, "Expand a single base price to every territory using equalized price points")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "create",
+		ShortUsage: "asc iap offer-codes create [flags]",
+		ShortHelp:  "Create an in-app purchase offer code.",
+		LongHelp: `Create an in-app purchase offer code.
+
+Prices come from --prices and --prices-file; add --equalize with a single base
+price to cover every territory.
+
+Examples:
+  asc iap offer-codes create --iap "IAP_ID" --name "Launch" --eligibility NON_SPENDER --prices "USA:PP_ID,GBR:PP_ID"
+  asc iap offer-codes create --iap "IAP_ID" --name "Launch" --eligibility NON_SPENDER,CHURNED_SPENDER --prices "USA:PP_ID" --equalize`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*iapID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --iap is required")
+				return flag.ErrHelp
+			}
+			if strings.TrimSpace(*name) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --name is required")
+				return flag.ErrHelp
+			}
+			if strings.TrimSpace(*eligibility) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --eligibility is required")
+				return flag.ErrHelp
+			}
+			if strings.TrimSpace(*prices) == "" && strings.TrimSpace(*pricesFile) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --prices or --prices-file is required")
+				return flag.ErrHelp
+			}
+
+			eligibilities := splitCSVUpper(*eligibility)
+			for _, item := range eligibilities {
+				if !asc.IsValidInAppPurchaseOfferCodeCustomerEligibility(item) {
+					return fmt.Errorf("iap offer-codes create: --eligibility must be one of: %s", strings.Join(asc.ValidInAppPurchaseOfferCodeCustomerEligibilities, ", "))
+				}
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("iap offer-codes create: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			offerPrices, err := resolveIAPOfferPrices(requestCtx, client, *prices, *pricesFile, *equalize)
+			if err != nil {
+				return fmt.Errorf("iap offer-codes create: %w", err)
+			}
+
+			attrs := asc.InAppPurchaseOfferCodeCreateAttributes{
+				Name:                  strings.TrimSpace(*name),
+				CustomerEligibilities: eligibilities,
+			}
+
+			resp, err := client.CreateInAppPurchaseOfferCode(requestCtx, id, attrs, offerPrices)
+			if err != nil {
+				return fmt.Errorf("iap offer-codes create: failed to create: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// IAPOfferCodesDeactivateCommand returns the offer-codes deactivate subcommand.
+func IAPOfferCodesDeactivateCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("offer-codes deactivate", flag.ExitOnError)
+
+	offerCodeID := fs.String("id", "", "In-app purchase offer code ID")
+	confirm := fs.Bool("confirm", false, "Confirm deactivation")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "deactivate",
+		ShortUsage: "asc iap offer-codes deactivate --id \"OFFER_CODE_ID\" --confirm",
+		ShortHelp:  "Deactivate an in-app purchase offer code.",
+		LongHelp: `Deactivate an in-app purchase offer code.
+
+Deactivated codes can no longer be redeemed. This cannot be undone.
+
+Examples:
+  asc iap offer-codes deactivate --id "OFFER_CODE_ID" --confirm`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*offerCodeID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+			if !*confirm {
+				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("iap offer-codes deactivate: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			resp, err := client.UpdateInAppPurchaseOfferCodeActive(requestCtx, id, false)
+			if err != nil {
+				return fmt.Errorf("iap offer-codes deactivate: failed to update: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// IAPOfferCodesCustomCodesCommand returns the offer-codes custom-codes command group.
+func IAPOfferCodesCustomCodesCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("custom-codes", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "custom-codes",
+		ShortUsage: "asc iap offer-codes custom-codes <subcommand> [flags]",
+		ShortHelp:  "Manage custom codes for an in-app purchase offer code.",
+		LongHelp: `Manage custom codes for an in-app purchase offer code.
+
+Examples:
+  asc iap offer-codes custom-codes list --offer-code "OFFER_CODE_ID"
+  asc iap offer-codes custom-codes create --offer-code "OFFER_CODE_ID" --code "LAUNCH26" --quantity 500
+  asc iap offer-codes custom-codes deactivate --id "CUSTOM_CODE_ID" --confirm`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			IAPOfferCodesCustomCodesListCommand(),
+			IAPOfferCodesCustomCodesCreateCommand(),
+			IAPOfferCodesCustomCodesDeactivateCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// IAPOfferCodesCustomCodesListCommand returns the custom-codes list subcommand.
+func IAPOfferCodesCustomCodesListCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("custom-codes list", flag.ExitOnError)
+
+	offerCodeID := fs.String("offer-code", "", "In-app purchase offer code ID")
+	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
+	next := fs.String("next", "", "Fetch next page using a links.next URL")
+	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "list",
+		ShortUsage: "asc iap offer-codes custom-codes list [flags]",
+		ShortHelp:  "List custom codes for an in-app purchase offer code.",
+		LongHelp: `List custom codes for an in-app purchase offer code.
+
+Examples:
+  asc iap offer-codes custom-codes list --offer-code "OFFER_CODE_ID"
+  asc iap offer-codes custom-codes list --offer-code "OFFER_CODE_ID" --paginate --output table`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if *limit != 0 && (*limit < 1 || *limit > 200) {
+				return fmt.Errorf("iap offer-codes custom-codes list: --limit must be between 1 and 200")
+			}
+			if err := validateNextURL(*next); err != nil {
+				return fmt.Errorf("iap offer-codes custom-codes list: %w", err)
+			}
+
+			id := strings.TrimSpace(*offerCodeID)
+			if id == "" && strings.TrimSpace(*next) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --offer-code is required")
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("iap offer-codes custom-codes list: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			opts := []asc.OfferCodesOption{
+				asc.WithOfferCodesLimit(*limit),
+				asc.WithOfferCodesNextURL(*next),
+			}
+
+			if *paginate {
+				paginateOpts := append(opts, asc.WithOfferCodesLimit(200))
+				firstPage, err := client.GetInAppPurchaseOfferCodeCustomCodes(requestCtx, id, paginateOpts...)
+				if err != nil {
+					return fmt.Errorf("iap offer-codes custom-codes list: failed to fetch: %w", err)
+				}
+
+				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+					return client.GetInAppPurchaseOfferCodeCustomCodes(ctx, id, asc.WithOfferCodesNextURL(nextURL))
+				})
+				if err != nil {
+					return fmt.Errorf("iap offer-codes custom-codes list: %w", err)
+				}
+
+				return printOutput(resp, *output, *pretty)
+			}
+
+			resp, err := client.GetInAppPurchaseOfferCodeCustomCodes(requestCtx, id, opts...)
+			if err != nil {
+				return fmt.Errorf("iap offer-codes custom-codes list: failed to fetch: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// IAPOfferCodesCustomCodesCreateCommand returns the custom-codes create subcommand.
+func IAPOfferCodesCustomCodesCreateCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("custom-codes create", flag.ExitOnError)
+
+	offerCodeID := fs.String("offer-code", "", "In-app purchase offer code ID")
+	code := fs.String("code", "", "Custom code customers redeem (e.g., LAUNCH26)")
+	quantity := fs.Int("quantity", 0, "Number of redemptions available")
+	expirationDate := fs.String("expiration-date", "", "Expiration date (YYYY-MM-DD)")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "create",
+		ShortUsage: "asc iap offer-codes custom-codes create [flags]",
+		ShortHelp:  "Create a custom code for an in-app purchase offer code.",
+		LongHelp: `Create a custom code for an in-app purchase offer code.
+
+Examples:
+  asc iap offer-codes custom-codes create --offer-code "OFFER_CODE_ID" --code "LAUNCH26" --quantity 500
+  asc iap offer-codes custom-codes create --offer-code "OFFER_CODE_ID" --code "LAUNCH26" --quantity 500 --expiration-date "2026-06-30"`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*offerCodeID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --offer-code is required")
+				return flag.ErrHelp
+			}
+			attrs, err := shared.OfferCodeCustomCodeAttributes(*code, *quantity, *expirationDate)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, "Error:", err)
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("iap offer-codes custom-codes create: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			resp, err := client.CreateInAppPurchaseOfferCodeCustomCode(requestCtx, id, attrs)
+			if err != nil {
+				return fmt.Errorf("iap offer-codes custom-codes create: failed to create: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// IAPOfferCodesCustomCodesDeactivateCommand returns the custom-codes deactivate subcommand.
+func IAPOfferCodesCustomCodesDeactivateCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("custom-codes deactivate", flag.ExitOnError)
+
+	customCodeID := fs.String("id", "", "Custom code ID")
+	confirm := fs.Bool("confirm", false, "Confirm deactivation")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "deactivate",
+		ShortUsage: "asc iap offer-codes custom-codes deactivate --id \"CUSTOM_CODE_ID\" --confirm",
+		ShortHelp:  "Deactivate an in-app purchase offer custom code.",
+		LongHelp: `Deactivate an in-app purchase offer custom code.
+
+Examples:
+  asc iap offer-codes custom-codes deactivate --id "CUSTOM_CODE_ID" --confirm`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*customCodeID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+			if !*confirm {
+				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("iap offer-codes custom-codes deactivate: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			resp, err := client.UpdateInAppPurchaseOfferCodeCustomCodeActive(requestCtx, id, false)
+			if err != nil {
+				return fmt.Errorf("iap offer-codes custom-codes deactivate: failed to update: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// resolveIAPOfferPrices combines --prices and --prices-file, expanding the
+// single base price into every territory when equalize is set.
+func resolveIAPOfferPrices(ctx context.Context, client *asc.Client, value, filePath string, equalize bool) ([]asc.InAppPurchaseOfferPrice, error) {
+	parsed, err := shared.ParseTerritoryPricePoints(value, filePath)
+	if err != nil {
+		return nil, err
+	}
+	prices := make([]asc.InAppPurchaseOfferPrice, 0, len(parsed))
+	for _, price := range parsed {
+		prices = append(prices, asc.InAppPurchaseOfferPrice{
+			Territory:    price.Territory,
+			PricePointID: price.PricePointID,
+		})
+	}
+	if !equalize {
+		return prices, nil
+	}
+	if len(prices) != 1 {
+		return nil, fmt.Errorf("--equalize requires exactly one base price")
+	}
+
+	base := prices[0]
+	firstPage, err := client.GetInAppPurchasePricePointEqualizations(ctx, base.PricePointID, asc.WithPricePointsLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch equalizations: %w", err)
+	}
+	all, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetInAppPurchasePricePointEqualizations(ctx, base.PricePointID, asc.WithPricePointsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, err
+	}
+	equalizations, ok := all.(*asc.InAppPurchasePricePointsResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected equalizations response type %T", all)
+	}
+	for _, item := range equalizations.Data {
+		territory := strings.ToUpper(asc.RelationshipTerritoryID(item.Relationships))
+		if territory == "" || territory == base.Territory {
+			continue
+		}
+		prices = append(prices, asc.InAppPurchaseOfferPrice{
+			Territory:    territory,
+			PricePointID: item.ID,
+		})
+	}
+	return prices, nil
+}
@@ -0,0 +1,414 @@
+package iap
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// IAPPricingCommand returns the iap pricing command group.
+func IAPPricingCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("pricing", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "pricing",
+		ShortUsage: "asc iap pricing <subcommand> [flags]",
+		ShortHelp:  "Manage in-app purchase price schedules.",
+		LongHelp: `Manage in-app purchase price schedules.
+
+Prices follow the same model as app pricing: one manual price in a base
+territory, with other territories equalized automatically by Apple.
+
+Examples:
+  asc iap pricing get --id "IAP_ID"
+  asc iap pricing price-points --id "IAP_ID" --territory "USA"
+  asc iap pricing set --id "IAP_ID" --base-territory "USA" --price 4.99
+  asc iap pricing set --id "IAP_ID" --price-point "PRICE_POINT_ID" --start-date "2026-03-01"`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			IAPPricingGetCommand(),
+			IAPPricingPricePointsCommand(),
+			IAPPricingSetCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// IAPPricingGetCommand returns the iap pricing get subcommand.
+func IAPPricingGetCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("pricing get", flag.ExitOnError)
+
+	iapID := fs.String("id", "", "In-app purchase ID")
+	territory := fs.String("territory", "", "Filter prices by territory IDs (comma-separated, e.g., USA,GBR)")
+	automatic := fs.Bool("automatic", false, "Include automatically equalized prices")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "get",
+		ShortUsage: "asc iap pricing get [flags]",
+		ShortHelp:  "Show the current in-app purchase price schedule.",
+		LongHelp: `Show the current in-app purchase price schedule.
+
+Prints the base territory and manual prices with customer price and proceeds.
+Use --automatic to include prices Apple equalized for other territories.
+
+Examples:
+  asc iap pricing get --id "IAP_ID"
+  asc iap pricing get --id "IAP_ID" --automatic --territory "GBR,DEU" --output table`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*iapID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+			territories := splitCSVUpper(*territory)
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("iap pricing get: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			schedule, err := client.GetInAppPurchasePriceSchedule(requestCtx, id)
+			if err != nil {
+				if asc.IsNotFound(err) {
+					return fmt.Errorf("iap pricing get: price schedule not found for in-app purchase %q", id)
+				}
+				return fmt.Errorf("iap pricing get: %w", err)
+			}
+			scheduleID := strings.TrimSpace(schedule.Data.ID)
+			if scheduleID == "" {
+				return fmt.Errorf("iap pricing get: price schedule ID missing from response")
+			}
+
+			baseTerritory, err := client.GetInAppPurchasePriceScheduleBaseTerritory(requestCtx, scheduleID)
+			if err != nil {
+				return fmt.Errorf("iap pricing get: get base territory: %w", err)
+			}
+
+			result := &asc.InAppPurchasePricingResult{
+				IAPID:         id,
+				ScheduleID:    scheduleID,
+				BaseTerritory: strings.ToUpper(strings.TrimSpace(baseTerritory.Data.ID)),
+			}
+
+			result.ManualPrices, err = collectIAPPrices(requestCtx, territories, func(ctx context.Context, opts ...asc.IAPPricesOption) (*asc.InAppPurchasePricesResponse, error) {
+				return client.GetInAppPurchasePriceScheduleManualPrices(ctx, scheduleID, opts...)
+			})
+			if err != nil {
+				return fmt.Errorf("iap pricing get: manual prices: %w", err)
+			}
+
+			if *automatic {
+				result.AutomaticPrices, err = collectIAPPrices(requestCtx, territories, func(ctx context.Context, opts ...asc.IAPPricesOption) (*asc.InAppPurchasePricesResponse, error) {
+					return client.GetInAppPurchasePriceScheduleAutomaticPrices(ctx, scheduleID, opts...)
+				})
+				if err != nil {
+					return fmt.Errorf("iap pricing get: automatic prices: %w", err)
+				}
+			}
+
+			return printOutput(result, *output, *pretty)
+		},
+	}
+}
+
+// IAPPricingPricePointsCommand returns the iap pricing price-points subcommand.
+func IAPPricingPricePointsCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("pricing price-points", flag.ExitOnError)
+
+	iapID := fs.String("id", "", "In-app purchase ID")
+	territory := fs.String("territory", "", "Filter by territory (e.g., USA)")
+	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
+	next := fs.String("next", "", "Fetch next page using a links.next URL")
+	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "price-points",
+		ShortUsage: "asc iap pricing price-points [flags]",
+		ShortHelp:  "List price points for an in-app purchase.",
+		LongHelp: `List price points for an in-app purchase.
+
+Examples:
+  asc iap pricing price-points --id "IAP_ID" --territory "USA"
+  asc iap pricing price-points --id "IAP_ID" --territory "USA" --paginate --output table`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if *limit != 0 && (*limit < 1 || *limit > 200) {
+				return fmt.Errorf("iap pricing price-points: --limit must be between 1 and 200")
+			}
+			if err := validateNextURL(*next); err != nil {
+				return fmt.Errorf("iap pricing price-points: %w", err)
+			}
+
+			id := strings.TrimSpace(*iapID)
+			if id == "" && strings.TrimSpace(*next) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("iap pricing price-points: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			opts := []asc.PricePointsOption{
+				asc.WithPricePointsLimit(*limit),
+				asc.WithPricePointsNextURL(*next),
+				asc.WithPricePointsTerritory(*territory),
+			}
+
+			if *paginate {
+				paginateOpts := append(opts, asc.WithPricePointsLimit(200))
+				firstPage, err := client.GetInAppPurchasePricePoints(requestCtx, id, paginateOpts...)
+				if err != nil {
+					return fmt.Errorf("iap pricing price-points: failed to fetch: %w", err)
+				}
+
+				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+					return client.GetInAppPurchasePricePoints(ctx, id, asc.WithPricePointsNextURL(nextURL))
+				})
+				if err != nil {
+					return fmt.Errorf("iap pricing price-points: %w", err)
+				}
+
+				return printOutput(resp, *output, *pretty)
+			}
+
+			resp, err := client.GetInAppPurchasePricePoints(requestCtx, id, opts...)
+			if err != nil {
+				return fmt.Errorf("iap pricing price-points: failed to fetch: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// IAPPricingSetCommand returns the iap pricing set subcommand.
+func IAPPricingSetCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("pricing set", flag.ExitOnError)
+
+	iapID := fs.String("id", "", "In-app purchase ID")
+	pricePointID := fs.String("price-point", "", "In-app purchase price point ID")
+	price := fs.String("price", "", "Customer price in the base territory (e.g., 4.99); resolves the price point")
+	baseTerritory := fs.String("base-territory", "", "Base territory ID (e.g., USA); defaults to the current base territory")
+	startDate := fs.String("start-date", "", "Start date (YYYY-MM-DD); defaults to immediately")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "set",
+		ShortUsage: "asc iap pricing set [flags]",
+		ShortHelp:  "Set the in-app purchase price in its base territory.",
+		LongHelp: `Set the in-app purchase price in its base territory.
+
+Creates a new price schedule with one manual price in the base territory.
+Apple equalizes prices for all other territories, and manual prices from the
+previous schedule are replaced.
+
+Examples:
+  asc iap pricing set --id "IAP_ID" --price 4.99
+  asc iap pricing set --id "IAP_ID" --base-territory "USA" --price 4.99 --start-date "2026-03-01"
+  asc iap pricing set --id "IAP_ID" --base-territory "GBR" --price-point "PRICE_POINT_ID"`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*iapID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+			pricePointValue := strings.TrimSpace(*pricePointID)
+			priceValue := strings.TrimSpace(*price)
+			if pricePointValue == "" && priceValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --price-point or --price is required")
+				return flag.ErrHelp
+			}
+			if pricePointValue != "" && priceValue != "" {
+				return fmt.Errorf("iap pricing set: --price-point and --price are mutually exclusive")
+			}
+
+			startDateValue, err := normalizeIAPStartDate(*startDate)
+			if err != nil {
+				return fmt.Errorf("iap pricing set: %w", err)
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("iap pricing set: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			baseTerritoryID, err := resolveIAPBaseTerritory(requestCtx, client, id, *baseTerritory)
+			if err != nil {
+				return fmt.Errorf("iap pricing set: %w", err)
+			}
+
+			if pricePointValue == "" {
+				pricePointValue, err = findIAPPricePoint(requestCtx, client, id, baseTerritoryID, priceValue)
+				if err != nil {
+					return fmt.Errorf("iap pricing set: %w", err)
+				}
+			}
+
+			resp, err := client.CreateInAppPurchasePriceSchedule(requestCtx, id, asc.InAppPurchasePriceScheduleCreateAttributes{
+				PricePointID:    pricePointValue,
+				StartDate:       startDateValue,
+				BaseTerritoryID: baseTerritoryID,
+			})
+			if err != nil {
+				return fmt.Errorf("iap pricing set: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+type iapPricesFetcher func(ctx context.Context, opts ...asc.IAPPricesOption) (*asc.InAppPurchasePricesResponse, error)
+
+// collectIAPPrices walks all pages itself because each page carries its own
+// included price points.
+func collectIAPPrices(ctx context.Context, territories []string, fetch iapPricesFetcher) ([]asc.InAppPurchasePriceSummary, error) {
+	summaries := make([]asc.InAppPurchasePriceSummary, 0)
+	opts := []asc.IAPPricesOption{
+		asc.WithIAPPricesLimit(200),
+		asc.WithIAPPricesTerritories(territories),
+	}
+	seen := make(map[string]bool)
+	for {
+		page, err := fetch(ctx, opts...)
+		if err != nil {
+			return nil, err
+		}
+		pageSummaries, err := asc.SummarizeInAppPurchasePrices(page)
+		if err != nil {
+			return nil, err
+		}
+		summaries = append(summaries, pageSummaries...)
+
+		next := strings.TrimSpace(page.Links.Next)
+		if next == "" {
+			return summaries, nil
+		}
+		if seen[next] {
+			return nil, fmt.Errorf("detected repeated pagination URL")
+		}
+		seen[next] = true
+		opts = []asc.IAPPricesOption{asc.WithIAPPricesNextURL(next)}
+	}
+}
+
+func resolveIAPBaseTerritory(ctx context.Context, client *asc.Client, iapID, baseTerritory string) (string, error) {
+	trimmed := strings.ToUpper(strings.TrimSpace(baseTerritory))
+	if trimmed != "" {
+		return trimmed, nil
+	}
+
+	schedule, err := client.GetInAppPurchasePriceSchedule(ctx, iapID)
+	if err != nil {
+		if asc.IsNotFound(err) {
+			return "", fmt.Errorf("--base-territory is required when the price schedule is missing")
+		}
+		return "", fmt.Errorf("get price schedule: %w", err)
+	}
+	scheduleID := strings.TrimSpace(schedule.Data.ID)
+	if scheduleID == "" {
+		return "", fmt.Errorf("--base-territory is required when the price schedule is missing")
+	}
+
+	territory, err := client.GetInAppPurchasePriceScheduleBaseTerritory(ctx, scheduleID)
+	if err != nil {
+		if asc.IsNotFound(err) {
+			return "", fmt.Errorf("--base-territory is required when the price schedule has no base territory")
+		}
+		return "", fmt.Errorf("get base territory: %w", err)
+	}
+
+	territoryID := strings.ToUpper(strings.TrimSpace(territory.Data.ID))
+	if territoryID == "" {
+		return "", fmt.Errorf("base territory ID missing from response")
+	}
+	return territoryID, nil
+}
+
+func findIAPPricePoint(ctx context.Context, client *asc.Client, iapID, territory, price string) (string, error) {
+	want, err := strconv.ParseFloat(price, 64)
+	if err != nil || want < 0 {
+		return "", fmt.Errorf("--price must be a non-negative number")
+	}
+
+	firstPage, err := client.GetInAppPurchasePricePoints(ctx, iapID,
+		asc.WithPricePointsTerritory(territory),
+		asc.WithPricePointsLimit(200),
+	)
+	if err != nil {
+		return "", fmt.Errorf("list price points: %w", err)
+	}
+	all, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetInAppPurchasePricePoints(ctx, iapID, asc.WithPricePointsNextURL(nextURL))
+	})
+	if err != nil {
+		return "", fmt.Errorf("list price points: %w", err)
+	}
+
+	points, ok := all.(*asc.InAppPurchasePricePointsResponse)
+	if !ok {
+		return "", fmt.Errorf("unexpected price points response %T", all)
+	}
+	for _, point := range points.Data {
+		got, err := strconv.ParseFloat(strings.TrimSpace(point.Attributes.CustomerPrice), 64)
+		if err != nil {
+			continue
+		}
+		if priceEqual(got, want) {
+			return point.ID, nil
+		}
+	}
+	return "", fmt.Errorf("no price point with customer price %s in %s", price, territory)
+}
+
+func priceEqual(a, b float64) bool {
+	diff := a - b
+	if diff < 0 {
+		diff = -diff
+	}
+	return diff < 0.000001
+}
+
+func normalizeIAPStartDate(value string) (string, error) {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return "", nil
+	}
+	parsed, err := time.Parse("2006-01-02", trimmed)
+	if err != nil {
+		return "", fmt.Errorf("--start-date must be in YYYY-MM-DD format")
+	}
+	return parsed.Format("2006-01-02"), nil
+}
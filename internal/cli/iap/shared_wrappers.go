@@ -40,3 +40,7 @@ func splitCSV(value string) []string {
 func splitCSVUpper(value string) []string {
 	return shared.SplitCSVUpper(value)
 }
+
+func contextWithUploadTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	return shared.ContextWithUploadTimeout(ctx)
+}
@@ -0,0 +1,206 @@
+package metadata
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// MetadataCommand returns the metadata command with subcommands.
+func MetadataCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("metadata", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "metadata",
+		ShortUsage: "asc metadata <subcommand> [flags]",
+		ShortHelp:  "Plan and apply App Store metadata from a directory.",
+		LongHelp: `Plan and apply App Store metadata from a directory.
+
+The directory may contain any of:
+  version/<locale>.strings   Version localizations (description, keywords, whatsNew, ...)
+  app-info/<locale>.strings  App info localizations (name, subtitle, privacy URLs)
+  categories.json            {"primary": "GAMES", "secondary": "ENTERTAINMENT"}
+  age-rating.json            Age rating declaration fields (e.g. {"gambling": false})
+  availability.json          {"availableInNewTerritories": true, "territories": {"USA": true}}
+
+Examples:
+  asc metadata plan --app "APP_ID" --version "1.2.0" --dir "./appstore"
+  asc metadata apply --app "APP_ID" --version "1.2.0" --dir "./appstore"`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			MetadataPlanCommand(),
+			MetadataApplyCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+type metadataFlags struct {
+	appID     *string
+	dir       *string
+	version   *string
+	versionID *string
+	platform  *string
+	appInfoID *string
+	pretty    *bool
+}
+
+func bindMetadataFlags(fs *flag.FlagSet) metadataFlags {
+	return metadataFlags{
+		appID:     fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)"),
+		dir:       fs.String("dir", "", "Metadata directory"),
+		version:   fs.String("version", "", "App Store version string (for version localizations)"),
+		versionID: fs.String("version-id", "", "App Store version ID (overrides --version)"),
+		platform:  fs.String("platform", "IOS", "Platform: IOS, MAC_OS, TV_OS, VISION_OS"),
+		appInfoID: fs.String("app-info", "", "App Info ID (optional override)"),
+		pretty:    fs.Bool("pretty", false, "Pretty-print JSON output"),
+	}
+}
+
+// MetadataPlanCommand returns the metadata plan subcommand.
+func MetadataPlanCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("metadata plan", flag.ExitOnError)
+	flags := bindMetadataFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "plan",
+		ShortUsage: "asc metadata plan --app APP_ID --dir ./appstore [flags]",
+		ShortHelp:  "Show per-field metadata differences without applying them.",
+		LongHelp: `Show per-field metadata differences without applying them.
+
+The plan is printed as JSON with changes sorted by section, locale,
+territory, and field so it can be diffed between runs.
+
+Examples:
+  asc metadata plan --app "APP_ID" --version "1.2.0" --dir "./appstore"
+  asc metadata plan --app "APP_ID" --version-id "VERSION_ID" --dir "./appstore" --pretty`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			plan, _, _, _, err := buildMetadataPlan(ctx, "metadata plan", flags)
+			if err != nil {
+				return err
+			}
+			return printMetadataJSON(plan, *flags.pretty)
+		},
+	}
+}
+
+// MetadataApplyCommand returns the metadata apply subcommand.
+func MetadataApplyCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("metadata apply", flag.ExitOnError)
+	flags := bindMetadataFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "apply",
+		ShortUsage: "asc metadata apply --app APP_ID --dir ./appstore [flags]",
+		ShortHelp:  "Apply changed metadata fields to App Store Connect.",
+		LongHelp: `Apply changed metadata fields to App Store Connect.
+
+Only fields that differ from App Store Connect are written. Run
+"asc metadata plan" first to review the changes.
+
+Examples:
+  asc metadata apply --app "APP_ID" --version "1.2.0" --dir "./appstore"`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			plan, target, desired, current, err := buildMetadataPlan(ctx, "metadata apply", flags)
+			if err != nil {
+				return err
+			}
+
+			result := &metadataApplyResult{
+				metadataPlan: *plan,
+				Applied:      make([]metadataApplyStep, 0),
+			}
+
+			var applyErr error
+			if len(plan.Changes) > 0 {
+				client, err := getASCClient()
+				if err != nil {
+					return fmt.Errorf("metadata apply: %w", err)
+				}
+
+				requestCtx, cancel := contextWithTimeout(ctx)
+				defer cancel()
+
+				applyErr = applyMetadataPlan(requestCtx, client, target, desired, current, result)
+			}
+
+			if err := printMetadataJSON(result, *flags.pretty); err != nil {
+				return err
+			}
+			if applyErr != nil {
+				return shared.NewReportedError(fmt.Errorf("metadata apply: %w", applyErr))
+			}
+			return nil
+		},
+	}
+}
+
+func buildMetadataPlan(ctx context.Context, command string, flags metadataFlags) (*metadataPlan, metadataTarget, *metadataDesired, *metadataCurrent, error) {
+	resolvedAppID := resolveAppID(*flags.appID)
+	if resolvedAppID == "" {
+		fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
+		return nil, metadataTarget{}, nil, nil, flag.ErrHelp
+	}
+	dirValue := strings.TrimSpace(*flags.dir)
+	if dirValue == "" {
+		fmt.Fprintln(os.Stderr, "Error: --dir is required")
+		return nil, metadataTarget{}, nil, nil, flag.ErrHelp
+	}
+	platform, err := shared.NormalizeAppStoreVersionPlatform(*flags.platform)
+	if err != nil {
+		return nil, metadataTarget{}, nil, nil, fmt.Errorf("%s: %w", command, err)
+	}
+
+	desired, err := readMetadataDir(dirValue)
+	if err != nil {
+		return nil, metadataTarget{}, nil, nil, fmt.Errorf("%s: %w", command, err)
+	}
+
+	client, err := getASCClient()
+	if err != nil {
+		return nil, metadataTarget{}, nil, nil, fmt.Errorf("%s: %w", command, err)
+	}
+
+	requestCtx, cancel := contextWithTimeout(ctx)
+	defer cancel()
+
+	target, err := resolveMetadataTarget(requestCtx, client, resolvedAppID, *flags.version, *flags.versionID, platform, *flags.appInfoID, desired)
+	if err != nil {
+		return nil, metadataTarget{}, nil, nil, fmt.Errorf("%s: %w", command, err)
+	}
+
+	current, err := fetchMetadataCurrent(requestCtx, client, target, desired)
+	if err != nil {
+		return nil, metadataTarget{}, nil, nil, fmt.Errorf("%s: %w", command, err)
+	}
+
+	plan := &metadataPlan{
+		Dir:       dirValue,
+		AppID:     target.AppID,
+		VersionID: target.VersionID,
+		AppInfoID: target.AppInfoID,
+		Changes:   diffMetadata(desired, current),
+	}
+	return plan, target, desired, current, nil
+}
+
+func printMetadataJSON(data interface{}, pretty bool) error {
+	if pretty {
+		return asc.PrintPrettyJSON(data)
+	}
+	return asc.PrintJSON(data)
+}
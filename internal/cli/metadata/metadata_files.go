@@ -0,0 +1,219 @@
+package metadata
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	metadataVersionDir       = "version"
+	metadataAppInfoDir       = "app-info"
+	metadataCategoriesFile   = "categories.json"
+	metadataAgeRatingFile    = "age-rating.json"
+	metadataAvailabilityFile = "availability.json"
+)
+
+// metadataCategories describes the desired primary and secondary categories.
+type metadataCategories struct {
+	Primary   string `json:"primary,omitempty"`
+	Secondary string `json:"secondary,omitempty"`
+}
+
+// metadataAvailability describes the desired territory availability.
+type metadataAvailability struct {
+	AvailableInNewTerritories *bool           `json:"availableInNewTerritories,omitempty"`
+	Territories               map[string]bool `json:"territories,omitempty"`
+}
+
+// metadataDesired is the desired metadata state read from a directory.
+type metadataDesired struct {
+	VersionLocalizations map[string]map[string]string
+	AppInfoLocalizations map[string]map[string]string
+	Categories           *metadataCategories
+	AgeRating            map[string]any
+	Availability         *metadataAvailability
+}
+
+func (d *metadataDesired) needsVersion() bool {
+	return len(d.VersionLocalizations) > 0
+}
+
+func (d *metadataDesired) needsAppInfo() bool {
+	return len(d.AppInfoLocalizations) > 0 || d.Categories != nil || len(d.AgeRating) > 0
+}
+
+func (d *metadataDesired) empty() bool {
+	return !d.needsVersion() && !d.needsAppInfo() && d.Availability == nil
+}
+
+// readMetadataDir loads the desired metadata from a directory laid out as:
+//
+//	version/<locale>.strings
+//	app-info/<locale>.strings
+//	categories.json
+//	age-rating.json
+//	availability.json
+//
+// Every entry is optional, but at least one must be present.
+func readMetadataDir(dir string) (*metadataDesired, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%q is not a directory", dir)
+	}
+
+	desired := &metadataDesired{}
+
+	desired.VersionLocalizations, err = readMetadataStringsDir(filepath.Join(dir, metadataVersionDir))
+	if err != nil {
+		return nil, err
+	}
+	if err := shared.ValidateVersionLocalizationKeys(desired.VersionLocalizations); err != nil {
+		return nil, fmt.Errorf("%s: %w", metadataVersionDir, err)
+	}
+
+	desired.AppInfoLocalizations, err = readMetadataStringsDir(filepath.Join(dir, metadataAppInfoDir))
+	if err != nil {
+		return nil, err
+	}
+	if err := shared.ValidateAppInfoLocalizationKeys(desired.AppInfoLocalizations); err != nil {
+		return nil, fmt.Errorf("%s: %w", metadataAppInfoDir, err)
+	}
+
+	var categories metadataCategories
+	found, err := readMetadataJSONFile(filepath.Join(dir, metadataCategoriesFile), &categories)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		categories.Primary = strings.TrimSpace(categories.Primary)
+		categories.Secondary = strings.TrimSpace(categories.Secondary)
+		if categories.Primary == "" && categories.Secondary == "" {
+			return nil, fmt.Errorf("%s: primary or secondary is required", metadataCategoriesFile)
+		}
+		desired.Categories = &categories
+	}
+
+	var ageRating asc.AgeRatingDeclarationAttributes
+	found, err = readMetadataJSONFile(filepath.Join(dir, metadataAgeRatingFile), &ageRating)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		fields, err := ageRatingFieldValues(ageRating)
+		if err != nil {
+			return nil, err
+		}
+		if len(fields) == 0 {
+			return nil, fmt.Errorf("%s: no age rating fields set", metadataAgeRatingFile)
+		}
+		desired.AgeRating = fields
+	}
+
+	var availability metadataAvailability
+	found, err = readMetadataJSONFile(filepath.Join(dir, metadataAvailabilityFile), &availability)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		territories := make(map[string]bool, len(availability.Territories))
+		for territory, available := range availability.Territories {
+			normalized := strings.ToUpper(strings.TrimSpace(territory))
+			if normalized == "" {
+				return nil, fmt.Errorf("%s: territory IDs must not be empty", metadataAvailabilityFile)
+			}
+			if _, exists := territories[normalized]; exists {
+				return nil, fmt.Errorf("%s: duplicate territory %q", metadataAvailabilityFile, normalized)
+			}
+			territories[normalized] = available
+		}
+		availability.Territories = territories
+		if availability.AvailableInNewTerritories == nil && len(territories) == 0 {
+			return nil, fmt.Errorf("%s: availableInNewTerritories or territories is required", metadataAvailabilityFile)
+		}
+		desired.Availability = &availability
+	}
+
+	if desired.empty() {
+		return nil, fmt.Errorf("no metadata found in %q", dir)
+	}
+	return desired, nil
+}
+
+func readMetadataStringsDir(dir string) (map[string]map[string]string, error) {
+	info, err := os.Lstat(dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("expected directory: %q", dir)
+	}
+	return shared.ReadLocalizationStrings(dir, nil)
+}
+
+// readMetadataJSONFile decodes a JSON file strictly and reports whether it exists.
+func readMetadataJSONFile(path string, target any) (bool, error) {
+	info, err := os.Lstat(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return false, nil
+		}
+		return false, err
+	}
+	if info.Mode()&os.ModeSymlink != 0 {
+		return false, fmt.Errorf("refusing to read symlink %q", path)
+	}
+	if !info.Mode().IsRegular() {
+		return false, fmt.Errorf("expected regular file: %q", path)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return false, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(target); err != nil {
+		return false, fmt.Errorf("%s: invalid JSON: %w", filepath.Base(path), err)
+	}
+	return true, nil
+}
+
+// ageRatingFieldValues flattens the set age rating fields into a JSON-keyed map.
+func ageRatingFieldValues(attrs asc.AgeRatingDeclarationAttributes) (map[string]any, error) {
+	data, err := json.Marshal(attrs)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]any)
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// ageRatingAttributesFromFields builds update attributes from a JSON-keyed map.
+func ageRatingAttributesFromFields(fields map[string]any) (asc.AgeRatingDeclarationAttributes, error) {
+	var attrs asc.AgeRatingDeclarationAttributes
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return attrs, err
+	}
+	if err := json.Unmarshal(data, &attrs); err != nil {
+		return attrs, err
+	}
+	return attrs, nil
+}
@@ -0,0 +1,477 @@
+package metadata
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	metadataSectionVersionLocalizations = "version-localizations"
+	metadataSectionAppInfoLocalizations = "app-info-localizations"
+	metadataSectionCategories           = "categories"
+	metadataSectionAgeRating            = "age-rating"
+	metadataSectionAvailability         = "availability"
+)
+
+var metadataSectionOrder = map[string]int{
+	metadataSectionVersionLocalizations: 0,
+	metadataSectionAppInfoLocalizations: 1,
+	metadataSectionCategories:           2,
+	metadataSectionAgeRating:            3,
+	metadataSectionAvailability:         4,
+}
+
+// metadataChange is a single field that differs between the directory and App Store Connect.
+type metadataChange struct {
+	Section   string `json:"section"`
+	Locale    string `json:"locale,omitempty"`
+	Territory string `json:"territory,omitempty"`
+	Field     string `json:"field"`
+	Action    string `json:"action"`
+	Current   any    `json:"current"`
+	Desired   any    `json:"desired"`
+}
+
+// metadataPlan is the stable JSON plan emitted by metadata plan and apply.
+type metadataPlan struct {
+	Dir       string           `json:"dir"`
+	AppID     string           `json:"appId"`
+	VersionID string           `json:"versionId,omitempty"`
+	AppInfoID string           `json:"appInfoId,omitempty"`
+	Changes   []metadataChange `json:"changes"`
+}
+
+// metadataApplyStep records one write performed by metadata apply.
+type metadataApplyStep struct {
+	Section string `json:"section"`
+	Locale  string `json:"locale,omitempty"`
+	Action  string `json:"action"`
+	ID      string `json:"id,omitempty"`
+}
+
+// metadataApplyResult is the JSON output of metadata apply.
+type metadataApplyResult struct {
+	metadataPlan
+	Applied []metadataApplyStep `json:"applied"`
+}
+
+// metadataCurrent is the live metadata state fetched from App Store Connect.
+type metadataCurrent struct {
+	VersionLocalizations      map[string]map[string]string
+	AppInfoLocalizations      map[string]map[string]string
+	Categories                metadataCategories
+	AgeRatingID               string
+	AgeRating                 map[string]any
+	AvailableInNewTerritories *bool
+	Territories               map[string]bool
+}
+
+type metadataTarget struct {
+	AppID     string
+	VersionID string
+	AppInfoID string
+}
+
+func resolveMetadataTarget(ctx context.Context, client *asc.Client, appID, version, versionID, platform, appInfoID string, desired *metadataDesired) (metadataTarget, error) {
+	target := metadataTarget{AppID: appID}
+
+	if desired.needsVersion() {
+		target.VersionID = strings.TrimSpace(versionID)
+		if target.VersionID == "" {
+			if strings.TrimSpace(version) == "" {
+				return target, fmt.Errorf("--version or --version-id is required for %s", metadataVersionDir)
+			}
+			resolved, err := shared.ResolveAppStoreVersionID(ctx, client, appID, strings.TrimSpace(version), platform)
+			if err != nil {
+				return target, err
+			}
+			target.VersionID = resolved
+		}
+	}
+
+	if desired.needsAppInfo() {
+		resolved, err := shared.ResolveAppInfoID(ctx, client, appID, appInfoID)
+		if err != nil {
+			return target, err
+		}
+		target.AppInfoID = resolved
+	}
+
+	return target, nil
+}
+
+// fetchMetadataCurrent loads only the sections present in the desired state.
+func fetchMetadataCurrent(ctx context.Context, client *asc.Client, target metadataTarget, desired *metadataDesired) (*metadataCurrent, error) {
+	current := &metadataCurrent{}
+
+	if desired.needsVersion() {
+		resp, err := client.GetAppStoreVersionLocalizations(ctx, target.VersionID, asc.WithAppStoreVersionLocalizationsLimit(200))
+		if err != nil {
+			return nil, fmt.Errorf("fetch version localizations: %w", err)
+		}
+		current.VersionLocalizations = make(map[string]map[string]string, len(resp.Data))
+		for _, item := range resp.Data {
+			if strings.TrimSpace(item.Attributes.Locale) == "" {
+				continue
+			}
+			current.VersionLocalizations[item.Attributes.Locale] = shared.VersionLocalizationStrings(item.Attributes)
+		}
+	}
+
+	if len(desired.AppInfoLocalizations) > 0 {
+		resp, err := client.GetAppInfoLocalizations(ctx, target.AppInfoID, asc.WithAppInfoLocalizationsLimit(200))
+		if err != nil {
+			return nil, fmt.Errorf("fetch app info localizations: %w", err)
+		}
+		current.AppInfoLocalizations = make(map[string]map[string]string, len(resp.Data))
+		for _, item := range resp.Data {
+			if strings.TrimSpace(item.Attributes.Locale) == "" {
+				continue
+			}
+			current.AppInfoLocalizations[item.Attributes.Locale] = shared.AppInfoLocalizationStrings(item.Attributes)
+		}
+	}
+
+	if desired.Categories != nil {
+		primary, err := client.GetAppInfoPrimaryCategoryRelationship(ctx, target.AppInfoID)
+		if err != nil {
+			return nil, fmt.Errorf("fetch primary category: %w", err)
+		}
+		if primary.Data != nil {
+			current.Categories.Primary = primary.Data.ID
+		}
+		secondary, err := client.GetAppInfoSecondaryCategoryRelationship(ctx, target.AppInfoID)
+		if err != nil {
+			return nil, fmt.Errorf("fetch secondary category: %w", err)
+		}
+		if secondary.Data != nil {
+			current.Categories.Secondary = secondary.Data.ID
+		}
+	}
+
+	if len(desired.AgeRating) > 0 {
+		resp, err := client.GetAgeRatingDeclarationForAppInfo(ctx, target.AppInfoID)
+		if err != nil {
+			return nil, fmt.Errorf("fetch age rating declaration: %w", err)
+		}
+		fields, err := ageRatingFieldValues(resp.Data.Attributes)
+		if err != nil {
+			return nil, fmt.Errorf("fetch age rating declaration: %w", err)
+		}
+		current.AgeRatingID = resp.Data.ID
+		current.AgeRating = fields
+	}
+
+	if desired.Availability != nil {
+		current.Territories = make(map[string]bool)
+		resp, err := client.GetAppAvailabilityV2(ctx, target.AppID)
+		if err != nil && !asc.IsNotFound(err) {
+			return nil, fmt.Errorf("fetch app availability: %w", err)
+		}
+		if err == nil {
+			availableInNewTerritories := resp.Data.Attributes.AvailableInNewTerritories
+			current.AvailableInNewTerritories = &availableInNewTerritories
+
+			firstPage, err := client.GetTerritoryAvailabilities(ctx, resp.Data.ID, asc.WithTerritoryAvailabilitiesLimit(200))
+			if err != nil {
+				return nil, fmt.Errorf("fetch territory availabilities: %w", err)
+			}
+			paginated, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				return client.GetTerritoryAvailabilities(ctx, resp.Data.ID, asc.WithTerritoryAvailabilitiesNextURL(nextURL))
+			})
+			if err != nil {
+				return nil, fmt.Errorf("fetch territory availabilities: %w", err)
+			}
+			territories, ok := paginated.(*asc.TerritoryAvailabilitiesResponse)
+			if !ok {
+				return nil, fmt.Errorf("fetch territory availabilities: unexpected response type %T", paginated)
+			}
+			for _, item := range territories.Data {
+				territoryID, err := territoryIDForAvailability(item)
+				if err != nil {
+					return nil, err
+				}
+				current.Territories[territoryID] = item.Attributes.Available
+			}
+		}
+	}
+
+	return current, nil
+}
+
+func territoryIDForAvailability(item asc.Resource[asc.TerritoryAvailabilityAttributes]) (string, error) {
+	if len(item.Relationships) == 0 {
+		return "", fmt.Errorf("territory availability %q missing territory relationship", item.ID)
+	}
+	var relationships asc.TerritoryAvailabilityRelationships
+	if err := json.Unmarshal(item.Relationships, &relationships); err != nil {
+		return "", fmt.Errorf("decode territory availability relationships for %q: %w", item.ID, err)
+	}
+	territoryID := strings.ToUpper(strings.TrimSpace(relationships.Territory.Data.ID))
+	if territoryID == "" {
+		return "", fmt.Errorf("territory availability %q missing territory id", item.ID)
+	}
+	return territoryID, nil
+}
+
+// diffMetadata compares desired and current state field by field.
+// Empty localization values and unset fields in the desired state are ignored.
+func diffMetadata(desired *metadataDesired, current *metadataCurrent) []metadataChange {
+	changes := make([]metadataChange, 0)
+
+	changes = append(changes, diffLocalizations(metadataSectionVersionLocalizations, desired.VersionLocalizations, current.VersionLocalizations)...)
+	changes = append(changes, diffLocalizations(metadataSectionAppInfoLocalizations, desired.AppInfoLocalizations, current.AppInfoLocalizations)...)
+
+	if desired.Categories != nil {
+		if desired.Categories.Primary != "" && desired.Categories.Primary != current.Categories.Primary {
+			changes = append(changes, metadataChange{
+				Section: metadataSectionCategories,
+				Field:   "primary",
+				Action:  "update",
+				Current: optionalString(current.Categories.Primary),
+				Desired: desired.Categories.Primary,
+			})
+		}
+		if desired.Categories.Secondary != "" && desired.Categories.Secondary != current.Categories.Secondary {
+			changes = append(changes, metadataChange{
+				Section: metadataSectionCategories,
+				Field:   "secondary",
+				Action:  "update",
+				Current: optionalString(current.Categories.Secondary),
+				Desired: desired.Categories.Secondary,
+			})
+		}
+	}
+
+	for field, value := range desired.AgeRating {
+		currentValue, ok := current.AgeRating[field]
+		if ok && reflect.DeepEqual(currentValue, value) {
+			continue
+		}
+		changes = append(changes, metadataChange{
+			Section: metadataSectionAgeRating,
+			Field:   field,
+			Action:  "update",
+			Current: currentValue,
+			Desired: value,
+		})
+	}
+
+	if desired.Availability != nil {
+		action := "update"
+		if current.AvailableInNewTerritories == nil {
+			action = "create"
+		}
+		if desired.Availability.AvailableInNewTerritories != nil {
+			want := *desired.Availability.AvailableInNewTerritories
+			if current.AvailableInNewTerritories == nil || *current.AvailableInNewTerritories != want {
+				var currentValue any
+				if current.AvailableInNewTerritories != nil {
+					currentValue = *current.AvailableInNewTerritories
+				}
+				changes = append(changes, metadataChange{
+					Section: metadataSectionAvailability,
+					Field:   "availableInNewTerritories",
+					Action:  action,
+					Current: currentValue,
+					Desired: want,
+				})
+			}
+		}
+		for territory, want := range desired.Availability.Territories {
+			currentValue, ok := current.Territories[territory]
+			if ok && currentValue == want {
+				continue
+			}
+			change := metadataChange{
+				Section:   metadataSectionAvailability,
+				Territory: territory,
+				Field:     "available",
+				Action:    action,
+				Desired:   want,
+			}
+			if ok {
+				change.Current = currentValue
+			}
+			changes = append(changes, change)
+		}
+	}
+
+	sortMetadataChanges(changes)
+	return changes
+}
+
+func diffLocalizations(section string, desired, current map[string]map[string]string) []metadataChange {
+	changes := make([]metadataChange, 0)
+	for locale, values := range desired {
+		currentValues, exists := current[locale]
+		action := "update"
+		if !exists {
+			action = "create"
+		}
+		for field, value := range values {
+			if strings.TrimSpace(value) == "" {
+				continue
+			}
+			currentValue := currentValues[field]
+			if exists && currentValue == value {
+				continue
+			}
+			changes = append(changes, metadataChange{
+				Section: section,
+				Locale:  locale,
+				Field:   field,
+				Action:  action,
+				Current: optionalString(currentValue),
+				Desired: value,
+			})
+		}
+	}
+	return changes
+}
+
+func sortMetadataChanges(changes []metadataChange) {
+	sort.SliceStable(changes, func(i, j int) bool {
+		a, b := changes[i], changes[j]
+		if a.Section != b.Section {
+			return metadataSectionOrder[a.Section] < metadataSectionOrder[b.Section]
+		}
+		if a.Locale != b.Locale {
+			return a.Locale < b.Locale
+		}
+		if a.Territory != b.Territory {
+			return a.Territory < b.Territory
+		}
+		return a.Field < b.Field
+	})
+}
+
+func optionalString(value string) any {
+	if value == "" {
+		return nil
+	}
+	return value
+}
+
+// changedLocalizationValues groups changed localization fields by locale.
+func changedLocalizationValues(changes []metadataChange, section string) map[string]map[string]string {
+	values := make(map[string]map[string]string)
+	for _, change := range changes {
+		if change.Section != section {
+			continue
+		}
+		desired, _ := change.Desired.(string)
+		if values[change.Locale] == nil {
+			values[change.Locale] = make(map[string]string)
+		}
+		values[change.Locale][change.Field] = desired
+	}
+	return values
+}
+
+func changesForSection(changes []metadataChange, section string) []metadataChange {
+	filtered := make([]metadataChange, 0)
+	for _, change := range changes {
+		if change.Section == section {
+			filtered = append(filtered, change)
+		}
+	}
+	return filtered
+}
+
+// applyMetadataPlan writes only the changed fields, section by section.
+// It stops at the first failure; completed steps are recorded in result.
+func applyMetadataPlan(ctx context.Context, client *asc.Client, target metadataTarget, desired *metadataDesired, current *metadataCurrent, result *metadataApplyResult) error {
+	changes := result.Changes
+
+	if values := changedLocalizationValues(changes, metadataSectionVersionLocalizations); len(values) > 0 {
+		results, err := shared.UploadVersionLocalizations(ctx, client, target.VersionID, values, false)
+		if err != nil {
+			return fmt.Errorf("%s: %w", metadataSectionVersionLocalizations, err)
+		}
+		result.Applied = append(result.Applied, localizationApplySteps(metadataSectionVersionLocalizations, results)...)
+	}
+
+	if values := changedLocalizationValues(changes, metadataSectionAppInfoLocalizations); len(values) > 0 {
+		results, err := shared.UploadAppInfoLocalizations(ctx, client, target.AppInfoID, values, false)
+		if err != nil {
+			return fmt.Errorf("%s: %w", metadataSectionAppInfoLocalizations, err)
+		}
+		result.Applied = append(result.Applied, localizationApplySteps(metadataSectionAppInfoLocalizations, results)...)
+	}
+
+	if len(changesForSection(changes, metadataSectionCategories)) > 0 {
+		primary := desired.Categories.Primary
+		if primary == "" {
+			primary = current.Categories.Primary
+		}
+		if _, err := client.UpdateAppInfoCategories(ctx, target.AppInfoID, primary, desired.Categories.Secondary); err != nil {
+			return fmt.Errorf("%s: %w", metadataSectionCategories, err)
+		}
+		result.Applied = append(result.Applied, metadataApplyStep{Section: metadataSectionCategories, Action: "update", ID: target.AppInfoID})
+	}
+
+	if ageRatingChanges := changesForSection(changes, metadataSectionAgeRating); len(ageRatingChanges) > 0 {
+		if current.AgeRatingID == "" {
+			return fmt.Errorf("%s: age rating declaration not found", metadataSectionAgeRating)
+		}
+		fields := make(map[string]any, len(ageRatingChanges))
+		for _, change := range ageRatingChanges {
+			fields[change.Field] = change.Desired
+		}
+		attrs, err := ageRatingAttributesFromFields(fields)
+		if err != nil {
+			return fmt.Errorf("%s: %w", metadataSectionAgeRating, err)
+		}
+		if _, err := client.UpdateAgeRatingDeclaration(ctx, current.AgeRatingID, attrs); err != nil {
+			return fmt.Errorf("%s: %w", metadataSectionAgeRating, err)
+		}
+		result.Applied = append(result.Applied, metadataApplyStep{Section: metadataSectionAgeRating, Action: "update", ID: current.AgeRatingID})
+	}
+
+	if availabilityChanges := changesForSection(changes, metadataSectionAvailability); len(availabilityChanges) > 0 {
+		attrs := asc.AppAvailabilityV2CreateAttributes{
+			AvailableInNewTerritories: desired.Availability.AvailableInNewTerritories,
+		}
+		if attrs.AvailableInNewTerritories == nil {
+			attrs.AvailableInNewTerritories = current.AvailableInNewTerritories
+		}
+		for _, change := range availabilityChanges {
+			if change.Territory == "" {
+				continue
+			}
+			available, _ := change.Desired.(bool)
+			attrs.TerritoryAvailabilities = append(attrs.TerritoryAvailabilities, asc.TerritoryAvailabilityCreate{
+				TerritoryID: change.Territory,
+				Available:   available,
+			})
+		}
+		resp, err := client.CreateAppAvailabilityV2(ctx, target.AppID, attrs)
+		if err != nil {
+			return fmt.Errorf("%s: %w", metadataSectionAvailability, err)
+		}
+		result.Applied = append(result.Applied, metadataApplyStep{Section: metadataSectionAvailability, Action: availabilityChanges[0].Action, ID: resp.Data.ID})
+	}
+
+	return nil
+}
+
+func localizationApplySteps(section string, results []asc.LocalizationUploadLocaleResult) []metadataApplyStep {
+	steps := make([]metadataApplyStep, 0, len(results))
+	for _, item := range results {
+		steps = append(steps, metadataApplyStep{
+			Section: section,
+			Locale:  item.Locale,
+			Action:  item.Action,
+			ID:      item.LocalizationID,
+		})
+	}
+	return steps
+}
@@ -0,0 +1,204 @@
+package metadata
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeMetadataFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write %s: %v", path, err)
+	}
+}
+
+func TestReadMetadataDir(t *testing.T) {
+	dir := t.TempDir()
+	writeMetadataFile(t, filepath.Join(dir, "version", "en-US.strings"), `"description" = "Hello";`+"\n"+`"whatsNew" = "Fixes";`)
+	writeMetadataFile(t, filepath.Join(dir, "app-info", "en-US.strings"), `"name" = "My App";`)
+	writeMetadataFile(t, filepath.Join(dir, "categories.json"), `{"primary":"GAMES"}`)
+	writeMetadataFile(t, filepath.Join(dir, "age-rating.json"), `{"gambling":false,"violenceRealistic":"NONE"}`)
+	writeMetadataFile(t, filepath.Join(dir, "availability.json"), `{"availableInNewTerritories":true,"territories":{"usa":true,"GBR":false}}`)
+
+	desired, err := readMetadataDir(dir)
+	if err != nil {
+		t.Fatalf("readMetadataDir() error: %v", err)
+	}
+	if desired.VersionLocalizations["en-US"]["whatsNew"] != "Fixes" {
+		t.Fatalf("unexpected version localizations: %+v", desired.VersionLocalizations)
+	}
+	if desired.AppInfoLocalizations["en-US"]["name"] != "My App" {
+		t.Fatalf("unexpected app info localizations: %+v", desired.AppInfoLocalizations)
+	}
+	if desired.Categories == nil || desired.Categories.Primary != "GAMES" {
+		t.Fatalf("unexpected categories: %+v", desired.Categories)
+	}
+	if len(desired.AgeRating) != 2 || desired.AgeRating["gambling"] != false {
+		t.Fatalf("unexpected age rating: %+v", desired.AgeRating)
+	}
+	if desired.Availability == nil || !desired.Availability.Territories["USA"] {
+		t.Fatalf("expected normalized territory USA, got %+v", desired.Availability)
+	}
+}
+
+func TestReadMetadataDir_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string
+	}{
+		{name: "empty directory"},
+		{
+			name:  "unsupported version key",
+			files: map[string]string{"version/en-US.strings": `"name" = "Wrong section";`},
+		},
+		{
+			name:  "unknown age rating field",
+			files: map[string]string{"age-rating.json": `{"notAField":true}`},
+		},
+		{
+			name:  "invalid age rating type",
+			files: map[string]string{"age-rating.json": `{"gambling":"yes"}`},
+		},
+		{
+			name:  "empty categories",
+			files: map[string]string{"categories.json": `{}`},
+		},
+		{
+			name:  "duplicate territory",
+			files: map[string]string{"availability.json": `{"territories":{"usa":true,"USA":false}}`},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, content := range test.files {
+				writeMetadataFile(t, filepath.Join(dir, name), content)
+			}
+			if _, err := readMetadataDir(dir); err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
+
+func TestDiffMetadata(t *testing.T) {
+	trueValue := true
+	desired := &metadataDesired{
+		VersionLocalizations: map[string]map[string]string{
+			"en-US": {"description": "Same", "whatsNew": "New notes", "keywords": ""},
+			"fr-FR": {"description": "Bonjour"},
+		},
+		AppInfoLocalizations: map[string]map[string]string{
+			"en-US": {"name": "My App"},
+		},
+		Categories: &metadataCategories{Primary: "GAMES", Secondary: "ENTERTAINMENT"},
+		AgeRating:  map[string]any{"gambling": false, "violenceRealistic": "NONE"},
+		Availability: &metadataAvailability{
+			AvailableInNewTerritories: &trueValue,
+			Territories:               map[string]bool{"USA": true, "GBR": true},
+		},
+	}
+	falseValue := false
+	current := &metadataCurrent{
+		VersionLocalizations: map[string]map[string]string{
+			"en-US": {"description": "Same", "whatsNew": "Old notes", "keywords": "kept"},
+		},
+		AppInfoLocalizations: map[string]map[string]string{
+			"en-US": {"name": "My App"},
+		},
+		Categories:                metadataCategories{Primary: "GAMES"},
+		AgeRating:                 map[string]any{"gambling": true, "violenceRealistic": "NONE"},
+		AvailableInNewTerritories: &falseValue,
+		Territories:               map[string]bool{"USA": true, "GBR": false},
+	}
+
+	changes := diffMetadata(desired, current)
+
+	type key struct {
+		section, locale, territory, field, action string
+	}
+	got := make([]key, 0, len(changes))
+	for _, change := range changes {
+		got = append(got, key{change.Section, change.Locale, change.Territory, change.Field, change.Action})
+	}
+	want := []key{
+		{metadataSectionVersionLocalizations, "en-US", "", "whatsNew", "update"},
+		{metadataSectionVersionLocalizations, "fr-FR", "", "description", "create"},
+		{metadataSectionCategories, "", "", "secondary", "update"},
+		{metadataSectionAgeRating, "", "", "gambling", "update"},
+		{metadataSectionAvailability, "", "", "availableInNewTerritories", "update"},
+		{metadataSectionAvailability, "", "GBR", "available", "update"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d changes, got %d: %+v", len(want), len(got), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("change %d: expected %+v, got %+v", i, want[i], got[i])
+		}
+	}
+
+	if changes[0].Current != "Old notes" || changes[0].Desired != "New notes" {
+		t.Fatalf("unexpected values for first change: %+v", changes[0])
+	}
+	if changes[1].Current != nil {
+		t.Fatalf("expected nil current for new locale, got %v", changes[1].Current)
+	}
+}
+
+func TestDiffMetadata_NoChangesIsStableJSON(t *testing.T) {
+	desired := &metadataDesired{
+		VersionLocalizations: map[string]map[string]string{"en-US": {"description": "Same"}},
+	}
+	current := &metadataCurrent{
+		VersionLocalizations: map[string]map[string]string{"en-US": {"description": "Same"}},
+	}
+
+	plan := metadataPlan{Dir: "appstore", AppID: "app-1", VersionID: "ver-1", Changes: diffMetadata(desired, current)}
+	data, err := json.Marshal(plan)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	expected := `{"dir":"appstore","appId":"app-1","versionId":"ver-1","changes":[]}`
+	if string(data) != expected {
+		t.Fatalf("expected %s, got %s", expected, data)
+	}
+}
+
+func TestChangedLocalizationValues(t *testing.T) {
+	changes := []metadataChange{
+		{Section: metadataSectionVersionLocalizations, Locale: "en-US", Field: "whatsNew", Desired: "Notes"},
+		{Section: metadataSectionVersionLocalizations, Locale: "en-US", Field: "keywords", Desired: "a,b"},
+		{Section: metadataSectionAppInfoLocalizations, Locale: "en-US", Field: "name", Desired: "Name"},
+	}
+
+	values := changedLocalizationValues(changes, metadataSectionVersionLocalizations)
+	if len(values) != 1 || len(values["en-US"]) != 2 {
+		t.Fatalf("unexpected values: %+v", values)
+	}
+	if values["en-US"]["keywords"] != "a,b" {
+		t.Fatalf("expected keywords a,b, got %q", values["en-US"]["keywords"])
+	}
+}
+
+func TestAgeRatingAttributesFromFields(t *testing.T) {
+	attrs, err := ageRatingAttributesFromFields(map[string]any{"gambling": true, "kidsAgeBand": "NINE_TO_ELEVEN"})
+	if err != nil {
+		t.Fatalf("ageRatingAttributesFromFields() error: %v", err)
+	}
+	if attrs.Gambling == nil || !*attrs.Gambling {
+		t.Fatalf("expected gambling true, got %+v", attrs.Gambling)
+	}
+	if attrs.KidsAgeBand == nil || *attrs.KidsAgeBand != "NINE_TO_ELEVEN" {
+		t.Fatalf("expected kids age band, got %+v", attrs.KidsAgeBand)
+	}
+	if attrs.SeventeenPlus != nil {
+		t.Fatalf("expected unset fields to stay nil, got %+v", attrs.SeventeenPlus)
+	}
+}
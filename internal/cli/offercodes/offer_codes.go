@@ -27,16 +27,21 @@ func OfferCodesCommand() *ffcli.Command {
 		ShortHelp:  "Manage subscription offer codes.",
 		LongHelp: `Manage one-time use offer codes for subscriptions.
 
+Offer codes themselves and custom codes are managed with
+"asc subscriptions offer-codes" and "asc iap offer-codes".
+
 Examples:
   asc offer-codes list --offer-code "OFFER_CODE_ID"
   asc offer-codes generate --offer-code "OFFER_CODE_ID" --quantity 10 --expiration-date "2026-02-01"
-  asc offer-codes values --id "ONE_TIME_USE_CODE_ID" --output "./offer-codes.txt"`,
+  asc offer-codes values --id "ONE_TIME_USE_CODE_ID" --output "./offer-codes.txt"
+  asc offer-codes deactivate --id "ONE_TIME_USE_CODE_ID" --confirm`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			OfferCodesListCommand(),
 			OfferCodesGenerateCommand(),
 			OfferCodesValuesCommand(),
+			OfferCodesDeactivateCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -280,6 +285,56 @@ Examples:
 	}
 }
 
+// OfferCodesDeactivateCommand returns the offer codes deactivate subcommand.
+func OfferCodesDeactivateCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("deactivate", flag.ExitOnError)
+
+	id := fs.String("id", "", "One-time use offer code batch ID (required)")
+	confirm := fs.Bool("confirm", false, "Confirm deactivation")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "deactivate",
+		ShortUsage: "asc offer-codes deactivate --id \"ONE_TIME_USE_CODE_ID\" --confirm",
+		ShortHelp:  "Deactivate a one-time use offer code batch.",
+		LongHelp: `Deactivate a one-time use offer code batch.
+
+Unredeemed codes in the batch can no longer be redeemed.
+
+Examples:
+  asc offer-codes deactivate --id "ONE_TIME_USE_CODE_ID" --confirm`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			trimmedID := strings.TrimSpace(*id)
+			if trimmedID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+			if !*confirm {
+				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("offer-codes deactivate: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			resp, err := client.UpdateSubscriptionOfferCodeOneTimeUseCodeActive(requestCtx, trimmedID, false)
+			if err != nil {
+				return fmt.Errorf("offer-codes deactivate: failed to update: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
 func normalizeOfferCodeExpirationDate(value string) (string, error) {
 	return normalizeDate(value, "--expiration-date")
 }
@@ -0,0 +1,313 @@
+package profiles
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	profileInspectCertActive   = "active"
+	profileInspectCertNotFound = "not-found"
+)
+
+// ProfilesInspectCommand returns the profiles inspect subcommand.
+func ProfilesInspectCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("inspect", flag.ExitOnError)
+
+	filePath := fs.String("file", "", "Path to a .mobileprovision, .provisionprofile or certificate (.cer, .crt, .pem) file")
+	id := fs.String("id", "", "App Store Connect profile ID to compare against (default: match by UUID)")
+	offline := fs.Bool("offline", false, "Only decode the file; skip the App Store Connect cross-check")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "inspect",
+		ShortUsage: "asc profiles inspect --file ./profile.mobileprovision [flags]",
+		ShortHelp:  "Decode a local provisioning profile or certificate.",
+		LongHelp: `Decode a local provisioning profile or certificate.
+
+Reads the signed (CMS/PKCS#7) profile, decodes the embedded plist and reports
+its UUID, team, entitlements, expiration, provisioned devices and developer
+certificate fingerprints. Works on any OS; no Keychain or "security" tool needed.
+
+Certificate files (.cer, .crt, .pem; DER or PEM encoded) are decoded too and
+report the common name, serial number, fingerprints and expiration.
+
+Unless --offline is set, the file is cross-checked against App Store Connect:
+a profile is matched by UUID (or --id) and each certificate by serial number.
+--id only applies to profiles.
+
+Examples:
+  asc profiles inspect --file "./profile.mobileprovision"
+  asc profiles inspect --file "./profile.mobileprovision" --offline --output table
+  asc profiles inspect --file "./profile.mobileprovision" --id "PROFILE_ID"
+  asc profiles inspect --file "./distribution.cer"`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			pathValue := strings.TrimSpace(*filePath)
+			if pathValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --file is required")
+				return flag.ErrHelp
+			}
+			idValue := strings.TrimSpace(*id)
+			if idValue != "" && *offline {
+				fmt.Fprintln(os.Stderr, "Error: --id cannot be used with --offline")
+				return flag.ErrHelp
+			}
+			if isCertificateFile(pathValue) {
+				if idValue != "" {
+					fmt.Fprintln(os.Stderr, "Error: --id cannot be used with a certificate file")
+					return flag.ErrHelp
+				}
+				return inspectCertificateFile(ctx, pathValue, *offline, *output, *pretty)
+			}
+
+			profile, err := shared.ReadProvisioningProfile(pathValue)
+			if err != nil {
+				return fmt.Errorf("profiles inspect: %w", err)
+			}
+			result := buildProfileInspectResult(pathValue, profile, time.Now())
+
+			if !*offline {
+				client, err := getASCClient()
+				if err != nil {
+					return fmt.Errorf("profiles inspect: %w", err)
+				}
+
+				requestCtx, cancel := contextWithTimeout(ctx)
+				defer cancel()
+
+				if err := crossCheckProfile(requestCtx, client, profile, idValue, result); err != nil {
+					return fmt.Errorf("profiles inspect: %w", err)
+				}
+			}
+
+			return printOutput(result, *output, *pretty)
+		},
+	}
+}
+
+func isCertificateFile(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".cer", ".crt", ".pem", ".der":
+		return true
+	default:
+		return false
+	}
+}
+
+func inspectCertificateFile(ctx context.Context, path string, offline bool, output string, pretty bool) error {
+	cert, err := shared.ReadCertificateFile(path)
+	if err != nil {
+		return fmt.Errorf("profiles inspect: %w", err)
+	}
+	result := &asc.CertificateInspectResult{
+		File:                      path,
+		ProfileInspectCertificate: buildProfileInspectCertificate(*cert, time.Now()),
+	}
+
+	if !offline {
+		client, err := getASCClient()
+		if err != nil {
+			return fmt.Errorf("profiles inspect: %w", err)
+		}
+
+		requestCtx, cancel := contextWithTimeout(ctx)
+		defer cancel()
+
+		serials, err := fetchCertificateSerials(requestCtx, client)
+		if err != nil {
+			return fmt.Errorf("profiles inspect: %w", err)
+		}
+		if issue := matchCertificateSerial(&result.ProfileInspectCertificate, serials); issue != "" {
+			result.Issues = append(result.Issues, issue)
+		}
+	}
+
+	return printOutput(result, output, pretty)
+}
+
+func buildProfileInspectResult(path string, profile *shared.ProvisioningProfile, now time.Time) *asc.ProfileInspectResult {
+	result := &asc.ProfileInspectResult{
+		File:                  path,
+		Name:                  profile.Name,
+		UUID:                  profile.UUID,
+		TeamName:              profile.TeamName,
+		AppIDName:             profile.AppIDName,
+		ApplicationIdentifier: profile.ApplicationIdentifier(),
+		Platforms:             profile.Platform,
+		CreationDate:          formatProfileTime(profile.CreationDate),
+		ExpirationDate:        formatProfileTime(profile.ExpirationDate),
+		Expired:               !profile.ExpirationDate.IsZero() && !profile.ExpirationDate.After(now),
+		ProvisionsAllDevices:  profile.ProvisionsAllDevices,
+		Devices:               profile.ProvisionedDevices,
+		Entitlements:          profile.Entitlements,
+		Certificates:          []asc.ProfileInspectCertificate{},
+	}
+	if len(profile.TeamIdentifier) > 0 {
+		result.TeamID = profile.TeamIdentifier[0]
+	}
+	if result.Devices == nil {
+		result.Devices = []string{}
+	}
+	if result.Entitlements == nil {
+		result.Entitlements = map[string]interface{}{}
+	}
+	for _, cert := range profile.Certificates() {
+		result.Certificates = append(result.Certificates, buildProfileInspectCertificate(cert, now))
+	}
+	return result
+}
+
+func buildProfileInspectCertificate(cert shared.ProvisioningCertificate, now time.Time) asc.ProfileInspectCertificate {
+	return asc.ProfileInspectCertificate{
+		CommonName:     cert.CommonName,
+		SerialNumber:   cert.SerialNumber,
+		SHA1:           cert.SHA1,
+		SHA256:         cert.SHA256,
+		ExpirationDate: formatProfileTime(cert.ExpirationDate),
+		Expired:        !cert.ExpirationDate.IsZero() && !cert.ExpirationDate.After(now),
+	}
+}
+
+// matchCertificateSerial records the App Store Connect ID of cert and returns
+// an issue when its serial number is unknown.
+func matchCertificateSerial(cert *asc.ProfileInspectCertificate, serials map[string]string) string {
+	if certID, ok := serials[normalizeCertificateSerial(cert.SerialNumber)]; ok && cert.SerialNumber != "" {
+		cert.ASCID = certID
+		cert.ASCStatus = profileInspectCertActive
+		return ""
+	}
+	cert.ASCStatus = profileInspectCertNotFound
+	return fmt.Sprintf("certificate %q (serial %s) is not in App Store Connect (revoked or from another team)", cert.CommonName, cert.SerialNumber)
+}
+
+// crossCheckProfile compares the decoded profile with App Store Connect and
+// records differences as issues rather than failing the command.
+func crossCheckProfile(ctx context.Context, client *asc.Client, profile *shared.ProvisioningProfile, id string, result *asc.ProfileInspectResult) error {
+	remote := &asc.ProfileInspectRemote{}
+	result.AppStoreConnect = remote
+
+	var match *asc.Resource[asc.ProfileAttributes]
+	if id != "" {
+		resp, err := client.GetProfile(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to fetch profile: %w", err)
+		}
+		match = &resp.Data
+	} else {
+		found, err := findProfileByUUID(ctx, client, profile.UUID)
+		if err != nil {
+			return err
+		}
+		match = found
+	}
+
+	if match == nil {
+		remote.Issues = append(remote.Issues, fmt.Sprintf("profile %s was not found in App Store Connect (deleted or regenerated)", profile.UUID))
+	} else {
+		attrs := match.Attributes
+		remote.Found = true
+		remote.ID = match.ID
+		remote.Name = attrs.Name
+		remote.ProfileType = attrs.ProfileType
+		remote.State = string(attrs.ProfileState)
+		remote.ExpirationDate = attrs.ExpirationDate
+
+		if attrs.UUID != "" && !strings.EqualFold(attrs.UUID, profile.UUID) {
+			remote.Issues = append(remote.Issues, fmt.Sprintf("local UUID %s does not match App Store Connect UUID %s", profile.UUID, attrs.UUID))
+		}
+		if attrs.ProfileState != "" && attrs.ProfileState != asc.ProfileStateActive {
+			remote.Issues = append(remote.Issues, fmt.Sprintf("profile state is %s", attrs.ProfileState))
+		}
+		if remoteExpiry, ok := shared.ParseSigningDate(attrs.ExpirationDate); ok && !profile.ExpirationDate.IsZero() {
+			if diff := remoteExpiry.Sub(profile.ExpirationDate); diff > time.Minute || diff < -time.Minute {
+				remote.Issues = append(remote.Issues, fmt.Sprintf("expiration date differs (local %s, App Store Connect %s)", result.ExpirationDate, attrs.ExpirationDate))
+			}
+		}
+	}
+
+	if len(result.Certificates) == 0 {
+		return nil
+	}
+	serials, err := fetchCertificateSerials(ctx, client)
+	if err != nil {
+		return err
+	}
+	for i := range result.Certificates {
+		if issue := matchCertificateSerial(&result.Certificates[i], serials); issue != "" {
+			remote.Issues = append(remote.Issues, issue)
+		}
+	}
+	return nil
+}
+
+func findProfileByUUID(ctx context.Context, client *asc.Client, uuid string) (*asc.Resource[asc.ProfileAttributes], error) {
+	firstPage, err := client.GetProfiles(ctx, asc.WithProfilesLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch profiles: %w", err)
+	}
+	all, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetProfiles(ctx, asc.WithProfilesNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch profiles: %w", err)
+	}
+	profiles, ok := all.(*asc.ProfilesResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected profiles response type %T", all)
+	}
+	for i := range profiles.Data {
+		if strings.EqualFold(profiles.Data[i].Attributes.UUID, uuid) {
+			return &profiles.Data[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func fetchCertificateSerials(ctx context.Context, client *asc.Client) (map[string]string, error) {
+	firstPage, err := client.GetCertificates(ctx, asc.WithCertificatesLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch certificates: %w", err)
+	}
+	all, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetCertificates(ctx, asc.WithCertificatesNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch certificates: %w", err)
+	}
+	certificates, ok := all.(*asc.CertificatesResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected certificates response type %T", all)
+	}
+	serials := make(map[string]string, len(certificates.Data))
+	for _, cert := range certificates.Data {
+		if serial := normalizeCertificateSerial(cert.Attributes.SerialNumber); serial != "" {
+			serials[serial] = cert.ID
+		}
+	}
+	return serials, nil
+}
+
+func normalizeCertificateSerial(value string) string {
+	value = strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(value), ":", ""))
+	return strings.TrimLeft(value, "0")
+}
+
+func formatProfileTime(value time.Time) string {
+	if value.IsZero() {
+		return ""
+	}
+	return value.UTC().Format(time.RFC3339)
+}
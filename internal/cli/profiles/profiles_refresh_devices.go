@@ -0,0 +1,395 @@
+package profiles
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	refreshStatusUnchanged    = "unchanged"
+	refreshStatusRefreshed    = "refreshed"
+	refreshStatusWouldRefresh = "would-refresh"
+	refreshStatusFailed       = "failed"
+)
+
+// ProfilesRefreshDevicesCommand returns the profiles refresh-devices subcommand.
+func ProfilesRefreshDevicesCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("refresh-devices", flag.ExitOnError)
+
+	bundleID := fs.String("bundle-id", "", "Bundle identifier (e.g., com.example.app)")
+	profileType := fs.String("profile-type", "", "Development or ad hoc profile type (e.g., IOS_APP_ADHOC)")
+	outputDir := fs.String("output-dir", "./profiles", "Directory for refreshed .mobileprovision files")
+	overwrite := fs.Bool("overwrite", false, "Overwrite existing .mobileprovision files")
+	dryRun := fs.Bool("dry-run", false, "Report which profiles would be refreshed without changes")
+	confirm := fs.Bool("confirm", false, "Confirm deleting and recreating profiles")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "refresh-devices",
+		ShortUsage: "asc profiles refresh-devices --bundle-id BUNDLE_ID --profile-type TYPE [flags]",
+		ShortHelp:  "Recreate profiles with all enabled devices.",
+		LongHelp: `Recreate development and ad hoc profiles with all enabled devices.
+
+Each profile of --profile-type for --bundle-id is compared with the current
+set of ENABLED devices for its platform. Profiles whose device list differs
+are recreated with the same name and certificates, and the new
+.mobileprovision files are written to --output-dir. Files that already hold
+identical content are left in place.
+
+Profile names must be unique, so the replacement is first created under a
+temporary name, the old profile is deleted, and the replacement is recreated
+under the original name. If a step fails the temporary profile is kept and
+named in the output.
+
+Examples:
+  asc profiles refresh-devices --bundle-id "com.example.app" --profile-type IOS_APP_ADHOC --dry-run
+  asc profiles refresh-devices --bundle-id "com.example.app" --profile-type IOS_APP_ADHOC --confirm
+  asc profiles refresh-devices --bundle-id "com.example.app" --profile-type IOS_APP_DEVELOPMENT --output-dir "./signing" --overwrite --confirm`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			bundleValue := strings.TrimSpace(*bundleID)
+			if bundleValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --bundle-id is required")
+				return flag.ErrHelp
+			}
+			profileTypeValue := strings.ToUpper(strings.TrimSpace(*profileType))
+			if profileTypeValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --profile-type is required")
+				return flag.ErrHelp
+			}
+			if !profileTypeUsesDevices(profileTypeValue) {
+				fmt.Fprintln(os.Stderr, "Error: --profile-type must be a development or ad hoc profile type")
+				return flag.ErrHelp
+			}
+			if !*dryRun && !*confirm {
+				fmt.Fprintln(os.Stderr, "Error: --confirm is required (or use --dry-run)")
+				return flag.ErrHelp
+			}
+			dirValue := strings.TrimSpace(*outputDir)
+			if dirValue == "" {
+				dirValue = "./profiles"
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("profiles refresh-devices: %w", err)
+			}
+
+			bundleResourceID, err := resolveBundleIDResource(ctx, client, bundleValue)
+			if err != nil {
+				return fmt.Errorf("profiles refresh-devices: %w", err)
+			}
+
+			platform := devicePlatformForProfileType(profileTypeValue)
+			deviceIDs, err := fetchEnabledDeviceIDs(ctx, client, platform)
+			if err != nil {
+				return fmt.Errorf("profiles refresh-devices: failed to fetch devices: %w", err)
+			}
+
+			profiles, err := fetchBundleProfiles(ctx, client, bundleResourceID, profileTypeValue)
+			if err != nil {
+				return fmt.Errorf("profiles refresh-devices: failed to fetch profiles: %w", err)
+			}
+			if len(profiles) == 0 {
+				return fmt.Errorf("profiles refresh-devices: no %s profiles found for %s", profileTypeValue, bundleValue)
+			}
+
+			result := &asc.ProfileRefreshResult{
+				BundleID:    bundleValue,
+				ProfileType: profileTypeValue,
+				Platform:    platform,
+				DeviceCount: len(deviceIDs),
+				DryRun:      *dryRun,
+				Profiles:    make([]asc.ProfileRefreshItem, 0, len(profiles)),
+			}
+			failed := 0
+			for _, profile := range profiles {
+				item := refreshProfileDevices(ctx, client, profile, bundleResourceID, deviceIDs, profileRefreshOptions{
+					OutputDir: dirValue,
+					Overwrite: *overwrite,
+					DryRun:    *dryRun,
+				})
+				if item.Status == refreshStatusFailed {
+					failed++
+				}
+				result.Profiles = append(result.Profiles, item)
+			}
+
+			if err := printOutput(result, *output, *pretty); err != nil {
+				return err
+			}
+			if failed > 0 {
+				return shared.NewReportedError(fmt.Errorf("profiles refresh-devices: %d profiles failed to refresh", failed))
+			}
+			return nil
+		},
+	}
+}
+
+type profileRefreshOptions struct {
+	OutputDir string
+	Overwrite bool
+	DryRun    bool
+}
+
+// refreshProfileDevices compares one profile's devices with deviceIDs and, when
+// they differ, replaces the profile. Each API call gets its own timeout so a
+// long list of profiles cannot exhaust a shared deadline.
+func refreshProfileDevices(ctx context.Context, client *asc.Client, profile asc.Resource[asc.ProfileAttributes], bundleResourceID string, deviceIDs []string, opts profileRefreshOptions) asc.ProfileRefreshItem {
+	item := asc.ProfileRefreshItem{
+		ID:      profile.ID,
+		Name:    profile.Attributes.Name,
+		Devices: len(deviceIDs),
+	}
+	fail := func(format string, args ...any) asc.ProfileRefreshItem {
+		item.Status = refreshStatusFailed
+		item.Error = fmt.Sprintf(format, args...)
+		return item
+	}
+
+	certCtx, cancel := contextWithTimeout(ctx)
+	certificateIDs, err := shared.FetchProfileCertificateIDs(certCtx, client, profile.ID)
+	cancel()
+	if err != nil {
+		return fail("fetch certificates: %v", err)
+	}
+	item.CertificateIDs = certificateIDs
+	deviceCtx, cancel := contextWithTimeout(ctx)
+	currentDevices, err := shared.FetchProfileDeviceIDs(deviceCtx, client, profile.ID)
+	cancel()
+	if err != nil {
+		return fail("fetch devices: %v", err)
+	}
+	item.PreviousDevices = len(currentDevices)
+
+	content := profile.Attributes.ProfileContent
+	if sameIDs(currentDevices, deviceIDs) {
+		item.Status = refreshStatusUnchanged
+	} else if opts.DryRun {
+		item.Status = refreshStatusWouldRefresh
+		return item
+	} else {
+		// Profile names must be unique and profiles cannot be renamed, so the
+		// replacement is first created under a temporary name. The old profile
+		// is only deleted once that succeeds, which means a failure at any step
+		// still leaves a usable profile behind.
+		tempName := temporaryProfileName(profile.Attributes.Name, time.Now())
+		temp, err := createRefreshedProfile(ctx, client, tempName, profile.Attributes.ProfileType, bundleResourceID, certificateIDs, deviceIDs)
+		if err != nil {
+			return fail("create profile: %v", err)
+		}
+		content = temp.Data.Attributes.ProfileContent
+
+		deleteCtx, cancel := contextWithTimeout(ctx)
+		err = client.DeleteProfile(deleteCtx, profile.ID)
+		cancel()
+		if err != nil {
+			return fail("temporary profile %q (%s) was created but old profile could not be deleted: %v", tempName, temp.Data.ID, err)
+		}
+
+		created, err := createRefreshedProfile(ctx, client, profile.Attributes.Name, profile.Attributes.ProfileType, bundleResourceID, certificateIDs, deviceIDs)
+		if err != nil {
+			item.NewID = temp.Data.ID
+			return fail("old profile was deleted but the replacement could not be renamed; it is kept as %q: %v", tempName, err)
+		}
+		item.NewID = created.Data.ID
+		content = created.Data.Attributes.ProfileContent
+		item.Status = refreshStatusRefreshed
+
+		deleteCtx, cancel = contextWithTimeout(ctx)
+		err = client.DeleteProfile(deleteCtx, temp.Data.ID)
+		cancel()
+		if err != nil {
+			item.Status = refreshStatusFailed
+			item.Error = fmt.Sprintf("temporary profile %q (%s) could not be deleted: %v", tempName, temp.Data.ID, err)
+		}
+	}
+
+	if opts.DryRun {
+		return item
+	}
+	path, err := writeRefreshedProfile(opts.OutputDir, profile.Attributes.Name, content, opts.Overwrite)
+	if err != nil {
+		return fail("write profile: %v", err)
+	}
+	item.OutputFile = path
+	return item
+}
+
+func createRefreshedProfile(ctx context.Context, client *asc.Client, name, profileType, bundleResourceID string, certificateIDs, deviceIDs []string) (*asc.ProfileResponse, error) {
+	requestCtx, cancel := contextWithTimeout(ctx)
+	defer cancel()
+
+	return client.CreateProfile(requestCtx, asc.ProfileCreateAttributes{
+		Name:        name,
+		ProfileType: profileType,
+	}, bundleResourceID, certificateIDs, deviceIDs)
+}
+
+// temporaryProfileName returns a name that cannot collide with the profile
+// being replaced or with leftovers from an earlier interrupted refresh.
+func temporaryProfileName(name string, now time.Time) string {
+	return fmt.Sprintf("%s (refreshing %s)", name, now.UTC().Format("20060102T150405"))
+}
+
+// writeRefreshedProfile writes a profile to dir. An existing file with the
+// same content is left in place, so re-running against unchanged profiles
+// succeeds without --overwrite.
+func writeRefreshedProfile(dir, name, content string, overwrite bool) (string, error) {
+	decoded, err := decodeProfileContent(content)
+	if err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, profileFileName(name)+".mobileprovision")
+	if existing, err := readExistingProfile(path); err == nil && bytes.Equal(existing, decoded) {
+		return path, nil
+	}
+	if overwrite {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return "", err
+		}
+	}
+	if err := shared.WriteProfileFile(path, decoded); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+func readExistingProfile(path string) ([]byte, error) {
+	file, err := shared.OpenExistingNoFollow(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return io.ReadAll(file)
+}
+
+func resolveBundleIDResource(ctx context.Context, client *asc.Client, identifier string) (string, error) {
+	requestCtx, cancel := contextWithTimeout(ctx)
+	defer cancel()
+
+	resp, err := client.GetBundleIDs(requestCtx, asc.WithBundleIDsFilterIdentifier(identifier))
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch bundle ID: %w", err)
+	}
+	for _, item := range resp.Data {
+		if strings.EqualFold(item.Attributes.Identifier, identifier) {
+			return item.ID, nil
+		}
+	}
+	return "", fmt.Errorf("bundle ID %q not found", identifier)
+}
+
+func fetchEnabledDeviceIDs(ctx context.Context, client *asc.Client, platform string) ([]string, error) {
+	requestCtx, cancel := contextWithTimeout(ctx)
+	defer cancel()
+
+	firstPage, err := client.GetDevices(requestCtx,
+		asc.WithDevicesPlatform(platform),
+		asc.WithDevicesStatus("ENABLED"),
+		asc.WithDevicesLimit(200),
+	)
+	if err != nil {
+		return nil, err
+	}
+	all, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetDevices(ctx, asc.WithDevicesNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, err
+	}
+	devices, ok := all.(*asc.DevicesResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected devices response type %T", all)
+	}
+	ids := make([]string, 0, len(devices.Data))
+	for _, device := range devices.Data {
+		ids = append(ids, device.ID)
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// fetchBundleProfiles lists the bundle ID's profiles and keeps those of profileType.
+func fetchBundleProfiles(ctx context.Context, client *asc.Client, bundleResourceID, profileType string) ([]asc.Resource[asc.ProfileAttributes], error) {
+	requestCtx, cancel := contextWithTimeout(ctx)
+	defer cancel()
+
+	firstPage, err := client.GetBundleIDProfiles(requestCtx, bundleResourceID, asc.WithBundleIDProfilesLimit(200))
+	if err != nil {
+		return nil, err
+	}
+	all, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetBundleIDProfiles(ctx, bundleResourceID, asc.WithBundleIDProfilesNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, err
+	}
+	profiles, ok := all.(*asc.ProfilesResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected profiles response type %T", all)
+	}
+
+	matched := make([]asc.Resource[asc.ProfileAttributes], 0)
+	for _, profile := range profiles.Data {
+		if strings.EqualFold(profile.Attributes.ProfileType, profileType) {
+			matched = append(matched, profile)
+		}
+	}
+	return matched, nil
+}
+
+func profileTypeUsesDevices(profileType string) bool {
+	return strings.Contains(profileType, "DEVELOPMENT") || strings.Contains(profileType, "ADHOC")
+}
+
+// devicePlatformForProfileType maps a profile type to the device platform
+// whose devices it can include. Mac and Mac Catalyst profiles use Mac
+// devices; everything else uses IOS devices.
+func devicePlatformForProfileType(profileType string) string {
+	if strings.HasPrefix(profileType, "MAC_") {
+		return "MAC_OS"
+	}
+	return "IOS"
+}
+
+func sameIDs(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	sortedA := append([]string(nil), a...)
+	sortedB := append([]string(nil), b...)
+	sort.Strings(sortedA)
+	sort.Strings(sortedB)
+	for i := range sortedA {
+		if sortedA[i] != sortedB[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func profileFileName(name string) string {
+	clean := strings.TrimSpace(name)
+	clean = strings.NewReplacer("/", "_", "\\", "_", ":", "_").Replace(clean)
+	if clean == "" || clean == "." || clean == ".." {
+		return "profile"
+	}
+	return clean
+}
@@ -47,10 +47,11 @@ func PublishTestFlightCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("publish testflight", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App Store Connect app ID (required, or ASC_APP_ID env)")
-	ipaPath := fs.String("ipa", "", "Path to .ipa file (required)")
-	version := fs.String("version", "", "CFBundleShortVersionString (auto-extracted from IPA if not provided)")
-	buildNumber := fs.String("build-number", "", "CFBundleVersion (auto-extracted from IPA if not provided)")
-	platform := fs.String("platform", "IOS", "Platform: IOS, MAC_OS, TV_OS, VISION_OS")
+	ipaPath := fs.String("ipa", "", "Path to .ipa file (required unless --pkg)")
+	pkgPath := fs.String("pkg", "", "Path to macOS .pkg product archive (MAC_OS builds)")
+	version := fs.String("version", "", "CFBundleShortVersionString (auto-extracted from the IPA or pkg if not provided)")
+	buildNumber := fs.String("build-number", "", "CFBundleVersion (auto-extracted from the IPA or pkg if not provided)")
+	platform := fs.String("platform", "", "Platform: IOS, MAC_OS, TV_OS, VISION_OS (auto-detected from the IPA or pkg if not provided)")
 	groupIDs := fs.String("group", "", "Beta group ID(s), comma-separated")
 	notify := fs.Bool("notify", false, "Notify testers after adding to groups")
 	wait := fs.Bool("wait", false, "Wait for build processing to complete")
@@ -67,8 +68,10 @@ func PublishTestFlightCommand() *ffcli.Command {
 		ShortHelp:  "Upload and distribute to TestFlight.",
 		LongHelp: `Upload IPA and distribute to TestFlight beta groups.
 
+Use --pkg instead of --ipa to upload a macOS product archive (MAC_OS).
+
 Steps:
-1. Upload IPA to App Store Connect
+1. Upload IPA or pkg to App Store Connect
 2. Wait for processing (if --wait)
 3. Add build to specified beta groups
 4. Optionally notify testers
@@ -76,6 +79,7 @@ Steps:
 Examples:
   asc publish testflight --app "123" --ipa app.ipa --group "GROUP_ID"
   asc publish testflight --app "123" --ipa app.ipa --group "G1,G2" --wait --notify
+  asc publish testflight --app "123" --pkg app.pkg --group "GROUP_ID"
   asc publish testflight --app "123" --ipa app.ipa --group "GROUP_ID" --test-notes "Test instructions" --locale "en-US" --wait`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
@@ -85,8 +89,8 @@ Examples:
 				fmt.Fprintf(os.Stderr, "Error: --app is required (or set ASC_APP_ID)\n\n")
 				return flag.ErrHelp
 			}
-			if strings.TrimSpace(*ipaPath) == "" {
-				fmt.Fprintf(os.Stderr, "Error: --ipa is required\n\n")
+			if strings.TrimSpace(*ipaPath) == "" && strings.TrimSpace(*pkgPath) == "" {
+				fmt.Fprintf(os.Stderr, "Error: --ipa or --pkg is required\n\n")
 				return flag.ErrHelp
 			}
 
@@ -119,20 +123,16 @@ Examples:
 				return fmt.Errorf("publish testflight: --timeout must be greater than 0")
 			}
 
-			normalizedPlatform, err := normalizeSubmitPlatform(*platform)
+			artifact, err := shared.ResolveBuildArtifact(*ipaPath, *pkgPath)
 			if err != nil {
 				return fmt.Errorf("publish testflight: %w", err)
 			}
-
-			fileInfo, err := validateIPAPath(*ipaPath)
-			if err != nil {
-				return fmt.Errorf("publish testflight: %w", err)
-			}
-
-			versionValue, buildNumberValue, err := resolveBundleInfoForIPA(*ipaPath, *version, *buildNumber)
+			artifactInfo, err := artifact.ResolveInfo(*version, *buildNumber, *platform)
 			if err != nil {
 				return fmt.Errorf("publish testflight: %w", err)
 			}
+			versionValue := artifactInfo.Version
+			buildNumberValue := artifactInfo.BuildNumber
 
 			client, err := getASCClient()
 			if err != nil {
@@ -143,9 +143,9 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeoutDuration(ctx, timeoutValue)
 			defer cancel()
 
-			platformValue := asc.Platform(normalizedPlatform)
+			platformValue := artifactInfo.Platform
 			timeoutOverride := *timeout > 0
-			uploadResult, err := uploadBuildAndWaitForID(requestCtx, client, resolvedAppID, *ipaPath, fileInfo, versionValue, buildNumberValue, platformValue, *pollInterval, timeoutValue, timeoutOverride)
+			uploadResult, err := uploadBuildAndWaitForID(requestCtx, client, resolvedAppID, artifact, versionValue, buildNumberValue, platformValue, *pollInterval, timeoutValue, timeoutOverride)
 			if err != nil {
 				return fmt.Errorf("publish testflight: %w", err)
 			}
@@ -188,10 +188,11 @@ func PublishAppStoreCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("publish appstore", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App Store Connect app ID (required, or ASC_APP_ID env)")
-	ipaPath := fs.String("ipa", "", "Path to .ipa file (required)")
-	version := fs.String("version", "", "App Store version string (defaults to IPA version)")
-	buildNumber := fs.String("build-number", "", "CFBundleVersion (auto-extracted from IPA if not provided)")
-	platform := fs.String("platform", "IOS", "Platform: IOS, MAC_OS, TV_OS, VISION_OS")
+	ipaPath := fs.String("ipa", "", "Path to .ipa file (required unless --pkg)")
+	pkgPath := fs.String("pkg", "", "Path to macOS .pkg product archive (MAC_OS builds)")
+	version := fs.String("version", "", "App Store version string (defaults to the IPA or pkg version)")
+	buildNumber := fs.String("build-number", "", "CFBundleVersion (auto-extracted from the IPA or pkg if not provided)")
+	platform := fs.String("platform", "", "Platform: IOS, MAC_OS, TV_OS, VISION_OS (auto-detected from the IPA or pkg if not provided)")
 	submit := fs.Bool("submit", false, "Submit for review after attaching build")
 	confirm := fs.Bool("confirm", false, "Confirm submission (required with --submit)")
 	wait := fs.Bool("wait", false, "Wait for build processing")
@@ -206,8 +207,10 @@ func PublishAppStoreCommand() *ffcli.Command {
 		ShortHelp:  "Upload and submit to App Store.",
 		LongHelp: `Upload IPA, attach to version, and optionally submit for review.
 
+Use --pkg instead of --ipa to upload a macOS product archive (MAC_OS).
+
 Steps:
-1. Upload IPA to App Store Connect
+1. Upload IPA or pkg to App Store Connect
 2. Wait for processing (if --wait)
 3. Find or create App Store version
 4. Attach build to version
@@ -215,7 +218,8 @@ Steps:
 
 Examples:
   asc publish appstore --app "123" --ipa app.ipa --version 1.2.3
-  asc publish appstore --app "123" --ipa app.ipa --version 1.2.3 --submit --confirm`,
+  asc publish appstore --app "123" --ipa app.ipa --version 1.2.3 --submit --confirm
+  asc publish appstore --app "123" --pkg app.pkg --submit --confirm`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -229,8 +233,8 @@ Examples:
 				fmt.Fprintf(os.Stderr, "Error: --app is required (or set ASC_APP_ID)\n\n")
 				return flag.ErrHelp
 			}
-			if strings.TrimSpace(*ipaPath) == "" {
-				fmt.Fprintf(os.Stderr, "Error: --ipa is required\n\n")
+			if strings.TrimSpace(*ipaPath) == "" && strings.TrimSpace(*pkgPath) == "" {
+				fmt.Fprintf(os.Stderr, "Error: --ipa or --pkg is required\n\n")
 				return flag.ErrHelp
 			}
 			if *pollInterval <= 0 {
@@ -240,20 +244,16 @@ Examples:
 				return fmt.Errorf("publish appstore: --timeout must be greater than 0")
 			}
 
-			normalizedPlatform, err := normalizeSubmitPlatform(*platform)
+			artifact, err := shared.ResolveBuildArtifact(*ipaPath, *pkgPath)
 			if err != nil {
 				return fmt.Errorf("publish appstore: %w", err)
 			}
-
-			fileInfo, err := validateIPAPath(*ipaPath)
-			if err != nil {
-				return fmt.Errorf("publish appstore: %w", err)
-			}
-
-			versionValue, buildNumberValue, err := resolveBundleInfoForIPA(*ipaPath, *version, *buildNumber)
+			artifactInfo, err := artifact.ResolveInfo(*version, *buildNumber, *platform)
 			if err != nil {
 				return fmt.Errorf("publish appstore: %w", err)
 			}
+			versionValue := artifactInfo.Version
+			buildNumberValue := artifactInfo.BuildNumber
 
 			client, err := getASCClient()
 			if err != nil {
@@ -264,9 +264,9 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeoutDuration(ctx, timeoutValue)
 			defer cancel()
 
-			platformValue := asc.Platform(normalizedPlatform)
+			platformValue := artifactInfo.Platform
 			timeoutOverride := *timeout > 0
-			uploadResult, err := uploadBuildAndWaitForID(requestCtx, client, resolvedAppID, *ipaPath, fileInfo, versionValue, buildNumberValue, platformValue, *pollInterval, timeoutValue, timeoutOverride)
+			uploadResult, err := uploadBuildAndWaitForID(requestCtx, client, resolvedAppID, artifact, versionValue, buildNumberValue, platformValue, *pollInterval, timeoutValue, timeoutOverride)
 			if err != nil {
 				return fmt.Errorf("publish appstore: %w", err)
 			}
@@ -326,8 +326,8 @@ type publishUploadResult struct {
 	BuildNumber string
 }
 
-func uploadBuildAndWaitForID(ctx context.Context, client *asc.Client, appID, ipaPath string, fileInfo os.FileInfo, version, buildNumber string, platform asc.Platform, pollInterval time.Duration, uploadTimeout time.Duration, overrideUploadTimeout bool) (*publishUploadResult, error) {
-	_, fileResp, err := prepareBuildUpload(ctx, client, appID, fileInfo, version, buildNumber, platform)
+func uploadBuildAndWaitForID(ctx context.Context, client *asc.Client, appID string, artifact *shared.BuildArtifact, version, buildNumber string, platform asc.Platform, pollInterval time.Duration, uploadTimeout time.Duration, overrideUploadTimeout bool) (*publishUploadResult, error) {
+	_, fileResp, err := prepareBuildUpload(ctx, client, appID, artifact, version, buildNumber, platform)
 	if err != nil {
 		return nil, err
 	}
@@ -337,7 +337,7 @@ func uploadBuildAndWaitForID(ctx context.Context, client *asc.Client, appID, ipa
 	}
 
 	uploadCtx, uploadCancel := contextWithPublishUploadTimeout(ctx, uploadTimeout, overrideUploadTimeout)
-	err = asc.ExecuteUploadOperations(uploadCtx, ipaPath, fileResp.Data.Attributes.UploadOperations)
+	err = asc.ExecuteUploadOperations(uploadCtx, artifact.Path, fileResp.Data.Attributes.UploadOperations)
 	uploadCancel()
 	if err != nil {
 		return nil, err
@@ -379,56 +379,7 @@ func contextWithPublishUploadTimeout(ctx context.Context, timeout time.Duration,
 	return contextWithUploadTimeout(ctx)
 }
 
-func validateIPAPath(ipaPath string) (os.FileInfo, error) {
-	fileInfo, err := os.Stat(ipaPath)
-	if err != nil {
-		return nil, fmt.Errorf("failed to stat IPA: %w", err)
-	}
-	if fileInfo.IsDir() {
-		return nil, fmt.Errorf("--ipa must be a file")
-	}
-	return fileInfo, nil
-}
-
-func resolveBundleInfoForIPA(ipaPath, version, buildNumber string) (string, string, error) {
-	versionValue := strings.TrimSpace(version)
-	buildNumberValue := strings.TrimSpace(buildNumber)
-	if versionValue == "" || buildNumberValue == "" {
-		info, err := shared.ExtractBundleInfoFromIPA(ipaPath)
-		if err != nil {
-			missingFlags := make([]string, 0, 2)
-			if versionValue == "" {
-				missingFlags = append(missingFlags, "--version")
-			}
-			if buildNumberValue == "" {
-				missingFlags = append(missingFlags, "--build-number")
-			}
-			return "", "", fmt.Errorf("%s required (failed to extract from IPA: %w)", strings.Join(missingFlags, " and "), err)
-		}
-		if versionValue == "" {
-			versionValue = info.Version
-		}
-		if buildNumberValue == "" {
-			buildNumberValue = info.BuildNumber
-		}
-	}
-	if versionValue == "" || buildNumberValue == "" {
-		missingFields := make([]string, 0, 2)
-		missingFlags := make([]string, 0, 2)
-		if versionValue == "" {
-			missingFields = append(missingFields, "CFBundleShortVersionString")
-			missingFlags = append(missingFlags, "--version")
-		}
-		if buildNumberValue == "" {
-			missingFields = append(missingFields, "CFBundleVersion")
-			missingFlags = append(missingFlags, "--build-number")
-		}
-		return "", "", fmt.Errorf("Info.plist missing %s; provide %s", strings.Join(missingFields, " and "), strings.Join(missingFlags, " and "))
-	}
-	return versionValue, buildNumberValue, nil
-}
-
-func prepareBuildUpload(ctx context.Context, client *asc.Client, appID string, fileInfo os.FileInfo, version, buildNumber string, platform asc.Platform) (*asc.BuildUploadResponse, *asc.BuildUploadFileResponse, error) {
+func prepareBuildUpload(ctx context.Context, client *asc.Client, appID string, artifact *shared.BuildArtifact, version, buildNumber string, platform asc.Platform) (*asc.BuildUploadResponse, *asc.BuildUploadFileResponse, error) {
 	uploadReq := asc.BuildUploadCreateRequest{
 		Data: asc.BuildUploadCreateData{
 			Type: asc.ResourceTypeBuildUploads,
@@ -454,9 +405,9 @@ func prepareBuildUpload(ctx context.Context, client *asc.Client, appID string, f
 		Data: asc.BuildUploadFileCreateData{
 			Type: asc.ResourceTypeBuildUploadFiles,
 			Attributes: asc.BuildUploadFileAttributes{
-				FileName:  fileInfo.Name(),
-				FileSize:  fileInfo.Size(),
-				UTI:       asc.UTIIPA,
+				FileName:  artifact.FileInfo.Name(),
+				FileSize:  artifact.FileInfo.Size(),
+				UTI:       artifact.UTI(),
 				AssetType: asc.AssetTypeAsset,
 			},
 			Relationships: &asc.BuildUploadFileRelationships{
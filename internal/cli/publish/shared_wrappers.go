@@ -49,10 +49,6 @@ func parseCommaSeparatedIDs(value string) []string {
 	return shared.SplitCSV(value)
 }
 
-func normalizeSubmitPlatform(value string) (string, error) {
-	return shared.NormalizeAppStoreVersionPlatform(value)
-}
-
 func contextWithUploadTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
 	return shared.ContextWithUploadTimeout(ctx)
 }
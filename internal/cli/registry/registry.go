@@ -20,15 +20,18 @@ import (
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/categories"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/certificates"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/crashes"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/dev"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/devices"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/encryption"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/eula"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/export"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/feedback"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/finance"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/gamecenter"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/iap"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/install"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/localizations"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/metadata"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/migrate"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/nominations"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/offercodes"
@@ -77,6 +80,7 @@ func Subcommands(version string) []*ffcli.Command {
 		analytics.AnalyticsCommand(),
 		performance.PerformanceCommand(),
 		finance.FinanceCommand(),
+		export.ExportCommand(),
 		apps.AppsCommand(),
 		apps.AppSetupCommand(),
 		apps.AppTagsCommand(),
@@ -100,6 +104,7 @@ func Subcommands(version string) []*ffcli.Command {
 		preorders.PreOrdersCommand(),
 		prerelease.PreReleaseVersionsCommand(),
 		localizations.LocalizationsCommand(),
+		metadata.MetadataCommand(),
 		assets.AssetsCommand(),
 		buildlocalizations.BuildLocalizationsCommand(),
 		testflight.BetaGroupsCommand(),
@@ -116,6 +121,7 @@ func Subcommands(version string) []*ffcli.Command {
 		encryption.EncryptionCommand(),
 		migrate.MigrateCommand(),
 		gamecenter.GameCenterCommand(),
+		dev.DevCommand(),
 		VersionCommand(version),
 	}
 }
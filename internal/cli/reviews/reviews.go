@@ -43,7 +43,9 @@ Examples:
   asc reviews --app "123456789" --sort -createdDate --limit 5
   asc reviews --next "<links.next>"
   asc reviews --app "123456789" --paginate
+  asc reviews digest --app "123456789" --since 7d
   asc reviews respond --review-id "REVIEW_ID" --response "Thanks!"
+  asc reviews respond --app "123456789" --batch responses.yaml --dry-run
   asc reviews response get --id "RESPONSE_ID"
   asc reviews response delete --id "RESPONSE_ID" --confirm
   asc reviews response for-review --review-id "REVIEW_ID"`,
@@ -51,6 +53,7 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			ReviewsListCommand(),
+			ReviewsDigestCommand(),
 			ReviewsRespondCommand(),
 			ReviewsResponseCommand(),
 		},
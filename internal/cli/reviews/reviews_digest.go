@@ -0,0 +1,336 @@
+package reviews
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+const reviewDigestUnknownVersion = "unknown"
+
+// ReviewsDigestCommand returns the reviews digest subcommand.
+func ReviewsDigestCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("digest", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	since := fs.String("since", "7d", "Window to digest: a duration like 7d, 2w or 48h, or a date (YYYY-MM-DD)")
+	versions := fs.Int("versions", 5, "Attribute reviews to the N most recent App Store versions (0 to skip)")
+	top := fs.Int("top", 10, "Maximum keywords and phrases to report")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "digest",
+		ShortUsage: "asc reviews digest [flags]",
+		ShortHelp:  "Summarize recent customer reviews.",
+		LongHelp: `Summarize recent customer reviews.
+
+Groups reviews created within --since by rating, territory and app version,
+and lists recurring keywords and phrases found by counting terms across
+review titles and bodies. The analysis runs locally; review text is not sent
+anywhere. Each review is flagged when it already has a developer response.
+
+Reviews are matched to versions by listing reviews on each of the --versions
+most recent App Store versions; others are reported under "unknown".
+
+Examples:
+  asc reviews digest --app "123456789"
+  asc reviews digest --app "123456789" --since 30d --output table
+  asc reviews digest --app "123456789" --since 2026-01-01 --versions 0 --top 20`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID := resolveAppID(*appID)
+			if resolvedAppID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
+				return flag.ErrHelp
+			}
+			cutoff, err := parseReviewsSince(*since, time.Now())
+			if err != nil {
+				return fmt.Errorf("reviews digest: %w", err)
+			}
+			if *versions < 0 {
+				return fmt.Errorf("reviews digest: --versions must not be negative")
+			}
+			if *top < 1 {
+				return fmt.Errorf("reviews digest: --top must be at least 1")
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("reviews digest: %w", err)
+			}
+
+			reviews, err := fetchReviewsSince(ctx, client, resolvedAppID, cutoff)
+			if err != nil {
+				return fmt.Errorf("reviews digest: %w", err)
+			}
+			versionsByReview, err := fetchReviewVersions(ctx, client, resolvedAppID, cutoff, *versions)
+			if err != nil {
+				return fmt.Errorf("reviews digest: %w", err)
+			}
+
+			digest := buildReviewDigest(reviews, versionsByReview, *top)
+			digest.AppID = resolvedAppID
+			digest.Since = cutoff.UTC().Format(time.RFC3339)
+			return printOutput(digest, *output, *pretty)
+		},
+	}
+}
+
+// parseReviewsSince resolves a --since value to the earliest creation time to
+// include. Durations accept d and w suffixes in addition to Go durations.
+func parseReviewsSince(value string, now time.Time) (time.Time, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if value == "" {
+		return time.Time{}, fmt.Errorf("--since is required")
+	}
+	if date, err := time.Parse("2006-01-02", value); err == nil {
+		return date, nil
+	}
+	for suffix, unit := range map[string]time.Duration{"d": 24 * time.Hour, "w": 7 * 24 * time.Hour} {
+		if number, ok := strings.CutSuffix(value, suffix); ok {
+			count, err := strconv.Atoi(number)
+			if err != nil || count <= 0 {
+				return time.Time{}, fmt.Errorf("--since must be a duration like 7d, 2w or 48h, or a date (YYYY-MM-DD)")
+			}
+			return now.Add(-time.Duration(count) * unit), nil
+		}
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration <= 0 {
+		return time.Time{}, fmt.Errorf("--since must be a duration like 7d, 2w or 48h, or a date (YYYY-MM-DD)")
+	}
+	return now.Add(-duration), nil
+}
+
+// fetchReviewsSince lists the app's reviews newest first, with their response
+// links, stopping at the first review created before since. Each page gets
+// its own timeout.
+func fetchReviewsSince(ctx context.Context, client *asc.Client, appID string, since time.Time) ([]asc.Resource[asc.ReviewAttributes], error) {
+	return collectReviewsSince(since, func(next string) (*asc.ReviewsResponse, error) {
+		requestCtx, cancel := contextWithTimeout(ctx)
+		defer cancel()
+
+		if next != "" {
+			return client.GetReviews(requestCtx, appID, asc.WithNextURL(next))
+		}
+		return client.GetReviews(requestCtx, appID,
+			asc.WithReviewSort("-createdDate"),
+			asc.WithReviewInclude([]string{"response"}),
+			asc.WithLimit(200),
+		)
+	})
+}
+
+// fetchReviewVersions maps review IDs created since the cutoff to the version
+// string of the App Store version they were left on, checking the limit most
+// recently created versions.
+func fetchReviewVersions(ctx context.Context, client *asc.Client, appID string, since time.Time, limit int) (map[string]string, error) {
+	versionsByReview := map[string]string{}
+	if limit == 0 {
+		return versionsByReview, nil
+	}
+
+	listCtx, cancel := contextWithTimeout(ctx)
+	defer cancel()
+
+	firstPage, err := client.GetAppStoreVersions(listCtx, appID, asc.WithAppStoreVersionsLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch versions: %w", err)
+	}
+	paginated, err := asc.PaginateAll(listCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetAppStoreVersions(ctx, appID, asc.WithAppStoreVersionsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch versions: %w", err)
+	}
+	versions, ok := paginated.(*asc.AppStoreVersionsResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected versions response type %T", paginated)
+	}
+
+	sorted := append([]asc.Resource[asc.AppStoreVersionAttributes](nil), versions.Data...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Attributes.CreatedDate > sorted[j].Attributes.CreatedDate
+	})
+	if len(sorted) > limit {
+		sorted = sorted[:limit]
+	}
+
+	for _, version := range sorted {
+		reviews, err := collectReviewsSince(since, func(next string) (*asc.ReviewsResponse, error) {
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			if next != "" {
+				return client.GetAppStoreVersionReviews(requestCtx, version.ID, asc.WithNextURL(next))
+			}
+			return client.GetAppStoreVersionReviews(requestCtx, version.ID, asc.WithReviewSort("-createdDate"), asc.WithLimit(200))
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch reviews for version %s: %w", version.Attributes.VersionString, err)
+		}
+		for _, review := range reviews {
+			versionsByReview[review.ID] = version.Attributes.VersionString
+		}
+	}
+	return versionsByReview, nil
+}
+
+// collectReviewsSince pages through reviews sorted newest first until one is
+// older than since. Reviews with an unparseable creation date are kept.
+func collectReviewsSince(since time.Time, fetch func(next string) (*asc.ReviewsResponse, error)) ([]asc.Resource[asc.ReviewAttributes], error) {
+	var (
+		all  []asc.Resource[asc.ReviewAttributes]
+		next string
+		seen = make(map[string]bool)
+	)
+	for {
+		resp, err := fetch(next)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch reviews: %w", err)
+		}
+		for _, review := range resp.Data {
+			created, err := time.Parse(time.RFC3339, review.Attributes.CreatedDate)
+			if err == nil && created.Before(since) {
+				return all, nil
+			}
+			all = append(all, review)
+		}
+		if resp.Links.Next == "" {
+			return all, nil
+		}
+		if seen[resp.Links.Next] {
+			return nil, fmt.Errorf("detected repeated review pagination URL")
+		}
+		seen[resp.Links.Next] = true
+		next = resp.Links.Next
+	}
+}
+
+type reviewDigestGroupCounter struct {
+	groups map[string]*asc.ReviewDigestGroup
+	totals map[string]int
+}
+
+func newReviewDigestGroupCounter() *reviewDigestGroupCounter {
+	return &reviewDigestGroupCounter{groups: map[string]*asc.ReviewDigestGroup{}, totals: map[string]int{}}
+}
+
+func (c *reviewDigestGroupCounter) add(key string, rating int, responded bool) {
+	group, ok := c.groups[key]
+	if !ok {
+		group = &asc.ReviewDigestGroup{Key: key}
+		c.groups[key] = group
+	}
+	group.Count++
+	c.totals[key] += rating
+	if responded {
+		group.Responded++
+	}
+}
+
+// sorted returns the groups ordered by less, or by count then key when less is nil.
+func (c *reviewDigestGroupCounter) sorted(less func(a, b asc.ReviewDigestGroup) bool) []asc.ReviewDigestGroup {
+	groups := make([]asc.ReviewDigestGroup, 0, len(c.groups))
+	for key, group := range c.groups {
+		group.AverageRating = roundRating(float64(c.totals[key]) / float64(group.Count))
+		groups = append(groups, *group)
+	}
+	if less == nil {
+		less = func(a, b asc.ReviewDigestGroup) bool {
+			if a.Count != b.Count {
+				return a.Count > b.Count
+			}
+			return a.Key < b.Key
+		}
+	}
+	sort.Slice(groups, func(i, j int) bool { return less(groups[i], groups[j]) })
+	return groups
+}
+
+// buildReviewDigest groups reviews and extracts their recurring terms.
+func buildReviewDigest(reviews []asc.Resource[asc.ReviewAttributes], versionsByReview map[string]string, top int) *asc.ReviewDigest {
+	digest := &asc.ReviewDigest{
+		Total:   len(reviews),
+		Reviews: make([]asc.ReviewDigestReview, 0, len(reviews)),
+	}
+	ratings := newReviewDigestGroupCounter()
+	territories := newReviewDigestGroupCounter()
+	versions := newReviewDigestGroupCounter()
+	keywordCounter := newReviewTermCounter()
+	phraseCounter := newReviewTermCounter()
+	reviewKeywords := make([]map[string]bool, 0, len(reviews))
+	ratingTotal := 0
+
+	for _, review := range reviews {
+		attrs := review.Attributes
+		responseID := asc.ReviewResponseID(review)
+		responded := responseID != ""
+		version := versionsByReview[review.ID]
+		if version == "" {
+			version = reviewDigestUnknownVersion
+		}
+		territory := strings.ToUpper(strings.TrimSpace(attrs.Territory))
+
+		ratingTotal += attrs.Rating
+		if responded {
+			digest.Responded++
+		}
+		ratings.add(strconv.Itoa(attrs.Rating), attrs.Rating, responded)
+		territories.add(territory, attrs.Rating, responded)
+		versions.add(version, attrs.Rating, responded)
+
+		keywords, phrases := reviewTerms(attrs)
+		keywordCounter.add(keywords, attrs.Rating)
+		phraseCounter.add(phrases, attrs.Rating)
+		reviewKeywords = append(reviewKeywords, keywords)
+
+		digest.Reviews = append(digest.Reviews, asc.ReviewDigestReview{
+			ID:          review.ID,
+			Rating:      attrs.Rating,
+			Title:       attrs.Title,
+			Body:        attrs.Body,
+			Nickname:    attrs.ReviewerNickname,
+			Territory:   territory,
+			Version:     version,
+			CreatedDate: attrs.CreatedDate,
+			Responded:   responded,
+			ResponseID:  responseID,
+		})
+	}
+
+	if len(reviews) > 0 {
+		digest.AverageRating = roundRating(float64(ratingTotal) / float64(len(reviews)))
+	}
+	digest.Ratings = ratings.sorted(func(a, b asc.ReviewDigestGroup) bool { return a.Key > b.Key })
+	digest.Territories = territories.sorted(nil)
+	digest.Versions = versions.sorted(nil)
+	digest.Keywords = keywordCounter.top(top)
+	digest.Phrases = phraseCounter.top(top)
+
+	// Tag each review with the digest keywords it mentions, for triage.
+	for i, keywords := range reviewKeywords {
+		for _, keyword := range digest.Keywords {
+			if keywords[keyword.Term] {
+				digest.Reviews[i].Keywords = append(digest.Reviews[i].Keywords, keyword.Term)
+			}
+		}
+	}
+	return digest
+}
+
+func roundRating(value float64) float64 {
+	return math.Round(value*100) / 100
+}
@@ -0,0 +1,234 @@
+package reviews
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	reviewBatchStatusPlanned   = "planned"
+	reviewBatchStatusResponded = "responded"
+	reviewBatchStatusFailed    = "failed"
+)
+
+// reviewResponseRules is the --batch file: rules are tried in order and the
+// first one matching a review supplies its response.
+type reviewResponseRules struct {
+	Rules []reviewResponseRule `yaml:"rules"`
+}
+
+// reviewResponseRule matches reviews by rating, keyword and territory. Empty
+// criteria match every review; keywords match if any one appears in the title
+// or body as whole words.
+type reviewResponseRule struct {
+	Name        string   `yaml:"name"`
+	Ratings     []int    `yaml:"ratings"`
+	Keywords    []string `yaml:"keywords"`
+	Territories []string `yaml:"territories"`
+	Response    string   `yaml:"response"`
+
+	template *template.Template
+}
+
+// reviewResponseData is the data available to response templates.
+type reviewResponseData struct {
+	Nickname  string
+	Title     string
+	Body      string
+	Rating    int
+	Territory string
+}
+
+func loadReviewResponseRules(path string) (*reviewResponseRules, error) {
+	file, err := shared.OpenExistingNoFollow(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return nil, err
+	}
+	var rules reviewResponseRules
+	if err := yaml.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("invalid YAML in %s: %w", path, err)
+	}
+	if len(rules.Rules) == 0 {
+		return nil, fmt.Errorf("%s defines no rules", path)
+	}
+
+	for i := range rules.Rules {
+		rule := &rules.Rules[i]
+		if strings.TrimSpace(rule.Name) == "" {
+			rule.Name = fmt.Sprintf("rule %d", i+1)
+		}
+		if strings.TrimSpace(rule.Response) == "" {
+			return nil, fmt.Errorf("%s: response is required", rule.Name)
+		}
+		for _, rating := range rule.Ratings {
+			if rating < 1 || rating > 5 {
+				return nil, fmt.Errorf("%s: ratings must be between 1 and 5", rule.Name)
+			}
+		}
+		tmpl, err := template.New(rule.Name).Option("missingkey=error").Parse(rule.Response)
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid response template: %w", rule.Name, err)
+		}
+		// Render once so references to unknown fields fail before anything is posted.
+		if err := tmpl.Execute(io.Discard, reviewResponseData{}); err != nil {
+			return nil, fmt.Errorf("%s: invalid response template: %w", rule.Name, err)
+		}
+		rule.template = tmpl
+	}
+	return &rules, nil
+}
+
+func (r *reviewResponseRule) matches(review asc.ReviewAttributes) bool {
+	if len(r.Ratings) > 0 {
+		found := false
+		for _, rating := range r.Ratings {
+			found = found || rating == review.Rating
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(r.Territories) > 0 {
+		found := false
+		for _, territory := range r.Territories {
+			found = found || strings.EqualFold(strings.TrimSpace(territory), strings.TrimSpace(review.Territory))
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(r.Keywords) > 0 {
+		text := review.Title + "\n" + review.Body
+		for _, keyword := range r.Keywords {
+			if containsReviewPhrase(text, keyword) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func (r *reviewResponseRule) render(review asc.ReviewAttributes) (string, error) {
+	var buf bytes.Buffer
+	err := r.template.Execute(&buf, reviewResponseData{
+		Nickname:  review.ReviewerNickname,
+		Title:     review.Title,
+		Body:      review.Body,
+		Rating:    review.Rating,
+		Territory: review.Territory,
+	})
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// match returns the first rule matching review, or nil.
+func (r *reviewResponseRules) match(review asc.ReviewAttributes) *reviewResponseRule {
+	for i := range r.Rules {
+		if r.Rules[i].matches(review) {
+			return &r.Rules[i]
+		}
+	}
+	return nil
+}
+
+// executeReviewsRespondBatch answers reviews created since the cutoff that
+// have no response yet, using the first matching rule from the batch file.
+func executeReviewsRespondBatch(ctx context.Context, appID, batchPath, since string, dryRun bool, output string, pretty bool) error {
+	rules, err := loadReviewResponseRules(batchPath)
+	if err != nil {
+		return fmt.Errorf("reviews respond: %w", err)
+	}
+	cutoff, err := parseReviewsSince(since, time.Now())
+	if err != nil {
+		return fmt.Errorf("reviews respond: %w", err)
+	}
+
+	client, err := getASCClient()
+	if err != nil {
+		return fmt.Errorf("reviews respond: %w", err)
+	}
+
+	reviews, err := fetchReviewsSince(ctx, client, appID, cutoff)
+	if err != nil {
+		return fmt.Errorf("reviews respond: %w", err)
+	}
+
+	result := &asc.ReviewResponseBatchResult{
+		AppID:   appID,
+		Since:   cutoff.UTC().Format(time.RFC3339),
+		DryRun:  dryRun,
+		Reviews: len(reviews),
+		Items:   []asc.ReviewResponseBatchItem{},
+	}
+	for _, review := range reviews {
+		if asc.ReviewResponseID(review) != "" {
+			result.AlreadyResponded++
+			continue
+		}
+		rule := rules.match(review.Attributes)
+		if rule == nil {
+			result.Unmatched++
+			continue
+		}
+
+		item := asc.ReviewResponseBatchItem{
+			ReviewID:  review.ID,
+			Rating:    review.Attributes.Rating,
+			Territory: review.Attributes.Territory,
+			Rule:      rule.Name,
+			Status:    reviewBatchStatusPlanned,
+		}
+		body, err := rule.render(review.Attributes)
+		switch {
+		case err != nil:
+			item.Status, item.Error = reviewBatchStatusFailed, err.Error()
+		case body == "":
+			item.Status, item.Error = reviewBatchStatusFailed, "response template rendered empty"
+		default:
+			item.Response = body
+		}
+
+		if item.Status == reviewBatchStatusPlanned && !dryRun {
+			createCtx, cancel := contextWithTimeout(ctx)
+			resp, err := client.CreateCustomerReviewResponse(createCtx, review.ID, body)
+			cancel()
+			if err != nil {
+				item.Status, item.Error = reviewBatchStatusFailed, err.Error()
+			} else {
+				item.Status, item.ResponseID = reviewBatchStatusResponded, resp.Data.ID
+				result.Responded++
+			}
+		}
+		if item.Status == reviewBatchStatusFailed {
+			result.Failed++
+		}
+		result.Items = append(result.Items, item)
+	}
+
+	if err := printOutput(result, output, pretty); err != nil {
+		return err
+	}
+	if result.Failed > 0 {
+		return shared.NewReportedError(fmt.Errorf("reviews respond: %d of %d responses failed", result.Failed, len(result.Items)))
+	}
+	return nil
+}
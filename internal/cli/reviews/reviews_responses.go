@@ -16,8 +16,12 @@ import (
 func ReviewsRespondCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("respond", flag.ExitOnError)
 
-	reviewID := fs.String("review-id", "", "Customer review ID (required)")
-	response := fs.String("response", "", "Response body text (required)")
+	reviewID := fs.String("review-id", "", "Customer review ID (required unless --batch)")
+	response := fs.String("response", "", "Response body text (required unless --batch)")
+	batch := fs.String("batch", "", "YAML file of response rules to apply to unanswered reviews")
+	appID := fs.String("app", "", "App Store Connect app ID for --batch (or ASC_APP_ID env)")
+	since := fs.String("since", "7d", "With --batch, only answer reviews created within this window (e.g. 7d, 48h, 2026-01-01)")
+	dryRun := fs.Bool("dry-run", false, "With --batch, show the responses that would be posted without posting them")
 	output := fs.String("output", "json", "Output format: json (default), table, markdown")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
@@ -30,12 +34,47 @@ func ReviewsRespondCommand() *ffcli.Command {
 This command creates a developer response to a customer review on the App Store.
 Responses are visible to all App Store users.
 
+With --batch, reviews created within --since that have no response yet are
+answered from a YAML rules file. Rules are tried in order and the first match
+wins; a rule may filter on ratings, keywords (any one, matched as whole words
+in the title or body) and territories. Responses are Go templates with
+{{.Nickname}}, {{.Title}}, {{.Body}}, {{.Rating}} and {{.Territory}}:
+
+  rules:
+    - name: crashes
+      ratings: [1, 2]
+      keywords: [crash, crashes, freezes]
+      response: Sorry about the crashes, {{.Nickname}}. Please update to the latest version.
+    - name: thanks
+      ratings: [5]
+      response: Thank you for the kind review!
+
+Run with --dry-run first to review the planned responses.
+
 Examples:
   asc reviews respond --review-id "REVIEW_ID" --response "Thanks for your feedback!"
-  asc reviews respond --review-id "REVIEW_ID" --response "We appreciate your review." --output table`,
+  asc reviews respond --review-id "REVIEW_ID" --response "We appreciate your review." --output table
+  asc reviews respond --app "123456789" --batch responses.yaml --dry-run --output table
+  asc reviews respond --app "123456789" --batch responses.yaml --since 3d`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
+			if strings.TrimSpace(*batch) != "" {
+				if strings.TrimSpace(*reviewID) != "" || strings.TrimSpace(*response) != "" {
+					fmt.Fprintln(os.Stderr, "Error: --batch cannot be combined with --review-id or --response")
+					return flag.ErrHelp
+				}
+				resolvedAppID := resolveAppID(*appID)
+				if resolvedAppID == "" {
+					fmt.Fprintln(os.Stderr, "Error: --app is required with --batch (or set ASC_APP_ID)")
+					return flag.ErrHelp
+				}
+				return executeReviewsRespondBatch(ctx, resolvedAppID, strings.TrimSpace(*batch), *since, *dryRun, *output, *pretty)
+			}
+			if *dryRun {
+				fmt.Fprintln(os.Stderr, "Error: --dry-run requires --batch")
+				return flag.ErrHelp
+			}
 			if strings.TrimSpace(*reviewID) == "" {
 				fmt.Fprintln(os.Stderr, "Error: --review-id is required")
 				return flag.ErrHelp
@@ -0,0 +1,132 @@
+package reviews
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+var reviewWordPattern = regexp.MustCompile(`[\p{L}\p{N}']+`)
+
+// reviewStopwords are words too common in reviews to say anything about them.
+var reviewStopwords = map[string]bool{}
+
+func init() {
+	for _, word := range strings.Fields(`
+		a about above after again against all also am an and any app apps are as at
+		be because been before being below between both but by can could
+		did do does doing done down during each even ever every few for from
+		get gets got had has have having he her here hers him his how however
+		i if in into is it its itself just like made make makes many me more most
+		much my myself no nor not now of off on once one only or other our ours out
+		over own please really same she should so some still such than that the
+		their theirs them then there these they this those through to too under
+		until up us use used using very was way we well were what when where which
+		while who whom why will with would yet you your yours
+		it's i'm i've i'd i'll don't doesn't didn't isn't wasn't can't won't
+		that's there's you're they're we're
+	`) {
+		reviewStopwords[word] = true
+	}
+}
+
+// reviewTokens lowercases text and splits it into words, keeping apostrophes
+// inside words so contractions stay whole.
+func reviewTokens(text string) []string {
+	words := reviewWordPattern.FindAllString(strings.ToLower(text), -1)
+	tokens := words[:0]
+	for _, word := range words {
+		word = strings.Trim(word, "'")
+		if word != "" {
+			tokens = append(tokens, word)
+		}
+	}
+	return tokens
+}
+
+// isReviewKeyword reports whether a token carries meaning on its own.
+func isReviewKeyword(token string) bool {
+	if len([]rune(token)) < 3 || reviewStopwords[token] {
+		return false
+	}
+	return strings.IndexFunc(token, func(r rune) bool { return !unicode.IsDigit(r) }) >= 0
+}
+
+// reviewTerms returns the distinct keywords and phrases (runs of two or three
+// adjacent keywords) in a review's title and body.
+func reviewTerms(review asc.ReviewAttributes) (keywords, phrases map[string]bool) {
+	keywords = map[string]bool{}
+	phrases = map[string]bool{}
+	for _, text := range []string{review.Title, review.Body} {
+		tokens := reviewTokens(text)
+		for i, token := range tokens {
+			if !isReviewKeyword(token) {
+				continue
+			}
+			keywords[token] = true
+			for n := 2; n <= 3 && i+n <= len(tokens); n++ {
+				run := tokens[i : i+n]
+				if !isReviewKeyword(run[n-1]) {
+					break
+				}
+				phrases[strings.Join(run, " ")] = true
+			}
+		}
+	}
+	return keywords, phrases
+}
+
+type reviewTermCounter struct {
+	counts  map[string]int
+	ratings map[string]int
+}
+
+func newReviewTermCounter() *reviewTermCounter {
+	return &reviewTermCounter{counts: map[string]int{}, ratings: map[string]int{}}
+}
+
+func (c *reviewTermCounter) add(terms map[string]bool, rating int) {
+	for term := range terms {
+		c.counts[term]++
+		c.ratings[term] += rating
+	}
+}
+
+// top returns up to limit terms mentioned in at least two reviews, most
+// frequent first.
+func (c *reviewTermCounter) top(limit int) []asc.ReviewDigestTerm {
+	terms := make([]asc.ReviewDigestTerm, 0)
+	for term, count := range c.counts {
+		if count < 2 {
+			continue
+		}
+		terms = append(terms, asc.ReviewDigestTerm{
+			Term:          term,
+			Count:         count,
+			AverageRating: roundRating(float64(c.ratings[term]) / float64(count)),
+		})
+	}
+	sort.Slice(terms, func(i, j int) bool {
+		if terms[i].Count != terms[j].Count {
+			return terms[i].Count > terms[j].Count
+		}
+		return terms[i].Term < terms[j].Term
+	})
+	if limit > 0 && len(terms) > limit {
+		terms = terms[:limit]
+	}
+	return terms
+}
+
+// containsReviewPhrase reports whether text contains phrase as whole words,
+// ignoring case and punctuation.
+func containsReviewPhrase(text, phrase string) bool {
+	want := strings.Join(reviewTokens(phrase), " ")
+	if want == "" {
+		return false
+	}
+	return strings.Contains(" "+strings.Join(reviewTokens(text), " ")+" ", " "+want+" ")
+}
@@ -0,0 +1,136 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+const assetPollInterval = 2 * time.Second
+
+// AssetUploader wires the resource-specific reserve, commit, and state calls
+// into UploadImageAsset.
+type AssetUploader struct {
+	Reserve func(ctx context.Context, fileName string, fileSize int64) (string, []asc.UploadOperation, error)
+	Commit  func(ctx context.Context, assetID, checksum string) error
+	State   func(ctx context.Context, assetID string) (*asc.AssetDeliveryState, error)
+}
+
+// UploadedAsset describes an image asset after App Store Connect processed it.
+type UploadedAsset struct {
+	ID       string
+	FileName string
+	FileSize int64
+	State    string
+}
+
+// UploadImageAsset validates an image, reserves an upload, sends the file
+// parts, commits the checksum, and waits for delivery to finish.
+func UploadImageAsset(ctx context.Context, filePath string, uploader AssetUploader) (*UploadedAsset, error) {
+	if err := asc.ValidateImageFile(filePath); err != nil {
+		return nil, err
+	}
+
+	file, err := OpenExistingNoFollow(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	checksum, err := asc.ComputeChecksumFromReader(file, asc.ChecksumAlgorithmMD5)
+	if err != nil {
+		return nil, err
+	}
+
+	assetID, operations, err := uploader.Reserve(ctx, info.Name(), info.Size())
+	if err != nil {
+		return nil, fmt.Errorf("failed to reserve upload: %w", err)
+	}
+	if len(operations) == 0 {
+		return nil, fmt.Errorf("no upload operations returned for %q", info.Name())
+	}
+
+	if err := asc.UploadAssetFromFile(ctx, file, info.Size(), operations); err != nil {
+		return nil, err
+	}
+
+	if err := uploader.Commit(ctx, assetID, checksum.Hash); err != nil {
+		return nil, fmt.Errorf("failed to commit upload: %w", err)
+	}
+
+	state, err := WaitForAssetDeliveryState(ctx, assetID, func(ctx context.Context) (*asc.AssetDeliveryState, error) {
+		return uploader.State(ctx, assetID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &UploadedAsset{
+		ID:       assetID,
+		FileName: info.Name(),
+		FileSize: info.Size(),
+		State:    state,
+	}, nil
+}
+
+// WaitForAssetDeliveryState polls an uploaded asset until App Store Connect
+// reports COMPLETE or FAILED, returning the last observed state.
+func WaitForAssetDeliveryState(ctx context.Context, assetID string, fetch func(context.Context) (*asc.AssetDeliveryState, error)) (string, error) {
+	ticker := time.NewTicker(assetPollInterval)
+	defer ticker.Stop()
+
+	var lastState string
+	for {
+		state, err := fetch(ctx)
+		if err != nil {
+			return lastState, err
+		}
+		if state != nil {
+			lastState = state.State
+			switch strings.ToUpper(state.State) {
+			case "COMPLETE":
+				return state.State, nil
+			case "FAILED":
+				return state.State, fmt.Errorf("asset %s delivery failed: %s", assetID, formatAssetErrors(state.Errors))
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return lastState, fmt.Errorf("timed out waiting for asset %s delivery: %w", assetID, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+func formatAssetErrors(errors []asc.ErrorDetail) string {
+	if len(errors) == 0 {
+		return "unknown error"
+	}
+	parts := make([]string, 0, len(errors))
+	for _, item := range errors {
+		if item.Code != "" && item.Message != "" {
+			parts = append(parts, fmt.Sprintf("%s: %s", item.Code, item.Message))
+			continue
+		}
+		if item.Message != "" {
+			parts = append(parts, item.Message)
+			continue
+		}
+		if item.Code != "" {
+			parts = append(parts, item.Code)
+		}
+	}
+	if len(parts) == 0 {
+		return "unknown error"
+	}
+	return strings.Join(parts, "; ")
+}
@@ -0,0 +1,160 @@
+package shared
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// BuildArtifact is the local build file passed to --ipa or --pkg.
+type BuildArtifact struct {
+	Path     string
+	Pkg      bool
+	FileInfo os.FileInfo
+}
+
+// BuildArtifactInfo holds the upload attributes resolved from flags and the artifact.
+type BuildArtifactInfo struct {
+	BundleID    string
+	Version     string
+	BuildNumber string
+	Platform    asc.Platform
+}
+
+// ResolveBuildArtifact validates --ipa and --pkg. It returns nil when neither
+// is set so callers can report the missing flag with their usage text.
+func ResolveBuildArtifact(ipaPath, pkgPath string) (*BuildArtifact, error) {
+	ipaPath = strings.TrimSpace(ipaPath)
+	pkgPath = strings.TrimSpace(pkgPath)
+	if ipaPath != "" && pkgPath != "" {
+		return nil, fmt.Errorf("--ipa and --pkg are mutually exclusive")
+	}
+	if ipaPath == "" && pkgPath == "" {
+		return nil, nil
+	}
+
+	artifact := &BuildArtifact{Path: ipaPath}
+	if pkgPath != "" {
+		artifact = &BuildArtifact{Path: pkgPath, Pkg: true}
+	}
+	fileInfo, err := os.Stat(artifact.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat %s: %w", artifact.Kind(), err)
+	}
+	if fileInfo.IsDir() {
+		return nil, fmt.Errorf("%s must be a file", artifact.Flag())
+	}
+	artifact.FileInfo = fileInfo
+	return artifact, nil
+}
+
+// Kind returns a display name for the artifact type.
+func (a *BuildArtifact) Kind() string {
+	if a.Pkg {
+		return "pkg"
+	}
+	return "IPA"
+}
+
+// Flag returns the flag the artifact was passed with.
+func (a *BuildArtifact) Flag() string {
+	if a.Pkg {
+		return "--pkg"
+	}
+	return "--ipa"
+}
+
+// UTI returns the uniform type identifier for the build upload file.
+func (a *BuildArtifact) UTI() asc.UTI {
+	if a.Pkg {
+		return asc.UTIPKG
+	}
+	return asc.UTIIPA
+}
+
+// BundleInfo reads the app's bundle ID, version and build number from the artifact.
+func (a *BuildArtifact) BundleInfo() (IPABundleInfo, error) {
+	if a.Pkg {
+		return ExtractBundleInfoFromPkg(a.Path)
+	}
+	return ExtractBundleInfoFromIPA(a.Path)
+}
+
+// ResolveInfo combines the --version, --build-number and --platform flags with
+// values read from the artifact. The artifact is only required to be readable
+// when a version or build number is missing. Without --platform, .pkg uploads
+// are MAC_OS and IPAs use CFBundleSupportedPlatforms, falling back to IOS.
+func (a *BuildArtifact) ResolveInfo(version, buildNumber, platform string) (BuildArtifactInfo, error) {
+	resolved := BuildArtifactInfo{
+		Version:     strings.TrimSpace(version),
+		BuildNumber: strings.TrimSpace(buildNumber),
+	}
+	platformValue := strings.TrimSpace(platform)
+
+	var detectedPlatform string
+	if resolved.Version == "" || resolved.BuildNumber == "" {
+		info, err := a.BundleInfo()
+		if err != nil {
+			missingFlags := make([]string, 0, 2)
+			if resolved.Version == "" {
+				missingFlags = append(missingFlags, "--version")
+			}
+			if resolved.BuildNumber == "" {
+				missingFlags = append(missingFlags, "--build-number")
+			}
+			return BuildArtifactInfo{}, fmt.Errorf("%s required (failed to extract from %s: %w)", strings.Join(missingFlags, " and "), a.Kind(), err)
+		}
+		if resolved.Version == "" {
+			resolved.Version = info.Version
+		}
+		if resolved.BuildNumber == "" {
+			resolved.BuildNumber = info.BuildNumber
+		}
+		resolved.BundleID = info.BundleID
+		detectedPlatform = info.Platform
+	} else if platformValue == "" && !a.Pkg {
+		if info, err := a.BundleInfo(); err == nil {
+			resolved.BundleID = info.BundleID
+			detectedPlatform = info.Platform
+		}
+	}
+
+	if resolved.Version == "" || resolved.BuildNumber == "" {
+		missingFields := make([]string, 0, 2)
+		missingFlags := make([]string, 0, 2)
+		if resolved.Version == "" {
+			missingFields = append(missingFields, "CFBundleShortVersionString")
+			missingFlags = append(missingFlags, "--version")
+		}
+		if resolved.BuildNumber == "" {
+			missingFields = append(missingFields, "CFBundleVersion")
+			missingFlags = append(missingFlags, "--build-number")
+		}
+		source := "Info.plist"
+		if a.Pkg {
+			source = "pkg"
+		}
+		return BuildArtifactInfo{}, fmt.Errorf("%s missing %s; provide %s", source, strings.Join(missingFields, " and "), strings.Join(missingFlags, " and "))
+	}
+
+	switch {
+	case platformValue != "":
+		normalized, err := NormalizePlatform(platformValue)
+		if err != nil {
+			return BuildArtifactInfo{}, err
+		}
+		if a.Pkg && normalized != asc.PlatformMacOS {
+			return BuildArtifactInfo{}, fmt.Errorf("--pkg uploads require --platform MAC_OS")
+		}
+		resolved.Platform = normalized
+	case a.Pkg:
+		resolved.Platform = asc.PlatformMacOS
+	case detectedPlatform != "":
+		resolved.Platform = asc.Platform(detectedPlatform)
+	default:
+		resolved.Platform = asc.PlatformIOS
+	}
+	return resolved, nil
+}
@@ -34,7 +34,7 @@ func WaitForBuildByNumber(ctx context.Context, client *asc.Client, appID, versio
 	defer ticker.Stop()
 
 	for {
-		build, err := findBuildByNumber(ctx, client, appID, version, buildNumber, platform)
+		build, err := FindBuildByNumber(ctx, client, appID, version, buildNumber, platform)
 		if err != nil {
 			return nil, err
 		}
@@ -50,7 +50,8 @@ func WaitForBuildByNumber(ctx context.Context, client *asc.Client, appID, versio
 	}
 }
 
-func findBuildByNumber(ctx context.Context, client *asc.Client, appID, version, buildNumber, platform string) (*asc.BuildResponse, error) {
+// FindBuildByNumber returns the build matching version and build number, or nil if none exists.
+func FindBuildByNumber(ctx context.Context, client *asc.Client, appID, version, buildNumber, platform string) (*asc.BuildResponse, error) {
 	preReleaseResp, err := client.GetPreReleaseVersions(ctx, appID,
 		asc.WithPreReleaseVersionsVersion(version),
 		asc.WithPreReleaseVersionsPlatform(platform),
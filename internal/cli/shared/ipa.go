@@ -12,8 +12,36 @@ import (
 )
 
 type IPABundleInfo struct {
+	BundleID    string
 	Version     string
 	BuildNumber string
+	// Platform is the App Store Connect platform detected from the artifact
+	// (e.g. IOS, TV_OS), or empty when it cannot be determined.
+	Platform string
+}
+
+// supportedPlatformValues maps CFBundleSupportedPlatforms entries to App Store
+// Connect platforms.
+var supportedPlatformValues = map[string]string{
+	"iPhoneOS":  "IOS",
+	"AppleTVOS": "TV_OS",
+	"XROS":      "VISION_OS",
+	"MacOSX":    "MAC_OS",
+}
+
+// IPANestedBundle is an app extension or watch app embedded in the main app.
+type IPANestedBundle struct {
+	Path      string
+	InfoPlist map[string]interface{}
+}
+
+// IPAContents holds the parts of an IPA needed for pre-upload validation.
+type IPAContents struct {
+	AppPath         string
+	InfoPlist       map[string]interface{}
+	Files           []string
+	EmbeddedProfile []byte
+	NestedBundles   []IPANestedBundle
 }
 
 // ExtractBundleInfoFromIPA reads CFBundleVersion info from an IPA.
@@ -68,11 +96,115 @@ func readBundleInfoFromInfoPlist(file *zip.File) (IPABundleInfo, error) {
 	}
 
 	return IPABundleInfo{
+		BundleID:    coercePlistValueToString(info["CFBundleIdentifier"]),
 		Version:     coercePlistValueToString(info["CFBundleShortVersionString"]),
 		BuildNumber: coercePlistValueToString(info["CFBundleVersion"]),
+		Platform:    platformFromInfoPlist(info),
 	}, nil
 }
 
+func platformFromInfoPlist(info map[string]interface{}) string {
+	values, ok := info["CFBundleSupportedPlatforms"].([]interface{})
+	if !ok {
+		return ""
+	}
+	for _, value := range values {
+		name, ok := value.(string)
+		if !ok {
+			continue
+		}
+		if platform, ok := supportedPlatformValues[name]; ok {
+			return platform
+		}
+	}
+	return ""
+}
+
+// ReadIPAContents reads the top-level app's Info.plist, file list and embedded
+// provisioning profile, plus the Info.plist of every nested .app/.appex bundle.
+func ReadIPAContents(ipaPath string) (*IPAContents, error) {
+	reader, err := zip.OpenReader(ipaPath)
+	if err != nil {
+		return nil, fmt.Errorf("open IPA: %w", err)
+	}
+	defer reader.Close()
+
+	var appInfo *zip.File
+	for _, file := range reader.File {
+		if !file.FileInfo().IsDir() && isTopLevelAppInfoPlist(file.Name) {
+			appInfo = file
+			break
+		}
+	}
+	if appInfo == nil {
+		return nil, fmt.Errorf("Info.plist not found in IPA")
+	}
+
+	contents := &IPAContents{AppPath: path.Dir(path.Clean(appInfo.Name))}
+	if contents.InfoPlist, err = readZipPlist(appInfo); err != nil {
+		return nil, fmt.Errorf("Info.plist: %w", err)
+	}
+
+	prefix := contents.AppPath + "/"
+	for _, file := range reader.File {
+		name := path.Clean(file.Name)
+		if file.FileInfo().IsDir() || !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		relative := strings.TrimPrefix(name, prefix)
+		contents.Files = append(contents.Files, relative)
+
+		switch {
+		case relative == "embedded.mobileprovision":
+			data, err := readZipFile(file)
+			if err != nil {
+				return nil, fmt.Errorf("embedded.mobileprovision: %w", err)
+			}
+			contents.EmbeddedProfile = data
+		case path.Base(relative) == "Info.plist" && isNestedBundleDir(path.Dir(relative)):
+			info, err := readZipPlist(file)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", relative, err)
+			}
+			contents.NestedBundles = append(contents.NestedBundles, IPANestedBundle{
+				Path:      path.Dir(relative),
+				InfoPlist: info,
+			})
+		}
+	}
+	return contents, nil
+}
+
+// PlistString returns a string value from a decoded plist dictionary.
+func PlistString(values map[string]interface{}, key string) string {
+	return coercePlistValueToString(values[key])
+}
+
+func isNestedBundleDir(dir string) bool {
+	return strings.HasSuffix(dir, ".appex") || strings.HasSuffix(dir, ".app")
+}
+
+func readZipFile(file *zip.File) ([]byte, error) {
+	reader, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return io.ReadAll(reader)
+}
+
+func readZipPlist(file *zip.File) (map[string]interface{}, error) {
+	data, err := readZipFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var values map[string]interface{}
+	if _, err := plist.Unmarshal(data, &values); err != nil {
+		return nil, fmt.Errorf("decode plist: %w", err)
+	}
+	return values, nil
+}
+
 func coercePlistValueToString(value interface{}) string {
 	switch v := value.(type) {
 	case string:
@@ -98,6 +98,50 @@ func TestExtractBundleInfoFromIPA_BinaryPlist(t *testing.T) {
 	}
 }
 
+func TestReadIPAContents(t *testing.T) {
+	mainPlist, err := plist.Marshal(map[string]interface{}{"CFBundleIdentifier": "com.example.app"}, plist.XMLFormat)
+	if err != nil {
+		t.Fatalf("marshal plist: %v", err)
+	}
+	widgetPlist, err := plist.Marshal(map[string]interface{}{"CFBundleIdentifier": "com.example.app.widget"}, plist.BinaryFormat)
+	if err != nil {
+		t.Fatalf("marshal plist: %v", err)
+	}
+	ipaPath := writeTestIPA(t, map[string][]byte{
+		"Payload/Demo.app/Info.plist":                          mainPlist,
+		"Payload/Demo.app/Demo":                                []byte("binary"),
+		"Payload/Demo.app/embedded.mobileprovision":            []byte("profile"),
+		"Payload/Demo.app/PlugIns/Widget.appex/Info.plist":     widgetPlist,
+		"Payload/Demo.app/Frameworks/Kit.framework/Info.plist": mainPlist,
+		"Payload/Demo.app/PlugIns/Widget.appex/embedded.plist": []byte("ignored"),
+		"Payload/Demo.app/PlugIns/Widget.appex/Widget":         []byte("binary"),
+		"Symbols/ignored.symbols":                              []byte("ignored"),
+	})
+
+	contents, err := ReadIPAContents(ipaPath)
+	if err != nil {
+		t.Fatalf("ReadIPAContents() error: %v", err)
+	}
+	if contents.AppPath != "Payload/Demo.app" {
+		t.Fatalf("unexpected app path %q", contents.AppPath)
+	}
+	if PlistString(contents.InfoPlist, "CFBundleIdentifier") != "com.example.app" {
+		t.Fatalf("unexpected Info.plist %v", contents.InfoPlist)
+	}
+	if string(contents.EmbeddedProfile) != "profile" {
+		t.Fatalf("unexpected embedded profile %q", contents.EmbeddedProfile)
+	}
+	if len(contents.Files) != 7 {
+		t.Fatalf("expected 7 app files, got %v", contents.Files)
+	}
+	if len(contents.NestedBundles) != 1 || contents.NestedBundles[0].Path != "PlugIns/Widget.appex" {
+		t.Fatalf("unexpected nested bundles %+v", contents.NestedBundles)
+	}
+	if PlistString(contents.NestedBundles[0].InfoPlist, "CFBundleIdentifier") != "com.example.app.widget" {
+		t.Fatalf("unexpected nested Info.plist %v", contents.NestedBundles[0].InfoPlist)
+	}
+}
+
 func writeTestIPA(t *testing.T, files map[string][]byte) string {
 	t.Helper()
 
@@ -145,3 +189,38 @@ func buildInfoPlistWithValues(t *testing.T, versionValue interface{}, buildValue
 	}
 	return data
 }
+
+func TestExtractBundleInfoFromIPA_DetectsPlatform(t *testing.T) {
+	tests := []struct {
+		platforms []interface{}
+		want      string
+	}{
+		{[]interface{}{"iPhoneOS"}, "IOS"},
+		{[]interface{}{"AppleTVOS"}, "TV_OS"},
+		{[]interface{}{"XROS"}, "VISION_OS"},
+		{[]interface{}{"iPhoneSimulator"}, ""},
+		{nil, ""},
+	}
+	for _, test := range tests {
+		values := map[string]interface{}{
+			"CFBundleShortVersionString": "1.0",
+			"CFBundleVersion":            "1",
+		}
+		if test.platforms != nil {
+			values["CFBundleSupportedPlatforms"] = test.platforms
+		}
+		data, err := plist.Marshal(values, plist.XMLFormat)
+		if err != nil {
+			t.Fatalf("marshal plist: %v", err)
+		}
+		ipaPath := writeTestIPA(t, map[string][]byte{"Payload/Demo.app/Info.plist": data})
+
+		info, err := ExtractBundleInfoFromIPA(ipaPath)
+		if err != nil {
+			t.Fatalf("ExtractBundleInfoFromIPA() error: %v", err)
+		}
+		if info.Platform != test.want {
+			t.Fatalf("platforms %v: expected %q, got %q", test.platforms, test.want, info.Platform)
+		}
+	}
+}
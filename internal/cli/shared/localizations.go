@@ -173,6 +173,40 @@ func mapAppInfoLocalizationStrings(attrs asc.AppInfoLocalizationAttributes) map[
 	return values
 }
 
+// VersionLocalizationStrings maps version localization attributes to .strings keys.
+func VersionLocalizationStrings(attrs asc.AppStoreVersionLocalizationAttributes) map[string]string {
+	return mapVersionLocalizationStrings(attrs)
+}
+
+// AppInfoLocalizationStrings maps app info localization attributes to .strings keys.
+func AppInfoLocalizationStrings(attrs asc.AppInfoLocalizationAttributes) map[string]string {
+	return mapAppInfoLocalizationStrings(attrs)
+}
+
+// ValidateVersionLocalizationKeys rejects keys that are not version localization fields.
+func ValidateVersionLocalizationKeys(valuesByLocale map[string]map[string]string) error {
+	return validateLocalizationKeysByLocale(valuesByLocale, buildAllowedKeys(versionLocalizationKeys))
+}
+
+// ValidateAppInfoLocalizationKeys rejects keys that are not app info localization fields.
+func ValidateAppInfoLocalizationKeys(valuesByLocale map[string]map[string]string) error {
+	return validateLocalizationKeysByLocale(valuesByLocale, buildAllowedKeys(appInfoLocalizationKeys))
+}
+
+func validateLocalizationKeysByLocale(valuesByLocale map[string]map[string]string, allowed map[string]bool) error {
+	locales := make([]string, 0, len(valuesByLocale))
+	for locale := range valuesByLocale {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+	for _, locale := range locales {
+		if err := validateLocalizationKeys(locale, valuesByLocale[locale], allowed); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func setIfNotEmpty(values map[string]string, key, value string) {
 	if strings.TrimSpace(value) == "" {
 		return
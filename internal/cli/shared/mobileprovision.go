@@ -0,0 +1,416 @@
+package shared
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"howett.net/plist"
+)
+
+// ProvisioningProfile is the decoded plist payload of a .mobileprovision file.
+type ProvisioningProfile struct {
+	Name                  string                 `plist:"Name"`
+	UUID                  string                 `plist:"UUID"`
+	TeamName              string                 `plist:"TeamName"`
+	TeamIdentifier        []string               `plist:"TeamIdentifier"`
+	AppIDName             string                 `plist:"AppIDName"`
+	Platform              []string               `plist:"Platform"`
+	CreationDate          time.Time              `plist:"CreationDate"`
+	ExpirationDate        time.Time              `plist:"ExpirationDate"`
+	ProvisionedDevices    []string               `plist:"ProvisionedDevices"`
+	ProvisionsAllDevices  bool                   `plist:"ProvisionsAllDevices"`
+	Entitlements          map[string]interface{} `plist:"Entitlements"`
+	DeveloperCertificates [][]byte               `plist:"DeveloperCertificates"`
+}
+
+// ProvisioningCertificate summarizes a developer certificate embedded in a profile.
+type ProvisioningCertificate struct {
+	CommonName     string
+	SerialNumber   string
+	SHA1           string
+	SHA256         string
+	ExpirationDate time.Time
+}
+
+// ReadProvisioningProfile reads and decodes a .mobileprovision file.
+func ReadProvisioningProfile(path string) (*ProvisioningProfile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseProvisioningProfile(data)
+}
+
+// ParseProvisioningProfile decodes the plist embedded in a CMS (PKCS#7)
+// signed provisioning profile. The signature itself is not verified.
+// Unsigned plist data is accepted as-is.
+func ParseProvisioningProfile(data []byte) (*ProvisioningProfile, error) {
+	payload := data
+	if len(data) > 0 && data[0] == 0x30 {
+		content, err := extractCMSContent(data)
+		if err != nil {
+			return nil, fmt.Errorf("decode CMS envelope: %w", err)
+		}
+		payload = content
+	}
+
+	var profile ProvisioningProfile
+	if _, err := plist.Unmarshal(payload, &profile); err != nil {
+		return nil, fmt.Errorf("decode profile plist: %w", err)
+	}
+	if strings.TrimSpace(profile.UUID) == "" {
+		return nil, fmt.Errorf("profile plist is missing UUID")
+	}
+	return &profile, nil
+}
+
+// ApplicationIdentifier returns the application-identifier entitlement.
+func (p *ProvisioningProfile) ApplicationIdentifier() string {
+	for _, key := range []string{"application-identifier", "com.apple.application-identifier"} {
+		if value := coercePlistValueToString(p.Entitlements[key]); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// Profile distribution kinds, matching the suffix of App Store Connect
+// profile types such as IOS_APP_ADHOC.
+const (
+	ProfileDistributionDevelopment = "DEVELOPMENT"
+	ProfileDistributionAdHoc       = "ADHOC"
+	ProfileDistributionInHouse     = "INHOUSE"
+	ProfileDistributionStore       = "STORE"
+)
+
+// Distribution infers how the profile distributes apps: development profiles
+// allow debugging, ad hoc profiles list devices, enterprise profiles cover all
+// devices, and anything else is an App Store (or Developer ID) profile.
+func (p *ProvisioningProfile) Distribution() string {
+	for _, key := range []string{"get-task-allow", "com.apple.security.get-task-allow"} {
+		if allowed, _ := p.Entitlements[key].(bool); allowed {
+			return ProfileDistributionDevelopment
+		}
+	}
+	switch {
+	case len(p.ProvisionedDevices) > 0:
+		return ProfileDistributionAdHoc
+	case p.ProvisionsAllDevices:
+		return ProfileDistributionInHouse
+	default:
+		return ProfileDistributionStore
+	}
+}
+
+// MatchesProfileType reports whether the profile could be of the given App
+// Store Connect profile type (e.g. IOS_APP_STORE, MAC_APP_DIRECT).
+func (p *ProvisioningProfile) MatchesProfileType(profileType string) bool {
+	profileType = strings.ToUpper(strings.TrimSpace(profileType))
+	index := strings.LastIndex(profileType, "_")
+	if index < 0 {
+		return false
+	}
+	prefix, kind := profileType[:index], profileType[index+1:]
+	if kind == "DIRECT" {
+		kind = ProfileDistributionStore
+	}
+	if kind != p.Distribution() {
+		return false
+	}
+	if len(p.Platform) == 0 {
+		return true
+	}
+	platform := "iOS"
+	switch {
+	case strings.HasPrefix(prefix, "MAC"):
+		platform = "OSX"
+	case strings.HasPrefix(prefix, "TVOS"):
+		platform = "tvOS"
+	}
+	for _, value := range p.Platform {
+		if strings.EqualFold(value, platform) {
+			return true
+		}
+	}
+	return false
+}
+
+// ApplicationIdentifierMatches compares an application-identifier entitlement
+// ("TEAMID.com.example.app" or a wildcard like "TEAMID.*") to a bundle ID.
+func ApplicationIdentifierMatches(applicationIdentifier, bundleID string) bool {
+	_, pattern, found := strings.Cut(applicationIdentifier, ".")
+	if !found {
+		return false
+	}
+	if strings.HasSuffix(pattern, "*") {
+		return strings.HasPrefix(bundleID, strings.TrimSuffix(pattern, "*"))
+	}
+	return pattern == bundleID
+}
+
+// Certificates returns fingerprints and metadata for the embedded developer certificates.
+func (p *ProvisioningProfile) Certificates() []ProvisioningCertificate {
+	certificates := make([]ProvisioningCertificate, 0, len(p.DeveloperCertificates))
+	for _, der := range p.DeveloperCertificates {
+		item := describeCertificate(der)
+		if cert, err := x509.ParseCertificate(der); err == nil {
+			fillCertificateDetails(&item, cert)
+		}
+		certificates = append(certificates, item)
+	}
+	return certificates
+}
+
+// ReadCertificateFile reads and decodes a DER or PEM encoded certificate
+// (.cer, .crt, .pem).
+func ReadCertificateFile(path string) (*ProvisioningCertificate, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCertificateFile(data)
+}
+
+// ParseCertificateFile decodes a DER or PEM encoded certificate. For PEM data
+// the first CERTIFICATE block is used.
+func ParseCertificateFile(data []byte) (*ProvisioningCertificate, error) {
+	der := data
+	for rest := data; ; {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type == "CERTIFICATE" {
+			der = block.Bytes
+			break
+		}
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("decode certificate: %w", err)
+	}
+	item := describeCertificate(der)
+	fillCertificateDetails(&item, cert)
+	return &item, nil
+}
+
+func describeCertificate(der []byte) ProvisioningCertificate {
+	sha1Sum := sha1.Sum(der)
+	sha256Sum := sha256.Sum256(der)
+	return ProvisioningCertificate{
+		SHA1:   strings.ToUpper(hex.EncodeToString(sha1Sum[:])),
+		SHA256: strings.ToUpper(hex.EncodeToString(sha256Sum[:])),
+	}
+}
+
+func fillCertificateDetails(item *ProvisioningCertificate, cert *x509.Certificate) {
+	item.CommonName = cert.Subject.CommonName
+	item.SerialNumber = fmt.Sprintf("%X", cert.SerialNumber)
+	item.ExpirationDate = cert.NotAfter.UTC()
+}
+
+var (
+	oidSignedData = []byte{0x2a, 0x86, 0x48, 0x86, 0xf7, 0x0d, 0x01, 0x07, 0x02}
+	oidData       = []byte{0x2a, 0x86, 0x48, 0x86, 0xf7, 0x0d, 0x01, 0x07, 0x01}
+
+	errBERTruncated = errors.New("truncated BER data")
+)
+
+const (
+	berTagOctetString = 0x04
+	berTagOID         = 0x06
+	berTagSequence    = 0x10
+	berClassContext   = 2
+	berMaxDepth       = 32
+)
+
+// berValue is a single BER element. Apple signs profiles with indefinite-length
+// encodings, so encoding/asn1 (DER only) cannot be used directly.
+type berValue struct {
+	class       int
+	tag         int
+	constructed bool
+	content     []byte
+}
+
+// extractCMSContent returns the encapsulated content of a CMS SignedData envelope.
+func extractCMSContent(data []byte) ([]byte, error) {
+	contentInfo, _, err := parseBER(data, 0)
+	if err != nil {
+		return nil, err
+	}
+	if !contentInfo.isUniversal(berTagSequence) {
+		return nil, errors.New("expected ContentInfo sequence")
+	}
+	contentType, rest, err := parseBER(contentInfo.content, 0)
+	if err != nil {
+		return nil, err
+	}
+	if !contentType.isUniversal(berTagOID) || !bytes.Equal(contentType.content, oidSignedData) {
+		return nil, errors.New("content is not PKCS#7 signed data")
+	}
+	explicit, _, err := parseBER(rest, 0)
+	if err != nil {
+		return nil, err
+	}
+	if explicit.class != berClassContext || explicit.tag != 0 {
+		return nil, errors.New("missing signed data content")
+	}
+	signedData, _, err := parseBER(explicit.content, 0)
+	if err != nil {
+		return nil, err
+	}
+	if !signedData.isUniversal(berTagSequence) {
+		return nil, errors.New("expected SignedData sequence")
+	}
+
+	// SignedData: version, digestAlgorithms, encapContentInfo, ...
+	rest = signedData.content
+	for i := 0; i < 2; i++ {
+		if _, rest, err = parseBER(rest, 0); err != nil {
+			return nil, err
+		}
+	}
+	encap, _, err := parseBER(rest, 0)
+	if err != nil {
+		return nil, err
+	}
+	if !encap.isUniversal(berTagSequence) {
+		return nil, errors.New("expected encapsulated content sequence")
+	}
+	eContentType, rest, err := parseBER(encap.content, 0)
+	if err != nil {
+		return nil, err
+	}
+	if !eContentType.isUniversal(berTagOID) || !bytes.Equal(eContentType.content, oidData) {
+		return nil, errors.New("encapsulated content is not data")
+	}
+	eContent, _, err := parseBER(rest, 0)
+	if err != nil {
+		return nil, err
+	}
+	if eContent.class != berClassContext || eContent.tag != 0 {
+		return nil, errors.New("signed data has no embedded content")
+	}
+	octets, _, err := parseBER(eContent.content, 0)
+	if err != nil {
+		return nil, err
+	}
+	return octets.octetString(0)
+}
+
+func (v berValue) isUniversal(tag int) bool {
+	return v.class == 0 && v.tag == tag
+}
+
+// octetString returns the bytes of a primitive or constructed OCTET STRING.
+func (v berValue) octetString(depth int) ([]byte, error) {
+	if !v.isUniversal(berTagOctetString) {
+		return nil, errors.New("expected octet string")
+	}
+	if !v.constructed {
+		return v.content, nil
+	}
+	if depth > berMaxDepth {
+		return nil, errors.New("BER nesting too deep")
+	}
+	var out []byte
+	rest := v.content
+	for len(rest) > 0 {
+		child, next, err := parseBER(rest, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		chunk, err := child.octetString(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, chunk...)
+		rest = next
+	}
+	return out, nil
+}
+
+// parseBER reads one element from data and returns it with the remaining bytes.
+func parseBER(data []byte, depth int) (berValue, []byte, error) {
+	if depth > berMaxDepth {
+		return berValue{}, nil, errors.New("BER nesting too deep")
+	}
+	if len(data) < 2 {
+		return berValue{}, nil, errBERTruncated
+	}
+	value := berValue{
+		class:       int(data[0] >> 6),
+		constructed: data[0]&0x20 != 0,
+		tag:         int(data[0] & 0x1f),
+	}
+	offset := 1
+	if value.tag == 0x1f {
+		value.tag = 0
+		for {
+			if offset >= len(data) {
+				return berValue{}, nil, errBERTruncated
+			}
+			b := data[offset]
+			offset++
+			value.tag = value.tag<<7 | int(b&0x7f)
+			if b&0x80 == 0 {
+				break
+			}
+			if value.tag > 1<<21 {
+				return berValue{}, nil, errors.New("BER tag too large")
+			}
+		}
+	}
+	if offset >= len(data) {
+		return berValue{}, nil, errBERTruncated
+	}
+	lengthByte := data[offset]
+	offset++
+
+	if lengthByte == 0x80 {
+		if !value.constructed {
+			return berValue{}, nil, errors.New("indefinite length on primitive BER element")
+		}
+		rest := data[offset:]
+		for {
+			if len(rest) >= 2 && rest[0] == 0 && rest[1] == 0 {
+				value.content = data[offset : len(data)-len(rest)]
+				return value, rest[2:], nil
+			}
+			_, next, err := parseBER(rest, depth+1)
+			if err != nil {
+				return berValue{}, nil, err
+			}
+			rest = next
+		}
+	}
+
+	length := int(lengthByte)
+	if lengthByte&0x80 != 0 {
+		count := int(lengthByte & 0x7f)
+		if count > 4 || offset+count > len(data) {
+			return berValue{}, nil, errors.New("invalid BER length")
+		}
+		length = 0
+		for _, b := range data[offset : offset+count] {
+			length = length<<8 | int(b)
+		}
+		offset += count
+	}
+	if length < 0 || length > len(data)-offset {
+		return berValue{}, nil, errBERTruncated
+	}
+	value.content = data[offset : offset+length]
+	return value, data[offset+length:], nil
+}
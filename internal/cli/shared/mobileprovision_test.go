@@ -0,0 +1,282 @@
+package shared
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"howett.net/plist"
+)
+
+func TestParseProvisioningProfile_DER(t *testing.T) {
+	certDER := buildTestCertificate(t)
+	payload := buildProfilePlist(t, certDER)
+
+	profile, err := ParseProvisioningProfile(buildCMSDER(payload))
+	if err != nil {
+		t.Fatalf("ParseProvisioningProfile() error: %v", err)
+	}
+	assertTestProfile(t, profile)
+
+	certificates := profile.Certificates()
+	if len(certificates) != 1 {
+		t.Fatalf("expected 1 certificate, got %d", len(certificates))
+	}
+	cert := certificates[0]
+	if cert.CommonName != "Apple Distribution: Example (TEAM123456)" {
+		t.Fatalf("unexpected common name %q", cert.CommonName)
+	}
+	if cert.SerialNumber != "1A2B3C" {
+		t.Fatalf("unexpected serial %q", cert.SerialNumber)
+	}
+	if len(cert.SHA1) != 40 || len(cert.SHA256) != 64 || cert.SHA1 != strings.ToUpper(cert.SHA1) {
+		t.Fatalf("unexpected fingerprints %q %q", cert.SHA1, cert.SHA256)
+	}
+}
+
+func TestParseProvisioningProfile_IndefiniteLengthBER(t *testing.T) {
+	payload := buildProfilePlist(t, buildTestCertificate(t))
+
+	profile, err := ParseProvisioningProfile(buildCMSIndefinite(payload))
+	if err != nil {
+		t.Fatalf("ParseProvisioningProfile() error: %v", err)
+	}
+	assertTestProfile(t, profile)
+}
+
+func TestParseProvisioningProfile_PlainPlist(t *testing.T) {
+	profile, err := ParseProvisioningProfile(buildProfilePlist(t, nil))
+	if err != nil {
+		t.Fatalf("ParseProvisioningProfile() error: %v", err)
+	}
+	assertTestProfile(t, profile)
+}
+
+func TestParseProvisioningProfile_Invalid(t *testing.T) {
+	payload := buildProfilePlist(t, nil)
+	der := buildCMSDER(payload)
+
+	tests := map[string][]byte{
+		"truncated":   der[:len(der)/2],
+		"not signed":  berTLV(0x30, append(berTLV(0x06, oidData), berTLV(0xa0, nil)...)),
+		"not a plist": []byte("hello"),
+	}
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := ParseProvisioningProfile(data); err == nil {
+				t.Fatalf("expected error")
+			}
+		})
+	}
+}
+
+func TestParseCertificateFile(t *testing.T) {
+	der := buildTestCertificate(t)
+	pemData := append([]byte("Bag Attributes\n"), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})...)
+
+	for name, data := range map[string][]byte{"der": der, "pem": pemData} {
+		t.Run(name, func(t *testing.T) {
+			cert, err := ParseCertificateFile(data)
+			if err != nil {
+				t.Fatalf("ParseCertificateFile() error: %v", err)
+			}
+			if cert.CommonName != "Apple Distribution: Example (TEAM123456)" || cert.SerialNumber != "1A2B3C" {
+				t.Fatalf("unexpected certificate %+v", cert)
+			}
+			if len(cert.SHA1) != 40 || cert.ExpirationDate.IsZero() {
+				t.Fatalf("unexpected certificate %+v", cert)
+			}
+		})
+	}
+
+	if _, err := ParseCertificateFile([]byte("not a certificate")); err == nil {
+		t.Fatalf("expected error for invalid certificate")
+	}
+}
+
+func TestApplicationIdentifierMatches(t *testing.T) {
+	tests := []struct {
+		appID    string
+		bundleID string
+		want     bool
+	}{
+		{"TEAM.com.example.app", "com.example.app", true},
+		{"TEAM.com.example.app", "com.example.app2", false},
+		{"TEAM.*", "com.example.app", true},
+		{"TEAM.com.example.*", "com.example.app", true},
+		{"TEAM.com.other.*", "com.example.app", false},
+		{"", "com.example.app", false},
+	}
+	for _, test := range tests {
+		if got := ApplicationIdentifierMatches(test.appID, test.bundleID); got != test.want {
+			t.Fatalf("ApplicationIdentifierMatches(%q, %q) = %t, want %t", test.appID, test.bundleID, got, test.want)
+		}
+	}
+}
+
+func TestProvisioningProfileMatchesProfileType(t *testing.T) {
+	adHoc := &ProvisioningProfile{Platform: []string{"iOS"}, ProvisionedDevices: []string{"UDID-1"}}
+	development := &ProvisioningProfile{Platform: []string{"iOS"}, ProvisionedDevices: []string{"UDID-1"}, Entitlements: map[string]interface{}{"get-task-allow": true}}
+	store := &ProvisioningProfile{Platform: []string{"OSX"}}
+	tests := []struct {
+		profile     *ProvisioningProfile
+		profileType string
+		want        bool
+	}{
+		{adHoc, "IOS_APP_ADHOC", true},
+		{adHoc, "IOS_APP_STORE", false},
+		{adHoc, "TVOS_APP_ADHOC", false},
+		{development, "IOS_APP_DEVELOPMENT", true},
+		{development, "IOS_APP_ADHOC", false},
+		{store, "MAC_APP_STORE", true},
+		{store, "MAC_APP_DIRECT", true},
+		{store, "IOS_APP_STORE", false},
+		{store, "invalid", false},
+	}
+	for _, test := range tests {
+		if got := test.profile.MatchesProfileType(test.profileType); got != test.want {
+			t.Fatalf("MatchesProfileType(%q) on %s profile = %t, want %t", test.profileType, test.profile.Distribution(), got, test.want)
+		}
+	}
+}
+
+func assertTestProfile(t *testing.T, profile *ProvisioningProfile) {
+	t.Helper()
+	if profile.UUID != "11111111-2222-3333-4444-555555555555" {
+		t.Fatalf("unexpected UUID %q", profile.UUID)
+	}
+	if profile.Name != "Example AdHoc" || profile.TeamName != "Example Inc" {
+		t.Fatalf("unexpected profile %+v", profile)
+	}
+	if len(profile.TeamIdentifier) != 1 || profile.TeamIdentifier[0] != "TEAM123456" {
+		t.Fatalf("unexpected team identifier %v", profile.TeamIdentifier)
+	}
+	if len(profile.ProvisionedDevices) != 2 {
+		t.Fatalf("expected 2 devices, got %v", profile.ProvisionedDevices)
+	}
+	if profile.ApplicationIdentifier() != "TEAM123456.com.example.app" {
+		t.Fatalf("unexpected application identifier %q", profile.ApplicationIdentifier())
+	}
+	if profile.ExpirationDate.IsZero() {
+		t.Fatalf("expected expiration date")
+	}
+}
+
+func buildProfilePlist(t *testing.T, certDER []byte) []byte {
+	t.Helper()
+	values := map[string]interface{}{
+		"Name":               "Example AdHoc",
+		"UUID":               "11111111-2222-3333-4444-555555555555",
+		"TeamName":           "Example Inc",
+		"TeamIdentifier":     []string{"TEAM123456"},
+		"AppIDName":          "Example",
+		"Platform":           []string{"iOS"},
+		"CreationDate":       time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		"ExpirationDate":     time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC),
+		"ProvisionedDevices": []string{"UDID-1", "UDID-2"},
+		"Entitlements": map[string]interface{}{
+			"application-identifier": "TEAM123456.com.example.app",
+			"get-task-allow":         false,
+		},
+	}
+	if certDER != nil {
+		values["DeveloperCertificates"] = [][]byte{certDER}
+	}
+	data, err := plist.Marshal(values, plist.XMLFormat)
+	if err != nil {
+		t.Fatalf("marshal plist: %v", err)
+	}
+	return data
+}
+
+func buildTestCertificate(t *testing.T) []byte {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(0x1a2b3c),
+		Subject:      pkix.Name{CommonName: "Apple Distribution: Example (TEAM123456)"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(365 * 24 * time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create certificate: %v", err)
+	}
+	return der
+}
+
+func berTLV(tag byte, content []byte) []byte {
+	var out bytes.Buffer
+	out.WriteByte(tag)
+	switch length := len(content); {
+	case length < 0x80:
+		out.WriteByte(byte(length))
+	case length < 0x100:
+		out.Write([]byte{0x81, byte(length)})
+	case length < 0x10000:
+		out.Write([]byte{0x82, byte(length >> 8), byte(length)})
+	default:
+		out.Write([]byte{0x83, byte(length >> 16), byte(length >> 8), byte(length)})
+	}
+	out.Write(content)
+	return out.Bytes()
+}
+
+func berIndefinite(tag byte, children ...[]byte) []byte {
+	out := []byte{tag, 0x80}
+	for _, child := range children {
+		out = append(out, child...)
+	}
+	return append(out, 0x00, 0x00)
+}
+
+func concatBytes(parts ...[]byte) []byte {
+	var out []byte
+	for _, part := range parts {
+		out = append(out, part...)
+	}
+	return out
+}
+
+func buildCMSDER(payload []byte) []byte {
+	encap := berTLV(0x30, concatBytes(
+		berTLV(0x06, oidData),
+		berTLV(0xa0, berTLV(0x04, payload)),
+	))
+	signedData := berTLV(0x30, concatBytes(
+		berTLV(0x02, []byte{0x01}),
+		berTLV(0x31, nil),
+		encap,
+		berTLV(0x31, nil),
+	))
+	return berTLV(0x30, concatBytes(
+		berTLV(0x06, oidSignedData),
+		berTLV(0xa0, signedData),
+	))
+}
+
+// buildCMSIndefinite mirrors Apple's encoding: indefinite lengths and a
+// constructed, chunked OCTET STRING for the payload.
+func buildCMSIndefinite(payload []byte) []byte {
+	half := len(payload) / 2
+	octets := berIndefinite(0x24, berTLV(0x04, payload[:half]), berTLV(0x04, payload[half:]))
+	encap := berIndefinite(0x30, berTLV(0x06, oidData), berIndefinite(0xa0, octets))
+	signedData := berIndefinite(0x30,
+		berTLV(0x02, []byte{0x01}),
+		berIndefinite(0x31),
+		encap,
+		berIndefinite(0x31),
+	)
+	return berIndefinite(0x30, berTLV(0x06, oidSignedData), berIndefinite(0xa0, signedData))
+}
@@ -0,0 +1,31 @@
+package shared
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// OfferCodeCustomCodeAttributes validates custom code flags for subscription
+// and in-app purchase offer codes.
+func OfferCodeCustomCodeAttributes(code string, quantity int, expirationDate string) (asc.OfferCodeCustomCodeCreateAttributes, error) {
+	attrs := asc.OfferCodeCustomCodeCreateAttributes{
+		CustomCode:    strings.TrimSpace(code),
+		NumberOfCodes: quantity,
+	}
+	if attrs.CustomCode == "" {
+		return attrs, fmt.Errorf("--code is required")
+	}
+	if quantity <= 0 {
+		return attrs, fmt.Errorf("--quantity is required")
+	}
+	if strings.TrimSpace(expirationDate) != "" {
+		normalized, err := NormalizeDate(expirationDate, "--expiration-date")
+		if err != nil {
+			return attrs, err
+		}
+		attrs.ExpirationDate = normalized
+	}
+	return attrs, nil
+}
@@ -0,0 +1,225 @@
+package shared
+
+import (
+	"bytes"
+	"compress/bzip2"
+	"compress/zlib"
+	"encoding/binary"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+)
+
+const (
+	xarMagic           = "xar!"
+	xarHeaderSize      = 28
+	maxXarTOCSize      = 64 << 20
+	maxPkgMetadataSize = 8 << 20
+)
+
+// xarTOC is the XML table of contents of a xar archive (the .pkg container).
+type xarTOC struct {
+	Files []xarFile `xml:"toc>file"`
+}
+
+type xarFile struct {
+	Name  string    `xml:"name"`
+	Type  string    `xml:"type"`
+	Data  *xarData  `xml:"data"`
+	Files []xarFile `xml:"file"`
+}
+
+type xarData struct {
+	Length   int64 `xml:"length"`
+	Offset   int64 `xml:"offset"`
+	Size     int64 `xml:"size"`
+	Encoding struct {
+		Style string `xml:"style,attr"`
+	} `xml:"encoding"`
+}
+
+// pkgBundle is a <bundle> element from a Distribution or PackageInfo file.
+type pkgBundle struct {
+	ID           string `xml:"id,attr"`
+	Path         string `xml:"path,attr"`
+	ShortVersion string `xml:"CFBundleShortVersionString,attr"`
+	Version      string `xml:"CFBundleVersion,attr"`
+}
+
+// ExtractBundleInfoFromPkg reads CFBundleVersion info for the top-level app in
+// a macOS product archive. The Distribution file is used when present, falling
+// back to the PackageInfo of each component package.
+func ExtractBundleInfoFromPkg(pkgPath string) (IPABundleInfo, error) {
+	file, err := os.Open(pkgPath)
+	if err != nil {
+		return IPABundleInfo{}, fmt.Errorf("open pkg: %w", err)
+	}
+	defer file.Close()
+
+	heapOffset, toc, err := readXarTOC(file)
+	if err != nil {
+		return IPABundleInfo{}, err
+	}
+
+	var distribution *xarFile
+	var packageInfos []xarFile
+	walkXarFiles(toc.Files, "", func(name string, entry xarFile) {
+		switch {
+		case name == "Distribution":
+			found := entry
+			distribution = &found
+		case path.Base(name) == "PackageInfo":
+			packageInfos = append(packageInfos, entry)
+		}
+	})
+	if distribution == nil && len(packageInfos) == 0 {
+		return IPABundleInfo{}, fmt.Errorf("Distribution or PackageInfo not found in pkg")
+	}
+
+	candidates := make([]xarFile, 0, len(packageInfos)+1)
+	if distribution != nil {
+		candidates = append(candidates, *distribution)
+	}
+	candidates = append(candidates, packageInfos...)
+	for _, entry := range candidates {
+		data, err := readXarFile(file, heapOffset, entry)
+		if err != nil {
+			return IPABundleInfo{}, fmt.Errorf("read %s: %w", entry.Name, err)
+		}
+		bundles, err := parsePkgBundles(data)
+		if err != nil {
+			return IPABundleInfo{}, fmt.Errorf("parse %s: %w", entry.Name, err)
+		}
+		if bundle, ok := selectPkgAppBundle(bundles); ok {
+			return IPABundleInfo{
+				BundleID:    bundle.ID,
+				Version:     bundle.ShortVersion,
+				BuildNumber: bundle.Version,
+				Platform:    "MAC_OS",
+			}, nil
+		}
+	}
+
+	return IPABundleInfo{}, fmt.Errorf("no .app bundle found in pkg Distribution or PackageInfo")
+}
+
+func readXarTOC(file *os.File) (int64, *xarTOC, error) {
+	header := make([]byte, xarHeaderSize)
+	if _, err := file.ReadAt(header, 0); err != nil {
+		return 0, nil, fmt.Errorf("read pkg header: %w", err)
+	}
+	if string(header[:4]) != xarMagic {
+		return 0, nil, fmt.Errorf("not a pkg (xar) archive")
+	}
+	headerSize := int64(binary.BigEndian.Uint16(header[4:6]))
+	tocCompressed := binary.BigEndian.Uint64(header[8:16])
+	tocUncompressed := binary.BigEndian.Uint64(header[16:24])
+	if headerSize < xarHeaderSize || tocCompressed == 0 || tocCompressed > maxXarTOCSize || tocUncompressed > maxXarTOCSize {
+		return 0, nil, fmt.Errorf("invalid pkg header")
+	}
+
+	section := io.NewSectionReader(file, headerSize, int64(tocCompressed))
+	reader, err := zlib.NewReader(section)
+	if err != nil {
+		return 0, nil, fmt.Errorf("read pkg table of contents: %w", err)
+	}
+	defer reader.Close()
+
+	var toc xarTOC
+	if err := xml.NewDecoder(io.LimitReader(reader, maxXarTOCSize)).Decode(&toc); err != nil {
+		return 0, nil, fmt.Errorf("parse pkg table of contents: %w", err)
+	}
+	return headerSize + int64(tocCompressed), &toc, nil
+}
+
+func walkXarFiles(files []xarFile, parent string, fn func(name string, entry xarFile)) {
+	for _, entry := range files {
+		name := path.Join(parent, entry.Name)
+		if entry.Type == "directory" {
+			walkXarFiles(entry.Files, name, fn)
+			continue
+		}
+		fn(name, entry)
+	}
+}
+
+func readXarFile(file *os.File, heapOffset int64, entry xarFile) ([]byte, error) {
+	if entry.Data == nil {
+		return nil, fmt.Errorf("missing data")
+	}
+	if entry.Data.Offset < 0 || entry.Data.Length < 0 || entry.Data.Size > maxPkgMetadataSize {
+		return nil, fmt.Errorf("invalid data size")
+	}
+
+	var reader io.Reader = io.NewSectionReader(file, heapOffset+entry.Data.Offset, entry.Data.Length)
+	switch entry.Data.Encoding.Style {
+	case "", "application/octet-stream":
+	case "application/x-gzip":
+		// xar labels zlib streams as gzip.
+		zreader, err := zlib.NewReader(reader)
+		if err != nil {
+			return nil, err
+		}
+		defer zreader.Close()
+		reader = zreader
+	case "application/x-bzip2":
+		reader = bzip2.NewReader(reader)
+	default:
+		return nil, fmt.Errorf("unsupported encoding %q", entry.Data.Encoding.Style)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(reader, maxPkgMetadataSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxPkgMetadataSize {
+		return nil, fmt.Errorf("file exceeds %d bytes", maxPkgMetadataSize)
+	}
+	return data, nil
+}
+
+func parsePkgBundles(data []byte) ([]pkgBundle, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	var bundles []pkgBundle
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return bundles, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		start, ok := token.(xml.StartElement)
+		if !ok || start.Name.Local != "bundle" {
+			continue
+		}
+		var bundle pkgBundle
+		if err := decoder.DecodeElement(&bundle, &start); err != nil {
+			return nil, err
+		}
+		bundles = append(bundles, bundle)
+	}
+}
+
+// selectPkgAppBundle picks the least nested .app bundle, which is the main app
+// rather than a helper or login item embedded inside it.
+func selectPkgAppBundle(bundles []pkgBundle) (pkgBundle, bool) {
+	var selected pkgBundle
+	bestDepth := -1
+	for _, bundle := range bundles {
+		cleaned := path.Clean(strings.TrimPrefix(strings.TrimSpace(bundle.Path), "./"))
+		if !strings.HasSuffix(cleaned, ".app") {
+			continue
+		}
+		depth := strings.Count(cleaned, "/")
+		if bestDepth == -1 || depth < bestDepth {
+			selected = bundle
+			bestDepth = depth
+		}
+	}
+	return selected, bestDepth != -1
+}
@@ -0,0 +1,180 @@
+package shared
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+const testDistribution = `<?xml version="1.0" encoding="utf-8"?>
+<installer-gui-script minSpecVersion="1">
+    <pkg-ref id="com.example.mac">
+        <bundle-version>
+            <bundle CFBundleShortVersionString="1.0" CFBundleVersion="1" id="com.example.mac.helper" path="Example.app/Contents/Library/LoginItems/Helper.app"/>
+            <bundle CFBundleShortVersionString="2.3.4" CFBundleVersion="567" id="com.example.mac" path="Example.app"/>
+        </bundle-version>
+    </pkg-ref>
+    <product id="com.example.mac" version="2.3.4"/>
+</installer-gui-script>`
+
+const testPackageInfo = `<?xml version="1.0" encoding="utf-8"?>
+<pkg-info format-version="2" identifier="com.example.mac" version="2.3.4" install-location="/Applications">
+    <bundle CFBundleShortVersionString="2.3.4" CFBundleVersion="890" id="com.example.mac" path="./Example.app"/>
+</pkg-info>`
+
+type testPkgMember struct {
+	name       string
+	data       string
+	compressed bool
+}
+
+func TestExtractBundleInfoFromPkg_Distribution(t *testing.T) {
+	pkgPath := writeTestPkg(t, []testPkgMember{
+		{name: "Distribution", data: testDistribution},
+		{name: "Example.pkg/PackageInfo", data: testPackageInfo, compressed: true},
+	})
+
+	info, err := ExtractBundleInfoFromPkg(pkgPath)
+	if err != nil {
+		t.Fatalf("ExtractBundleInfoFromPkg() error: %v", err)
+	}
+	if info.BundleID != "com.example.mac" || info.Version != "2.3.4" || info.BuildNumber != "567" || info.Platform != "MAC_OS" {
+		t.Fatalf("unexpected info %+v", info)
+	}
+}
+
+func TestExtractBundleInfoFromPkg_FallsBackToPackageInfo(t *testing.T) {
+	pkgPath := writeTestPkg(t, []testPkgMember{
+		{name: "Example.pkg/PackageInfo", data: testPackageInfo, compressed: true},
+	})
+
+	info, err := ExtractBundleInfoFromPkg(pkgPath)
+	if err != nil {
+		t.Fatalf("ExtractBundleInfoFromPkg() error: %v", err)
+	}
+	if info.Version != "2.3.4" || info.BuildNumber != "890" {
+		t.Fatalf("unexpected info %+v", info)
+	}
+}
+
+func TestExtractBundleInfoFromPkg_Invalid(t *testing.T) {
+	notPkg := filepath.Join(t.TempDir(), "app.pkg")
+	if err := os.WriteFile(notPkg, []byte("PK\x03\x04 not a xar archive at all"), 0o600); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+	if _, err := ExtractBundleInfoFromPkg(notPkg); err == nil || !strings.Contains(err.Error(), "not a pkg") {
+		t.Fatalf("expected not a pkg error, got %v", err)
+	}
+
+	noApp := writeTestPkg(t, []testPkgMember{{name: "Distribution", data: `<installer-gui-script/>`}})
+	if _, err := ExtractBundleInfoFromPkg(noApp); err == nil || !strings.Contains(err.Error(), "no .app bundle") {
+		t.Fatalf("expected missing bundle error, got %v", err)
+	}
+}
+
+func TestBuildArtifactResolveInfo(t *testing.T) {
+	pkgPath := writeTestPkg(t, []testPkgMember{{name: "Distribution", data: testDistribution}})
+	artifact, err := ResolveBuildArtifact("", pkgPath)
+	if err != nil {
+		t.Fatalf("ResolveBuildArtifact() error: %v", err)
+	}
+	if artifact.UTI() != asc.UTIPKG {
+		t.Fatalf("expected pkg UTI, got %q", artifact.UTI())
+	}
+
+	info, err := artifact.ResolveInfo("", "", "")
+	if err != nil {
+		t.Fatalf("ResolveInfo() error: %v", err)
+	}
+	if info.Platform != asc.PlatformMacOS || info.Version != "2.3.4" || info.BuildNumber != "567" {
+		t.Fatalf("unexpected info %+v", info)
+	}
+
+	if _, err := artifact.ResolveInfo("", "", "IOS"); err == nil || !strings.Contains(err.Error(), "require --platform MAC_OS") {
+		t.Fatalf("expected platform error, got %v", err)
+	}
+	if _, err := ResolveBuildArtifact("app.ipa", pkgPath); err == nil || !strings.Contains(err.Error(), "mutually exclusive") {
+		t.Fatalf("expected mutually exclusive error, got %v", err)
+	}
+
+	ipaPath := writeTestIPA(t, map[string][]byte{"Payload/Demo.app/Info.plist": []byte("not a plist")})
+	ipa, err := ResolveBuildArtifact(ipaPath, "")
+	if err != nil {
+		t.Fatalf("ResolveBuildArtifact() error: %v", err)
+	}
+	info, err = ipa.ResolveInfo("1.0", "1", "")
+	if err != nil {
+		t.Fatalf("ResolveInfo() error: %v", err)
+	}
+	if info.Platform != asc.PlatformIOS {
+		t.Fatalf("expected IOS fallback, got %q", info.Platform)
+	}
+}
+
+// writeTestPkg writes a minimal xar archive with the given members.
+func writeTestPkg(t *testing.T, members []testPkgMember) string {
+	t.Helper()
+
+	var heap bytes.Buffer
+	var toc strings.Builder
+	toc.WriteString(`<?xml version="1.0" encoding="UTF-8"?><xar><toc>`)
+	id := 0
+	for _, member := range members {
+		data := []byte(member.data)
+		style := "application/octet-stream"
+		if member.compressed {
+			data = zlibCompress(t, data)
+			style = "application/x-gzip"
+		}
+		offset := heap.Len()
+		heap.Write(data)
+
+		parts := strings.Split(member.name, "/")
+		for _, dir := range parts[:len(parts)-1] {
+			id++
+			fmt.Fprintf(&toc, `<file id="%d"><name>%s</name><type>directory</type>`, id, dir)
+		}
+		id++
+		fmt.Fprintf(&toc, `<file id="%d"><name>%s</name><type>file</type><data><length>%d</length><offset>%d</offset><size>%d</size><encoding style="%s"/></data></file>`,
+			id, parts[len(parts)-1], len(data), offset, len(member.data), style)
+		for range parts[:len(parts)-1] {
+			toc.WriteString(`</file>`)
+		}
+	}
+	toc.WriteString(`</toc></xar>`)
+
+	compressedTOC := zlibCompress(t, []byte(toc.String()))
+	header := make([]byte, xarHeaderSize)
+	copy(header, xarMagic)
+	binary.BigEndian.PutUint16(header[4:6], xarHeaderSize)
+	binary.BigEndian.PutUint16(header[6:8], 1)
+	binary.BigEndian.PutUint64(header[8:16], uint64(len(compressedTOC)))
+	binary.BigEndian.PutUint64(header[16:24], uint64(toc.Len()))
+
+	pkgPath := filepath.Join(t.TempDir(), "app.pkg")
+	archive := append(append(header, compressedTOC...), heap.Bytes()...)
+	if err := os.WriteFile(pkgPath, archive, 0o600); err != nil {
+		t.Fatalf("write pkg: %v", err)
+	}
+	return pkgPath
+}
+
+func zlibCompress(t *testing.T, data []byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	writer := zlib.NewWriter(&buf)
+	if _, err := writer.Write(data); err != nil {
+		t.Fatalf("compress: %v", err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("compress: %v", err)
+	}
+	return buf.Bytes()
+}
@@ -0,0 +1,63 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// FetchProfileCertificateIDs returns the IDs of all certificates linked to a profile.
+func FetchProfileCertificateIDs(ctx context.Context, client *asc.Client, profileID string) ([]string, error) {
+	return fetchProfileLinkageIDs(ctx, profileID, client.GetProfileCertificatesRelationships)
+}
+
+// FetchProfileDeviceIDs returns the IDs of all devices linked to a profile.
+func FetchProfileDeviceIDs(ctx context.Context, client *asc.Client, profileID string) ([]string, error) {
+	return fetchProfileLinkageIDs(ctx, profileID, client.GetProfileDevicesRelationships)
+}
+
+func fetchProfileLinkageIDs(ctx context.Context, profileID string, fetch func(context.Context, string, ...asc.LinkagesOption) (*asc.LinkagesResponse, error)) ([]string, error) {
+	firstPage, err := fetch(ctx, profileID, asc.WithLinkagesLimit(200))
+	if err != nil {
+		return nil, err
+	}
+	all, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return fetch(ctx, profileID, asc.WithLinkagesNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp, ok := all.(*asc.LinkagesResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected linkages response type %T", all)
+	}
+	ids := make([]string, 0, len(resp.Data))
+	for _, item := range resp.Data {
+		ids = append(ids, item.ID)
+	}
+	return ids, nil
+}
+
+// ParseSigningDate parses certificate and profile expiration dates returned by
+// App Store Connect.
+func ParseSigningDate(value string) (time.Time, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, false
+	}
+	layouts := []string{
+		time.RFC3339,
+		time.RFC3339Nano,
+		"2006-01-02T15:04:05.000-0700",
+		"2006-01-02T15:04:05-0700",
+	}
+	for _, layout := range layouts {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			return parsed, true
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,315 @@
+package shared
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+const (
+	// ReportManifestName is the manifest file written into a backfill directory.
+	ReportManifestName = "manifest.json"
+
+	reportStatusDownloaded  = "downloaded"
+	reportStatusUnavailable = "unavailable"
+	reportStatusPending     = "not yet published"
+
+	// MaxReportBackfillConcurrency caps parallel report downloads.
+	MaxReportBackfillConcurrency = 8
+)
+
+// ReportBackfillJob is one report to fetch during a backfill.
+type ReportBackfillJob struct {
+	Period   string
+	FileName string
+	// PublishedBy is when Apple is expected to have published the report.
+	// A missing report before then is counted as pending rather than
+	// recorded as unavailable, so the next run asks for it again.
+	PublishedBy time.Time
+	Download    func(ctx context.Context) (*asc.ReportDownload, error)
+}
+
+// published reports whether a missing report can be treated as permanently
+// unavailable.
+func (j ReportBackfillJob) published(now time.Time) bool {
+	return j.PublishedBy.IsZero() || !now.Before(j.PublishedBy)
+}
+
+// ReportManifest records what a backfill directory contains, so later runs can
+// skip finished work. Entries are keyed by file name.
+type ReportManifest struct {
+	Entries map[string]ReportManifestEntry `json:"entries"`
+}
+
+// ReportManifestEntry describes a downloaded report or a period that had no report.
+type ReportManifestEntry struct {
+	Period    string `json:"period"`
+	Status    string `json:"status"`
+	SHA256    string `json:"sha256,omitempty"`
+	Size      int64  `json:"size,omitempty"`
+	UpdatedAt string `json:"updatedAt"`
+}
+
+// ReportBackfillOptions configures RunReportBackfill.
+type ReportBackfillOptions struct {
+	Dir              string
+	Concurrency      int
+	RetryUnavailable bool
+	Progress         io.Writer
+}
+
+type reportBackfill struct {
+	opts         ReportBackfillOptions
+	manifestPath string
+
+	mu       sync.Mutex
+	manifest *ReportManifest
+	result   *asc.ReportBackfillResult
+	done     int
+}
+
+// RunReportBackfill downloads each job's report into opts.Dir with bounded
+// concurrency. Reports already in the manifest with a matching hash, and
+// published periods previously recorded as unavailable, are skipped. The manifest is
+// saved after every report so an interrupted run resumes where it stopped.
+// Per-report errors are collected in the result; the returned error is only
+// set when the manifest cannot be read or written.
+func RunReportBackfill(ctx context.Context, jobs []ReportBackfillJob, opts ReportBackfillOptions) (*asc.ReportBackfillResult, error) {
+	if opts.Concurrency < 1 {
+		opts.Concurrency = 1
+	}
+	if opts.Progress == nil {
+		opts.Progress = io.Discard
+	}
+	if err := os.MkdirAll(opts.Dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	backfill := &reportBackfill{
+		opts:         opts,
+		manifestPath: filepath.Join(opts.Dir, ReportManifestName),
+		result: &asc.ReportBackfillResult{
+			Dir:       opts.Dir,
+			Requested: len(jobs),
+		},
+	}
+	backfill.result.Manifest = backfill.manifestPath
+	manifest, err := LoadReportManifest(backfill.manifestPath)
+	if err != nil {
+		return nil, err
+	}
+	backfill.manifest = manifest
+
+	queue := make(chan ReportBackfillJob)
+	var wg sync.WaitGroup
+	var saveErr error
+	var saveOnce sync.Once
+	for i := 0; i < opts.Concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range queue {
+				if err := backfill.run(ctx, job); err != nil {
+					saveOnce.Do(func() { saveErr = err })
+				}
+			}
+		}()
+	}
+
+enqueue:
+	for _, job := range jobs {
+		select {
+		case <-ctx.Done():
+			break enqueue
+		case queue <- job:
+		}
+	}
+	close(queue)
+	wg.Wait()
+
+	if saveErr != nil {
+		return backfill.result, fmt.Errorf("failed to write manifest: %w", saveErr)
+	}
+	if err := ctx.Err(); err != nil {
+		return backfill.result, err
+	}
+	sort.Slice(backfill.result.Failures, func(i, j int) bool {
+		return backfill.result.Failures[i].Period < backfill.result.Failures[j].Period
+	})
+	return backfill.result, nil
+}
+
+// run processes one job and returns an error only when the manifest cannot be saved.
+func (b *reportBackfill) run(ctx context.Context, job ReportBackfillJob) error {
+	path := filepath.Join(b.opts.Dir, job.FileName)
+
+	b.mu.Lock()
+	entry, known := b.manifest.Entries[job.FileName]
+	b.mu.Unlock()
+
+	if known && entry.Status == reportStatusUnavailable && !b.opts.RetryUnavailable && job.published(time.Now()) {
+		return b.finish(job, "unavailable (recorded)", nil)
+	}
+
+	if hash, size, err := hashReportFile(path); err == nil {
+		switch {
+		case known && entry.Status == reportStatusDownloaded && entry.SHA256 == hash:
+			return b.finish(job, "skipped", nil)
+		case !known:
+			// Adopt a report saved outside the backfill.
+			return b.finish(job, "skipped", &ReportManifestEntry{Period: job.Period, Status: reportStatusDownloaded, SHA256: hash, Size: size})
+		}
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return b.fail(job, err)
+	}
+
+	requestCtx, cancel := contextWithTimeout(ctx)
+	defer cancel()
+
+	download, err := asc.WithRetry(requestCtx, func() (*asc.ReportDownload, error) {
+		return job.Download(requestCtx)
+	}, asc.ResolveRetryOptions())
+	if err != nil {
+		if asc.IsNotFound(err) {
+			if !job.published(time.Now()) {
+				return b.finish(job, reportStatusPending, nil)
+			}
+			return b.finish(job, reportStatusUnavailable, &ReportManifestEntry{Period: job.Period, Status: reportStatusUnavailable})
+		}
+		return b.fail(job, err)
+	}
+	defer download.Body.Close()
+
+	hash, size, err := writeReportAtomically(path, download.Body)
+	if err != nil {
+		return b.fail(job, err)
+	}
+	return b.finish(job, reportStatusDownloaded, &ReportManifestEntry{Period: job.Period, Status: reportStatusDownloaded, SHA256: hash, Size: size})
+}
+
+func (b *reportBackfill) finish(job ReportBackfillJob, outcome string, entry *ReportManifestEntry) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch outcome {
+	case reportStatusDownloaded:
+		b.result.Downloaded++
+	case reportStatusUnavailable, "unavailable (recorded)":
+		b.result.Unavailable++
+	case reportStatusPending:
+		b.result.Pending++
+	default:
+		b.result.Skipped++
+	}
+	b.done++
+	fmt.Fprintf(b.opts.Progress, "[%d/%d] %s: %s\n", b.done, b.result.Requested, job.Period, outcome)
+
+	if entry == nil {
+		return nil
+	}
+	entry.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+	b.manifest.Entries[job.FileName] = *entry
+	return b.saveLocked()
+}
+
+func (b *reportBackfill) fail(job ReportBackfillJob, err error) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.result.Failed++
+	b.result.Failures = append(b.result.Failures, asc.ReportBackfillFailure{
+		Period: job.Period,
+		File:   job.FileName,
+		Error:  err.Error(),
+	})
+	b.done++
+	fmt.Fprintf(b.opts.Progress, "[%d/%d] %s: failed: %v\n", b.done, b.result.Requested, job.Period, err)
+	return nil
+}
+
+func (b *reportBackfill) saveLocked() error {
+	data, err := json.MarshalIndent(b.manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	return WriteFileAtomic(b.manifestPath, append(data, '\n'), 0o644)
+}
+
+// LoadReportManifest reads a backfill manifest, returning an empty manifest
+// when the file does not exist yet.
+func LoadReportManifest(path string) (*ReportManifest, error) {
+	manifest := &ReportManifest{Entries: map[string]ReportManifestEntry{}}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return manifest, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest %s: %w", path, err)
+	}
+	if manifest.Entries == nil {
+		manifest.Entries = map[string]ReportManifestEntry{}
+	}
+	return manifest, nil
+}
+
+func hashReportFile(path string) (string, int64, error) {
+	file, err := OpenExistingNoFollow(path)
+	if err != nil {
+		return "", 0, err
+	}
+	defer file.Close()
+
+	hasher := sha256.New()
+	size, err := io.Copy(hasher, file)
+	if err != nil {
+		return "", 0, err
+	}
+	return hex.EncodeToString(hasher.Sum(nil)), size, nil
+}
+
+// writeReportAtomically streams a report to a temporary file and renames it
+// into place, so an interrupted download never leaves a truncated report.
+func writeReportAtomically(path string, reader io.Reader) (string, int64, error) {
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".asc-report-*")
+	if err != nil {
+		return "", 0, err
+	}
+	tmpName := tmp.Name()
+	defer os.Remove(tmpName)
+
+	hasher := sha256.New()
+	size, err := io.Copy(io.MultiWriter(tmp, hasher), reader)
+	if err != nil {
+		tmp.Close()
+		return "", 0, err
+	}
+	if err := tmp.Chmod(0o600); err != nil {
+		tmp.Close()
+		return "", 0, err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return "", 0, err
+	}
+	if err := tmp.Close(); err != nil {
+		return "", 0, err
+	}
+	if err := os.Rename(tmpName, path); err != nil {
+		return "", 0, err
+	}
+	return hex.EncodeToString(hasher.Sum(nil)), size, nil
+}
@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/config"
 )
 
@@ -109,3 +110,14 @@ func DecompressGzipFile(sourcePath, destPath string) (int64, error) {
 	}
 	return written, out.Sync()
 }
+
+// PrintReportSummary prints report aggregates as json, csv, table or markdown.
+func PrintReportSummary(summary *asc.ReportSummary, format string, pretty bool) error {
+	if strings.EqualFold(strings.TrimSpace(format), "csv") {
+		if pretty {
+			return fmt.Errorf("--pretty is only valid with JSON output")
+		}
+		return asc.PrintReportSummaryCSV(summary)
+	}
+	return printOutput(summary, format, pretty)
+}
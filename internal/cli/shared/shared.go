@@ -298,6 +298,9 @@ func resolveCredentials() (resolvedCredentials, error) {
 func getASCClient() (*asc.Client, error) {
 	resolved, err := resolveCredentials()
 	if err != nil {
+		if asc.ReplayEnabled() {
+			return asc.NewReplayClient()
+		}
 		return nil, err
 	}
 	return asc.NewClient(resolved.keyID, resolved.issuerID, resolved.keyPath)
@@ -567,7 +570,7 @@ func validateNextURL(next string) error {
 	if err != nil {
 		return fmt.Errorf("--next must be a valid URL: %w", err)
 	}
-	if parsed.Scheme != "https" || parsed.Host != "api.appstoreconnect.apple.com" {
+	if !asc.IsAPIURL(parsed.String()) {
 		return fmt.Errorf("--next must be an App Store Connect URL")
 	}
 	return nil
@@ -0,0 +1,64 @@
+package shared
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+)
+
+// TerritoryPricePoint pairs a territory with a price point ID.
+type TerritoryPricePoint struct {
+	Territory    string
+	PricePointID string
+}
+
+// ParseTerritoryPricePoints reads TERRITORY:PRICE_POINT_ID pairs from a
+// comma-separated flag value and from a file with one TERRITORY,PRICE_POINT_ID
+// pair per line. Blank lines, # comments and a territory header are skipped.
+func ParseTerritoryPricePoints(value, filePath string) ([]TerritoryPricePoint, error) {
+	var entries []string
+	entries = append(entries, SplitCSV(value)...)
+
+	if path := strings.TrimSpace(filePath); path != "" {
+		file, err := OpenExistingNoFollow(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open prices file: %w", err)
+		}
+		defer file.Close()
+
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			if field, _, _ := strings.Cut(line, ","); strings.EqualFold(strings.TrimSpace(field), "territory") {
+				continue
+			}
+			entries = append(entries, strings.Replace(line, ",", ":", 1))
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, fmt.Errorf("failed to read prices file: %w", err)
+		}
+	}
+
+	prices := make([]TerritoryPricePoint, 0, len(entries))
+	seen := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		territory, pricePointID, ok := strings.Cut(entry, ":")
+		territory = strings.ToUpper(strings.TrimSpace(territory))
+		pricePointID = strings.TrimSpace(pricePointID)
+		if !ok || territory == "" || pricePointID == "" {
+			return nil, fmt.Errorf("invalid price %q (expected TERRITORY:PRICE_POINT_ID)", entry)
+		}
+		if seen[territory] {
+			return nil, fmt.Errorf("duplicate price for territory %s", territory)
+		}
+		seen[territory] = true
+		prices = append(prices, TerritoryPricePoint{
+			Territory:    territory,
+			PricePointID: pricePointID,
+		})
+	}
+	return prices, nil
+}
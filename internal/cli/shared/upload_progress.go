@@ -0,0 +1,183 @@
+package shared
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/term"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// Upload progress modes accepted by --progress.
+const (
+	UploadProgressAuto = "auto"
+	UploadProgressText = "text"
+	UploadProgressJSON = "json"
+	UploadProgressNone = "none"
+)
+
+const uploadProgressInterval = 500 * time.Millisecond
+
+// ResolveUploadProgressMode validates a --progress value. "auto" becomes
+// "text" when stderr is a terminal and "none" otherwise.
+func ResolveUploadProgressMode(value string) (string, error) {
+	mode := strings.ToLower(strings.TrimSpace(value))
+	switch mode {
+	case "", UploadProgressAuto:
+		if term.IsTerminal(int(os.Stderr.Fd())) {
+			return UploadProgressText, nil
+		}
+		return UploadProgressNone, nil
+	case UploadProgressText, UploadProgressJSON, UploadProgressNone:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("--progress must be one of auto, text, json, none")
+	}
+}
+
+// UploadProgressReporter renders upload progress to a writer (normally stderr),
+// either as a human-readable status line or as JSON lines.
+type UploadProgressReporter struct {
+	mu        sync.Mutex
+	mode      string
+	out       io.Writer
+	label     string
+	carriage  bool
+	now       func() time.Time
+	start     time.Time
+	lastPrint time.Time
+	printed   bool
+}
+
+// uploadProgressEvent is one JSON line emitted in json mode.
+type uploadProgressEvent struct {
+	Event          string  `json:"event"`
+	File           string  `json:"file"`
+	Part           *int    `json:"part,omitempty"`
+	PartsCompleted int     `json:"partsCompleted"`
+	TotalParts     int     `json:"totalParts"`
+	BytesSent      int64   `json:"bytesSent"`
+	TotalBytes     int64   `json:"totalBytes"`
+	BytesPerSecond float64 `json:"bytesPerSecond"`
+	ElapsedSeconds float64 `json:"elapsedSeconds"`
+}
+
+// NewUploadProgressReporter returns a reporter for a resolved mode, or nil for "none".
+func NewUploadProgressReporter(mode, label string, out io.Writer) *UploadProgressReporter {
+	if mode == UploadProgressNone || mode == "" {
+		return nil
+	}
+	carriage := false
+	if file, ok := out.(*os.File); ok {
+		carriage = term.IsTerminal(int(file.Fd()))
+	}
+	now := time.Now
+	return &UploadProgressReporter{
+		mode:     mode,
+		out:      out,
+		label:    label,
+		carriage: carriage,
+		now:      now,
+		start:    now(),
+	}
+}
+
+// Report handles a progress update. Byte updates are throttled; part
+// completions are always emitted in json mode.
+func (r *UploadProgressReporter) Report(progress asc.UploadProgress) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	done := progress.TotalParts > 0 && progress.PartsCompleted == progress.TotalParts
+	partEvent := progress.PartIndex >= 0
+	if !done && !(partEvent && r.mode == UploadProgressJSON) && now.Sub(r.lastPrint) < uploadProgressInterval {
+		return
+	}
+	r.lastPrint = now
+
+	elapsed := now.Sub(r.start).Seconds()
+	rate := 0.0
+	if elapsed > 0 {
+		rate = float64(progress.BytesSent-progress.ResumedBytes) / elapsed
+	}
+
+	if r.mode == UploadProgressJSON {
+		event := uploadProgressEvent{
+			Event:          "progress",
+			File:           r.label,
+			PartsCompleted: progress.PartsCompleted,
+			TotalParts:     progress.TotalParts,
+			BytesSent:      progress.BytesSent,
+			TotalBytes:     progress.TotalBytes,
+			BytesPerSecond: rate,
+			ElapsedSeconds: elapsed,
+		}
+		if partEvent {
+			index := progress.PartIndex
+			event.Event = "part"
+			event.Part = &index
+		}
+		data, err := json.Marshal(event)
+		if err == nil {
+			fmt.Fprintln(r.out, string(data))
+		}
+		return
+	}
+
+	percent := 0.0
+	if progress.TotalBytes > 0 {
+		percent = float64(progress.BytesSent) * 100 / float64(progress.TotalBytes)
+	}
+	line := fmt.Sprintf("Uploading %s: %s / %s (%.0f%%), %s/s, %d/%d parts",
+		r.label,
+		FormatByteSize(progress.BytesSent),
+		FormatByteSize(progress.TotalBytes),
+		percent,
+		FormatByteSize(int64(rate)),
+		progress.PartsCompleted,
+		progress.TotalParts,
+	)
+	if r.carriage {
+		fmt.Fprintf(r.out, "\r\033[K%s", line)
+	} else {
+		fmt.Fprintln(r.out, line)
+	}
+	r.printed = true
+}
+
+// Finish terminates the status line in text mode.
+func (r *UploadProgressReporter) Finish() {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.carriage && r.printed {
+		fmt.Fprintln(r.out)
+	}
+	r.printed = false
+}
+
+// FormatByteSize formats a byte count using binary units (e.g. "1.5 MB").
+func FormatByteSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit && exp < 4; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(size)/float64(div), "KMGTP"[exp])
+}
@@ -2,6 +2,8 @@ package signing
 
 import (
 	"context"
+	"os"
+	"time"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
 
@@ -32,3 +34,15 @@ func printOutput(data interface{}, format string, pretty bool) error {
 func splitCSV(value string) []string {
 	return shared.SplitCSV(value)
 }
+
+func splitCSVUpper(value string) []string {
+	return shared.SplitCSVUpper(value)
+}
+
+func writeFileAtomic(path string, data []byte, perm os.FileMode) error {
+	return shared.WriteFileAtomic(path, data, perm)
+}
+
+func parseSigningDate(value string) (time.Time, bool) {
+	return shared.ParseSigningDate(value)
+}
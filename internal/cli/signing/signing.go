@@ -18,11 +18,16 @@ func SigningCommand() *ffcli.Command {
 		LongHelp: `Manage signing assets for App Store Connect.
 
 Examples:
-  asc signing fetch --bundle-id com.example.app --profile-type IOS_APP_STORE --output ./signing`,
+  asc signing fetch --bundle-id com.example.app --profile-type IOS_APP_STORE --output ./signing
+  asc signing store push --repo ../signing-store --input ./signing --bundle-id com.example.app --profile-type IOS_APP_STORE
+  asc signing store pull --repo ../signing-store --bundle-id com.example.app --profile-type IOS_APP_STORE
+  asc signing renew --within 30d --dry-run`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			SigningFetchCommand(),
+			SigningStoreCommand(),
+			SigningRenewCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
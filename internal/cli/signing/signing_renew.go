@@ -0,0 +1,423 @@
+package signing
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	renewStateExpired  = "EXPIRED"
+	renewStateExpiring = "EXPIRING"
+	renewStateInvalid  = "INVALID"
+
+	renewActionRenewed     = "renewed"
+	renewActionWouldRenew  = "would-renew"
+	renewActionSkipped     = "skipped"
+	renewActionManual      = "manual"
+	renewActionFailed      = "failed"
+	renewNameSuffixPattern = ` \(renewed \d{4}-\d{2}-\d{2}\)$`
+)
+
+var renewNameSuffix = regexp.MustCompile(renewNameSuffixPattern)
+
+// SigningRenewCommand returns the signing renew subcommand.
+func SigningRenewCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("renew", flag.ExitOnError)
+
+	within := fs.String("within", "30d", "Renewal window: assets expiring within this period (e.g. 30d, 2w, 72h)")
+	profileType := fs.String("profile-type", "", "Filter profiles by type(s), comma-separated")
+	certificateType := fs.String("certificate-type", "", "Filter certificates by type(s), comma-separated")
+	dryRun := fs.Bool("dry-run", false, "Report what would be renewed without making changes")
+	deleteOld := fs.Bool("delete-old", false, "Delete replaced profiles after regenerating them (requires --confirm)")
+	confirm := fs.Bool("confirm", false, "Confirm deletion of replaced profiles")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "renew",
+		ShortUsage: "asc signing renew [flags]",
+		ShortHelp:  "Find expiring certificates and profiles and regenerate profiles.",
+		LongHelp: `Find expiring or invalid certificates and profiles and regenerate profiles.
+
+Profiles that are INVALID or expire within --within are recreated with the
+same bundle ID, devices and certificates. Profiles that already have a valid
+renewal (same base name, type and bundle ID) are skipped, so the command can
+be re-run safely; with --delete-old the replaced profile is deleted then. Certificates that are themselves
+expiring are dropped from the new profile; if none remain, other valid
+certificates of the matching type are used.
+
+Certificates cannot be regenerated without a private key, so expiring
+certificates are reported for manual renewal with
+"asc certificates create --generate-key".
+
+The report lists each asset before and after renewal.
+
+Examples:
+  asc signing renew --within 30d --dry-run
+  asc signing renew --within 30d --profile-type IOS_APP_STORE
+  asc signing renew --within 2w --delete-old --confirm --output table`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			window, err := parseRenewWindow(*within)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: --within %v\n", err)
+				return flag.ErrHelp
+			}
+			if *deleteOld && !*dryRun && !*confirm {
+				fmt.Fprintln(os.Stderr, "Error: --confirm is required with --delete-old")
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("signing renew: %w", err)
+			}
+
+			result, err := renewSigningAssets(ctx, client, signingRenewOptions{
+				Within:           strings.TrimSpace(*within),
+				Window:           window,
+				ProfileTypes:     splitCSVUpper(*profileType),
+				CertificateTypes: splitCSVUpper(*certificateType),
+				DryRun:           *dryRun,
+				DeleteOld:        *deleteOld,
+				Now:              time.Now().UTC(),
+			})
+			if err != nil {
+				return fmt.Errorf("signing renew: %w", err)
+			}
+
+			if err := printOutput(result, *output, *pretty); err != nil {
+				return err
+			}
+
+			failed := 0
+			for _, item := range result.Items {
+				if item.Action == renewActionFailed {
+					failed++
+				}
+			}
+			if failed > 0 {
+				return shared.NewReportedError(fmt.Errorf("signing renew: %d profiles failed to renew", failed))
+			}
+
+			return nil
+		},
+	}
+}
+
+type signingRenewOptions struct {
+	Within           string
+	Window           time.Duration
+	ProfileTypes     []string
+	CertificateTypes []string
+	DryRun           bool
+	DeleteOld        bool
+	Now              time.Time
+}
+
+// renewSigningAssets reports expiring certificates and renews expiring
+// profiles. Every API call gets its own timeout, so renewing many profiles
+// cannot run out a single shared deadline.
+func renewSigningAssets(ctx context.Context, client *asc.Client, opts signingRenewOptions) (*asc.SigningRenewResult, error) {
+	cutoff := opts.Now.Add(opts.Window)
+	result := &asc.SigningRenewResult{
+		Within: opts.Within,
+		Cutoff: cutoff.Format(time.RFC3339),
+		DryRun: opts.DryRun,
+		Items:  []asc.SigningRenewItem{},
+	}
+
+	// Fetch every certificate so profiles can be checked against all of
+	// them; --certificate-type only narrows what is reported.
+	certCtx, cancel := contextWithTimeout(ctx)
+	certificates, err := listAllCertificates(certCtx, client)
+	cancel()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch certificates: %w", err)
+	}
+	// Certificates that remain usable past the cutoff, by ID.
+	validCertificates := map[string]asc.Resource[asc.CertificateAttributes]{}
+	for _, cert := range certificates.Data {
+		state := renewState(cert.Attributes.ExpirationDate, "", opts.Now, cutoff)
+		if state == "" {
+			validCertificates[cert.ID] = cert
+			continue
+		}
+		if len(opts.CertificateTypes) > 0 && !containsString(opts.CertificateTypes, strings.ToUpper(cert.Attributes.CertificateType)) {
+			continue
+		}
+		result.Items = append(result.Items, asc.SigningRenewItem{
+			Kind:           "certificate",
+			ID:             cert.ID,
+			Name:           cert.Attributes.Name,
+			Type:           cert.Attributes.CertificateType,
+			State:          state,
+			ExpirationDate: cert.Attributes.ExpirationDate,
+			Action:         renewActionManual,
+			Note:           fmt.Sprintf("run: asc certificates create --certificate-type %s --generate-key --p12 <path>", cert.Attributes.CertificateType),
+		})
+	}
+
+	profileCtx, cancel := contextWithTimeout(ctx)
+	profiles, err := listAllProfiles(profileCtx, client, opts.ProfileTypes)
+	cancel()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch profiles: %w", err)
+	}
+	// Profiles that stay valid past the cutoff, grouped by base name and type,
+	// so profiles renewed by an earlier run are not renewed again.
+	replacements := map[string][]asc.Resource[asc.ProfileAttributes]{}
+	for _, profile := range profiles.Data {
+		if renewState(profile.Attributes.ExpirationDate, profile.Attributes.ProfileState, opts.Now, cutoff) == "" {
+			key := renewalKey(profile.Attributes)
+			replacements[key] = append(replacements[key], profile)
+		}
+	}
+	for _, profile := range profiles.Data {
+		state := renewState(profile.Attributes.ExpirationDate, profile.Attributes.ProfileState, opts.Now, cutoff)
+		if state == "" {
+			continue
+		}
+		item := asc.SigningRenewItem{
+			Kind:           "profile",
+			ID:             profile.ID,
+			Name:           profile.Attributes.Name,
+			Type:           profile.Attributes.ProfileType,
+			State:          state,
+			ExpirationDate: profile.Attributes.ExpirationDate,
+		}
+		renewProfile(ctx, client, profile, replacements[renewalKey(profile.Attributes)], validCertificates, opts, &item)
+		result.Items = append(result.Items, item)
+	}
+
+	return result, nil
+}
+
+// renewProfile recreates a profile and records the outcome on item. A profile
+// that already has a valid replacement for the same bundle ID is skipped.
+// Errors are reported per profile so one failure does not hide the rest of
+// the report.
+func renewProfile(ctx context.Context, client *asc.Client, profile asc.Resource[asc.ProfileAttributes], replacements []asc.Resource[asc.ProfileAttributes], validCertificates map[string]asc.Resource[asc.CertificateAttributes], opts signingRenewOptions, item *asc.SigningRenewItem) {
+	fail := func(format string, args ...any) {
+		item.Action = renewActionFailed
+		item.Note = fmt.Sprintf(format, args...)
+	}
+
+	requestCtx, cancel := contextWithTimeout(ctx)
+	bundleID, err := client.GetProfileBundleIDRelationship(requestCtx, profile.ID)
+	cancel()
+	if err != nil {
+		fail("fetch bundle ID: %v", err)
+		return
+	}
+	for _, replacement := range replacements {
+		requestCtx, cancel = contextWithTimeout(ctx)
+		replacementBundleID, err := client.GetProfileBundleIDRelationship(requestCtx, replacement.ID)
+		cancel()
+		if err != nil {
+			fail("fetch bundle ID of %s: %v", replacement.ID, err)
+			return
+		}
+		if replacementBundleID.Data.ID != bundleID.Data.ID {
+			continue
+		}
+		item.Action = renewActionSkipped
+		item.NewID = replacement.ID
+		item.NewName = replacement.Attributes.Name
+		item.NewExpirationDate = replacement.Attributes.ExpirationDate
+		item.Note = "already renewed"
+		if opts.DeleteOld && !opts.DryRun {
+			deleteOldProfile(ctx, client, profile.ID, item)
+		}
+		return
+	}
+	requestCtx, cancel = contextWithTimeout(ctx)
+	certificateIDs, err := shared.FetchProfileCertificateIDs(requestCtx, client, profile.ID)
+	cancel()
+	if err != nil {
+		fail("fetch certificates: %v", err)
+		return
+	}
+	requestCtx, cancel = contextWithTimeout(ctx)
+	deviceIDs, err := shared.FetchProfileDeviceIDs(requestCtx, client, profile.ID)
+	cancel()
+	if err != nil {
+		fail("fetch devices: %v", err)
+		return
+	}
+
+	keep := make([]string, 0, len(certificateIDs))
+	for _, id := range certificateIDs {
+		if _, ok := validCertificates[id]; ok {
+			keep = append(keep, id)
+		}
+	}
+	if len(keep) == 0 {
+		keep = replacementCertificateIDs(profile.Attributes.ProfileType, validCertificates)
+	}
+	if len(keep) == 0 {
+		item.Action = renewActionSkipped
+		item.Note = "no valid certificates for this profile type; create one with asc certificates create --generate-key"
+		return
+	}
+
+	newName := renewedProfileName(profile.Attributes.Name, opts.Now)
+	item.NewName = newName
+	if opts.DryRun {
+		item.Action = renewActionWouldRenew
+		return
+	}
+
+	requestCtx, cancel = contextWithTimeout(ctx)
+	created, err := client.CreateProfile(requestCtx, asc.ProfileCreateAttributes{
+		Name:        newName,
+		ProfileType: profile.Attributes.ProfileType,
+	}, bundleID.Data.ID, keep, deviceIDs)
+	cancel()
+	if err != nil {
+		fail("create profile: %v", err)
+		return
+	}
+	item.Action = renewActionRenewed
+	item.NewID = created.Data.ID
+	item.NewExpirationDate = created.Data.Attributes.ExpirationDate
+
+	if opts.DeleteOld {
+		deleteOldProfile(ctx, client, profile.ID, item)
+	}
+}
+
+func deleteOldProfile(ctx context.Context, client *asc.Client, profileID string, item *asc.SigningRenewItem) {
+	requestCtx, cancel := contextWithTimeout(ctx)
+	defer cancel()
+
+	if err := client.DeleteProfile(requestCtx, profileID); err != nil {
+		item.Note = fmt.Sprintf("delete old profile: %v", err)
+		return
+	}
+	item.Deleted = true
+}
+
+// renewalKey identifies a profile and its renewals: the name without any
+// renewal suffix, plus the profile type.
+func renewalKey(attrs asc.ProfileAttributes) string {
+	base := renewNameSuffix.ReplaceAllString(strings.TrimSpace(attrs.Name), "")
+	return base + "\x00" + strings.ToUpper(attrs.ProfileType)
+}
+
+func replacementCertificateIDs(profileType string, validCertificates map[string]asc.Resource[asc.CertificateAttributes]) []string {
+	certType, err := inferCertificateType(profileType)
+	if err != nil {
+		return nil
+	}
+	var ids []string
+	for id, cert := range validCertificates {
+		if strings.EqualFold(cert.Attributes.CertificateType, certType) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func listAllCertificates(ctx context.Context, client *asc.Client) (*asc.CertificatesResponse, error) {
+	firstPage, err := client.GetCertificates(ctx, asc.WithCertificatesLimit(200))
+	if err != nil {
+		return nil, err
+	}
+	all, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetCertificates(ctx, asc.WithCertificatesNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp, ok := all.(*asc.CertificatesResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected certificates response type %T", all)
+	}
+	return resp, nil
+}
+
+func listAllProfiles(ctx context.Context, client *asc.Client, types []string) (*asc.ProfilesResponse, error) {
+	opts := []asc.ProfilesOption{asc.WithProfilesLimit(200)}
+	if len(types) > 0 {
+		opts = append(opts, asc.WithProfilesTypes(types))
+	}
+	firstPage, err := client.GetProfiles(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	all, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetProfiles(ctx, asc.WithProfilesNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp, ok := all.(*asc.ProfilesResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected profiles response type %T", all)
+	}
+	return resp, nil
+}
+
+// renewState classifies an asset by state and expiration date. It returns
+// an empty string for assets that do not need renewal.
+func renewState(expirationDate string, profileState asc.ProfileState, now, cutoff time.Time) string {
+	if profileState == asc.ProfileStateInvalid {
+		return renewStateInvalid
+	}
+	expires, ok := parseSigningDate(expirationDate)
+	if !ok {
+		return ""
+	}
+	switch {
+	case !expires.After(now):
+		return renewStateExpired
+	case !expires.After(cutoff):
+		return renewStateExpiring
+	default:
+		return ""
+	}
+}
+
+// parseRenewWindow accepts day and week suffixes in addition to Go durations.
+func parseRenewWindow(value string) (time.Duration, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if value == "" {
+		return 0, fmt.Errorf("is required")
+	}
+	for suffix, unit := range map[string]time.Duration{"d": 24 * time.Hour, "w": 7 * 24 * time.Hour} {
+		if number, ok := strings.CutSuffix(value, suffix); ok {
+			count, err := strconv.Atoi(number)
+			if err != nil || count < 0 {
+				return 0, fmt.Errorf("must be a duration like 30d, 2w or 72h")
+			}
+			return time.Duration(count) * unit, nil
+		}
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration < 0 {
+		return 0, fmt.Errorf("must be a duration like 30d, 2w or 72h")
+	}
+	return duration, nil
+}
+
+// renewedProfileName derives a unique profile name, replacing any previous
+// renewal suffix so names do not grow on every renewal.
+func renewedProfileName(name string, now time.Time) string {
+	base := renewNameSuffix.ReplaceAllString(strings.TrimSpace(name), "")
+	return fmt.Sprintf("%s (renewed %s)", base, now.Format("2006-01-02"))
+}
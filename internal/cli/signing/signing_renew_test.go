@@ -0,0 +1,74 @@
+package signing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestParseRenewWindow(t *testing.T) {
+	tests := []struct {
+		value string
+		want  time.Duration
+	}{
+		{value: "30d", want: 30 * 24 * time.Hour},
+		{value: "2w", want: 14 * 24 * time.Hour},
+		{value: "72h", want: 72 * time.Hour},
+		{value: "0d", want: 0},
+	}
+	for _, test := range tests {
+		got, err := parseRenewWindow(test.value)
+		if err != nil {
+			t.Fatalf("parseRenewWindow(%q) error: %v", test.value, err)
+		}
+		if got != test.want {
+			t.Fatalf("parseRenewWindow(%q) = %v, want %v", test.value, got, test.want)
+		}
+	}
+
+	for _, value := range []string{"", "soon", "-3d", "1.5d"} {
+		if _, err := parseRenewWindow(value); err == nil {
+			t.Fatalf("expected error for %q", value)
+		}
+	}
+}
+
+func TestRenewedProfileNameReplacesPreviousSuffix(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	if got := renewedProfileName("App Store", now); got != "App Store (renewed 2026-03-01)" {
+		t.Fatalf("unexpected name %q", got)
+	}
+	if got := renewedProfileName("App Store (renewed 2025-03-01)", now); got != "App Store (renewed 2026-03-01)" {
+		t.Fatalf("unexpected name %q", got)
+	}
+}
+
+func TestRenewalKeyMatchesRenewedProfiles(t *testing.T) {
+	original := renewalKey(asc.ProfileAttributes{Name: "App Store", ProfileType: "IOS_APP_STORE"})
+	renewed := renewalKey(asc.ProfileAttributes{Name: "App Store (renewed 2026-03-01)", ProfileType: "ios_app_store"})
+	if original != renewed {
+		t.Fatalf("expected renewed profile to share key %q, got %q", original, renewed)
+	}
+	if other := renewalKey(asc.ProfileAttributes{Name: "App Store", ProfileType: "IOS_APP_DEVELOPMENT"}); other == original {
+		t.Fatal("expected a different profile type to have a different key")
+	}
+}
+
+func TestRenewState(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	cutoff := now.Add(30 * 24 * time.Hour)
+
+	if got := renewState("2026-02-01T00:00:00.000+0000", "", now, cutoff); got != renewStateExpired {
+		t.Fatalf("expected EXPIRED, got %q", got)
+	}
+	if got := renewState("2026-03-10T00:00:00Z", "", now, cutoff); got != renewStateExpiring {
+		t.Fatalf("expected EXPIRING, got %q", got)
+	}
+	if got := renewState("2027-03-10T00:00:00Z", "", now, cutoff); got != "" {
+		t.Fatalf("expected no renewal, got %q", got)
+	}
+	if got := renewState("2027-03-10T00:00:00Z", "INVALID", now, cutoff); got != renewStateInvalid {
+		t.Fatalf("expected INVALID, got %q", got)
+	}
+}
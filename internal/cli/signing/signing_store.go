@@ -0,0 +1,470 @@
+package signing
+
+import (
+	"bytes"
+	"context"
+	"encoding/pem"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+var runGit = defaultRunGit
+
+// SigningStoreCommand returns the signing store command with subcommands.
+func SigningStoreCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("store", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "store",
+		ShortUsage: "asc signing store <subcommand> [flags]",
+		ShortHelp:  "Share encrypted signing assets through a directory or git repo.",
+		LongHelp: `Share encrypted signing assets through a directory or git working tree.
+
+Certificates (.cer), PKCS#12 files (.p12) and provisioning profiles are
+encrypted with AES-256-GCM using a key derived from a passphrase with scrypt.
+An index (asc-signing-index.json) records which bundle IDs and profile types
+each asset belongs to.
+
+The passphrase is read from --passphrase or ASC_SIGNING_PASSPHRASE.
+
+Examples:
+  asc signing store push --repo ../signing-store --input ./signing --bundle-id com.example.app --profile-type IOS_APP_STORE
+  asc signing store pull --repo ../signing-store --bundle-id com.example.app --profile-type IOS_APP_STORE --output ./signing`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			SigningStorePushCommand(),
+			SigningStorePullCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// SigningStorePushCommand returns the signing store push subcommand.
+func SigningStorePushCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("push", flag.ExitOnError)
+
+	repo := fs.String("repo", "", "Store directory or git working tree (required)")
+	inputPath := fs.String("input", "./signing", "Directory containing .cer, .p12 and profile files")
+	bundleID := fs.String("bundle-id", "", "Bundle identifier the assets belong to (required)")
+	profileType := fs.String("profile-type", "", "Profile type the assets belong to, e.g. IOS_APP_STORE (required)")
+	passphrase := fs.String("passphrase", "", "Store passphrase (or set "+signingStorePassphraseEnv+")")
+	noCommit := fs.Bool("no-commit", false, "Do not commit changes when --repo is a git working tree")
+	format := fs.String("format", "json", "Output format for metadata: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "push",
+		ShortUsage: "asc signing store push --repo DIR --bundle-id ID --profile-type TYPE [flags]",
+		ShortHelp:  "Encrypt signing assets into the store.",
+		LongHelp: `Encrypt signing assets into the store.
+
+Every .cer, .p12, .mobileprovision and .provisionprofile file in --input is
+encrypted into --repo and tagged with --bundle-id and --profile-type. Assets
+that are already stored with identical content are left untouched.
+
+Files are checked before anything is stored: profiles must be for
+--bundle-id and --profile-type, and certificates must be embedded in one of
+those profiles. PKCS#12 files are password protected, so they cannot be
+checked and are tagged as given; keep only related .p12 files in --input.
+
+When --repo is a git working tree, the changes are committed (not pushed)
+unless --no-commit is set.
+
+Examples:
+  asc signing fetch --bundle-id com.example.app --profile-type IOS_APP_STORE --output ./signing
+  asc signing store push --repo ../signing-store --input ./signing --bundle-id com.example.app --profile-type IOS_APP_STORE`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			repoValue := strings.TrimSpace(*repo)
+			if repoValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --repo is required")
+				return flag.ErrHelp
+			}
+			bundle := strings.TrimSpace(*bundleID)
+			if bundle == "" {
+				fmt.Fprintln(os.Stderr, "Error: --bundle-id is required")
+				return flag.ErrHelp
+			}
+			profType := strings.ToUpper(strings.TrimSpace(*profileType))
+			if profType == "" {
+				fmt.Fprintln(os.Stderr, "Error: --profile-type is required")
+				return flag.ErrHelp
+			}
+			secret := resolveSigningStorePassphrase(*passphrase)
+			if secret == "" {
+				fmt.Fprintf(os.Stderr, "Error: --passphrase is required (or set %s)\n", signingStorePassphraseEnv)
+				return flag.ErrHelp
+			}
+			inputDir := strings.TrimSpace(*inputPath)
+			if inputDir == "" {
+				inputDir = "./signing"
+			}
+
+			result, err := pushSigningStore(repoValue, inputDir, bundle, profType, secret)
+			if err != nil {
+				return fmt.Errorf("signing store push: %w", err)
+			}
+
+			if !*noCommit && signingStoreChanged(result) && isGitWorkTree(repoValue) {
+				message := fmt.Sprintf("Update signing assets for %s (%s)", bundle, profType)
+				if err := commitSigningStore(ctx, repoValue, message); err != nil {
+					return fmt.Errorf("signing store push: %w", err)
+				}
+				result.Committed = true
+			}
+
+			return printOutput(result, *format, *pretty)
+		},
+	}
+}
+
+// SigningStorePullCommand returns the signing store pull subcommand.
+func SigningStorePullCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("pull", flag.ExitOnError)
+
+	repo := fs.String("repo", "", "Store directory or git working tree (required)")
+	outputPath := fs.String("output", "./signing", "Output directory for decrypted signing files")
+	bundleID := fs.String("bundle-id", "", "Only pull assets for this bundle identifier")
+	profileType := fs.String("profile-type", "", "Only pull assets for this profile type")
+	passphrase := fs.String("passphrase", "", "Store passphrase (or set "+signingStorePassphraseEnv+")")
+	force := fs.Bool("force", false, "Overwrite existing files that differ from the store")
+	format := fs.String("format", "json", "Output format for metadata: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "pull",
+		ShortUsage: "asc signing store pull --repo DIR [flags]",
+		ShortHelp:  "Decrypt signing assets from the store.",
+		LongHelp: `Decrypt signing assets from the store.
+
+Assets are filtered by --bundle-id and --profile-type when provided. Existing
+files with identical content are skipped; files that differ are only replaced
+with --force.
+
+The store is read as-is; run git pull in --repo first to get the latest assets.
+
+Examples:
+  asc signing store pull --repo ../signing-store --output ./signing
+  asc signing store pull --repo ../signing-store --bundle-id com.example.app --profile-type IOS_APP_STORE`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			repoValue := strings.TrimSpace(*repo)
+			if repoValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --repo is required")
+				return flag.ErrHelp
+			}
+			secret := resolveSigningStorePassphrase(*passphrase)
+			if secret == "" {
+				fmt.Fprintf(os.Stderr, "Error: --passphrase is required (or set %s)\n", signingStorePassphraseEnv)
+				return flag.ErrHelp
+			}
+			outputDir := strings.TrimSpace(*outputPath)
+			if outputDir == "" {
+				outputDir = "./signing"
+			}
+
+			result, err := pullSigningStore(
+				repoValue,
+				outputDir,
+				strings.TrimSpace(*bundleID),
+				strings.ToUpper(strings.TrimSpace(*profileType)),
+				secret,
+				*force,
+			)
+			if err != nil {
+				return fmt.Errorf("signing store pull: %w", err)
+			}
+
+			return printOutput(result, *format, *pretty)
+		},
+	}
+}
+
+func pushSigningStore(repo, inputDir, bundleID, profileType, passphrase string) (*asc.SigningStoreResult, error) {
+	entries, err := os.ReadDir(inputDir)
+	if err != nil {
+		return nil, fmt.Errorf("read input dir: %w", err)
+	}
+	files, err := readSigningStoreInput(inputDir, entries, bundleID, profileType)
+	if err != nil {
+		return nil, err
+	}
+
+	idx, err := loadSigningStoreIndex(repo)
+	if err != nil {
+		return nil, err
+	}
+	var key []byte
+	if idx == nil {
+		idx, key, err = newSigningStoreIndex(passphrase)
+	} else {
+		key, err = idx.unlock(passphrase)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := &asc.SigningStoreResult{
+		Action:    "push",
+		Repo:      repo,
+		Directory: inputDir,
+	}
+	now := time.Now().UTC().Format(time.RFC3339)
+
+	for _, file := range files {
+		name, kind, data := file.name, file.kind, file.data
+		digest := sha256Hex(data)
+		storePath := signingStoreAssetPath(kind, name)
+
+		status := "unchanged"
+		stored := idx.find(kind, name)
+		switch {
+		case stored == nil:
+			idx.Assets = append(idx.Assets, signingStoreEntry{
+				Name:         name,
+				Kind:         kind,
+				Path:         storePath,
+				BundleIDs:    []string{},
+				ProfileTypes: []string{},
+			})
+			stored = &idx.Assets[len(idx.Assets)-1]
+			status = "added"
+		case stored.SHA256 != digest:
+			status = "updated"
+		}
+
+		if status != "unchanged" {
+			sealed, err := sealSigningStoreData(key, storePath, data)
+			if err != nil {
+				return nil, fmt.Errorf("encrypt %s: %w", name, err)
+			}
+			if err := writeFileAtomic(filepath.Join(repo, filepath.FromSlash(storePath)), sealed, 0o644); err != nil {
+				return nil, fmt.Errorf("write %s: %w", storePath, err)
+			}
+			stored.SHA256 = digest
+			stored.UpdatedAt = now
+		}
+
+		if !containsString(stored.BundleIDs, bundleID) || !containsString(stored.ProfileTypes, profileType) {
+			stored.BundleIDs = appendUnique(stored.BundleIDs, bundleID)
+			stored.ProfileTypes = appendUnique(stored.ProfileTypes, profileType)
+			stored.UpdatedAt = now
+			if status == "unchanged" {
+				status = "tagged"
+			}
+		}
+
+		result.Assets = append(result.Assets, asc.SigningStoreAsset{
+			Name:   name,
+			Kind:   kind,
+			Path:   storePath,
+			Status: status,
+		})
+	}
+
+	if err := saveSigningStoreIndex(repo, idx); err != nil {
+		return nil, fmt.Errorf("write index: %w", err)
+	}
+	return result, nil
+}
+
+type signingStoreInputFile struct {
+	name string
+	kind string
+	data []byte
+}
+
+// readSigningStoreInput reads the signing files in inputDir and checks that
+// they belong to bundleID and profileType before anything is tagged.
+// Profiles must match both; certificates must be embedded in one of those
+// profiles. PKCS#12 files are password protected and cannot be inspected, so
+// they are taken as given.
+func readSigningStoreInput(inputDir string, entries []os.DirEntry, bundleID, profileType string) ([]signingStoreInputFile, error) {
+	var files []signingStoreInputFile
+	profileCertificates := map[string]bool{}
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+		name := entry.Name()
+		kind := signingStoreKind(name)
+		if kind == "" {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(inputDir, name))
+		if err != nil {
+			return nil, err
+		}
+		if kind == signingStoreKindProfile {
+			profile, err := shared.ParseProvisioningProfile(data)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", name, err)
+			}
+			if appID := profile.ApplicationIdentifier(); !shared.ApplicationIdentifierMatches(appID, bundleID) {
+				return nil, fmt.Errorf("%s is for %q, not bundle ID %s", name, appID, bundleID)
+			}
+			if !profile.MatchesProfileType(profileType) {
+				return nil, fmt.Errorf("%s is a %s profile, not %s", name, profile.Distribution(), profileType)
+			}
+			for _, der := range profile.DeveloperCertificates {
+				profileCertificates[sha256Hex(der)] = true
+			}
+		}
+		files = append(files, signingStoreInputFile{name: name, kind: kind, data: data})
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no signing files (.cer, .p12, .mobileprovision, .provisionprofile) found in %s", inputDir)
+	}
+
+	for _, file := range files {
+		if file.kind != signingStoreKindCertificate {
+			continue
+		}
+		der := file.data
+		if block, _ := pem.Decode(file.data); block != nil {
+			der = block.Bytes
+		}
+		if !profileCertificates[sha256Hex(der)] {
+			return nil, fmt.Errorf("%s is not used by any %s profile for %s in %s", file.name, profileType, bundleID, inputDir)
+		}
+	}
+	return files, nil
+}
+
+func pullSigningStore(repo, outputDir, bundleID, profileType, passphrase string, force bool) (*asc.SigningStoreResult, error) {
+	idx, err := loadSigningStoreIndex(repo)
+	if err != nil {
+		return nil, err
+	}
+	if idx == nil {
+		return nil, fmt.Errorf("no signing store found in %s", repo)
+	}
+	key, err := idx.unlock(passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &asc.SigningStoreResult{
+		Action:    "pull",
+		Repo:      repo,
+		Directory: outputDir,
+	}
+
+	for _, entry := range idx.Assets {
+		if !entry.matches(bundleID, profileType) {
+			continue
+		}
+		// Index entries come from a shared repo, so never let a name or path
+		// escape the store or output directory.
+		if entry.Name != filepath.Base(entry.Name) || signingStoreKind(entry.Name) != entry.Kind ||
+			entry.Path != signingStoreAssetPath(entry.Kind, entry.Name) {
+			return nil, fmt.Errorf("invalid asset %q in index", entry.Name)
+		}
+
+		sealed, err := os.ReadFile(filepath.Join(repo, filepath.FromSlash(entry.Path)))
+		if err != nil {
+			return nil, fmt.Errorf("read %s: %w", entry.Path, err)
+		}
+		data, err := openSigningStoreData(key, entry.Path, sealed)
+		if err != nil {
+			return nil, fmt.Errorf("decrypt %s: %w", entry.Path, err)
+		}
+		if sha256Hex(data) != entry.SHA256 {
+			return nil, fmt.Errorf("checksum mismatch for %s", entry.Path)
+		}
+
+		outputFile := filepath.Join(outputDir, entry.Name)
+		status := "written"
+		existing, err := os.ReadFile(outputFile)
+		switch {
+		case err == nil && bytes.Equal(existing, data):
+			status = "unchanged"
+		case err == nil && !force:
+			return nil, fmt.Errorf("output file already exists and differs from store: %s (use --force to overwrite)", outputFile)
+		case err == nil:
+			status = "overwritten"
+		case !errors.Is(err, os.ErrNotExist):
+			return nil, err
+		}
+
+		if status != "unchanged" {
+			perm := os.FileMode(0o600)
+			if entry.Kind == signingStoreKindProfile {
+				perm = 0o644
+			}
+			if err := writeFileAtomic(outputFile, data, perm); err != nil {
+				return nil, fmt.Errorf("write %s: %w", outputFile, err)
+			}
+		}
+
+		result.Assets = append(result.Assets, asc.SigningStoreAsset{
+			Name:   entry.Name,
+			Kind:   entry.Kind,
+			Path:   outputFile,
+			Status: status,
+		})
+	}
+
+	if len(result.Assets) == 0 {
+		return nil, fmt.Errorf("no assets in store match the given filters")
+	}
+	return result, nil
+}
+
+func signingStoreChanged(result *asc.SigningStoreResult) bool {
+	for _, asset := range result.Assets {
+		if asset.Status != "unchanged" {
+			return true
+		}
+	}
+	return false
+}
+
+func isGitWorkTree(dir string) bool {
+	_, err := os.Stat(filepath.Join(dir, ".git"))
+	return err == nil
+}
+
+func commitSigningStore(ctx context.Context, repo, message string) error {
+	paths := []string{signingStoreIndexFile}
+	for _, dir := range []string{"certificates", "p12", "profiles"} {
+		if _, err := os.Stat(filepath.Join(repo, dir)); err == nil {
+			paths = append(paths, dir)
+		}
+	}
+	if err := runGit(ctx, repo, append([]string{"add", "--all", "--"}, paths...)...); err != nil {
+		return fmt.Errorf("git add: %w", err)
+	}
+	if err := runGit(ctx, repo, append([]string{"commit", "--quiet", "-m", message, "--"}, paths...)...); err != nil {
+		return fmt.Errorf("git commit: %w", err)
+	}
+	return nil
+}
+
+func defaultRunGit(ctx context.Context, dir string, args ...string) error {
+	cmd := exec.CommandContext(ctx, "git", append([]string{"-C", dir}, args...)...)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		if trimmed := strings.TrimSpace(string(output)); trimmed != "" {
+			return fmt.Errorf("%w: %s", err, trimmed)
+		}
+		return err
+	}
+	return nil
+}
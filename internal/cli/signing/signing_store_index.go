@@ -0,0 +1,278 @@
+package signing
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	signingStoreIndexFile     = "asc-signing-index.json"
+	signingStoreVersion       = 1
+	signingStorePassphraseEnv = "ASC_SIGNING_PASSPHRASE"
+	signingStoreVerifierText  = "asc-signing-store"
+
+	signingStoreKindCertificate = "certificate"
+	signingStoreKindP12         = "p12"
+	signingStoreKindProfile     = "profile"
+
+	scryptN      = 1 << 15
+	scryptR      = 8
+	scryptP      = 1
+	scryptKeyLen = 32
+	saltSize     = 16
+)
+
+var errSigningStorePassphrase = errors.New("incorrect passphrase or corrupted store")
+
+// signingStoreIndex is the plaintext index stored alongside encrypted assets.
+type signingStoreIndex struct {
+	Version  int                 `json:"version"`
+	KDF      signingStoreKDF     `json:"kdf"`
+	Verifier string              `json:"verifier"`
+	Assets   []signingStoreEntry `json:"assets"`
+}
+
+type signingStoreKDF struct {
+	Algorithm string `json:"algorithm"`
+	Salt      string `json:"salt"`
+	N         int    `json:"n"`
+	R         int    `json:"r"`
+	P         int    `json:"p"`
+}
+
+// signingStoreEntry ties an encrypted asset to the bundle IDs and profile
+// types it was pushed for.
+type signingStoreEntry struct {
+	Name         string   `json:"name"`
+	Kind         string   `json:"kind"`
+	Path         string   `json:"path"`
+	SHA256       string   `json:"sha256"`
+	BundleIDs    []string `json:"bundleIds"`
+	ProfileTypes []string `json:"profileTypes"`
+	UpdatedAt    string   `json:"updatedAt"`
+}
+
+func (e *signingStoreEntry) matches(bundleID, profileType string) bool {
+	if bundleID != "" && !containsString(e.BundleIDs, bundleID) {
+		return false
+	}
+	if profileType != "" && !containsString(e.ProfileTypes, profileType) {
+		return false
+	}
+	return true
+}
+
+func (idx *signingStoreIndex) find(kind, name string) *signingStoreEntry {
+	for i := range idx.Assets {
+		if idx.Assets[i].Kind == kind && idx.Assets[i].Name == name {
+			return &idx.Assets[i]
+		}
+	}
+	return nil
+}
+
+func (idx *signingStoreIndex) sort() {
+	sort.Slice(idx.Assets, func(i, j int) bool {
+		return idx.Assets[i].Path < idx.Assets[j].Path
+	})
+}
+
+// signingStoreKind classifies a signing file by extension.
+func signingStoreKind(name string) string {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".cer":
+		return signingStoreKindCertificate
+	case ".p12":
+		return signingStoreKindP12
+	case ".mobileprovision", ".provisionprofile":
+		return signingStoreKindProfile
+	default:
+		return ""
+	}
+}
+
+func signingStoreAssetPath(kind, name string) string {
+	dir := map[string]string{
+		signingStoreKindCertificate: "certificates",
+		signingStoreKindP12:         "p12",
+		signingStoreKindProfile:     "profiles",
+	}[kind]
+	return filepath.ToSlash(filepath.Join(dir, name+".enc"))
+}
+
+func resolveSigningStorePassphrase(value string) string {
+	if value != "" {
+		return value
+	}
+	return os.Getenv(signingStorePassphraseEnv)
+}
+
+// loadSigningStoreIndex reads the index from repo. A missing index returns
+// nil without error so push can initialize a new store.
+func loadSigningStoreIndex(repo string) (*signingStoreIndex, error) {
+	data, err := os.ReadFile(filepath.Join(repo, signingStoreIndexFile))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var idx signingStoreIndex
+	if err := json.Unmarshal(data, &idx); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", signingStoreIndexFile, err)
+	}
+	if idx.Version != signingStoreVersion {
+		return nil, fmt.Errorf("unsupported signing store version %d", idx.Version)
+	}
+	if idx.KDF.Algorithm != "scrypt" {
+		return nil, fmt.Errorf("unsupported key derivation %q", idx.KDF.Algorithm)
+	}
+	return &idx, nil
+}
+
+func saveSigningStoreIndex(repo string, idx *signingStoreIndex) error {
+	idx.sort()
+	data, err := json.MarshalIndent(idx, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(filepath.Join(repo, signingStoreIndexFile), append(data, '\n'), 0o644)
+}
+
+// newSigningStoreIndex creates an empty index with a fresh salt and returns
+// it along with the derived key.
+func newSigningStoreIndex(passphrase string) (*signingStoreIndex, []byte, error) {
+	salt := make([]byte, saltSize)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return nil, nil, err
+	}
+	idx := &signingStoreIndex{
+		Version: signingStoreVersion,
+		KDF: signingStoreKDF{
+			Algorithm: "scrypt",
+			Salt:      base64.StdEncoding.EncodeToString(salt),
+			N:         scryptN,
+			R:         scryptR,
+			P:         scryptP,
+		},
+		Assets: []signingStoreEntry{},
+	}
+	key, err := idx.deriveKey(passphrase)
+	if err != nil {
+		return nil, nil, err
+	}
+	verifier, err := sealSigningStoreData(key, signingStoreVerifierText, []byte(signingStoreVerifierText))
+	if err != nil {
+		return nil, nil, err
+	}
+	idx.Verifier = base64.StdEncoding.EncodeToString(verifier)
+	return idx, key, nil
+}
+
+func (idx *signingStoreIndex) deriveKey(passphrase string) ([]byte, error) {
+	salt, err := base64.StdEncoding.DecodeString(idx.KDF.Salt)
+	if err != nil {
+		return nil, fmt.Errorf("decode salt: %w", err)
+	}
+	key, err := scrypt.Key([]byte(passphrase), salt, idx.KDF.N, idx.KDF.R, idx.KDF.P, scryptKeyLen)
+	if err != nil {
+		return nil, fmt.Errorf("derive key: %w", err)
+	}
+	return key, nil
+}
+
+// unlock derives the key and checks it against the stored verifier.
+func (idx *signingStoreIndex) unlock(passphrase string) ([]byte, error) {
+	key, err := idx.deriveKey(passphrase)
+	if err != nil {
+		return nil, err
+	}
+	verifier, err := base64.StdEncoding.DecodeString(idx.Verifier)
+	if err != nil {
+		return nil, fmt.Errorf("decode verifier: %w", err)
+	}
+	plaintext, err := openSigningStoreData(key, signingStoreVerifierText, verifier)
+	if err != nil {
+		return nil, err
+	}
+	if subtle.ConstantTimeCompare(plaintext, []byte(signingStoreVerifierText)) != 1 {
+		return nil, errSigningStorePassphrase
+	}
+	return key, nil
+}
+
+// sealSigningStoreData encrypts data with AES-256-GCM. The asset path is
+// bound as additional data so files cannot be swapped within the store.
+func sealSigningStoreData(key []byte, path string, data []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, data, []byte(path)), nil
+}
+
+func openSigningStoreData(key []byte, path string, data []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < gcm.NonceSize() {
+		return nil, errSigningStorePassphrase
+	}
+	nonce, ciphertext := data[:gcm.NonceSize()], data[gcm.NonceSize():]
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, []byte(path))
+	if err != nil {
+		return nil, errSigningStorePassphrase
+	}
+	return plaintext, nil
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func containsString(values []string, value string) bool {
+	for _, item := range values {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+func appendUnique(values []string, value string) []string {
+	if value == "" || containsString(values, value) {
+		return values
+	}
+	values = append(values, value)
+	sort.Strings(values)
+	return values
+}
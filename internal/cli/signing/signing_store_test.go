@@ -0,0 +1,354 @@
+package signing
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"howett.net/plist"
+)
+
+func writeSigningFixtures(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+	}
+}
+
+// signingStoreFixtures returns a certificate and an App Store profile that
+// embeds it, for the given application-identifier.
+func signingStoreFixtures(t *testing.T, applicationIdentifier string) (certificate, profile string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "Apple Distribution: Example (TEAM123456)"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(365 * 24 * time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create certificate: %v", err)
+	}
+	data, err := plist.Marshal(map[string]interface{}{
+		"Name":                  "App Store",
+		"UUID":                  "11111111-2222-3333-4444-555555555555",
+		"Platform":              []string{"iOS"},
+		"DeveloperCertificates": [][]byte{der},
+		"Entitlements": map[string]interface{}{
+			"application-identifier": applicationIdentifier,
+		},
+	}, plist.XMLFormat)
+	if err != nil {
+		t.Fatalf("marshal profile: %v", err)
+	}
+	return string(der), string(data)
+}
+
+func TestSigningStoreValidationErrors(t *testing.T) {
+	t.Setenv(signingStorePassphraseEnv, "")
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "push missing repo",
+			args:    []string{"store", "push", "--bundle-id", "com.example.app", "--profile-type", "IOS_APP_STORE", "--passphrase", "secret"},
+			wantErr: "--repo is required",
+		},
+		{
+			name:    "push missing bundle id",
+			args:    []string{"store", "push", "--repo", "store", "--profile-type", "IOS_APP_STORE", "--passphrase", "secret"},
+			wantErr: "--bundle-id is required",
+		},
+		{
+			name:    "push missing profile type",
+			args:    []string{"store", "push", "--repo", "store", "--bundle-id", "com.example.app", "--passphrase", "secret"},
+			wantErr: "--profile-type is required",
+		},
+		{
+			name:    "push missing passphrase",
+			args:    []string{"store", "push", "--repo", "store", "--bundle-id", "com.example.app", "--profile-type", "IOS_APP_STORE"},
+			wantErr: "--passphrase is required",
+		},
+		{
+			name:    "pull missing repo",
+			args:    []string{"store", "pull", "--passphrase", "secret"},
+			wantErr: "--repo is required",
+		},
+		{
+			name:    "pull missing passphrase",
+			args:    []string{"store", "pull", "--repo", "store"},
+			wantErr: "--passphrase is required",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cmd := SigningCommand()
+			cmd.FlagSet.SetOutput(io.Discard)
+
+			_, stderr := captureOutput(t, func() {
+				if err := cmd.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := cmd.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestSigningStorePushPullRoundTrip(t *testing.T) {
+	root := t.TempDir()
+	repo := filepath.Join(root, "store")
+	input := filepath.Join(root, "signing")
+	certificate, profile := signingStoreFixtures(t, "TEAM123456.com.example.*")
+	writeSigningFixtures(t, input, map[string]string{
+		"ABC123.cer":                certificate,
+		"dist.p12":                  "p12-bytes",
+		"App_Store.mobileprovision": profile,
+		"notes.txt":                 "ignored",
+	})
+
+	pushed, err := pushSigningStore(repo, input, "com.example.app", "IOS_APP_STORE", "secret")
+	if err != nil {
+		t.Fatalf("push error: %v", err)
+	}
+	if len(pushed.Assets) != 3 {
+		t.Fatalf("expected 3 assets, got %+v", pushed.Assets)
+	}
+	for _, asset := range pushed.Assets {
+		if asset.Status != "added" {
+			t.Fatalf("expected added status, got %+v", asset)
+		}
+		sealed, err := os.ReadFile(filepath.Join(repo, filepath.FromSlash(asset.Path)))
+		if err != nil {
+			t.Fatalf("read sealed asset: %v", err)
+		}
+		if strings.Contains(string(sealed), "bytes") || strings.Contains(string(sealed), "application-identifier") {
+			t.Fatalf("expected %s to be encrypted", asset.Path)
+		}
+	}
+
+	again, err := pushSigningStore(repo, input, "com.example.app", "IOS_APP_STORE", "secret")
+	if err != nil {
+		t.Fatalf("second push error: %v", err)
+	}
+	if signingStoreChanged(again) {
+		t.Fatalf("expected unchanged assets, got %+v", again.Assets)
+	}
+
+	tagged, err := pushSigningStore(repo, input, "com.example.widget", "IOS_APP_STORE", "secret")
+	if err != nil {
+		t.Fatalf("tag push error: %v", err)
+	}
+	if tagged.Assets[0].Status != "tagged" {
+		t.Fatalf("expected tagged status, got %+v", tagged.Assets[0])
+	}
+
+	var idx signingStoreIndex
+	data, err := os.ReadFile(filepath.Join(repo, signingStoreIndexFile))
+	if err != nil {
+		t.Fatalf("read index: %v", err)
+	}
+	if err := json.Unmarshal(data, &idx); err != nil {
+		t.Fatalf("parse index: %v", err)
+	}
+	if got := idx.Assets[0].BundleIDs; len(got) != 2 {
+		t.Fatalf("expected two bundle IDs, got %v", got)
+	}
+
+	output := filepath.Join(root, "out")
+	pulled, err := pullSigningStore(repo, output, "com.example.widget", "IOS_APP_STORE", "secret", false)
+	if err != nil {
+		t.Fatalf("pull error: %v", err)
+	}
+	if len(pulled.Assets) != 3 {
+		t.Fatalf("expected 3 pulled assets, got %+v", pulled.Assets)
+	}
+	content, err := os.ReadFile(filepath.Join(output, "dist.p12"))
+	if err != nil {
+		t.Fatalf("read pulled p12: %v", err)
+	}
+	if string(content) != "p12-bytes" {
+		t.Fatalf("unexpected p12 content %q", content)
+	}
+
+	if _, err := pullSigningStore(repo, output, "com.other.app", "", "secret", false); err == nil {
+		t.Fatal("expected error when no assets match")
+	}
+
+	if err := os.WriteFile(filepath.Join(output, "dist.p12"), []byte("local"), 0o600); err != nil {
+		t.Fatalf("write local p12: %v", err)
+	}
+	if _, err := pullSigningStore(repo, output, "", "", "secret", false); err == nil || !strings.Contains(err.Error(), "--force") {
+		t.Fatalf("expected --force error, got %v", err)
+	}
+	if _, err := pullSigningStore(repo, output, "", "", "secret", true); err != nil {
+		t.Fatalf("forced pull error: %v", err)
+	}
+}
+
+func TestSigningStoreRejectsWrongPassphrase(t *testing.T) {
+	root := t.TempDir()
+	repo := filepath.Join(root, "store")
+	input := filepath.Join(root, "signing")
+	certificate, profile := signingStoreFixtures(t, "TEAM123456.com.example.app")
+	writeSigningFixtures(t, input, map[string]string{"ABC123.cer": certificate, "App_Store.mobileprovision": profile})
+
+	if _, err := pushSigningStore(repo, input, "com.example.app", "IOS_APP_STORE", "secret"); err != nil {
+		t.Fatalf("push error: %v", err)
+	}
+	if _, err := pushSigningStore(repo, input, "com.example.app", "IOS_APP_STORE", "wrong"); !errors.Is(err, errSigningStorePassphrase) {
+		t.Fatalf("expected passphrase error on push, got %v", err)
+	}
+	if _, err := pullSigningStore(repo, filepath.Join(root, "out"), "", "", "wrong", false); !errors.Is(err, errSigningStorePassphrase) {
+		t.Fatalf("expected passphrase error on pull, got %v", err)
+	}
+}
+
+func TestSigningStorePullRejectsTraversal(t *testing.T) {
+	root := t.TempDir()
+	repo := filepath.Join(root, "store")
+	input := filepath.Join(root, "signing")
+	certificate, profile := signingStoreFixtures(t, "TEAM123456.com.example.app")
+	writeSigningFixtures(t, input, map[string]string{"ABC123.cer": certificate, "App_Store.mobileprovision": profile})
+
+	if _, err := pushSigningStore(repo, input, "com.example.app", "IOS_APP_STORE", "secret"); err != nil {
+		t.Fatalf("push error: %v", err)
+	}
+
+	idx, err := loadSigningStoreIndex(repo)
+	if err != nil {
+		t.Fatalf("load index: %v", err)
+	}
+	idx.Assets[0].Name = "../escape" + filepath.Ext(idx.Assets[0].Name)
+	if err := saveSigningStoreIndex(repo, idx); err != nil {
+		t.Fatalf("save index: %v", err)
+	}
+
+	if _, err := pullSigningStore(repo, filepath.Join(root, "out"), "", "", "secret", false); err == nil || !strings.Contains(err.Error(), "invalid asset") {
+		t.Fatalf("expected invalid asset error, got %v", err)
+	}
+}
+
+func TestSigningStorePushCommitsGitWorkTree(t *testing.T) {
+	root := t.TempDir()
+	repo := filepath.Join(root, "store")
+	input := filepath.Join(root, "signing")
+	certificate, profile := signingStoreFixtures(t, "TEAM123456.com.example.app")
+	writeSigningFixtures(t, input, map[string]string{"ABC123.cer": certificate, "App_Store.mobileprovision": profile})
+	if err := os.MkdirAll(filepath.Join(repo, ".git"), 0o755); err != nil {
+		t.Fatalf("mkdir .git: %v", err)
+	}
+
+	var calls [][]string
+	previous := runGit
+	runGit = func(ctx context.Context, dir string, args ...string) error {
+		calls = append(calls, args)
+		return nil
+	}
+	t.Cleanup(func() { runGit = previous })
+
+	cmd := SigningCommand()
+	stdout, _ := captureOutput(t, func() {
+		if err := cmd.Parse([]string{"store", "push", "--repo", repo, "--input", input, "--bundle-id", "com.example.app", "--profile-type", "ios_app_store", "--passphrase", "secret"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := cmd.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if len(calls) != 2 || calls[0][0] != "add" || calls[1][0] != "commit" {
+		t.Fatalf("expected git add and commit, got %v", calls)
+	}
+	if !strings.Contains(strings.Join(calls[1], " "), "IOS_APP_STORE") {
+		t.Fatalf("expected commit message to mention profile type, got %v", calls[1])
+	}
+	if !strings.Contains(stdout, `"committed":true`) {
+		t.Fatalf("unexpected output %q", stdout)
+	}
+}
+
+func TestSigningStorePushRejectsUnrelatedFiles(t *testing.T) {
+	certificate, profile := signingStoreFixtures(t, "TEAM123456.com.example.app")
+	otherCertificate, otherProfile := signingStoreFixtures(t, "TEAM123456.com.other.app")
+	tests := []struct {
+		name        string
+		files       map[string]string
+		profileType string
+		wantErr     string
+	}{
+		{
+			name:        "profile for another bundle ID",
+			files:       map[string]string{"Other.mobileprovision": otherProfile},
+			profileType: "IOS_APP_STORE",
+			wantErr:     "not bundle ID com.example.app",
+		},
+		{
+			name:        "profile of another type",
+			files:       map[string]string{"App_Store.mobileprovision": profile},
+			profileType: "IOS_APP_ADHOC",
+			wantErr:     "not IOS_APP_ADHOC",
+		},
+		{
+			name:        "certificate not in any profile",
+			files:       map[string]string{"App_Store.mobileprovision": profile, "ABC123.cer": certificate, "OTHER.cer": otherCertificate},
+			profileType: "IOS_APP_STORE",
+			wantErr:     "OTHER.cer is not used by any IOS_APP_STORE profile",
+		},
+		{
+			name:        "unreadable profile",
+			files:       map[string]string{"Broken.mobileprovision": "profile-bytes"},
+			profileType: "IOS_APP_STORE",
+			wantErr:     "Broken.mobileprovision",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := t.TempDir()
+			repo := filepath.Join(root, "store")
+			input := filepath.Join(root, "signing")
+			writeSigningFixtures(t, input, test.files)
+
+			_, err := pushSigningStore(repo, input, "com.example.app", test.profileType, "secret")
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+			}
+			if _, statErr := os.Stat(filepath.Join(repo, signingStoreIndexFile)); !os.IsNotExist(statErr) {
+				t.Fatalf("expected nothing to be stored, got %v", statErr)
+			}
+		})
+	}
+}
@@ -44,3 +44,7 @@ func splitCSVUpper(value string) []string {
 func parseCommaSeparatedIDs(value string) []string {
 	return shared.SplitCSV(value)
 }
+
+func contextWithUploadTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	return shared.ContextWithUploadTimeout(ctx)
+}
@@ -28,7 +28,15 @@ Examples:
   asc subscriptions list --group "GROUP_ID"
   asc subscriptions create --group "GROUP_ID" --ref-name "Monthly" --product-id "com.example.sub.monthly"
   asc subscriptions prices add --id "SUB_ID" --price-point "PRICE_POINT_ID"
-  asc subscriptions availability set --id "SUB_ID" --territory "USA,CAN"`,
+  asc subscriptions availability set --id "SUB_ID" --territory "USA,CAN"
+  asc subscriptions intro-offers create --subscription "SUB_ID" --mode FREE_TRIAL --duration ONE_WEEK --all-territories
+  asc subscriptions promo-offers list --subscription "SUB_ID"
+  asc subscriptions offer-codes list --subscription "SUB_ID"
+  asc subscriptions win-back list --subscription "SUB_ID"
+  asc subscriptions localizations create --subscription "SUB_ID" --locale "en-US" --name "Pro Monthly"
+  asc subscriptions review-screenshot upload --subscription "SUB_ID" --file "./review.png"
+  asc subscriptions submit --id "SUB_ID" --confirm
+  asc subscriptions grace-period get --app "APP_ID"`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
@@ -40,6 +48,16 @@ Examples:
 			SubscriptionsDeleteCommand(),
 			SubscriptionsPricesCommand(),
 			SubscriptionsAvailabilityCommand(),
+			SubscriptionsPricePointsCommand(),
+			SubscriptionsIntroOffersCommand(),
+			SubscriptionsPromoOffersCommand(),
+			SubscriptionsOfferCodesCommand(),
+			SubscriptionsWinBackCommand(),
+			SubscriptionsLocalizationsCommand(),
+			SubscriptionsImagesCommand(),
+			SubscriptionsReviewScreenshotCommand(),
+			SubscriptionsSubmitCommand(),
+			SubscriptionsGracePeriodCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -61,7 +79,9 @@ Examples:
   asc subscriptions groups list --app "APP_ID"
   asc subscriptions groups create --app "APP_ID" --reference-name "Premium"
   asc subscriptions groups get --id "GROUP_ID"
-  asc subscriptions groups delete --id "GROUP_ID" --confirm`,
+  asc subscriptions groups delete --id "GROUP_ID" --confirm
+  asc subscriptions groups localizations create --group "GROUP_ID" --locale "en-US" --name "Pro"
+  asc subscriptions groups submit --id "GROUP_ID" --confirm`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
@@ -70,6 +90,8 @@ Examples:
 			SubscriptionsGroupsGetCommand(),
 			SubscriptionsGroupsUpdateCommand(),
 			SubscriptionsGroupsDeleteCommand(),
+			SubscriptionsGroupsLocalizationsCommand(),
+			SubscriptionsGroupsSubmitCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -0,0 +1,642 @@
+package subscriptions
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// SubscriptionsImagesCommand returns the subscriptions images command group.
+func SubscriptionsImagesCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("images", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "images",
+		ShortUsage: "asc subscriptions images <subcommand> [flags]",
+		ShortHelp:  "Manage promotional images for a subscription.",
+		LongHelp: `Manage promotional images for a subscription.
+
+Examples:
+  asc subscriptions images list --subscription "SUB_ID"
+  asc subscriptions images upload --subscription "SUB_ID" --file "./promo.png"
+  asc subscriptions images delete --id "IMAGE_ID" --confirm`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			SubscriptionsImagesListCommand(),
+			SubscriptionsImagesUploadCommand(),
+			SubscriptionsImagesDeleteCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// SubscriptionsImagesListCommand returns the images list subcommand.
+func SubscriptionsImagesListCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("images list", flag.ExitOnError)
+
+	subID := fs.String("subscription", "", "Subscription ID")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "list",
+		ShortUsage: "asc subscriptions images list [flags]",
+		ShortHelp:  "List promotional images for a subscription.",
+		LongHelp: `List promotional images for a subscription.
+
+Examples:
+  asc subscriptions images list --subscription "SUB_ID"`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*subID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --subscription is required")
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions images list: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			resp, err := client.GetSubscriptionImages(requestCtx, id)
+			if err != nil {
+				return fmt.Errorf("subscriptions images list: failed to fetch: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// SubscriptionsImagesUploadCommand returns the images upload subcommand.
+func SubscriptionsImagesUploadCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("images upload", flag.ExitOnError)
+
+	subID := fs.String("subscription", "", "Subscription ID")
+	filePath := fs.String("file", "", "Path to image file (1024x1024)")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "upload",
+		ShortUsage: "asc subscriptions images upload [flags]",
+		ShortHelp:  "Upload a promotional image for a subscription.",
+		LongHelp: `Upload a promotional image for a subscription.
+
+Examples:
+  asc subscriptions images upload --subscription "SUB_ID" --file "./promo.png"`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*subID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --subscription is required")
+				return flag.ErrHelp
+			}
+			path := strings.TrimSpace(*filePath)
+			if path == "" {
+				fmt.Fprintln(os.Stderr, "Error: --file is required")
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions images upload: %w", err)
+			}
+
+			requestCtx, cancel := contextWithUploadTimeout(ctx)
+			defer cancel()
+
+			result, err := uploadSubscriptionAsset(requestCtx, id, path, shared.AssetUploader{
+				Reserve: func(ctx context.Context, fileName string, fileSize int64) (string, []asc.UploadOperation, error) {
+					resp, err := client.CreateSubscriptionImage(ctx, id, fileName, fileSize)
+					if err != nil {
+						return "", nil, err
+					}
+					return resp.Data.ID, resp.Data.Attributes.UploadOperations, nil
+				},
+				Commit: func(ctx context.Context, assetID, checksum string) error {
+					_, err := client.UpdateSubscriptionImage(ctx, assetID, true, checksum)
+					return err
+				},
+				State: func(ctx context.Context, assetID string) (*asc.AssetDeliveryState, error) {
+					resp, err := client.GetSubscriptionImage(ctx, assetID)
+					if err != nil {
+						return nil, err
+					}
+					return resp.Data.Attributes.AssetDeliveryState, nil
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("subscriptions images upload: %w", err)
+			}
+
+			return printOutput(result, *output, *pretty)
+		},
+	}
+}
+
+// SubscriptionsImagesDeleteCommand returns the images delete subcommand.
+func SubscriptionsImagesDeleteCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("images delete", flag.ExitOnError)
+
+	imageID := fs.String("id", "", "Subscription image ID")
+	confirm := fs.Bool("confirm", false, "Confirm deletion")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "delete",
+		ShortUsage: "asc subscriptions images delete --id \"IMAGE_ID\" --confirm",
+		ShortHelp:  "Delete a subscription promotional image.",
+		LongHelp: `Delete a subscription promotional image.
+
+Examples:
+  asc subscriptions images delete --id "IMAGE_ID" --confirm`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*imageID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+			if !*confirm {
+				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions images delete: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			if err := client.DeleteSubscriptionImage(requestCtx, id); err != nil {
+				return fmt.Errorf("subscriptions images delete: failed to delete: %w", err)
+			}
+
+			result := &asc.SubscriptionAssetDeleteResult{
+				ID:      id,
+				Deleted: true,
+			}
+
+			return printOutput(result, *output, *pretty)
+		},
+	}
+}
+
+// SubscriptionsReviewScreenshotCommand returns the subscriptions review-screenshot command group.
+func SubscriptionsReviewScreenshotCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("review-screenshot", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "review-screenshot",
+		ShortUsage: "asc subscriptions review-screenshot <subcommand> [flags]",
+		ShortHelp:  "Manage the App Review screenshot for a subscription.",
+		LongHelp: `Manage the App Review screenshot for a subscription.
+
+Examples:
+  asc subscriptions review-screenshot get --subscription "SUB_ID"
+  asc subscriptions review-screenshot upload --subscription "SUB_ID" --file "./review.png"
+  asc subscriptions review-screenshot upload --subscription "SUB_ID" --file "./review.png" --replace`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			SubscriptionsReviewScreenshotGetCommand(),
+			SubscriptionsReviewScreenshotUploadCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// SubscriptionsReviewScreenshotGetCommand returns the review-screenshot get subcommand.
+func SubscriptionsReviewScreenshotGetCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("review-screenshot get", flag.ExitOnError)
+
+	subID := fs.String("subscription", "", "Subscription ID")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "get",
+		ShortUsage: "asc subscriptions review-screenshot get [flags]",
+		ShortHelp:  "Show the App Review screenshot for a subscription.",
+		LongHelp: `Show the App Review screenshot for a subscription.
+
+Examples:
+  asc subscriptions review-screenshot get --subscription "SUB_ID"`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*subID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --subscription is required")
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions review-screenshot get: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			resp, err := client.GetSubscriptionReviewScreenshotForSubscription(requestCtx, id)
+			if err != nil {
+				if asc.IsNotFound(err) {
+					return fmt.Errorf("subscriptions review-screenshot get: no review screenshot for subscription %q", id)
+				}
+				return fmt.Errorf("subscriptions review-screenshot get: failed to fetch: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// SubscriptionsReviewScreenshotUploadCommand returns the review-screenshot upload subcommand.
+func SubscriptionsReviewScreenshotUploadCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("review-screenshot upload", flag.ExitOnError)
+
+	subID := fs.String("subscription", "", "Subscription ID")
+	filePath := fs.String("file", "", "Path to screenshot file")
+	replace := fs.Bool("replace", false, "Delete the existing review screenshot before uploading")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "upload",
+		ShortUsage: "asc subscriptions review-screenshot upload [flags]",
+		ShortHelp:  "Upload the App Review screenshot for a subscription.",
+		LongHelp: `Upload the App Review screenshot for a subscription.
+
+A subscription has at most one review screenshot. Use --replace to delete the
+current screenshot before uploading a new one.
+
+Examples:
+  asc subscriptions review-screenshot upload --subscription "SUB_ID" --file "./review.png"
+  asc subscriptions review-screenshot upload --subscription "SUB_ID" --file "./review.png" --replace`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*subID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --subscription is required")
+				return flag.ErrHelp
+			}
+			path := strings.TrimSpace(*filePath)
+			if path == "" {
+				fmt.Fprintln(os.Stderr, "Error: --file is required")
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions review-screenshot upload: %w", err)
+			}
+
+			requestCtx, cancel := contextWithUploadTimeout(ctx)
+			defer cancel()
+
+			existing, err := client.GetSubscriptionReviewScreenshotForSubscription(requestCtx, id)
+			if err != nil && !asc.IsNotFound(err) {
+				return fmt.Errorf("subscriptions review-screenshot upload: failed to check existing screenshot: %w", err)
+			}
+			if err == nil && strings.TrimSpace(existing.Data.ID) != "" {
+				if !*replace {
+					return fmt.Errorf("subscriptions review-screenshot upload: subscription %q already has review screenshot %q (use --replace)", id, existing.Data.ID)
+				}
+				if err := client.DeleteSubscriptionReviewScreenshot(requestCtx, existing.Data.ID); err != nil {
+					return fmt.Errorf("subscriptions review-screenshot upload: failed to delete existing screenshot: %w", err)
+				}
+			}
+
+			result, err := uploadSubscriptionAsset(requestCtx, id, path, shared.AssetUploader{
+				Reserve: func(ctx context.Context, fileName string, fileSize int64) (string, []asc.UploadOperation, error) {
+					resp, err := client.CreateSubscriptionReviewScreenshot(ctx, id, fileName, fileSize)
+					if err != nil {
+						return "", nil, err
+					}
+					return resp.Data.ID, resp.Data.Attributes.UploadOperations, nil
+				},
+				Commit: func(ctx context.Context, assetID, checksum string) error {
+					_, err := client.UpdateSubscriptionReviewScreenshot(ctx, assetID, true, checksum)
+					return err
+				},
+				State: func(ctx context.Context, assetID string) (*asc.AssetDeliveryState, error) {
+					resp, err := client.GetSubscriptionReviewScreenshot(ctx, assetID)
+					if err != nil {
+						return nil, err
+					}
+					return resp.Data.Attributes.AssetDeliveryState, nil
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("subscriptions review-screenshot upload: %w", err)
+			}
+
+			return printOutput(result, *output, *pretty)
+		},
+	}
+}
+
+// SubscriptionsSubmitCommand returns the subscriptions submit subcommand.
+func SubscriptionsSubmitCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("submit", flag.ExitOnError)
+
+	subID := fs.String("id", "", "Subscription ID")
+	confirm := fs.Bool("confirm", false, "Confirm submission (required)")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "submit",
+		ShortUsage: "asc subscriptions submit [flags]",
+		ShortHelp:  "Submit a subscription for App Review.",
+		LongHelp: `Submit a subscription for App Review.
+
+The subscription needs a localization, a price, and a review screenshot, and
+its group needs a localization, before App Store Connect accepts the
+submission. The first subscription for an app must be submitted with a new
+app version.
+
+Examples:
+  asc subscriptions submit --id "SUB_ID" --confirm`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*subID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+			if !*confirm {
+				fmt.Fprintln(os.Stderr, "Error: --confirm is required to submit for review")
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions submit: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			resp, err := client.CreateSubscriptionSubmission(requestCtx, id)
+			if err != nil {
+				return fmt.Errorf("subscriptions submit: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// SubscriptionsGroupsSubmitCommand returns the subscriptions groups submit subcommand.
+func SubscriptionsGroupsSubmitCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("groups submit", flag.ExitOnError)
+
+	groupID := fs.String("id", "", "Subscription group ID")
+	confirm := fs.Bool("confirm", false, "Confirm submission (required)")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "submit",
+		ShortUsage: "asc subscriptions groups submit [flags]",
+		ShortHelp:  "Submit every ready subscription in a group for App Review.",
+		LongHelp: `Submit every ready subscription in a group for App Review.
+
+Examples:
+  asc subscriptions groups submit --id "GROUP_ID" --confirm`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*groupID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+			if !*confirm {
+				fmt.Fprintln(os.Stderr, "Error: --confirm is required to submit for review")
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions groups submit: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			resp, err := client.CreateSubscriptionGroupSubmission(requestCtx, id)
+			if err != nil {
+				return fmt.Errorf("subscriptions groups submit: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// SubscriptionsGracePeriodCommand returns the subscriptions grace-period command group.
+func SubscriptionsGracePeriodCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("grace-period", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "grace-period",
+		ShortUsage: "asc subscriptions grace-period <subcommand> [flags]",
+		ShortHelp:  "Manage the billing grace period for an app's subscriptions.",
+		LongHelp: `Manage the billing grace period for an app's subscriptions.
+
+Examples:
+  asc subscriptions grace-period get --app "APP_ID"
+  asc subscriptions grace-period update --app "APP_ID" --opt-in --duration SIXTEEN_DAYS --renewal-type ALL_RENEWALS`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			SubscriptionsGracePeriodGetCommand(),
+			SubscriptionsGracePeriodUpdateCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// SubscriptionsGracePeriodGetCommand returns the grace-period get subcommand.
+func SubscriptionsGracePeriodGetCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("grace-period get", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "get",
+		ShortUsage: "asc subscriptions grace-period get [flags]",
+		ShortHelp:  "Show billing grace period settings.",
+		LongHelp: `Show billing grace period settings.
+
+Examples:
+  asc subscriptions grace-period get --app "APP_ID"`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID := resolveAppID(*appID)
+			if resolvedAppID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions grace-period get: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			resp, err := client.GetSubscriptionGracePeriod(requestCtx, resolvedAppID)
+			if err != nil {
+				return fmt.Errorf("subscriptions grace-period get: failed to fetch: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// SubscriptionsGracePeriodUpdateCommand returns the grace-period update subcommand.
+func SubscriptionsGracePeriodUpdateCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("grace-period update", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	optIn := fs.Bool("opt-in", false, "Enable the billing grace period in production")
+	sandboxOptIn := fs.Bool("sandbox-opt-in", false, "Enable the billing grace period in sandbox")
+	duration := fs.String("duration", "", "Grace period duration: "+strings.Join(asc.ValidSubscriptionGracePeriodDurations, ", "))
+	renewalType := fs.String("renewal-type", "", "Renewals covered: "+strings.Join(asc.ValidSubscriptionGracePeriodRenewalTypes, ", "))
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "update",
+		ShortUsage: "asc subscriptions grace-period update [flags]",
+		ShortHelp:  "Update billing grace period settings.",
+		LongHelp: `Update billing grace period settings.
+
+Boolean flags accept explicit values, e.g. --opt-in=false to turn the grace
+period off.
+
+Examples:
+  asc subscriptions grace-period update --app "APP_ID" --opt-in --duration SIXTEEN_DAYS
+  asc subscriptions grace-period update --app "APP_ID" --sandbox-opt-in --renewal-type PAID_TO_PAID_ONLY
+  asc subscriptions grace-period update --app "APP_ID" --opt-in=false`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID := resolveAppID(*appID)
+			if resolvedAppID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
+				return flag.ErrHelp
+			}
+
+			visited := map[string]bool{}
+			fs.Visit(func(f *flag.Flag) {
+				visited[f.Name] = true
+			})
+
+			hasUpdates := visited["opt-in"] ||
+				visited["sandbox-opt-in"] ||
+				visited["duration"] ||
+				visited["renewal-type"]
+			if !hasUpdates {
+				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
+				return flag.ErrHelp
+			}
+
+			attrs := asc.SubscriptionGracePeriodUpdateAttributes{}
+			if visited["opt-in"] {
+				value := *optIn
+				attrs.OptIn = &value
+			}
+			if visited["sandbox-opt-in"] {
+				value := *sandboxOptIn
+				attrs.SandboxOptIn = &value
+			}
+			if visited["duration"] {
+				value := strings.ToUpper(strings.TrimSpace(*duration))
+				if !asc.IsValidSubscriptionGracePeriodDuration(value) {
+					return fmt.Errorf("subscriptions grace-period update: --duration must be one of: %s", strings.Join(asc.ValidSubscriptionGracePeriodDurations, ", "))
+				}
+				attrs.Duration = &value
+			}
+			if visited["renewal-type"] {
+				value := strings.ToUpper(strings.TrimSpace(*renewalType))
+				if !asc.IsValidSubscriptionGracePeriodRenewalType(value) {
+					return fmt.Errorf("subscriptions grace-period update: --renewal-type must be one of: %s", strings.Join(asc.ValidSubscriptionGracePeriodRenewalTypes, ", "))
+				}
+				attrs.RenewalType = &value
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions grace-period update: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			current, err := client.GetSubscriptionGracePeriod(requestCtx, resolvedAppID)
+			if err != nil {
+				return fmt.Errorf("subscriptions grace-period update: failed to fetch: %w", err)
+			}
+
+			resp, err := client.UpdateSubscriptionGracePeriod(requestCtx, current.Data.ID, attrs)
+			if err != nil {
+				return fmt.Errorf("subscriptions grace-period update: failed to update: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+func uploadSubscriptionAsset(ctx context.Context, subID, filePath string, uploader shared.AssetUploader) (*asc.SubscriptionAssetUploadResult, error) {
+	asset, err := shared.UploadImageAsset(ctx, filePath, uploader)
+	if err != nil {
+		return nil, err
+	}
+
+	return &asc.SubscriptionAssetUploadResult{
+		SubscriptionID: subID,
+		AssetID:        asset.ID,
+		FileName:       asset.FileName,
+		FilePath:       filePath,
+		FileSize:       asset.FileSize,
+		State:          asset.State,
+	}, nil
+}
@@ -0,0 +1,344 @@
+package subscriptions
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// SubscriptionsIntroOffersCommand returns the subscriptions intro-offers command group.
+func SubscriptionsIntroOffersCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("intro-offers", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "intro-offers",
+		ShortUsage: "asc subscriptions intro-offers <subcommand> [flags]",
+		ShortHelp:  "Manage subscription introductory offers.",
+		LongHelp: `Manage subscription introductory offers.
+
+Introductory offers are defined per territory. Paid offers need a price point
+for each territory; free trials only need the territories.
+
+Examples:
+  asc subscriptions intro-offers list --subscription "SUB_ID"
+  asc subscriptions intro-offers create --subscription "SUB_ID" --mode FREE_TRIAL --duration ONE_WEEK --all-territories
+  asc subscriptions intro-offers create --subscription "SUB_ID" --mode PAY_AS_YOU_GO --duration ONE_MONTH --periods 3 --prices "USA:PRICE_POINT_ID" --equalize
+  asc subscriptions intro-offers delete --id "OFFER_ID" --confirm`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			SubscriptionsIntroOffersListCommand(),
+			SubscriptionsIntroOffersCreateCommand(),
+			SubscriptionsIntroOffersDeleteCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// SubscriptionsIntroOffersListCommand returns the intro-offers list subcommand.
+func SubscriptionsIntroOffersListCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("intro-offers list", flag.ExitOnError)
+
+	subID := fs.String("subscription", "", "Subscription ID")
+	territory := fs.String("territory", "", "Filter by territory IDs (comma-separated)")
+	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
+	next := fs.String("next", "", "Fetch next page using a links.next URL")
+	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "list",
+		ShortUsage: "asc subscriptions intro-offers list [flags]",
+		ShortHelp:  "List introductory offers for a subscription.",
+		LongHelp: `List introductory offers for a subscription.
+
+Examples:
+  asc subscriptions intro-offers list --subscription "SUB_ID"
+  asc subscriptions intro-offers list --subscription "SUB_ID" --territory "USA,GBR"
+  asc subscriptions intro-offers list --subscription "SUB_ID" --paginate --output table`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if *limit != 0 && (*limit < 1 || *limit > 200) {
+				return fmt.Errorf("subscriptions intro-offers list: --limit must be between 1 and 200")
+			}
+			if err := validateNextURL(*next); err != nil {
+				return fmt.Errorf("subscriptions intro-offers list: %w", err)
+			}
+
+			id := strings.TrimSpace(*subID)
+			if id == "" && strings.TrimSpace(*next) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --subscription is required")
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions intro-offers list: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			opts := []asc.SubscriptionOffersOption{
+				asc.WithSubscriptionOffersLimit(*limit),
+				asc.WithSubscriptionOffersNextURL(*next),
+				asc.WithSubscriptionOffersTerritories(splitCSVUpper(*territory)),
+			}
+
+			if *paginate {
+				paginateOpts := append(opts, asc.WithSubscriptionOffersLimit(200))
+				firstPage, err := client.GetSubscriptionIntroductoryOffers(requestCtx, id, paginateOpts...)
+				if err != nil {
+					return fmt.Errorf("subscriptions intro-offers list: failed to fetch: %w", err)
+				}
+
+				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+					return client.GetSubscriptionIntroductoryOffers(ctx, id, asc.WithSubscriptionOffersNextURL(nextURL))
+				})
+				if err != nil {
+					return fmt.Errorf("subscriptions intro-offers list: %w", err)
+				}
+
+				return printOutput(resp, *output, *pretty)
+			}
+
+			resp, err := client.GetSubscriptionIntroductoryOffers(requestCtx, id, opts...)
+			if err != nil {
+				return fmt.Errorf("subscriptions intro-offers list: failed to fetch: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// SubscriptionsIntroOffersCreateCommand returns the intro-offers create subcommand.
+func SubscriptionsIntroOffersCreateCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("intro-offers create", flag.ExitOnError)
+
+	subID := fs.String("subscription", "", "Subscription ID")
+	duration := fs.String("duration", "", "Offer duration: "+strings.Join(asc.ValidSubscriptionOfferDurations, ", "))
+	mode := fs.String("mode", "", "Offer mode: "+strings.Join(asc.ValidSubscriptionOfferModes, ", "))
+	periods := fs.Int("periods", 1, "Number of periods")
+	startDate := fs.String("start-date", "", "Start date (YYYY-MM-DD)")
+	endDate := fs.String("end-date", "", "End date (YYYY-MM-DD)")
+	prices := fs.String("prices", "", "Per-territory prices as TERRITORY:PRICE_POINT_ID (comma-separated)")
+	pricesFile := fs.String("prices-file", "", "File with one TERRITORY,PRICE_POINT_ID per line")
+	equalize := fs.Bool("equalize", false, "Expand a single base price to every territory using equalized price points")
+	territory := fs.String("territory", "", "Territory IDs for free trials (comma-separated)")
+	allTerritories := fs.Bool("all-territories", false, "Create free trials in every App Store territory")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "create",
+		ShortUsage: "asc subscriptions intro-offers create [flags]",
+		ShortHelp:  "Create introductory offers for a subscription.",
+		LongHelp: `Create introductory offers for a subscription.
+
+One offer is created per territory. Paid offers (PAY_AS_YOU_GO, PAY_UP_FRONT)
+take prices from --prices and --prices-file; add --equalize with a single base
+price to cover every territory. Free trials take --territory or
+--all-territories instead of prices.
+
+Examples:
+  asc subscriptions intro-offers create --subscription "SUB_ID" --mode FREE_TRIAL --duration ONE_WEEK --territory "USA,GBR"
+  asc subscriptions intro-offers create --subscription "SUB_ID" --mode PAY_UP_FRONT --duration THREE_MONTHS --prices "USA:PP_ID,GBR:PP_ID"
+  asc subscriptions intro-offers create --subscription "SUB_ID" --mode PAY_AS_YOU_GO --duration ONE_MONTH --periods 3 --prices "USA:PP_ID" --equalize --start-date "2026-04-01" --end-date "2026-06-30"`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*subID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --subscription is required")
+				return flag.ErrHelp
+			}
+			if strings.TrimSpace(*duration) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --duration is required")
+				return flag.ErrHelp
+			}
+			if strings.TrimSpace(*mode) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --mode is required")
+				return flag.ErrHelp
+			}
+
+			offerDuration, err := normalizeOfferDuration(*duration)
+			if err != nil {
+				return fmt.Errorf("subscriptions intro-offers create: %w", err)
+			}
+			offerMode, err := normalizeOfferMode(*mode)
+			if err != nil {
+				return fmt.Errorf("subscriptions intro-offers create: %w", err)
+			}
+			if *periods < 1 {
+				return fmt.Errorf("subscriptions intro-offers create: --periods must be at least 1")
+			}
+
+			attrs := asc.SubscriptionIntroductoryOfferAttributes{
+				Duration:        offerDuration,
+				OfferMode:       offerMode,
+				NumberOfPeriods: *periods,
+			}
+			if strings.TrimSpace(*startDate) != "" {
+				attrs.StartDate, err = shared.NormalizeDate(*startDate, "--start-date")
+				if err != nil {
+					return fmt.Errorf("subscriptions intro-offers create: %w", err)
+				}
+			}
+			if strings.TrimSpace(*endDate) != "" {
+				attrs.EndDate, err = shared.NormalizeDate(*endDate, "--end-date")
+				if err != nil {
+					return fmt.Errorf("subscriptions intro-offers create: %w", err)
+				}
+			}
+
+			hasPrices := strings.TrimSpace(*prices) != "" || strings.TrimSpace(*pricesFile) != ""
+			territories := splitCSVUpper(*territory)
+			freeTrial := offerMode == "FREE_TRIAL"
+			if freeTrial {
+				if hasPrices || *equalize {
+					return fmt.Errorf("subscriptions intro-offers create: FREE_TRIAL offers do not take prices; use --territory or --all-territories")
+				}
+				if len(territories) == 0 && !*allTerritories {
+					fmt.Fprintln(os.Stderr, "Error: --territory or --all-territories is required for FREE_TRIAL")
+					return flag.ErrHelp
+				}
+				if len(territories) > 0 && *allTerritories {
+					return fmt.Errorf("subscriptions intro-offers create: --territory and --all-territories are mutually exclusive")
+				}
+			} else {
+				if len(territories) > 0 || *allTerritories {
+					return fmt.Errorf("subscriptions intro-offers create: --territory and --all-territories only apply to FREE_TRIAL; use --prices for %s", offerMode)
+				}
+				if !hasPrices {
+					fmt.Fprintln(os.Stderr, "Error: --prices or --prices-file is required")
+					return flag.ErrHelp
+				}
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions intro-offers create: %w", err)
+			}
+
+			resolveCtx, cancel := contextWithTimeout(ctx)
+			var offerPrices []asc.SubscriptionOfferPrice
+			if freeTrial {
+				if *allTerritories {
+					territories, err = fetchAllTerritoryIDs(resolveCtx, client)
+				}
+				for _, territoryID := range territories {
+					offerPrices = append(offerPrices, asc.SubscriptionOfferPrice{Territory: territoryID})
+				}
+			} else {
+				offerPrices, err = resolveOfferPrices(resolveCtx, client, *prices, *pricesFile, *equalize)
+			}
+			cancel()
+			if err != nil {
+				return fmt.Errorf("subscriptions intro-offers create: %w", err)
+			}
+
+			created := &asc.SubscriptionIntroductoryOffersResponse{}
+			for _, price := range offerPrices {
+				createCtx, cancel := contextWithTimeout(ctx)
+				resp, err := client.CreateSubscriptionIntroductoryOffer(createCtx, id, attrs, price)
+				cancel()
+				if err != nil {
+					// Report the offers that were created so a retry can skip them.
+					if printErr := printOutput(created, *output, *pretty); printErr != nil {
+						return printErr
+					}
+					return shared.NewReportedError(fmt.Errorf("subscriptions intro-offers create: failed for %s after creating %d offers: %w", price.Territory, len(created.Data), err))
+				}
+				created.Data = append(created.Data, resp.Data)
+			}
+
+			return printOutput(created, *output, *pretty)
+		},
+	}
+}
+
+// SubscriptionsIntroOffersDeleteCommand returns the intro-offers delete subcommand.
+func SubscriptionsIntroOffersDeleteCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("intro-offers delete", flag.ExitOnError)
+
+	offerID := fs.String("id", "", "Introductory offer ID")
+	confirm := fs.Bool("confirm", false, "Confirm deletion")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "delete",
+		ShortUsage: "asc subscriptions intro-offers delete --id \"OFFER_ID\" --confirm",
+		ShortHelp:  "Delete an introductory offer.",
+		LongHelp: `Delete an introductory offer.
+
+Examples:
+  asc subscriptions intro-offers delete --id "OFFER_ID" --confirm`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*offerID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+			if !*confirm {
+				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions intro-offers delete: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			if err := client.DeleteSubscriptionIntroductoryOffer(requestCtx, id); err != nil {
+				return fmt.Errorf("subscriptions intro-offers delete: failed to delete: %w", err)
+			}
+
+			result := &asc.SubscriptionOfferDeleteResult{
+				ID:      id,
+				Deleted: true,
+			}
+
+			return printOutput(result, *output, *pretty)
+		},
+	}
+}
+
+func fetchAllTerritoryIDs(ctx context.Context, client *asc.Client) ([]string, error) {
+	firstPage, err := client.GetTerritories(ctx, asc.WithTerritoriesLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch territories: %w", err)
+	}
+	all, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetTerritories(ctx, asc.WithTerritoriesNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, err
+	}
+	territories, ok := all.(*asc.TerritoriesResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected territories response type %T", all)
+	}
+	ids := make([]string, 0, len(territories.Data))
+	for _, item := range territories.Data {
+		ids = append(ids, item.ID)
+	}
+	return ids, nil
+}
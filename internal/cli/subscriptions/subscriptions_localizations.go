@@ -0,0 +1,671 @@
+package subscriptions
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// SubscriptionsLocalizationsCommand returns the subscriptions localizations command group.
+func SubscriptionsLocalizationsCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("localizations", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "localizations",
+		ShortUsage: "asc subscriptions localizations <subcommand> [flags]",
+		ShortHelp:  "Manage subscription display names and descriptions.",
+		LongHelp: `Manage subscription display names and descriptions.
+
+Examples:
+  asc subscriptions localizations list --subscription "SUB_ID"
+  asc subscriptions localizations create --subscription "SUB_ID" --locale "en-US" --name "Pro Monthly" --description "All features, billed monthly"
+  asc subscriptions localizations update --id "LOC_ID" --description "Updated description"
+  asc subscriptions localizations delete --id "LOC_ID" --confirm`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			SubscriptionsLocalizationsListCommand(),
+			SubscriptionsLocalizationsGetCommand(),
+			SubscriptionsLocalizationsCreateCommand(),
+			SubscriptionsLocalizationsUpdateCommand(),
+			SubscriptionsLocalizationsDeleteCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// SubscriptionsLocalizationsListCommand returns the localizations list subcommand.
+func SubscriptionsLocalizationsListCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("localizations list", flag.ExitOnError)
+
+	subID := fs.String("subscription", "", "Subscription ID")
+	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
+	next := fs.String("next", "", "Fetch next page using a links.next URL")
+	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "list",
+		ShortUsage: "asc subscriptions localizations list [flags]",
+		ShortHelp:  "List localizations for a subscription.",
+		LongHelp: `List localizations for a subscription.
+
+Examples:
+  asc subscriptions localizations list --subscription "SUB_ID"
+  asc subscriptions localizations list --subscription "SUB_ID" --paginate --output table`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if *limit != 0 && (*limit < 1 || *limit > 200) {
+				return fmt.Errorf("subscriptions localizations list: --limit must be between 1 and 200")
+			}
+			if err := validateNextURL(*next); err != nil {
+				return fmt.Errorf("subscriptions localizations list: %w", err)
+			}
+
+			id := strings.TrimSpace(*subID)
+			if id == "" && strings.TrimSpace(*next) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --subscription is required")
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions localizations list: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			opts := []asc.SubscriptionLocalizationsOption{
+				asc.WithSubscriptionLocalizationsLimit(*limit),
+				asc.WithSubscriptionLocalizationsNextURL(*next),
+			}
+
+			if *paginate {
+				paginateOpts := append(opts, asc.WithSubscriptionLocalizationsLimit(200))
+				firstPage, err := client.GetSubscriptionLocalizations(requestCtx, id, paginateOpts...)
+				if err != nil {
+					return fmt.Errorf("subscriptions localizations list: failed to fetch: %w", err)
+				}
+
+				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+					return client.GetSubscriptionLocalizations(ctx, id, asc.WithSubscriptionLocalizationsNextURL(nextURL))
+				})
+				if err != nil {
+					return fmt.Errorf("subscriptions localizations list: %w", err)
+				}
+
+				return printOutput(resp, *output, *pretty)
+			}
+
+			resp, err := client.GetSubscriptionLocalizations(requestCtx, id, opts...)
+			if err != nil {
+				return fmt.Errorf("subscriptions localizations list: failed to fetch: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// SubscriptionsLocalizationsGetCommand returns the localizations get subcommand.
+func SubscriptionsLocalizationsGetCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("localizations get", flag.ExitOnError)
+
+	localizationID := fs.String("id", "", "Subscription localization ID")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "get",
+		ShortUsage: "asc subscriptions localizations get --id \"LOC_ID\"",
+		ShortHelp:  "Get a subscription localization.",
+		LongHelp: `Get a subscription localization.
+
+Examples:
+  asc subscriptions localizations get --id "LOC_ID"`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*localizationID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions localizations get: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			resp, err := client.GetSubscriptionLocalization(requestCtx, id)
+			if err != nil {
+				return fmt.Errorf("subscriptions localizations get: failed to fetch: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// SubscriptionsLocalizationsCreateCommand returns the localizations create subcommand.
+func SubscriptionsLocalizationsCreateCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("localizations create", flag.ExitOnError)
+
+	subID := fs.String("subscription", "", "Subscription ID")
+	locale := fs.String("locale", "", "Locale (e.g. en-US)")
+	name := fs.String("name", "", "Display name")
+	description := fs.String("description", "", "Description")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "create",
+		ShortUsage: "asc subscriptions localizations create [flags]",
+		ShortHelp:  "Create a subscription localization.",
+		LongHelp: `Create a subscription localization.
+
+Examples:
+  asc subscriptions localizations create --subscription "SUB_ID" --locale "en-US" --name "Pro Monthly"
+  asc subscriptions localizations create --subscription "SUB_ID" --locale "de-DE" --name "Pro Monatlich" --description "Alle Funktionen"`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*subID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --subscription is required")
+				return flag.ErrHelp
+			}
+			localeValue := strings.TrimSpace(*locale)
+			if localeValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --locale is required")
+				return flag.ErrHelp
+			}
+			nameValue := strings.TrimSpace(*name)
+			if nameValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --name is required")
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions localizations create: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			attrs := asc.SubscriptionLocalizationCreateAttributes{
+				Name:        nameValue,
+				Locale:      localeValue,
+				Description: strings.TrimSpace(*description),
+			}
+
+			resp, err := client.CreateSubscriptionLocalization(requestCtx, id, attrs)
+			if err != nil {
+				return fmt.Errorf("subscriptions localizations create: failed to create: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// SubscriptionsLocalizationsUpdateCommand returns the localizations update subcommand.
+func SubscriptionsLocalizationsUpdateCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("localizations update", flag.ExitOnError)
+
+	localizationID := fs.String("id", "", "Subscription localization ID")
+	name := fs.String("name", "", "Display name")
+	description := fs.String("description", "", "Description")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "update",
+		ShortUsage: "asc subscriptions localizations update [flags]",
+		ShortHelp:  "Update a subscription localization.",
+		LongHelp: `Update a subscription localization.
+
+Examples:
+  asc subscriptions localizations update --id "LOC_ID" --name "Pro Monthly"
+  asc subscriptions localizations update --id "LOC_ID" --description "Updated description"`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*localizationID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+
+			visited := map[string]bool{}
+			fs.Visit(func(f *flag.Flag) {
+				visited[f.Name] = true
+			})
+			if !visited["name"] && !visited["description"] {
+				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
+				return flag.ErrHelp
+			}
+
+			attrs := asc.SubscriptionLocalizationUpdateAttributes{}
+			if visited["name"] {
+				value := strings.TrimSpace(*name)
+				attrs.Name = &value
+			}
+			if visited["description"] {
+				value := strings.TrimSpace(*description)
+				attrs.Description = &value
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions localizations update: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			resp, err := client.UpdateSubscriptionLocalization(requestCtx, id, attrs)
+			if err != nil {
+				return fmt.Errorf("subscriptions localizations update: failed to update: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// SubscriptionsLocalizationsDeleteCommand returns the localizations delete subcommand.
+func SubscriptionsLocalizationsDeleteCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("localizations delete", flag.ExitOnError)
+
+	localizationID := fs.String("id", "", "Subscription localization ID")
+	confirm := fs.Bool("confirm", false, "Confirm deletion")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "delete",
+		ShortUsage: "asc subscriptions localizations delete --id \"LOC_ID\" --confirm",
+		ShortHelp:  "Delete a subscription localization.",
+		LongHelp: `Delete a subscription localization.
+
+Examples:
+  asc subscriptions localizations delete --id "LOC_ID" --confirm`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*localizationID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+			if !*confirm {
+				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions localizations delete: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			if err := client.DeleteSubscriptionLocalization(requestCtx, id); err != nil {
+				return fmt.Errorf("subscriptions localizations delete: failed to delete: %w", err)
+			}
+
+			result := &asc.SubscriptionLocalizationDeleteResult{
+				ID:      id,
+				Deleted: true,
+			}
+
+			return printOutput(result, *output, *pretty)
+		},
+	}
+}
+
+// SubscriptionsGroupsLocalizationsCommand returns the groups localizations command group.
+func SubscriptionsGroupsLocalizationsCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("groups localizations", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "localizations",
+		ShortUsage: "asc subscriptions groups localizations <subcommand> [flags]",
+		ShortHelp:  "Manage subscription group display names.",
+		LongHelp: `Manage subscription group display names.
+
+Examples:
+  asc subscriptions groups localizations list --group "GROUP_ID"
+  asc subscriptions groups localizations create --group "GROUP_ID" --locale "en-US" --name "Pro"
+  asc subscriptions groups localizations update --id "LOC_ID" --custom-app-name "Example Pro"
+  asc subscriptions groups localizations delete --id "LOC_ID" --confirm`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			SubscriptionsGroupsLocalizationsListCommand(),
+			SubscriptionsGroupsLocalizationsGetCommand(),
+			SubscriptionsGroupsLocalizationsCreateCommand(),
+			SubscriptionsGroupsLocalizationsUpdateCommand(),
+			SubscriptionsGroupsLocalizationsDeleteCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// SubscriptionsGroupsLocalizationsListCommand returns the groups localizations list subcommand.
+func SubscriptionsGroupsLocalizationsListCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("groups localizations list", flag.ExitOnError)
+
+	groupID := fs.String("group", "", "Subscription group ID")
+	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
+	next := fs.String("next", "", "Fetch next page using a links.next URL")
+	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "list",
+		ShortUsage: "asc subscriptions groups localizations list [flags]",
+		ShortHelp:  "List localizations for a subscription group.",
+		LongHelp: `List localizations for a subscription group.
+
+Examples:
+  asc subscriptions groups localizations list --group "GROUP_ID"
+  asc subscriptions groups localizations list --group "GROUP_ID" --paginate --output table`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if *limit != 0 && (*limit < 1 || *limit > 200) {
+				return fmt.Errorf("subscriptions groups localizations list: --limit must be between 1 and 200")
+			}
+			if err := validateNextURL(*next); err != nil {
+				return fmt.Errorf("subscriptions groups localizations list: %w", err)
+			}
+
+			id := strings.TrimSpace(*groupID)
+			if id == "" && strings.TrimSpace(*next) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --group is required")
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions groups localizations list: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			opts := []asc.SubscriptionLocalizationsOption{
+				asc.WithSubscriptionLocalizationsLimit(*limit),
+				asc.WithSubscriptionLocalizationsNextURL(*next),
+			}
+
+			if *paginate {
+				paginateOpts := append(opts, asc.WithSubscriptionLocalizationsLimit(200))
+				firstPage, err := client.GetSubscriptionGroupLocalizations(requestCtx, id, paginateOpts...)
+				if err != nil {
+					return fmt.Errorf("subscriptions groups localizations list: failed to fetch: %w", err)
+				}
+
+				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+					return client.GetSubscriptionGroupLocalizations(ctx, id, asc.WithSubscriptionLocalizationsNextURL(nextURL))
+				})
+				if err != nil {
+					return fmt.Errorf("subscriptions groups localizations list: %w", err)
+				}
+
+				return printOutput(resp, *output, *pretty)
+			}
+
+			resp, err := client.GetSubscriptionGroupLocalizations(requestCtx, id, opts...)
+			if err != nil {
+				return fmt.Errorf("subscriptions groups localizations list: failed to fetch: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// SubscriptionsGroupsLocalizationsGetCommand returns the groups localizations get subcommand.
+func SubscriptionsGroupsLocalizationsGetCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("groups localizations get", flag.ExitOnError)
+
+	localizationID := fs.String("id", "", "Subscription group localization ID")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "get",
+		ShortUsage: "asc subscriptions groups localizations get --id \"LOC_ID\"",
+		ShortHelp:  "Get a subscription group localization.",
+		LongHelp: `Get a subscription group localization.
+
+Examples:
+  asc subscriptions groups localizations get --id "LOC_ID"`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*localizationID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions groups localizations get: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			resp, err := client.GetSubscriptionGroupLocalization(requestCtx, id)
+			if err != nil {
+				return fmt.Errorf("subscriptions groups localizations get: failed to fetch: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// SubscriptionsGroupsLocalizationsCreateCommand returns the groups localizations create subcommand.
+func SubscriptionsGroupsLocalizationsCreateCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("groups localizations create", flag.ExitOnError)
+
+	groupID := fs.String("group", "", "Subscription group ID")
+	locale := fs.String("locale", "", "Locale (e.g. en-US)")
+	name := fs.String("name", "", "Display name")
+	customAppName := fs.String("custom-app-name", "", "App name shown on the subscription management page")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "create",
+		ShortUsage: "asc subscriptions groups localizations create [flags]",
+		ShortHelp:  "Create a subscription group localization.",
+		LongHelp: `Create a subscription group localization.
+
+Examples:
+  asc subscriptions groups localizations create --group "GROUP_ID" --locale "en-US" --name "Pro"
+  asc subscriptions groups localizations create --group "GROUP_ID" --locale "en-US" --name "Pro" --custom-app-name "Example Pro"`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*groupID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --group is required")
+				return flag.ErrHelp
+			}
+			localeValue := strings.TrimSpace(*locale)
+			if localeValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --locale is required")
+				return flag.ErrHelp
+			}
+			nameValue := strings.TrimSpace(*name)
+			if nameValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --name is required")
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions groups localizations create: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			attrs := asc.SubscriptionGroupLocalizationCreateAttributes{
+				Name:          nameValue,
+				Locale:        localeValue,
+				CustomAppName: strings.TrimSpace(*customAppName),
+			}
+
+			resp, err := client.CreateSubscriptionGroupLocalization(requestCtx, id, attrs)
+			if err != nil {
+				return fmt.Errorf("subscriptions groups localizations create: failed to create: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// SubscriptionsGroupsLocalizationsUpdateCommand returns the groups localizations update subcommand.
+func SubscriptionsGroupsLocalizationsUpdateCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("groups localizations update", flag.ExitOnError)
+
+	localizationID := fs.String("id", "", "Subscription group localization ID")
+	name := fs.String("name", "", "Display name")
+	customAppName := fs.String("custom-app-name", "", "App name shown on the subscription management page")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "update",
+		ShortUsage: "asc subscriptions groups localizations update [flags]",
+		ShortHelp:  "Update a subscription group localization.",
+		LongHelp: `Update a subscription group localization.
+
+Examples:
+  asc subscriptions groups localizations update --id "LOC_ID" --name "Pro"
+  asc subscriptions groups localizations update --id "LOC_ID" --custom-app-name "Example Pro"`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*localizationID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+
+			visited := map[string]bool{}
+			fs.Visit(func(f *flag.Flag) {
+				visited[f.Name] = true
+			})
+			if !visited["name"] && !visited["custom-app-name"] {
+				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
+				return flag.ErrHelp
+			}
+
+			attrs := asc.SubscriptionGroupLocalizationUpdateAttributes{}
+			if visited["name"] {
+				value := strings.TrimSpace(*name)
+				attrs.Name = &value
+			}
+			if visited["custom-app-name"] {
+				value := strings.TrimSpace(*customAppName)
+				attrs.CustomAppName = &value
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions groups localizations update: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			resp, err := client.UpdateSubscriptionGroupLocalization(requestCtx, id, attrs)
+			if err != nil {
+				return fmt.Errorf("subscriptions groups localizations update: failed to update: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// SubscriptionsGroupsLocalizationsDeleteCommand returns the groups localizations delete subcommand.
+func SubscriptionsGroupsLocalizationsDeleteCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("groups localizations delete", flag.ExitOnError)
+
+	localizationID := fs.String("id", "", "Subscription group localization ID")
+	confirm := fs.Bool("confirm", false, "Confirm deletion")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "delete",
+		ShortUsage: "asc subscriptions groups localizations delete --id \"LOC_ID\" --confirm",
+		ShortHelp:  "Delete a subscription group localization.",
+		LongHelp: `Delete a subscription group localization.
+
+Examples:
+  asc subscriptions groups localizations delete --id "LOC_ID" --confirm`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*localizationID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+			if !*confirm {
+				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions groups localizations delete: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			if err := client.DeleteSubscriptionGroupLocalization(requestCtx, id); err != nil {
+				return fmt.Errorf("subscriptions groups localizations delete: failed to delete: %w", err)
+			}
+
+			result := &asc.SubscriptionLocalizationDeleteResult{
+				ID:      id,
+				Deleted: true,
+			}
+
+			return printOutput(result, *output, *pretty)
+		},
+	}
+}
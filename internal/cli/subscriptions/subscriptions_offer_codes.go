@@ -0,0 +1,575 @@
+package subscriptions
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// SubscriptionsOfferCodesCommand returns the subscriptions offer-codes command group.
+func SubscriptionsOfferCodesCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("offer-codes", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "offer-codes",
+		ShortUsage: "asc subscriptions offer-codes <subcommand> [flags]",
+		ShortHelp:  "Manage subscription offer codes and custom codes.",
+		LongHelp: `Manage subscription offer codes and custom codes.
+
+One-time use code batches for an offer code are managed with "asc offer-codes".
+
+Examples:
+  asc subscriptions offer-codes list --subscription "SUB_ID"
+  asc subscriptions offer-codes create --subscription "SUB_ID" --name "Spring" --eligibility NEW,EXPIRED --mode PAY_UP_FRONT --duration THREE_MONTHS --prices "USA:PP_ID" --equalize
+  asc subscriptions offer-codes custom-codes create --offer-code "OFFER_CODE_ID" --code "SPRING26" --quantity 1000 --expiration-date "2026-06-30"
+  asc subscriptions offer-codes deactivate --id "OFFER_CODE_ID" --confirm`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			SubscriptionsOfferCodesListCommand(),
+			SubscriptionsOfferCodesGetCommand(),
+			SubscriptionsOfferCodesCreateCommand(),
+			SubscriptionsOfferCodesDeactivateCommand(),
+			SubscriptionsOfferCodesCustomCodesCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// SubscriptionsOfferCodesListCommand returns the offer-codes list subcommand.
+func SubscriptionsOfferCodesListCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("offer-codes list", flag.ExitOnError)
+
+	subID := fs.String("subscription", "", "Subscription ID")
+	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
+	next := fs.String("next", "", "Fetch next page using a links.next URL")
+	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "list",
+		ShortUsage: "asc subscriptions offer-codes list [flags]",
+		ShortHelp:  "List offer codes for a subscription.",
+		LongHelp: `List offer codes for a subscription.
+
+Examples:
+  asc subscriptions offer-codes list --subscription "SUB_ID"
+  asc subscriptions offer-codes list --subscription "SUB_ID" --paginate --output table`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if *limit != 0 && (*limit < 1 || *limit > 200) {
+				return fmt.Errorf("subscriptions offer-codes list: --limit must be between 1 and 200")
+			}
+			if err := validateNextURL(*next); err != nil {
+				return fmt.Errorf("subscriptions offer-codes list: %w", err)
+			}
+
+			id := strings.TrimSpace(*subID)
+			if id == "" && strings.TrimSpace(*next) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --subscription is required")
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions offer-codes list: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			opts := []asc.OfferCodesOption{
+				asc.WithOfferCodesLimit(*limit),
+				asc.WithOfferCodesNextURL(*next),
+			}
+
+			if *paginate {
+				paginateOpts := append(opts, asc.WithOfferCodesLimit(200))
+				firstPage, err := client.GetSubscriptionOfferCodes(requestCtx, id, paginateOpts...)
+				if err != nil {
+					return fmt.Errorf("subscriptions offer-codes list: failed to fetch: %w", err)
+				}
+
+				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+					return client.GetSubscriptionOfferCodes(ctx, id, asc.WithOfferCodesNextURL(nextURL))
+				})
+				if err != nil {
+					return fmt.Errorf("subscriptions offer-codes list: %w", err)
+				}
+
+				return printOutput(resp, *output, *pretty)
+			}
+
+			resp, err := client.GetSubscriptionOfferCodes(requestCtx, id, opts...)
+			if err != nil {
+				return fmt.Errorf("subscriptions offer-codes list: failed to fetch: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// SubscriptionsOfferCodesGetCommand returns the offer-codes get subcommand.
+func SubscriptionsOfferCodesGetCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("offer-codes get", flag.ExitOnError)
+
+	offerCodeID := fs.String("id", "", "Subscription offer code ID")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "get",
+		ShortUsage: "asc subscriptions offer-codes get --id \"OFFER_CODE_ID\"",
+		ShortHelp:  "Get a subscription offer code.",
+		LongHelp: `Get a subscription offer code.
+
+Examples:
+  asc subscriptions offer-codes get --id "OFFER_CODE_ID"`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*offerCodeID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions offer-codes get: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			resp, err := client.GetSubscriptionOfferCode(requestCtx, id)
+			if err != nil {
+				return fmt.Errorf("subscriptions offer-codes get: failed to fetch: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// SubscriptionsOfferCodesCreateCommand returns the offer-codes create subcommand.
+func SubscriptionsOfferCodesCreateCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("offer-codes create", flag.ExitOnError)
+
+	subID := fs.String("subscription", "", "Subscription ID")
+	name := fs.String("name", "", "Offer code reference name")
+	eligibility := fs.String("eligibility", "", "Customer eligibilities (comma-separated): "+strings.Join(asc.ValidSubscriptionOfferCodeCustomerEligibilities, ", "))
+	offerEligibility := fs.String("offer-eligibility", "STACK_WITH_INTRO_OFFERS", "Introductory offer handling: "+strings.Join(asc.ValidSubscriptionOfferCodeOfferEligibilities, ", "))
+	duration := fs.String("duration", "", "Offer duration: "+strings.Join(asc.ValidSubscriptionOfferDurations, ", "))
+	mode := fs.String("mode", "", "Offer mode: "+strings.Join(asc.ValidSubscriptionOfferModes, ", "))
+	periods := fs.Int("periods", 1, "Number of periods")
+	prices := fs.String("prices", "", "Per-territory prices as TERRITORY:PRICE_POINT_ID (comma-separated)")
+	pricesFile := fs.String("prices-file", "", "File with one TERRITORY,PRICE_POINT_ID per line")
+	equalize := fs.Bool("equalize", false, "Expand a single base price to every territory using equalized price points")
+	territory := fs.String("territory", "", "Territory IDs for free trials (comma-separated)")
+	allTerritories := fs.Bool("all-territories", false, "Offer free trials in every App Store territory")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "create",
+		ShortUsage: "asc subscriptions offer-codes create [flags]",
+		ShortHelp:  "Create a subscription offer code.",
+		LongHelp: `Create a subscription offer code.
+
+Paid offers (PAY_AS_YOU_GO, PAY_UP_FRONT) take prices from --prices and
+--prices-file; add --equalize with a single base price to cover every
+territory. Free trials take --territory or --all-territories instead of prices.
+
+Examples:
+  asc subscriptions offer-codes create --subscription "SUB_ID" --name "Free month" --eligibility NEW --mode FREE_TRIAL --duration ONE_MONTH --all-territories
+  asc subscriptions offer-codes create --subscription "SUB_ID" --name "Spring" --eligibility NEW,EXPIRED --mode PAY_UP_FRONT --duration THREE_MONTHS --prices "USA:PP_ID" --equalize
+  asc subscriptions offer-codes create --subscription "SUB_ID" --name "Win back" --eligibility EXPIRED --offer-eligibility REPLACE_INTRO_OFFERS --mode PAY_AS_YOU_GO --duration ONE_MONTH --periods 3 --prices-file "./prices.csv"`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*subID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --subscription is required")
+				return flag.ErrHelp
+			}
+			if strings.TrimSpace(*name) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --name is required")
+				return flag.ErrHelp
+			}
+			if strings.TrimSpace(*eligibility) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --eligibility is required")
+				return flag.ErrHelp
+			}
+			if strings.TrimSpace(*duration) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --duration is required")
+				return flag.ErrHelp
+			}
+			if strings.TrimSpace(*mode) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --mode is required")
+				return flag.ErrHelp
+			}
+
+			eligibilities, err := normalizeOfferCodeEligibilities(*eligibility)
+			if err != nil {
+				return fmt.Errorf("subscriptions offer-codes create: %w", err)
+			}
+			normalizedOfferEligibility := strings.ToUpper(strings.TrimSpace(*offerEligibility))
+			if !asc.IsValidSubscriptionOfferCodeOfferEligibility(normalizedOfferEligibility) {
+				return fmt.Errorf("subscriptions offer-codes create: --offer-eligibility must be one of: %s", strings.Join(asc.ValidSubscriptionOfferCodeOfferEligibilities, ", "))
+			}
+			offerDuration, err := normalizeOfferDuration(*duration)
+			if err != nil {
+				return fmt.Errorf("subscriptions offer-codes create: %w", err)
+			}
+			offerMode, err := normalizeOfferMode(*mode)
+			if err != nil {
+				return fmt.Errorf("subscriptions offer-codes create: %w", err)
+			}
+			if *periods < 1 {
+				return fmt.Errorf("subscriptions offer-codes create: --periods must be at least 1")
+			}
+
+			hasPrices := strings.TrimSpace(*prices) != "" || strings.TrimSpace(*pricesFile) != ""
+			territories := splitCSVUpper(*territory)
+			freeTrial := offerMode == "FREE_TRIAL"
+			if freeTrial {
+				if hasPrices || *equalize {
+					return fmt.Errorf("subscriptions offer-codes create: FREE_TRIAL offers do not take prices; use --territory or --all-territories")
+				}
+				if len(territories) == 0 && !*allTerritories {
+					fmt.Fprintln(os.Stderr, "Error: --territory or --all-territories is required for FREE_TRIAL")
+					return flag.ErrHelp
+				}
+				if len(territories) > 0 && *allTerritories {
+					return fmt.Errorf("subscriptions offer-codes create: --territory and --all-territories are mutually exclusive")
+				}
+			} else {
+				if len(territories) > 0 || *allTerritories {
+					return fmt.Errorf("subscriptions offer-codes create: --territory and --all-territories only apply to FREE_TRIAL; use --prices for %s", offerMode)
+				}
+				if !hasPrices {
+					fmt.Fprintln(os.Stderr, "Error: --prices or --prices-file is required")
+					return flag.ErrHelp
+				}
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions offer-codes create: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			var offerPrices []asc.SubscriptionOfferPrice
+			if freeTrial {
+				if *allTerritories {
+					territories, err = fetchAllTerritoryIDs(requestCtx, client)
+					if err != nil {
+						return fmt.Errorf("subscriptions offer-codes create: %w", err)
+					}
+				}
+				for _, territoryID := range territories {
+					offerPrices = append(offerPrices, asc.SubscriptionOfferPrice{Territory: territoryID})
+				}
+			} else {
+				offerPrices, err = resolveOfferPrices(requestCtx, client, *prices, *pricesFile, *equalize)
+				if err != nil {
+					return fmt.Errorf("subscriptions offer-codes create: %w", err)
+				}
+			}
+
+			attrs := asc.SubscriptionOfferCodeCreateAttributes{
+				Name:                  strings.TrimSpace(*name),
+				CustomerEligibilities: eligibilities,
+				OfferEligibility:      normalizedOfferEligibility,
+				Duration:              offerDuration,
+				OfferMode:             offerMode,
+				NumberOfPeriods:       *periods,
+			}
+
+			resp, err := client.CreateSubscriptionOfferCode(requestCtx, id, attrs, offerPrices)
+			if err != nil {
+				return fmt.Errorf("subscriptions offer-codes create: failed to create: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// SubscriptionsOfferCodesDeactivateCommand returns the offer-codes deactivate subcommand.
+func SubscriptionsOfferCodesDeactivateCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("offer-codes deactivate", flag.ExitOnError)
+
+	offerCodeID := fs.String("id", "", "Subscription offer code ID")
+	confirm := fs.Bool("confirm", false, "Confirm deactivation")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "deactivate",
+		ShortUsage: "asc subscriptions offer-codes deactivate --id \"OFFER_CODE_ID\" --confirm",
+		ShortHelp:  "Deactivate a subscription offer code.",
+		LongHelp: `Deactivate a subscription offer code.
+
+Deactivated codes can no longer be redeemed. This cannot be undone.
+
+Examples:
+  asc subscriptions offer-codes deactivate --id "OFFER_CODE_ID" --confirm`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*offerCodeID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+			if !*confirm {
+				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions offer-codes deactivate: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			resp, err := client.UpdateSubscriptionOfferCodeActive(requestCtx, id, false)
+			if err != nil {
+				return fmt.Errorf("subscriptions offer-codes deactivate: failed to update: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// SubscriptionsOfferCodesCustomCodesCommand returns the offer-codes custom-codes command group.
+func SubscriptionsOfferCodesCustomCodesCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("custom-codes", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "custom-codes",
+		ShortUsage: "asc subscriptions offer-codes custom-codes <subcommand> [flags]",
+		ShortHelp:  "Manage custom codes for a subscription offer code.",
+		LongHelp: `Manage custom codes for a subscription offer code.
+
+Examples:
+  asc subscriptions offer-codes custom-codes list --offer-code "OFFER_CODE_ID"
+  asc subscriptions offer-codes custom-codes create --offer-code "OFFER_CODE_ID" --code "SPRING26" --quantity 1000
+  asc subscriptions offer-codes custom-codes deactivate --id "CUSTOM_CODE_ID" --confirm`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			SubscriptionsOfferCodesCustomCodesListCommand(),
+			SubscriptionsOfferCodesCustomCodesCreateCommand(),
+			SubscriptionsOfferCodesCustomCodesDeactivateCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// SubscriptionsOfferCodesCustomCodesListCommand returns the custom-codes list subcommand.
+func SubscriptionsOfferCodesCustomCodesListCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("custom-codes list", flag.ExitOnError)
+
+	offerCodeID := fs.String("offer-code", "", "Subscription offer code ID")
+	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
+	next := fs.String("next", "", "Fetch next page using a links.next URL")
+	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "list",
+		ShortUsage: "asc subscriptions offer-codes custom-codes list [flags]",
+		ShortHelp:  "List custom codes for a subscription offer code.",
+		LongHelp: `List custom codes for a subscription offer code.
+
+Examples:
+  asc subscriptions offer-codes custom-codes list --offer-code "OFFER_CODE_ID"
+  asc subscriptions offer-codes custom-codes list --offer-code "OFFER_CODE_ID" --paginate --output table`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if *limit != 0 && (*limit < 1 || *limit > 200) {
+				return fmt.Errorf("subscriptions offer-codes custom-codes list: --limit must be between 1 and 200")
+			}
+			if err := validateNextURL(*next); err != nil {
+				return fmt.Errorf("subscriptions offer-codes custom-codes list: %w", err)
+			}
+
+			id := strings.TrimSpace(*offerCodeID)
+			if id == "" && strings.TrimSpace(*next) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --offer-code is required")
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions offer-codes custom-codes list: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			opts := []asc.OfferCodesOption{
+				asc.WithOfferCodesLimit(*limit),
+				asc.WithOfferCodesNextURL(*next),
+			}
+
+			if *paginate {
+				paginateOpts := append(opts, asc.WithOfferCodesLimit(200))
+				firstPage, err := client.GetSubscriptionOfferCodeCustomCodes(requestCtx, id, paginateOpts...)
+				if err != nil {
+					return fmt.Errorf("subscriptions offer-codes custom-codes list: failed to fetch: %w", err)
+				}
+
+				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+					return client.GetSubscriptionOfferCodeCustomCodes(ctx, id, asc.WithOfferCodesNextURL(nextURL))
+				})
+				if err != nil {
+					return fmt.Errorf("subscriptions offer-codes custom-codes list: %w", err)
+				}
+
+				return printOutput(resp, *output, *pretty)
+			}
+
+			resp, err := client.GetSubscriptionOfferCodeCustomCodes(requestCtx, id, opts...)
+			if err != nil {
+				return fmt.Errorf("subscriptions offer-codes custom-codes list: failed to fetch: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// SubscriptionsOfferCodesCustomCodesCreateCommand returns the custom-codes create subcommand.
+func SubscriptionsOfferCodesCustomCodesCreateCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("custom-codes create", flag.ExitOnError)
+
+	offerCodeID := fs.String("offer-code", "", "Subscription offer code ID")
+	code := fs.String("code", "", "Custom code customers redeem (e.g., SPRING26)")
+	quantity := fs.Int("quantity", 0, "Number of redemptions available")
+	expirationDate := fs.String("expiration-date", "", "Expiration date (YYYY-MM-DD)")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "create",
+		ShortUsage: "asc subscriptions offer-codes custom-codes create [flags]",
+		ShortHelp:  "Create a custom code for a subscription offer code.",
+		LongHelp: `Create a custom code for a subscription offer code.
+
+Examples:
+  asc subscriptions offer-codes custom-codes create --offer-code "OFFER_CODE_ID" --code "SPRING26" --quantity 1000
+  asc subscriptions offer-codes custom-codes create --offer-code "OFFER_CODE_ID" --code "SPRING26" --quantity 1000 --expiration-date "2026-06-30"`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*offerCodeID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --offer-code is required")
+				return flag.ErrHelp
+			}
+			attrs, err := shared.OfferCodeCustomCodeAttributes(*code, *quantity, *expirationDate)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, "Error:", err)
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions offer-codes custom-codes create: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			resp, err := client.CreateSubscriptionOfferCodeCustomCode(requestCtx, id, attrs)
+			if err != nil {
+				return fmt.Errorf("subscriptions offer-codes custom-codes create: failed to create: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// SubscriptionsOfferCodesCustomCodesDeactivateCommand returns the custom-codes deactivate subcommand.
+func SubscriptionsOfferCodesCustomCodesDeactivateCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("custom-codes deactivate", flag.ExitOnError)
+
+	customCodeID := fs.String("id", "", "Custom code ID")
+	confirm := fs.Bool("confirm", false, "Confirm deactivation")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "deactivate",
+		ShortUsage: "asc subscriptions offer-codes custom-codes deactivate --id \"CUSTOM_CODE_ID\" --confirm",
+		ShortHelp:  "Deactivate a subscription offer custom code.",
+		LongHelp: `Deactivate a subscription offer custom code.
+
+Examples:
+  asc subscriptions offer-codes custom-codes deactivate --id "CUSTOM_CODE_ID" --confirm`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*customCodeID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+			if !*confirm {
+				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions offer-codes custom-codes deactivate: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			resp, err := client.UpdateSubscriptionOfferCodeCustomCodeActive(requestCtx, id, false)
+			if err != nil {
+				return fmt.Errorf("subscriptions offer-codes custom-codes deactivate: failed to update: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+func normalizeOfferCodeEligibilities(value string) ([]string, error) {
+	eligibilities := splitCSVUpper(value)
+	for _, item := range eligibilities {
+		if !asc.IsValidSubscriptionOfferCodeCustomerEligibility(item) {
+			return nil, fmt.Errorf("--eligibility must be one of: %s", strings.Join(asc.ValidSubscriptionOfferCodeCustomerEligibilities, ", "))
+		}
+	}
+	return eligibilities, nil
+}
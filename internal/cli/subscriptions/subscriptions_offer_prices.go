@@ -0,0 +1,318 @@
+package subscriptions
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// SubscriptionsPricePointsCommand returns the subscriptions price-points command group.
+func SubscriptionsPricePointsCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("price-points", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "price-points",
+		ShortUsage: "asc subscriptions price-points <subcommand> [flags]",
+		ShortHelp:  "Inspect subscription price points.",
+		LongHelp: `Inspect subscription price points.
+
+Price point IDs are used when adding prices and when pricing offers.
+
+Examples:
+  asc subscriptions price-points list --id "SUB_ID" --territory "USA"
+  asc subscriptions price-points equalizations --price-point "PRICE_POINT_ID"`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			SubscriptionsPricePointsListCommand(),
+			SubscriptionsPricePointsEqualizationsCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// SubscriptionsPricePointsListCommand returns the price-points list subcommand.
+func SubscriptionsPricePointsListCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("price-points list", flag.ExitOnError)
+
+	subID := fs.String("id", "", "Subscription ID")
+	territory := fs.String("territory", "", "Territory ID (e.g., USA)")
+	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
+	next := fs.String("next", "", "Fetch next page using a links.next URL")
+	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "list",
+		ShortUsage: "asc subscriptions price-points list [flags]",
+		ShortHelp:  "List price points for a subscription.",
+		LongHelp: `List price points for a subscription.
+
+Examples:
+  asc subscriptions price-points list --id "SUB_ID" --territory "USA"
+  asc subscriptions price-points list --id "SUB_ID" --territory "USA" --paginate`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if *limit != 0 && (*limit < 1 || *limit > 200) {
+				return fmt.Errorf("subscriptions price-points list: --limit must be between 1 and 200")
+			}
+			if err := validateNextURL(*next); err != nil {
+				return fmt.Errorf("subscriptions price-points list: %w", err)
+			}
+
+			id := strings.TrimSpace(*subID)
+			if id == "" && strings.TrimSpace(*next) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions price-points list: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			opts := []asc.PricePointsOption{
+				asc.WithPricePointsLimit(*limit),
+				asc.WithPricePointsNextURL(*next),
+				asc.WithPricePointsTerritory(*territory),
+			}
+
+			if *paginate {
+				paginateOpts := append(opts, asc.WithPricePointsLimit(200))
+				firstPage, err := client.GetSubscriptionPricePoints(requestCtx, id, paginateOpts...)
+				if err != nil {
+					return fmt.Errorf("subscriptions price-points list: failed to fetch: %w", err)
+				}
+
+				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+					return client.GetSubscriptionPricePoints(ctx, id, asc.WithPricePointsNextURL(nextURL))
+				})
+				if err != nil {
+					return fmt.Errorf("subscriptions price-points list: %w", err)
+				}
+
+				return printOutput(resp, *output, *pretty)
+			}
+
+			resp, err := client.GetSubscriptionPricePoints(requestCtx, id, opts...)
+			if err != nil {
+				return fmt.Errorf("subscriptions price-points list: failed to fetch: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// SubscriptionsPricePointsEqualizationsCommand returns the price-points equalizations subcommand.
+func SubscriptionsPricePointsEqualizationsCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("price-points equalizations", flag.ExitOnError)
+
+	pricePointID := fs.String("price-point", "", "Subscription price point ID")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "equalizations",
+		ShortUsage: "asc subscriptions price-points equalizations [flags]",
+		ShortHelp:  "List equivalent price points in every other territory.",
+		LongHelp: `List equivalent price points in every other territory.
+
+Examples:
+  asc subscriptions price-points equalizations --price-point "PRICE_POINT_ID" --output table`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*pricePointID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --price-point is required")
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions price-points equalizations: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			resp, err := fetchSubscriptionPricePointEqualizations(requestCtx, client, id)
+			if err != nil {
+				return fmt.Errorf("subscriptions price-points equalizations: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// parseOfferPrices reads offer prices from --prices and --prices-file.
+func parseOfferPrices(value, filePath string) ([]asc.SubscriptionOfferPrice, error) {
+	parsed, err := shared.ParseTerritoryPricePoints(value, filePath)
+	if err != nil {
+		return nil, err
+	}
+	prices := make([]asc.SubscriptionOfferPrice, 0, len(parsed))
+	for _, price := range parsed {
+		prices = append(prices, asc.SubscriptionOfferPrice{
+			Territory:    price.Territory,
+			PricePointID: price.PricePointID,
+		})
+	}
+	return prices, nil
+}
+
+// equalizeOfferPrices expands a single base price into equivalent prices for
+// every territory using App Store Connect price equalizations.
+func equalizeOfferPrices(ctx context.Context, client *asc.Client, base asc.SubscriptionOfferPrice) ([]asc.SubscriptionOfferPrice, error) {
+	resp, err := fetchSubscriptionPricePointEqualizations(ctx, client, base.PricePointID)
+	if err != nil {
+		return nil, err
+	}
+
+	prices := []asc.SubscriptionOfferPrice{base}
+	for _, item := range resp.Data {
+		territory := strings.ToUpper(asc.RelationshipTerritoryID(item.Relationships))
+		if territory == "" || territory == base.Territory {
+			continue
+		}
+		prices = append(prices, asc.SubscriptionOfferPrice{
+			Territory:    territory,
+			PricePointID: item.ID,
+		})
+	}
+	return prices, nil
+}
+
+func fetchSubscriptionPricePointEqualizations(ctx context.Context, client *asc.Client, pricePointID string) (*asc.SubscriptionPricePointsResponse, error) {
+	firstPage, err := client.GetSubscriptionPricePointEqualizations(ctx, pricePointID, asc.WithPricePointsLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch equalizations: %w", err)
+	}
+	all, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetSubscriptionPricePointEqualizations(ctx, pricePointID, asc.WithPricePointsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp, ok := all.(*asc.SubscriptionPricePointsResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected equalizations response type %T", all)
+	}
+	return resp, nil
+}
+
+func normalizeOfferDuration(value string) (string, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(value))
+	if !asc.IsValidSubscriptionOfferDuration(normalized) {
+		return "", fmt.Errorf("--duration must be one of: %s", strings.Join(asc.ValidSubscriptionOfferDurations, ", "))
+	}
+	return normalized, nil
+}
+
+func normalizeOfferMode(value string) (string, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(value))
+	if !asc.IsValidSubscriptionOfferMode(normalized) {
+		return "", fmt.Errorf("--mode must be one of: %s", strings.Join(asc.ValidSubscriptionOfferModes, ", "))
+	}
+	return normalized, nil
+}
+
+// resolveOfferPrices combines --prices and --prices-file, expanding the single
+// base price into every territory when equalize is set.
+func resolveOfferPrices(ctx context.Context, client *asc.Client, value, filePath string, equalize bool) ([]asc.SubscriptionOfferPrice, error) {
+	prices, err := parseOfferPrices(value, filePath)
+	if err != nil {
+		return nil, err
+	}
+	if !equalize {
+		return prices, nil
+	}
+	if len(prices) != 1 {
+		return nil, fmt.Errorf("--equalize requires exactly one base price")
+	}
+	return equalizeOfferPrices(ctx, client, prices[0])
+}
+
+// offerPriceFlags holds the pricing flags shared by offer create commands.
+// Paid offers take prices; free trials take territories instead.
+type offerPriceFlags struct {
+	prices         *string
+	pricesFile     *string
+	equalize       *bool
+	territory      *string
+	allTerritories *bool
+}
+
+func bindOfferPriceFlags(fs *flag.FlagSet) offerPriceFlags {
+	return offerPriceFlags{
+		prices:         fs.String("prices", "", "Per-territory prices as TERRITORY:PRICE_POINT_ID (comma-separated)"),
+		pricesFile:     fs.String("prices-file", "", "File with one TERRITORY,PRICE_POINT_ID per line"),
+		equalize:       fs.Bool("equalize", false, "Expand a single base price to every territory using equalized price points"),
+		territory:      fs.String("territory", "", "Territory IDs for free trials (comma-separated)"),
+		allTerritories: fs.Bool("all-territories", false, "Offer free trials in every App Store territory"),
+	}
+}
+
+// check validates the pricing flags for an offer mode. It returns a usage
+// message for missing flags and an error for conflicting ones.
+func (f offerPriceFlags) check(offerMode string) (string, error) {
+	hasPrices := strings.TrimSpace(*f.prices) != "" || strings.TrimSpace(*f.pricesFile) != ""
+	territories := splitCSVUpper(*f.territory)
+	if offerMode == "FREE_TRIAL" {
+		if hasPrices || *f.equalize {
+			return "", fmt.Errorf("FREE_TRIAL offers do not take prices; use --territory or --all-territories")
+		}
+		if len(territories) == 0 && !*f.allTerritories {
+			return "--territory or --all-territories is required for FREE_TRIAL", nil
+		}
+		if len(territories) > 0 && *f.allTerritories {
+			return "", fmt.Errorf("--territory and --all-territories are mutually exclusive")
+		}
+		return "", nil
+	}
+	if len(territories) > 0 || *f.allTerritories {
+		return "", fmt.Errorf("--territory and --all-territories only apply to FREE_TRIAL; use --prices for %s", offerMode)
+	}
+	if !hasPrices {
+		return "--prices or --prices-file is required", nil
+	}
+	return "", nil
+}
+
+// resolve returns territory-only prices for free trials and parsed (and
+// optionally equalized) prices for paid offers.
+func (f offerPriceFlags) resolve(ctx context.Context, client *asc.Client, offerMode string) ([]asc.SubscriptionOfferPrice, error) {
+	if offerMode != "FREE_TRIAL" {
+		return resolveOfferPrices(ctx, client, *f.prices, *f.pricesFile, *f.equalize)
+	}
+
+	territories := splitCSVUpper(*f.territory)
+	if *f.allTerritories {
+		var err error
+		territories, err = fetchAllTerritoryIDs(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+	}
+	prices := make([]asc.SubscriptionOfferPrice, 0, len(territories))
+	for _, territoryID := range territories {
+		prices = append(prices, asc.SubscriptionOfferPrice{Territory: territoryID})
+	}
+	return prices, nil
+}
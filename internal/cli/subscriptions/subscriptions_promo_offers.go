@@ -0,0 +1,328 @@
+package subscriptions
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// SubscriptionsPromoOffersCommand returns the subscriptions promo-offers command group.
+func SubscriptionsPromoOffersCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("promo-offers", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "promo-offers",
+		ShortUsage: "asc subscriptions promo-offers <subcommand> [flags]",
+		ShortHelp:  "Manage subscription promotional offers.",
+		LongHelp: `Manage subscription promotional offers.
+
+Examples:
+  asc subscriptions promo-offers list --subscription "SUB_ID"
+  asc subscriptions promo-offers create --subscription "SUB_ID" --name "Win back" --offer-code "WINBACK" --mode PAY_AS_YOU_GO --duration ONE_MONTH --prices "USA:PP_ID" --equalize
+  asc subscriptions promo-offers update --id "OFFER_ID" --prices-file "./prices.csv"
+  asc subscriptions promo-offers delete --id "OFFER_ID" --confirm`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			SubscriptionsPromoOffersListCommand(),
+			SubscriptionsPromoOffersCreateCommand(),
+			SubscriptionsPromoOffersUpdateCommand(),
+			SubscriptionsPromoOffersDeleteCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// SubscriptionsPromoOffersListCommand returns the promo-offers list subcommand.
+func SubscriptionsPromoOffersListCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("promo-offers list", flag.ExitOnError)
+
+	subID := fs.String("subscription", "", "Subscription ID")
+	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
+	next := fs.String("next", "", "Fetch next page using a links.next URL")
+	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "list",
+		ShortUsage: "asc subscriptions promo-offers list [flags]",
+		ShortHelp:  "List promotional offers for a subscription.",
+		LongHelp: `List promotional offers for a subscription.
+
+Examples:
+  asc subscriptions promo-offers list --subscription "SUB_ID"
+  asc subscriptions promo-offers list --subscription "SUB_ID" --paginate --output table`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if *limit != 0 && (*limit < 1 || *limit > 200) {
+				return fmt.Errorf("subscriptions promo-offers list: --limit must be between 1 and 200")
+			}
+			if err := validateNextURL(*next); err != nil {
+				return fmt.Errorf("subscriptions promo-offers list: %w", err)
+			}
+
+			id := strings.TrimSpace(*subID)
+			if id == "" && strings.TrimSpace(*next) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --subscription is required")
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions promo-offers list: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			opts := []asc.SubscriptionOffersOption{
+				asc.WithSubscriptionOffersLimit(*limit),
+				asc.WithSubscriptionOffersNextURL(*next),
+			}
+
+			if *paginate {
+				paginateOpts := append(opts, asc.WithSubscriptionOffersLimit(200))
+				firstPage, err := client.GetSubscriptionPromotionalOffers(requestCtx, id, paginateOpts...)
+				if err != nil {
+					return fmt.Errorf("subscriptions promo-offers list: failed to fetch: %w", err)
+				}
+
+				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+					return client.GetSubscriptionPromotionalOffers(ctx, id, asc.WithSubscriptionOffersNextURL(nextURL))
+				})
+				if err != nil {
+					return fmt.Errorf("subscriptions promo-offers list: %w", err)
+				}
+
+				return printOutput(resp, *output, *pretty)
+			}
+
+			resp, err := client.GetSubscriptionPromotionalOffers(requestCtx, id, opts...)
+			if err != nil {
+				return fmt.Errorf("subscriptions promo-offers list: failed to fetch: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// SubscriptionsPromoOffersCreateCommand returns the promo-offers create subcommand.
+func SubscriptionsPromoOffersCreateCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("promo-offers create", flag.ExitOnError)
+
+	subID := fs.String("subscription", "", "Subscription ID")
+	name := fs.String("name", "", "Offer reference name")
+	offerCode := fs.String("offer-code", "", "Offer identifier used by the app")
+	duration := fs.String("duration", "", "Offer duration: "+strings.Join(asc.ValidSubscriptionOfferDurations, ", "))
+	mode := fs.String("mode", "", "Offer mode: "+strings.Join(asc.ValidSubscriptionOfferModes, ", "))
+	periods := fs.Int("periods", 1, "Number of periods")
+	prices := fs.String("prices", "", "Per-territory prices as TERRITORY:PRICE_POINT_ID (comma-separated)")
+	pricesFile := fs.String("prices-file", "", "File with one TERRITORY,PRICE_POINT_ID per line")
+	equalize := fs.Bool("equalize", false, "Expand a single base price to every territory using equalized price points")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "create",
+		ShortUsage: "asc subscriptions promo-offers create [flags]",
+		ShortHelp:  "Create a promotional offer for a subscription.",
+		LongHelp: `Create a promotional offer for a subscription.
+
+Examples:
+  asc subscriptions promo-offers create --subscription "SUB_ID" --name "Win back" --offer-code "WINBACK" --mode PAY_AS_YOU_GO --duration ONE_MONTH --periods 3 --prices "USA:PP_ID,GBR:PP_ID"
+  asc subscriptions promo-offers create --subscription "SUB_ID" --name "Win back" --offer-code "WINBACK" --mode PAY_UP_FRONT --duration THREE_MONTHS --prices "USA:PP_ID" --equalize`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*subID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --subscription is required")
+				return flag.ErrHelp
+			}
+			offerName := strings.TrimSpace(*name)
+			if offerName == "" {
+				fmt.Fprintln(os.Stderr, "Error: --name is required")
+				return flag.ErrHelp
+			}
+			code := strings.TrimSpace(*offerCode)
+			if code == "" {
+				fmt.Fprintln(os.Stderr, "Error: --offer-code is required")
+				return flag.ErrHelp
+			}
+			if strings.TrimSpace(*duration) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --duration is required")
+				return flag.ErrHelp
+			}
+			if strings.TrimSpace(*mode) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --mode is required")
+				return flag.ErrHelp
+			}
+			if strings.TrimSpace(*prices) == "" && strings.TrimSpace(*pricesFile) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --prices or --prices-file is required")
+				return flag.ErrHelp
+			}
+
+			offerDuration, err := normalizeOfferDuration(*duration)
+			if err != nil {
+				return fmt.Errorf("subscriptions promo-offers create: %w", err)
+			}
+			offerMode, err := normalizeOfferMode(*mode)
+			if err != nil {
+				return fmt.Errorf("subscriptions promo-offers create: %w", err)
+			}
+			if *periods < 1 {
+				return fmt.Errorf("subscriptions promo-offers create: --periods must be at least 1")
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions promo-offers create: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			offerPrices, err := resolveOfferPrices(requestCtx, client, *prices, *pricesFile, *equalize)
+			if err != nil {
+				return fmt.Errorf("subscriptions promo-offers create: %w", err)
+			}
+
+			attrs := asc.SubscriptionPromotionalOfferAttributes{
+				Name:            offerName,
+				OfferCode:       code,
+				Duration:        offerDuration,
+				OfferMode:       offerMode,
+				NumberOfPeriods: *periods,
+			}
+
+			resp, err := client.CreateSubscriptionPromotionalOffer(requestCtx, id, attrs, offerPrices)
+			if err != nil {
+				return fmt.Errorf("subscriptions promo-offers create: failed to create: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// SubscriptionsPromoOffersUpdateCommand returns the promo-offers update subcommand.
+func SubscriptionsPromoOffersUpdateCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("promo-offers update", flag.ExitOnError)
+
+	offerID := fs.String("id", "", "Promotional offer ID")
+	prices := fs.String("prices", "", "Per-territory prices as TERRITORY:PRICE_POINT_ID (comma-separated)")
+	pricesFile := fs.String("prices-file", "", "File with one TERRITORY,PRICE_POINT_ID per line")
+	equalize := fs.Bool("equalize", false, "Expand a single base price to every territory using equalized price points")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "update",
+		ShortUsage: "asc subscriptions promo-offers update [flags]",
+		ShortHelp:  "Replace the prices of a promotional offer.",
+		LongHelp: `Replace the prices of a promotional offer.
+
+Only prices can change after creation; the listed prices replace the current
+set, so territories not listed are removed from the offer.
+
+Examples:
+  asc subscriptions promo-offers update --id "OFFER_ID" --prices "USA:PP_ID,GBR:PP_ID"
+  asc subscriptions promo-offers update --id "OFFER_ID" --prices "USA:PP_ID" --equalize`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*offerID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+			if strings.TrimSpace(*prices) == "" && strings.TrimSpace(*pricesFile) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --prices or --prices-file is required")
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions promo-offers update: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			offerPrices, err := resolveOfferPrices(requestCtx, client, *prices, *pricesFile, *equalize)
+			if err != nil {
+				return fmt.Errorf("subscriptions promo-offers update: %w", err)
+			}
+
+			resp, err := client.UpdateSubscriptionPromotionalOfferPrices(requestCtx, id, offerPrices)
+			if err != nil {
+				return fmt.Errorf("subscriptions promo-offers update: failed to update: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// SubscriptionsPromoOffersDeleteCommand returns the promo-offers delete subcommand.
+func SubscriptionsPromoOffersDeleteCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("promo-offers delete", flag.ExitOnError)
+
+	offerID := fs.String("id", "", "Promotional offer ID")
+	confirm := fs.Bool("confirm", false, "Confirm deletion")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "delete",
+		ShortUsage: "asc subscriptions promo-offers delete --id \"OFFER_ID\" --confirm",
+		ShortHelp:  "Delete a promotional offer.",
+		LongHelp: `Delete a promotional offer.
+
+Examples:
+  asc subscriptions promo-offers delete --id "OFFER_ID" --confirm`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*offerID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+			if !*confirm {
+				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions promo-offers delete: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			if err := client.DeleteSubscriptionPromotionalOffer(requestCtx, id); err != nil {
+				return fmt.Errorf("subscriptions promo-offers delete: failed to delete: %w", err)
+			}
+
+			result := &asc.SubscriptionOfferDeleteResult{
+				ID:      id,
+				Deleted: true,
+			}
+
+			return printOutput(result, *output, *pretty)
+		},
+	}
+}
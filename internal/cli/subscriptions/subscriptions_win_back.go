@@ -0,0 +1,535 @@
+package subscriptions
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// SubscriptionsWinBackCommand returns the subscriptions win-back command group.
+func SubscriptionsWinBackCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("win-back", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "win-back",
+		ShortUsage: "asc subscriptions win-back <subcommand> [flags]",
+		ShortHelp:  "Manage subscription win-back offers.",
+		LongHelp: `Manage subscription win-back offers.
+
+Win-back offers target customers who previously subscribed. Eligibility is
+defined by how long they paid, how long ago they left, and how long they must
+wait between offers.
+
+Examples:
+  asc subscriptions win-back list --subscription "SUB_ID"
+  asc subscriptions win-back create --subscription "SUB_ID" --name "Come back" --offer-id "WINBACK_1" --mode PAY_UP_FRONT --duration THREE_MONTHS --paid-months 6 --last-subscribed 3-12 --start-date "2026-04-01" --prices "USA:PP_ID" --equalize
+  asc subscriptions win-back update --id "OFFER_ID" --priority HIGH
+  asc subscriptions win-back delete --id "OFFER_ID" --confirm`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			SubscriptionsWinBackListCommand(),
+			SubscriptionsWinBackGetCommand(),
+			SubscriptionsWinBackCreateCommand(),
+			SubscriptionsWinBackUpdateCommand(),
+			SubscriptionsWinBackDeleteCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// SubscriptionsWinBackListCommand returns the win-back list subcommand.
+func SubscriptionsWinBackListCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("win-back list", flag.ExitOnError)
+
+	subID := fs.String("subscription", "", "Subscription ID")
+	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
+	next := fs.String("next", "", "Fetch next page using a links.next URL")
+	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "list",
+		ShortUsage: "asc subscriptions win-back list [flags]",
+		ShortHelp:  "List win-back offers for a subscription.",
+		LongHelp: `List win-back offers for a subscription.
+
+Examples:
+  asc subscriptions win-back list --subscription "SUB_ID"
+  asc subscriptions win-back list --subscription "SUB_ID" --paginate --output table`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if *limit != 0 && (*limit < 1 || *limit > 200) {
+				return fmt.Errorf("subscriptions win-back list: --limit must be between 1 and 200")
+			}
+			if err := validateNextURL(*next); err != nil {
+				return fmt.Errorf("subscriptions win-back list: %w", err)
+			}
+
+			id := strings.TrimSpace(*subID)
+			if id == "" && strings.TrimSpace(*next) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --subscription is required")
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions win-back list: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			opts := []asc.SubscriptionOffersOption{
+				asc.WithSubscriptionOffersLimit(*limit),
+				asc.WithSubscriptionOffersNextURL(*next),
+			}
+
+			if *paginate {
+				paginateOpts := append(opts, asc.WithSubscriptionOffersLimit(200))
+				firstPage, err := client.GetWinBackOffers(requestCtx, id, paginateOpts...)
+				if err != nil {
+					return fmt.Errorf("subscriptions win-back list: failed to fetch: %w", err)
+				}
+
+				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+					return client.GetWinBackOffers(ctx, id, asc.WithSubscriptionOffersNextURL(nextURL))
+				})
+				if err != nil {
+					return fmt.Errorf("subscriptions win-back list: %w", err)
+				}
+
+				return printOutput(resp, *output, *pretty)
+			}
+
+			resp, err := client.GetWinBackOffers(requestCtx, id, opts...)
+			if err != nil {
+				return fmt.Errorf("subscriptions win-back list: failed to fetch: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// SubscriptionsWinBackGetCommand returns the win-back get subcommand.
+func SubscriptionsWinBackGetCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("win-back get", flag.ExitOnError)
+
+	offerID := fs.String("id", "", "Win-back offer ID")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "get",
+		ShortUsage: "asc subscriptions win-back get --id \"OFFER_ID\"",
+		ShortHelp:  "Get a win-back offer.",
+		LongHelp: `Get a win-back offer.
+
+Examples:
+  asc subscriptions win-back get --id "OFFER_ID"`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*offerID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions win-back get: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			resp, err := client.GetWinBackOffer(requestCtx, id)
+			if err != nil {
+				return fmt.Errorf("subscriptions win-back get: failed to fetch: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// SubscriptionsWinBackCreateCommand returns the win-back create subcommand.
+func SubscriptionsWinBackCreateCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("win-back create", flag.ExitOnError)
+
+	subID := fs.String("subscription", "", "Subscription ID")
+	name := fs.String("name", "", "Reference name")
+	offerIDFlag := fs.String("offer-id", "", "Offer identifier used in StoreKit")
+	duration := fs.String("duration", "", "Offer duration: "+strings.Join(asc.ValidSubscriptionOfferDurations, ", "))
+	mode := fs.String("mode", "", "Offer mode: "+strings.Join(asc.ValidSubscriptionOfferModes, ", "))
+	periods := fs.Int("periods", 1, "Number of periods")
+	paidMonths := fs.Int("paid-months", 0, "Minimum months of paid subscription to be eligible")
+	lastSubscribed := fs.String("last-subscribed", "", "Months since last subscribed as MIN-MAX (e.g. 3-12)")
+	waitMonths := fs.Int("wait-months", 0, "Months a customer must wait between win-back offers")
+	startDate := fs.String("start-date", "", "Start date (YYYY-MM-DD)")
+	endDate := fs.String("end-date", "", "End date (YYYY-MM-DD)")
+	priority := fs.String("priority", "NORMAL", "Priority: "+strings.Join(asc.ValidWinBackOfferPriorities, ", "))
+	promotionIntent := fs.String("promotion-intent", "", "Promotion intent: "+strings.Join(asc.ValidWinBackOfferPromotionIntents, ", "))
+	priceFlags := bindOfferPriceFlags(fs)
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "create",
+		ShortUsage: "asc subscriptions win-back create [flags]",
+		ShortHelp:  "Create a win-back offer for a subscription.",
+		LongHelp: `Create a win-back offer for a subscription.
+
+Paid offers (PAY_AS_YOU_GO, PAY_UP_FRONT) take prices from --prices and
+--prices-file; add --equalize with a single base price to cover every
+territory. Free trials take --territory or --all-territories instead.
+
+Examples:
+  asc subscriptions win-back create --subscription "SUB_ID" --name "Come back" --offer-id "WINBACK_1" --mode FREE_TRIAL --duration ONE_MONTH --paid-months 3 --last-subscribed 1-6 --start-date "2026-04-01" --all-territories
+  asc subscriptions win-back create --subscription "SUB_ID" --name "Half off" --offer-id "WINBACK_2" --mode PAY_AS_YOU_GO --duration ONE_MONTH --periods 3 --paid-months 6 --last-subscribed 3-12 --wait-months 6 --start-date "2026-04-01" --priority HIGH --prices "USA:PP_ID,GBR:PP_ID"`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*subID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --subscription is required")
+				return flag.ErrHelp
+			}
+			referenceName := strings.TrimSpace(*name)
+			if referenceName == "" {
+				fmt.Fprintln(os.Stderr, "Error: --name is required")
+				return flag.ErrHelp
+			}
+			offerIdentifier := strings.TrimSpace(*offerIDFlag)
+			if offerIdentifier == "" {
+				fmt.Fprintln(os.Stderr, "Error: --offer-id is required")
+				return flag.ErrHelp
+			}
+			if strings.TrimSpace(*duration) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --duration is required")
+				return flag.ErrHelp
+			}
+			if strings.TrimSpace(*mode) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --mode is required")
+				return flag.ErrHelp
+			}
+			if *paidMonths == 0 {
+				fmt.Fprintln(os.Stderr, "Error: --paid-months is required")
+				return flag.ErrHelp
+			}
+			if strings.TrimSpace(*lastSubscribed) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --last-subscribed is required")
+				return flag.ErrHelp
+			}
+			if strings.TrimSpace(*startDate) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --start-date is required")
+				return flag.ErrHelp
+			}
+
+			offerDuration, err := normalizeOfferDuration(*duration)
+			if err != nil {
+				return fmt.Errorf("subscriptions win-back create: %w", err)
+			}
+			offerMode, err := normalizeOfferMode(*mode)
+			if err != nil {
+				return fmt.Errorf("subscriptions win-back create: %w", err)
+			}
+			if *periods < 1 {
+				return fmt.Errorf("subscriptions win-back create: --periods must be at least 1")
+			}
+			if *paidMonths < 1 {
+				return fmt.Errorf("subscriptions win-back create: --paid-months must be at least 1")
+			}
+			if *waitMonths < 0 {
+				return fmt.Errorf("subscriptions win-back create: --wait-months must not be negative")
+			}
+			lastSubscribedRange, err := parseMonthRange(*lastSubscribed, "--last-subscribed")
+			if err != nil {
+				return fmt.Errorf("subscriptions win-back create: %w", err)
+			}
+
+			attrs := asc.WinBackOfferAttributes{
+				ReferenceName: referenceName,
+				OfferID:       offerIdentifier,
+				Duration:      offerDuration,
+				OfferMode:     offerMode,
+				PeriodCount:   *periods,
+				CustomerEligibilityPaidSubscriptionDurationInMonths: *paidMonths,
+				CustomerEligibilityTimeSinceLastSubscribedInMonths:  lastSubscribedRange,
+				CustomerEligibilityWaitBetweenOffersInMonths:        *waitMonths,
+			}
+			attrs.StartDate, err = shared.NormalizeDate(*startDate, "--start-date")
+			if err != nil {
+				return fmt.Errorf("subscriptions win-back create: %w", err)
+			}
+			if strings.TrimSpace(*endDate) != "" {
+				attrs.EndDate, err = shared.NormalizeDate(*endDate, "--end-date")
+				if err != nil {
+					return fmt.Errorf("subscriptions win-back create: %w", err)
+				}
+			}
+			attrs.Priority, err = normalizeWinBackPriority(*priority)
+			if err != nil {
+				return fmt.Errorf("subscriptions win-back create: %w", err)
+			}
+			if strings.TrimSpace(*promotionIntent) != "" {
+				attrs.PromotionIntent, err = normalizeWinBackPromotionIntent(*promotionIntent)
+				if err != nil {
+					return fmt.Errorf("subscriptions win-back create: %w", err)
+				}
+			}
+
+			if usage, err := priceFlags.check(offerMode); err != nil {
+				return fmt.Errorf("subscriptions win-back create: %w", err)
+			} else if usage != "" {
+				fmt.Fprintln(os.Stderr, "Error: "+usage)
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions win-back create: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			offerPrices, err := priceFlags.resolve(requestCtx, client, offerMode)
+			if err != nil {
+				return fmt.Errorf("subscriptions win-back create: %w", err)
+			}
+
+			resp, err := client.CreateWinBackOffer(requestCtx, id, attrs, offerPrices)
+			if err != nil {
+				return fmt.Errorf("subscriptions win-back create: failed to create: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// SubscriptionsWinBackUpdateCommand returns the win-back update subcommand.
+func SubscriptionsWinBackUpdateCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("win-back update", flag.ExitOnError)
+
+	offerID := fs.String("id", "", "Win-back offer ID")
+	paidMonths := fs.Int("paid-months", 0, "Minimum months of paid subscription to be eligible")
+	lastSubscribed := fs.String("last-subscribed", "", "Months since last subscribed as MIN-MAX (e.g. 3-12)")
+	waitMonths := fs.Int("wait-months", 0, "Months a customer must wait between win-back offers")
+	startDate := fs.String("start-date", "", "Start date (YYYY-MM-DD)")
+	endDate := fs.String("end-date", "", "End date (YYYY-MM-DD)")
+	priority := fs.String("priority", "", "Priority: "+strings.Join(asc.ValidWinBackOfferPriorities, ", "))
+	promotionIntent := fs.String("promotion-intent", "", "Promotion intent: "+strings.Join(asc.ValidWinBackOfferPromotionIntents, ", "))
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "update",
+		ShortUsage: "asc subscriptions win-back update [flags]",
+		ShortHelp:  "Update a win-back offer.",
+		LongHelp: `Update a win-back offer.
+
+Only eligibility, dates, priority, and promotion intent can be changed after
+creation.
+
+Examples:
+  asc subscriptions win-back update --id "OFFER_ID" --priority HIGH
+  asc subscriptions win-back update --id "OFFER_ID" --last-subscribed 6-24 --wait-months 12
+  asc subscriptions win-back update --id "OFFER_ID" --end-date "2026-12-31"`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*offerID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+
+			visited := map[string]bool{}
+			fs.Visit(func(f *flag.Flag) {
+				visited[f.Name] = true
+			})
+
+			hasUpdates := visited["paid-months"] ||
+				visited["last-subscribed"] ||
+				visited["wait-months"] ||
+				visited["start-date"] ||
+				visited["end-date"] ||
+				visited["priority"] ||
+				visited["promotion-intent"]
+			if !hasUpdates {
+				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
+				return flag.ErrHelp
+			}
+
+			attrs := asc.WinBackOfferUpdateAttributes{}
+			if visited["paid-months"] {
+				if *paidMonths < 1 {
+					return fmt.Errorf("subscriptions win-back update: --paid-months must be at least 1")
+				}
+				value := *paidMonths
+				attrs.CustomerEligibilityPaidSubscriptionDurationInMonths = &value
+			}
+			if visited["last-subscribed"] {
+				lastSubscribedRange, err := parseMonthRange(*lastSubscribed, "--last-subscribed")
+				if err != nil {
+					return fmt.Errorf("subscriptions win-back update: %w", err)
+				}
+				attrs.CustomerEligibilityTimeSinceLastSubscribedInMonths = lastSubscribedRange
+			}
+			if visited["wait-months"] {
+				if *waitMonths < 0 {
+					return fmt.Errorf("subscriptions win-back update: --wait-months must not be negative")
+				}
+				value := *waitMonths
+				attrs.CustomerEligibilityWaitBetweenOffersInMonths = &value
+			}
+			if visited["start-date"] {
+				value, err := shared.NormalizeDate(*startDate, "--start-date")
+				if err != nil {
+					return fmt.Errorf("subscriptions win-back update: %w", err)
+				}
+				attrs.StartDate = &value
+			}
+			if visited["end-date"] {
+				value, err := shared.NormalizeDate(*endDate, "--end-date")
+				if err != nil {
+					return fmt.Errorf("subscriptions win-back update: %w", err)
+				}
+				attrs.EndDate = &value
+			}
+			if visited["priority"] {
+				value, err := normalizeWinBackPriority(*priority)
+				if err != nil {
+					return fmt.Errorf("subscriptions win-back update: %w", err)
+				}
+				attrs.Priority = &value
+			}
+			if visited["promotion-intent"] {
+				value, err := normalizeWinBackPromotionIntent(*promotionIntent)
+				if err != nil {
+					return fmt.Errorf("subscriptions win-back update: %w", err)
+				}
+				attrs.PromotionIntent = &value
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions win-back update: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			resp, err := client.UpdateWinBackOffer(requestCtx, id, attrs)
+			if err != nil {
+				return fmt.Errorf("subscriptions win-back update: failed to update: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// SubscriptionsWinBackDeleteCommand returns the win-back delete subcommand.
+func SubscriptionsWinBackDeleteCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("win-back delete", flag.ExitOnError)
+
+	offerID := fs.String("id", "", "Win-back offer ID")
+	confirm := fs.Bool("confirm", false, "Confirm deletion")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "delete",
+		ShortUsage: "asc subscriptions win-back delete --id \"OFFER_ID\" --confirm",
+		ShortHelp:  "Delete a win-back offer.",
+		LongHelp: `Delete a win-back offer.
+
+Examples:
+  asc subscriptions win-back delete --id "OFFER_ID" --confirm`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*offerID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+			if !*confirm {
+				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions win-back delete: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			if err := client.DeleteWinBackOffer(requestCtx, id); err != nil {
+				return fmt.Errorf("subscriptions win-back delete: failed to delete: %w", err)
+			}
+
+			result := &asc.SubscriptionOfferDeleteResult{
+				ID:      id,
+				Deleted: true,
+			}
+
+			return printOutput(result, *output, *pretty)
+		},
+	}
+}
+
+// parseMonthRange parses a MIN-MAX month range such as "3-12".
+func parseMonthRange(value, flagName string) (*asc.IntegerRange, error) {
+	parts := strings.Split(strings.TrimSpace(value), "-")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("%s must be MIN-MAX months (e.g. 3-12)", flagName)
+	}
+	minimum, err := strconv.Atoi(strings.TrimSpace(parts[0]))
+	if err != nil {
+		return nil, fmt.Errorf("%s must be MIN-MAX months (e.g. 3-12)", flagName)
+	}
+	maximum, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+	if err != nil {
+		return nil, fmt.Errorf("%s must be MIN-MAX months (e.g. 3-12)", flagName)
+	}
+	if minimum < 1 || maximum < minimum {
+		return nil, fmt.Errorf("%s must satisfy 1 <= MIN <= MAX", flagName)
+	}
+	return &asc.IntegerRange{Minimum: minimum, Maximum: maximum}, nil
+}
+
+func normalizeWinBackPriority(value string) (string, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(value))
+	if !asc.IsValidWinBackOfferPriority(normalized) {
+		return "", fmt.Errorf("--priority must be one of: %s", strings.Join(asc.ValidWinBackOfferPriorities, ", "))
+	}
+	return normalized, nil
+}
+
+func normalizeWinBackPromotionIntent(value string) (string, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(value))
+	if !asc.IsValidWinBackOfferPromotionIntent(normalized) {
+		return "", fmt.Errorf("--promotion-intent must be one of: %s", strings.Join(asc.ValidWinBackOfferPromotionIntents, ", "))
+	}
+	return normalized, nil
+}
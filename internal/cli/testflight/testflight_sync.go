@@ -93,11 +93,13 @@ func TestFlightSyncCommand() *ffcli.Command {
 		LongHelp: `Sync TestFlight configuration.
 
 Examples:
-  asc testflight sync pull --app "APP_ID" --output "./testflight.yaml"`,
+  asc testflight sync pull --app "APP_ID" --output "./testflight.yaml"
+  asc testflight sync push --config "./testflight.yaml" --dry-run`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			TestFlightSyncPullCommand(),
+			TestFlightSyncPushCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -164,9 +166,6 @@ Examples:
 				return fmt.Errorf("testflight sync pull: %w", err)
 			}
 
-			requestCtx, cancel := contextWithTimeout(ctx)
-			defer cancel()
-
 			options := testFlightPullOptions{
 				includeBuilds:  *includeBuilds,
 				includeTesters: *includeTesters,
@@ -175,7 +174,7 @@ Examples:
 				testerFilters:  testerFilters,
 			}
 
-			config, err := pullTestFlightConfig(requestCtx, client, resolvedAppID, options)
+			config, err := pullTestFlightConfig(ctx, client, resolvedAppID, options)
 			if err != nil {
 				return fmt.Errorf("testflight sync pull: %w", err)
 			}
@@ -200,12 +199,17 @@ Examples:
 	}
 }
 
+// pullTestFlightConfig reads the app's TestFlight setup. Each request and
+// page gets its own timeout, so apps with many groups, builds or testers do
+// not run out a single shared deadline.
 func pullTestFlightConfig(ctx context.Context, client testFlightSyncClient, appID string, opts testFlightPullOptions) (*TestFlightConfig, error) {
 	if client == nil {
 		return nil, fmt.Errorf("client is required")
 	}
 
-	appResp, err := client.GetApp(ctx, appID)
+	appCtx, cancel := contextWithTimeout(ctx)
+	appResp, err := client.GetApp(appCtx, appID)
+	cancel()
 	if err != nil {
 		return nil, fmt.Errorf("fetch app: %w", err)
 	}
@@ -215,7 +219,9 @@ func pullTestFlightConfig(ctx context.Context, client testFlightSyncClient, appI
 		BundleID: appResp.Data.Attributes.BundleID,
 	}
 
-	groupFirstPage, err := client.GetBetaGroups(ctx, appID, asc.WithBetaGroupsLimit(200))
+	groupCtx, cancel := contextWithTimeout(ctx)
+	groupFirstPage, err := client.GetBetaGroups(groupCtx, appID, asc.WithBetaGroupsLimit(200))
+	cancel()
 	if err != nil {
 		return nil, fmt.Errorf("fetch beta groups: %w", err)
 	}
@@ -232,7 +238,9 @@ func pullTestFlightConfig(ctx context.Context, client testFlightSyncClient, appI
 	groupBuilds := make(map[string][]string)
 	if opts.includeBuilds {
 		for _, group := range filteredGroups {
-			buildFirstPage, err := client.GetBetaGroupBuilds(ctx, group.ID, asc.WithBetaGroupBuildsLimit(200))
+			buildCtx, cancel := contextWithTimeout(ctx)
+			buildFirstPage, err := client.GetBetaGroupBuilds(buildCtx, group.ID, asc.WithBetaGroupBuildsLimit(200))
+			cancel()
 			if err != nil {
 				return nil, fmt.Errorf("fetch beta group builds: %w", err)
 			}
@@ -264,7 +272,9 @@ func pullTestFlightConfig(ctx context.Context, client testFlightSyncClient, appI
 	testerConfigs := make(map[string]*TestFlightTesterConfig)
 	if opts.includeTesters {
 		for _, group := range filteredGroups {
-			testerFirstPage, err := client.GetBetaGroupTesters(ctx, group.ID, asc.WithBetaGroupTestersLimit(200))
+			testerCtx, cancel := contextWithTimeout(ctx)
+			testerFirstPage, err := client.GetBetaGroupTesters(testerCtx, group.ID, asc.WithBetaGroupTestersLimit(200))
+			cancel()
 			if err != nil {
 				return nil, fmt.Errorf("fetch beta group testers: %w", err)
 			}
@@ -338,7 +348,9 @@ func paginateBetaGroups(ctx context.Context, client testFlightSyncClient, appID
 		return &asc.BetaGroupsResponse{}, nil
 	}
 	allPages, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
-		return client.GetBetaGroups(ctx, appID, asc.WithBetaGroupsNextURL(nextURL))
+		pageCtx, cancel := contextWithTimeout(ctx)
+		defer cancel()
+		return client.GetBetaGroups(pageCtx, appID, asc.WithBetaGroupsNextURL(nextURL))
 	})
 	if err != nil {
 		return nil, err
@@ -358,7 +370,9 @@ func paginateBetaGroupBuilds(ctx context.Context, client testFlightSyncClient, g
 		return &asc.BuildsResponse{}, nil
 	}
 	allPages, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
-		return client.GetBetaGroupBuilds(ctx, groupID, asc.WithBetaGroupBuildsNextURL(nextURL))
+		pageCtx, cancel := contextWithTimeout(ctx)
+		defer cancel()
+		return client.GetBetaGroupBuilds(pageCtx, groupID, asc.WithBetaGroupBuildsNextURL(nextURL))
 	})
 	if err != nil {
 		return nil, err
@@ -378,7 +392,9 @@ func paginateBetaGroupTesters(ctx context.Context, client testFlightSyncClient,
 		return &asc.BetaTestersResponse{}, nil
 	}
 	allPages, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
-		return client.GetBetaGroupTesters(ctx, groupID, asc.WithBetaGroupTestersNextURL(nextURL))
+		pageCtx, cancel := contextWithTimeout(ctx)
+		defer cancel()
+		return client.GetBetaGroupTesters(pageCtx, groupID, asc.WithBetaGroupTestersNextURL(nextURL))
 	})
 	if err != nil {
 		return nil, err
@@ -0,0 +1,788 @@
+package testflight
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+	"gopkg.in/yaml.v3"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	testFlightSyncActionCreateGroup  = "create-group"
+	testFlightSyncActionUpdateGroup  = "update-group"
+	testFlightSyncActionCreateTester = "create-tester"
+	testFlightSyncActionAddTester    = "add-tester"
+	testFlightSyncActionAddBuild     = "add-build"
+	testFlightSyncActionRemoveBuild  = "remove-build"
+	testFlightSyncActionRemoveTester = "remove-tester"
+	testFlightSyncActionDeleteGroup  = "delete-group"
+)
+
+// testFlightSyncAction is a single change computed by sync push.
+type testFlightSyncAction struct {
+	Action   string   `json:"action"`
+	Group    string   `json:"group,omitempty"`
+	GroupID  string   `json:"groupId,omitempty"`
+	TesterID string   `json:"testerId,omitempty"`
+	Email    string   `json:"email,omitempty"`
+	BuildID  string   `json:"buildId,omitempty"`
+	Groups   []string `json:"groups,omitempty"`
+	Changes  []string `json:"changes,omitempty"`
+	Status   string   `json:"status,omitempty"`
+	Error    string   `json:"error,omitempty"`
+
+	// groupIndex and groupIndexes point into the desired config so that
+	// groups created earlier in the run can be resolved to their new IDs.
+	groupIndex   int
+	groupIndexes []int
+	tester       TestFlightTesterConfig
+}
+
+type testFlightSyncPlan struct {
+	Actions         []testFlightSyncAction
+	SkippedRemovals int
+
+	// groupIDs holds the live ID matched to each config group, if any.
+	groupIDs []string
+}
+
+type testFlightSyncPushResult struct {
+	File            string                 `json:"file"`
+	AppID           string                 `json:"appId"`
+	DryRun          bool                   `json:"dryRun"`
+	Prune           bool                   `json:"prune"`
+	Actions         []testFlightSyncAction `json:"actions"`
+	SkippedRemovals int                    `json:"skippedRemovals,omitempty"`
+	Applied         int                    `json:"applied"`
+	Failed          int                    `json:"failed"`
+}
+
+type testFlightSyncPushClient interface {
+	testFlightSyncClient
+	CreateBetaGroupWithAttributes(ctx context.Context, appID string, attrs asc.BetaGroupAttributes) (*asc.BetaGroupResponse, error)
+	UpdateBetaGroup(ctx context.Context, groupID string, req asc.BetaGroupUpdateRequest) (*asc.BetaGroupResponse, error)
+	DeleteBetaGroup(ctx context.Context, groupID string) error
+	CreateBetaTester(ctx context.Context, email, firstName, lastName string, groupIDs []string) (*asc.BetaTesterResponse, error)
+	AddBetaTestersToGroup(ctx context.Context, groupID string, testerIDs []string) error
+	RemoveBetaTestersFromGroup(ctx context.Context, groupID string, testerIDs []string) error
+	AddBetaGroupsToBuild(ctx context.Context, buildID string, groupIDs []string) error
+	RemoveBetaGroupsFromBuild(ctx context.Context, buildID string, groupIDs []string) error
+}
+
+// TestFlightSyncPushCommand applies a TestFlight YAML config to App Store Connect.
+func TestFlightSyncPushCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("push", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (defaults to app.id in config, or ASC_APP_ID env)")
+	configPath := fs.String("config", "", "Path to TestFlight YAML config (required)")
+	dryRun := fs.Bool("dry-run", false, "Print the plan without applying changes")
+	prune := fs.Bool("prune", false, "Remove groups, testers, and builds not present in the config")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "push",
+		ShortUsage: "asc testflight sync push [flags]",
+		ShortHelp:  "Apply a TestFlight YAML configuration to App Store Connect.",
+		LongHelp: `Apply a TestFlight YAML configuration to App Store Connect.
+
+The config uses the same schema as "sync pull". Groups are matched by ID, then
+by name; missing groups are created. Testers and builds are only managed when
+the config includes them. Nothing is removed unless --prune is set.
+
+Examples:
+  asc testflight sync push --config "./testflight.yaml" --dry-run
+  asc testflight sync push --config "./testflight.yaml"
+  asc testflight sync push --config "./testflight.yaml" --prune`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			configValue := strings.TrimSpace(*configPath)
+			if configValue == "" {
+				fmt.Fprintf(os.Stderr, "Error: --config is required\n\n")
+				return flag.ErrHelp
+			}
+
+			desired, err := readTestFlightConfigYAML(configValue)
+			if err != nil {
+				return fmt.Errorf("testflight sync push: %w", err)
+			}
+
+			resolvedAppID, err := resolveTestFlightPushAppID(*appID, desired)
+			if err != nil {
+				return fmt.Errorf("testflight sync push: %w", err)
+			}
+			if resolvedAppID == "" {
+				fmt.Fprintf(os.Stderr, "Error: --app is required (or set app.id in config or ASC_APP_ID)\n\n")
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("testflight sync push: %w", err)
+			}
+
+			live, err := pullTestFlightConfig(ctx, client, resolvedAppID, testFlightPullOptions{
+				includeBuilds:  testFlightConfigManagesBuilds(desired),
+				includeTesters: testFlightConfigManagesTesters(desired),
+			})
+			if err != nil {
+				return fmt.Errorf("testflight sync push: %w", err)
+			}
+
+			plan, err := planTestFlightSync(desired, live, *prune)
+			if err != nil {
+				return fmt.Errorf("testflight sync push: %w", err)
+			}
+
+			result := &testFlightSyncPushResult{
+				File:            filepath.Clean(configValue),
+				AppID:           resolvedAppID,
+				DryRun:          *dryRun,
+				Prune:           *prune,
+				Actions:         plan.Actions,
+				SkippedRemovals: plan.SkippedRemovals,
+			}
+			if plan.SkippedRemovals > 0 {
+				fmt.Fprintf(os.Stderr, "Note: %d removal(s) skipped; use --prune to apply them\n", plan.SkippedRemovals)
+			}
+
+			var applyErr error
+			if !*dryRun {
+				applyErr = applyTestFlightSyncPlan(ctx, client, resolvedAppID, desired, plan, result)
+			}
+
+			if *pretty {
+				if err := asc.PrintPrettyJSON(result); err != nil {
+					return err
+				}
+			} else if err := asc.PrintJSON(result); err != nil {
+				return err
+			}
+
+			if applyErr != nil {
+				return shared.NewReportedError(fmt.Errorf("testflight sync push: %w", applyErr))
+			}
+			return nil
+		},
+	}
+}
+
+func readTestFlightConfigYAML(path string) (*TestFlightConfig, error) {
+	info, err := os.Lstat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.Mode()&os.ModeSymlink != 0 {
+		return nil, fmt.Errorf("refusing to read symlink %q", path)
+	}
+	if !info.Mode().IsRegular() {
+		return nil, fmt.Errorf("expected regular file: %q", path)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(string(data)) == "" {
+		return nil, fmt.Errorf("config file is empty")
+	}
+
+	var config TestFlightConfig
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("invalid YAML: %w", err)
+	}
+	return &config, nil
+}
+
+func resolveTestFlightPushAppID(flagValue string, config *TestFlightConfig) (string, error) {
+	configAppID := ""
+	if config != nil {
+		configAppID = strings.TrimSpace(config.App.ID)
+	}
+	flagAppID := strings.TrimSpace(flagValue)
+	if flagAppID != "" {
+		if configAppID != "" && configAppID != flagAppID {
+			return "", fmt.Errorf("--app %q does not match app.id %q in config", flagAppID, configAppID)
+		}
+		return flagAppID, nil
+	}
+	if configAppID != "" {
+		return configAppID, nil
+	}
+	return resolveAppID(""), nil
+}
+
+func testFlightConfigManagesBuilds(config *TestFlightConfig) bool {
+	if config == nil {
+		return false
+	}
+	if config.Builds != nil {
+		return true
+	}
+	for _, group := range config.Groups {
+		if group.Builds != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func testFlightConfigManagesTesters(config *TestFlightConfig) bool {
+	return config != nil && config.Testers != nil
+}
+
+// planTestFlightSync computes the ordered actions needed to make live match desired.
+func planTestFlightSync(desired, live *TestFlightConfig, prune bool) (*testFlightSyncPlan, error) {
+	if desired == nil {
+		return nil, fmt.Errorf("config is required")
+	}
+	if live == nil {
+		live = &TestFlightConfig{}
+	}
+
+	matches, err := matchTestFlightGroups(desired.Groups, live.Groups)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &testFlightSyncPlan{groupIDs: matches}
+	var creates, updates, createTesters, addTesters, addBuilds, removeBuilds, removeTesters, deletes []testFlightSyncAction
+
+	liveGroupsByID := make(map[string]TestFlightGroupConfig, len(live.Groups))
+	for _, group := range live.Groups {
+		liveGroupsByID[group.ID] = group
+	}
+	matchedLiveIDs := make(map[string]struct{}, len(matches))
+
+	for i, group := range desired.Groups {
+		liveID := matches[i]
+		if liveID == "" {
+			creates = append(creates, testFlightSyncAction{
+				Action:     testFlightSyncActionCreateGroup,
+				Group:      group.Name,
+				groupIndex: i,
+			})
+			continue
+		}
+		matchedLiveIDs[liveID] = struct{}{}
+		changes, err := diffTestFlightGroup(group, liveGroupsByID[liveID])
+		if err != nil {
+			return nil, err
+		}
+		if len(changes) > 0 {
+			updates = append(updates, testFlightSyncAction{
+				Action:     testFlightSyncActionUpdateGroup,
+				Group:      group.Name,
+				GroupID:    liveID,
+				Changes:    changes,
+				groupIndex: i,
+			})
+		}
+	}
+
+	for _, group := range live.Groups {
+		if _, ok := matchedLiveIDs[group.ID]; ok {
+			continue
+		}
+		if !prune {
+			plan.SkippedRemovals++
+			continue
+		}
+		deletes = append(deletes, testFlightSyncAction{
+			Action:     testFlightSyncActionDeleteGroup,
+			Group:      group.Name,
+			GroupID:    group.ID,
+			groupIndex: -1,
+		})
+	}
+
+	refs := newTestFlightGroupRefs(desired.Groups)
+
+	if testFlightConfigManagesBuilds(desired) {
+		desiredBuilds := make(map[int]map[string]struct{}, len(desired.Groups))
+		for i, group := range desired.Groups {
+			desiredBuilds[i] = make(map[string]struct{})
+			for _, buildID := range normalizeFilters(group.Builds) {
+				desiredBuilds[i][buildID] = struct{}{}
+			}
+		}
+		for _, build := range desired.Builds {
+			buildID := strings.TrimSpace(build.ID)
+			if buildID == "" {
+				return nil, fmt.Errorf("build entries require an id")
+			}
+			for _, ref := range normalizeFilters(build.Groups) {
+				index, err := refs.resolve(ref)
+				if err != nil {
+					return nil, fmt.Errorf("build %s: %w", buildID, err)
+				}
+				desiredBuilds[index][buildID] = struct{}{}
+			}
+		}
+
+		for i, group := range desired.Groups {
+			liveID := matches[i]
+			current := make(map[string]struct{})
+			if liveID != "" {
+				for _, buildID := range liveGroupsByID[liveID].Builds {
+					current[buildID] = struct{}{}
+				}
+			}
+			for _, buildID := range sortedKeys(desiredBuilds[i]) {
+				if _, ok := current[buildID]; ok {
+					continue
+				}
+				addBuilds = append(addBuilds, testFlightSyncAction{
+					Action:     testFlightSyncActionAddBuild,
+					Group:      group.Name,
+					GroupID:    liveID,
+					BuildID:    buildID,
+					groupIndex: i,
+				})
+			}
+			for _, buildID := range sortedKeys(current) {
+				if _, ok := desiredBuilds[i][buildID]; ok {
+					continue
+				}
+				if !prune {
+					plan.SkippedRemovals++
+					continue
+				}
+				removeBuilds = append(removeBuilds, testFlightSyncAction{
+					Action:     testFlightSyncActionRemoveBuild,
+					Group:      group.Name,
+					GroupID:    liveID,
+					BuildID:    buildID,
+					groupIndex: i,
+				})
+			}
+		}
+	}
+
+	if testFlightConfigManagesTesters(desired) {
+		liveTestersByID := make(map[string]TestFlightTesterConfig, len(live.Testers))
+		liveTestersByEmail := make(map[string]TestFlightTesterConfig, len(live.Testers))
+		for _, tester := range live.Testers {
+			liveTestersByID[tester.ID] = tester
+			if email := strings.ToLower(strings.TrimSpace(tester.Email)); email != "" {
+				liveTestersByEmail[email] = tester
+			}
+		}
+		desiredTesterIDs := make(map[string]struct{}, len(desired.Testers))
+
+		for _, tester := range desired.Testers {
+			groupIndexes := make([]int, 0, len(tester.Groups))
+			seen := make(map[int]struct{}, len(tester.Groups))
+			for _, ref := range normalizeFilters(tester.Groups) {
+				index, err := refs.resolve(ref)
+				if err != nil {
+					return nil, fmt.Errorf("tester %s: %w", testerLabel(tester), err)
+				}
+				if _, ok := seen[index]; ok {
+					continue
+				}
+				seen[index] = struct{}{}
+				groupIndexes = append(groupIndexes, index)
+			}
+			sort.Ints(groupIndexes)
+
+			liveTester, found := liveTestersByID[strings.TrimSpace(tester.ID)]
+			if !found {
+				liveTester, found = liveTestersByEmail[strings.ToLower(strings.TrimSpace(tester.Email))]
+			}
+			if !found {
+				if strings.TrimSpace(tester.Email) == "" {
+					return nil, fmt.Errorf("tester %s: email is required to add a new tester", testerLabel(tester))
+				}
+				if len(groupIndexes) == 0 {
+					return nil, fmt.Errorf("tester %s: at least one group is required", testerLabel(tester))
+				}
+				groupNames := make([]string, 0, len(groupIndexes))
+				for _, index := range groupIndexes {
+					groupNames = append(groupNames, desired.Groups[index].Name)
+				}
+				createTesters = append(createTesters, testFlightSyncAction{
+					Action:       testFlightSyncActionCreateTester,
+					Email:        strings.TrimSpace(tester.Email),
+					Groups:       groupNames,
+					groupIndex:   -1,
+					groupIndexes: groupIndexes,
+					tester:       tester,
+				})
+				continue
+			}
+
+			desiredTesterIDs[liveTester.ID] = struct{}{}
+			currentGroups := make(map[string]struct{}, len(liveTester.Groups))
+			for _, groupID := range liveTester.Groups {
+				currentGroups[groupID] = struct{}{}
+			}
+			wantedLiveGroups := make(map[string]struct{}, len(groupIndexes))
+			for _, index := range groupIndexes {
+				liveID := matches[index]
+				if liveID != "" {
+					wantedLiveGroups[liveID] = struct{}{}
+					if _, ok := currentGroups[liveID]; ok {
+						continue
+					}
+				}
+				addTesters = append(addTesters, testFlightSyncAction{
+					Action:     testFlightSyncActionAddTester,
+					Group:      desired.Groups[index].Name,
+					GroupID:    liveID,
+					TesterID:   liveTester.ID,
+					Email:      liveTester.Email,
+					groupIndex: index,
+				})
+			}
+			for _, groupID := range uniqueSortedStrings(liveTester.Groups) {
+				if _, ok := wantedLiveGroups[groupID]; ok {
+					continue
+				}
+				if _, ok := matchedLiveIDs[groupID]; !ok {
+					// The group itself is unmanaged or being deleted.
+					continue
+				}
+				if !prune {
+					plan.SkippedRemovals++
+					continue
+				}
+				removeTesters = append(removeTesters, testFlightSyncAction{
+					Action:     testFlightSyncActionRemoveTester,
+					Group:      liveGroupsByID[groupID].Name,
+					GroupID:    groupID,
+					TesterID:   liveTester.ID,
+					Email:      liveTester.Email,
+					groupIndex: -1,
+				})
+			}
+		}
+
+		for _, tester := range live.Testers {
+			if _, ok := desiredTesterIDs[tester.ID]; ok {
+				continue
+			}
+			for _, groupID := range uniqueSortedStrings(tester.Groups) {
+				if _, ok := matchedLiveIDs[groupID]; !ok {
+					continue
+				}
+				if !prune {
+					plan.SkippedRemovals++
+					continue
+				}
+				removeTesters = append(removeTesters, testFlightSyncAction{
+					Action:     testFlightSyncActionRemoveTester,
+					Group:      liveGroupsByID[groupID].Name,
+					GroupID:    groupID,
+					TesterID:   tester.ID,
+					Email:      tester.Email,
+					groupIndex: -1,
+				})
+			}
+		}
+	}
+
+	sortTestFlightSyncActions(createTesters, func(a testFlightSyncAction) string { return strings.ToLower(a.Email) })
+	sortTestFlightSyncActions(addTesters, func(a testFlightSyncAction) string { return a.Group + "\x00" + a.TesterID })
+	sortTestFlightSyncActions(removeTesters, func(a testFlightSyncAction) string { return a.Group + "\x00" + a.TesterID })
+	sortTestFlightSyncActions(deletes, func(a testFlightSyncAction) string { return a.Group + "\x00" + a.GroupID })
+
+	for _, group := range [][]testFlightSyncAction{creates, updates, createTesters, addTesters, addBuilds, removeBuilds, removeTesters, deletes} {
+		plan.Actions = append(plan.Actions, group...)
+	}
+	if plan.Actions == nil {
+		plan.Actions = []testFlightSyncAction{}
+	}
+	return plan, nil
+}
+
+// matchTestFlightGroups returns the live group ID for each desired group, or
+// an empty string when the group must be created.
+func matchTestFlightGroups(desired, live []TestFlightGroupConfig) ([]string, error) {
+	names := make(map[string]struct{}, len(desired))
+	for _, group := range desired {
+		name := strings.ToLower(strings.TrimSpace(group.Name))
+		if name == "" {
+			return nil, fmt.Errorf("group entries require a name")
+		}
+		if _, ok := names[name]; ok {
+			return nil, fmt.Errorf("duplicate group name %q in config", strings.TrimSpace(group.Name))
+		}
+		names[name] = struct{}{}
+	}
+
+	liveIDs := make(map[string]struct{}, len(live))
+	for _, group := range live {
+		liveIDs[group.ID] = struct{}{}
+	}
+
+	matches := make([]string, len(desired))
+	claimed := make(map[string]struct{}, len(desired))
+	for i, group := range desired {
+		id := strings.TrimSpace(group.ID)
+		if id == "" {
+			continue
+		}
+		if _, ok := liveIDs[id]; ok {
+			matches[i] = id
+			claimed[id] = struct{}{}
+		}
+	}
+
+	for i, group := range desired {
+		if matches[i] != "" {
+			continue
+		}
+		name := strings.TrimSpace(group.Name)
+		found := make([]string, 0, 1)
+		for _, candidate := range live {
+			if _, ok := claimed[candidate.ID]; ok {
+				continue
+			}
+			if strings.EqualFold(strings.TrimSpace(candidate.Name), name) {
+				found = append(found, candidate.ID)
+			}
+		}
+		switch len(found) {
+		case 0:
+		case 1:
+			matches[i] = found[0]
+			claimed[found[0]] = struct{}{}
+		default:
+			return nil, fmt.Errorf("multiple beta groups named %q; use group ID", name)
+		}
+	}
+
+	return matches, nil
+}
+
+func diffTestFlightGroup(desired, live TestFlightGroupConfig) ([]string, error) {
+	if desired.IsInternalGroup != live.IsInternalGroup {
+		return nil, fmt.Errorf("group %q: isInternalGroup cannot be changed after creation", desired.Name)
+	}
+
+	changes := make([]string, 0)
+	if strings.TrimSpace(desired.Name) != strings.TrimSpace(live.Name) {
+		changes = append(changes, "name")
+	}
+	if desired.PublicLinkEnabled != live.PublicLinkEnabled {
+		changes = append(changes, "publicLinkEnabled")
+	}
+	if publicLinkLimitValue(desired.PublicLinkLimit) != publicLinkLimitValue(live.PublicLinkLimit) {
+		changes = append(changes, "publicLinkLimit")
+	}
+	if desired.FeedbackEnabled != live.FeedbackEnabled {
+		changes = append(changes, "feedbackEnabled")
+	}
+	return changes, nil
+}
+
+func publicLinkLimitValue(limit *int) int {
+	if limit == nil || *limit < 0 {
+		return 0
+	}
+	return *limit
+}
+
+type testFlightGroupRefs struct {
+	byID   map[string]int
+	byName map[string]int
+}
+
+func newTestFlightGroupRefs(groups []TestFlightGroupConfig) testFlightGroupRefs {
+	refs := testFlightGroupRefs{
+		byID:   make(map[string]int, len(groups)),
+		byName: make(map[string]int, len(groups)),
+	}
+	for i, group := range groups {
+		if id := strings.TrimSpace(group.ID); id != "" {
+			refs.byID[id] = i
+		}
+		refs.byName[strings.ToLower(strings.TrimSpace(group.Name))] = i
+	}
+	return refs
+}
+
+// resolve maps a group reference (ID or name) to an index in the config groups.
+func (r testFlightGroupRefs) resolve(ref string) (int, error) {
+	trimmed := strings.TrimSpace(ref)
+	if index, ok := r.byID[trimmed]; ok {
+		return index, nil
+	}
+	if index, ok := r.byName[strings.ToLower(trimmed)]; ok {
+		return index, nil
+	}
+	return 0, fmt.Errorf("group %q is not defined in config groups", trimmed)
+}
+
+func testerLabel(tester TestFlightTesterConfig) string {
+	if email := strings.TrimSpace(tester.Email); email != "" {
+		return email
+	}
+	if id := strings.TrimSpace(tester.ID); id != "" {
+		return id
+	}
+	return "(unnamed)"
+}
+
+func sortedKeys(values map[string]struct{}) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortTestFlightSyncActions(actions []testFlightSyncAction, key func(testFlightSyncAction) string) {
+	sort.SliceStable(actions, func(i, j int) bool {
+		return key(actions[i]) < key(actions[j])
+	})
+}
+
+// applyTestFlightSyncPlan executes result.Actions in order and records each
+// action's status. It stops at the first failure because later actions may
+// depend on groups created earlier. Each action gets its own timeout so a
+// large plan is not cut off partway through by a shared deadline.
+func applyTestFlightSyncPlan(ctx context.Context, client testFlightSyncPushClient, appID string, desired *TestFlightConfig, plan *testFlightSyncPlan, result *testFlightSyncPushResult) error {
+	groupIDs := make(map[int]string, len(plan.groupIDs))
+	for index, id := range plan.groupIDs {
+		if id != "" {
+			groupIDs[index] = id
+		}
+	}
+	resolveGroupID := func(index int) (string, error) {
+		if id := groupIDs[index]; id != "" {
+			return id, nil
+		}
+		return "", fmt.Errorf("group %q has not been created", desired.Groups[index].Name)
+	}
+
+	for i := range result.Actions {
+		action := &result.Actions[i]
+		actionCtx, cancel := contextWithTimeout(ctx)
+		err := applyTestFlightSyncAction(actionCtx, client, appID, desired, action, groupIDs, resolveGroupID)
+		cancel()
+		if err != nil {
+			action.Status = "failed"
+			action.Error = err.Error()
+			result.Failed++
+			return fmt.Errorf("%s failed: %w", action.Action, err)
+		}
+		action.Status = "applied"
+		result.Applied++
+	}
+	return nil
+}
+
+func applyTestFlightSyncAction(
+	ctx context.Context,
+	client testFlightSyncPushClient,
+	appID string,
+	desired *TestFlightConfig,
+	action *testFlightSyncAction,
+	groupIDs map[int]string,
+	resolveGroupID func(int) (string, error),
+) error {
+	switch action.Action {
+	case testFlightSyncActionCreateGroup:
+		group := desired.Groups[action.groupIndex]
+		attrs := asc.BetaGroupAttributes{
+			Name:              strings.TrimSpace(group.Name),
+			IsInternalGroup:   group.IsInternalGroup,
+			PublicLinkEnabled: group.PublicLinkEnabled,
+			FeedbackEnabled:   group.FeedbackEnabled,
+		}
+		if limit := publicLinkLimitValue(group.PublicLinkLimit); limit > 0 {
+			attrs.PublicLinkLimitEnabled = true
+			attrs.PublicLinkLimit = limit
+		}
+		resp, err := client.CreateBetaGroupWithAttributes(ctx, appID, attrs)
+		if err != nil {
+			return err
+		}
+		action.GroupID = resp.Data.ID
+		groupIDs[action.groupIndex] = resp.Data.ID
+		return nil
+	case testFlightSyncActionUpdateGroup:
+		group := desired.Groups[action.groupIndex]
+		publicLinkEnabled := group.PublicLinkEnabled
+		feedbackEnabled := group.FeedbackEnabled
+		limit := publicLinkLimitValue(group.PublicLinkLimit)
+		limitEnabled := limit > 0
+		attrs := &asc.BetaGroupUpdateAttributes{
+			Name:                   strings.TrimSpace(group.Name),
+			PublicLinkEnabled:      &publicLinkEnabled,
+			PublicLinkLimitEnabled: &limitEnabled,
+			PublicLinkLimit:        limit,
+			FeedbackEnabled:        &feedbackEnabled,
+		}
+		_, err := client.UpdateBetaGroup(ctx, action.GroupID, asc.BetaGroupUpdateRequest{
+			Data: asc.BetaGroupUpdateData{
+				Type:       asc.ResourceTypeBetaGroups,
+				ID:         action.GroupID,
+				Attributes: attrs,
+			},
+		})
+		return err
+	case testFlightSyncActionCreateTester:
+		ids := make([]string, 0, len(action.groupIndexes))
+		for _, index := range action.groupIndexes {
+			id, err := resolveGroupID(index)
+			if err != nil {
+				return err
+			}
+			ids = append(ids, id)
+		}
+		firstName, lastName := splitTesterName(action.tester.Name)
+		resp, err := client.CreateBetaTester(ctx, action.Email, firstName, lastName, ids)
+		if err != nil {
+			return err
+		}
+		action.TesterID = resp.Data.ID
+		return nil
+	case testFlightSyncActionAddTester:
+		groupID, err := resolveGroupID(action.groupIndex)
+		if err != nil {
+			return err
+		}
+		action.GroupID = groupID
+		return client.AddBetaTestersToGroup(ctx, groupID, []string{action.TesterID})
+	case testFlightSyncActionAddBuild:
+		groupID, err := resolveGroupID(action.groupIndex)
+		if err != nil {
+			return err
+		}
+		action.GroupID = groupID
+		return client.AddBetaGroupsToBuild(ctx, action.BuildID, []string{groupID})
+	case testFlightSyncActionRemoveBuild:
+		return client.RemoveBetaGroupsFromBuild(ctx, action.BuildID, []string{action.GroupID})
+	case testFlightSyncActionRemoveTester:
+		return client.RemoveBetaTestersFromGroup(ctx, action.GroupID, []string{action.TesterID})
+	case testFlightSyncActionDeleteGroup:
+		return client.DeleteBetaGroup(ctx, action.GroupID)
+	default:
+		return fmt.Errorf("unknown action %q", action.Action)
+	}
+}
+
+func splitTesterName(name string) (string, string) {
+	fields := strings.Fields(name)
+	switch len(fields) {
+	case 0:
+		return "", ""
+	case 1:
+		return fields[0], ""
+	default:
+		return fields[0], strings.Join(fields[1:], " ")
+	}
+}
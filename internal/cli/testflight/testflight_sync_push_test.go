@@ -0,0 +1,319 @@
+package testflight
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+type testFlightSyncPushStub struct {
+	testFlightSyncStub
+	calls     []string
+	failOn    string
+	createdID int
+}
+
+func (s *testFlightSyncPushStub) record(call string) error {
+	s.calls = append(s.calls, call)
+	if s.failOn != "" && strings.HasPrefix(call, s.failOn) {
+		return errors.New("boom")
+	}
+	return nil
+}
+
+func (s *testFlightSyncPushStub) CreateBetaGroupWithAttributes(ctx context.Context, appID string, attrs asc.BetaGroupAttributes) (*asc.BetaGroupResponse, error) {
+	if err := s.record("create-group " + attrs.Name); err != nil {
+		return nil, err
+	}
+	s.createdID++
+	return &asc.BetaGroupResponse{Data: asc.Resource[asc.BetaGroupAttributes]{ID: fmt.Sprintf("new-group-%d", s.createdID)}}, nil
+}
+
+func (s *testFlightSyncPushStub) UpdateBetaGroup(ctx context.Context, groupID string, req asc.BetaGroupUpdateRequest) (*asc.BetaGroupResponse, error) {
+	if err := s.record("update-group " + groupID); err != nil {
+		return nil, err
+	}
+	return &asc.BetaGroupResponse{}, nil
+}
+
+func (s *testFlightSyncPushStub) DeleteBetaGroup(ctx context.Context, groupID string) error {
+	return s.record("delete-group " + groupID)
+}
+
+func (s *testFlightSyncPushStub) CreateBetaTester(ctx context.Context, email, firstName, lastName string, groupIDs []string) (*asc.BetaTesterResponse, error) {
+	if err := s.record(fmt.Sprintf("create-tester %s %s", email, strings.Join(groupIDs, ","))); err != nil {
+		return nil, err
+	}
+	return &asc.BetaTesterResponse{Data: asc.Resource[asc.BetaTesterAttributes]{ID: "new-tester"}}, nil
+}
+
+func (s *testFlightSyncPushStub) AddBetaTestersToGroup(ctx context.Context, groupID string, testerIDs []string) error {
+	return s.record(fmt.Sprintf("add-tester %s %s", groupID, strings.Join(testerIDs, ",")))
+}
+
+func (s *testFlightSyncPushStub) RemoveBetaTestersFromGroup(ctx context.Context, groupID string, testerIDs []string) error {
+	return s.record(fmt.Sprintf("remove-tester %s %s", groupID, strings.Join(testerIDs, ",")))
+}
+
+func (s *testFlightSyncPushStub) AddBetaGroupsToBuild(ctx context.Context, buildID string, groupIDs []string) error {
+	return s.record(fmt.Sprintf("add-build %s %s", buildID, strings.Join(groupIDs, ",")))
+}
+
+func (s *testFlightSyncPushStub) RemoveBetaGroupsFromBuild(ctx context.Context, buildID string, groupIDs []string) error {
+	return s.record(fmt.Sprintf("remove-build %s %s", buildID, strings.Join(groupIDs, ",")))
+}
+
+func testFlightSyncLiveConfig() *TestFlightConfig {
+	return &TestFlightConfig{
+		App: TestFlightAppConfig{ID: "app-1", Name: "Demo"},
+		Groups: []TestFlightGroupConfig{
+			{ID: "group-1", Name: "Alpha", FeedbackEnabled: true, Builds: []string{"build-1"}},
+			{ID: "group-2", Name: "Legacy", Builds: []string{"build-1", "build-2"}},
+		},
+		Builds: []TestFlightBuildConfig{
+			{ID: "build-1", Groups: []string{"group-1", "group-2"}},
+			{ID: "build-2", Groups: []string{"group-2"}},
+		},
+		Testers: []TestFlightTesterConfig{
+			{ID: "tester-1", Email: "ada@example.com", Groups: []string{"group-1"}},
+			{ID: "tester-2", Email: "grace@example.com", Groups: []string{"group-1", "group-2"}},
+		},
+	}
+}
+
+func testFlightSyncDesiredConfig() *TestFlightConfig {
+	return &TestFlightConfig{
+		App: TestFlightAppConfig{ID: "app-1"},
+		Groups: []TestFlightGroupConfig{
+			{ID: "group-1", Name: "Alpha", FeedbackEnabled: false, Builds: []string{"build-1", "build-3"}},
+			{Name: "Gamma", FeedbackEnabled: true},
+		},
+		Builds: []TestFlightBuildConfig{
+			{ID: "build-4", Groups: []string{"Gamma"}},
+		},
+		Testers: []TestFlightTesterConfig{
+			{ID: "tester-1", Email: "ada@example.com", Groups: []string{"group-1", "Gamma"}},
+			{Email: "new@example.com", Name: "New Tester", Groups: []string{"gamma"}},
+		},
+	}
+}
+
+func planActionSummaries(plan *testFlightSyncPlan) []string {
+	result := make([]string, 0, len(plan.Actions))
+	for _, action := range plan.Actions {
+		parts := []string{action.Action, action.Group}
+		if action.TesterID != "" {
+			parts = append(parts, action.TesterID)
+		} else if action.Email != "" {
+			parts = append(parts, action.Email)
+		}
+		if action.BuildID != "" {
+			parts = append(parts, action.BuildID)
+		}
+		result = append(result, strings.Join(parts, " "))
+	}
+	return result
+}
+
+func TestPlanTestFlightSync_WithoutPrune(t *testing.T) {
+	plan, err := planTestFlightSync(testFlightSyncDesiredConfig(), testFlightSyncLiveConfig(), false)
+	if err != nil {
+		t.Fatalf("planTestFlightSync() error: %v", err)
+	}
+
+	want := []string{
+		"create-group Gamma",
+		"update-group Alpha",
+		"create-tester  new@example.com",
+		"add-tester Gamma tester-1",
+		"add-build Alpha build-3",
+		"add-build Gamma build-4",
+	}
+	got := planActionSummaries(plan)
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("unexpected plan:\n got: %q\nwant: %q", got, want)
+	}
+	if plan.Actions[1].Changes[0] != "feedbackEnabled" {
+		t.Fatalf("expected feedbackEnabled change, got %v", plan.Actions[1].Changes)
+	}
+	// Legacy group delete + tester-2 removal from Alpha.
+	if plan.SkippedRemovals != 2 {
+		t.Fatalf("expected 2 skipped removals, got %d", plan.SkippedRemovals)
+	}
+}
+
+func TestPlanTestFlightSync_WithPrune(t *testing.T) {
+	plan, err := planTestFlightSync(testFlightSyncDesiredConfig(), testFlightSyncLiveConfig(), true)
+	if err != nil {
+		t.Fatalf("planTestFlightSync() error: %v", err)
+	}
+
+	got := planActionSummaries(plan)
+	tail := got[len(got)-2:]
+	want := []string{
+		"remove-tester Alpha tester-2",
+		"delete-group Legacy",
+	}
+	if strings.Join(tail, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("unexpected removal actions:\n got: %q\nwant: %q", tail, want)
+	}
+	if plan.SkippedRemovals != 0 {
+		t.Fatalf("expected no skipped removals, got %d", plan.SkippedRemovals)
+	}
+}
+
+func TestPlanTestFlightSync_NoChanges(t *testing.T) {
+	live := testFlightSyncLiveConfig()
+	plan, err := planTestFlightSync(live, testFlightSyncLiveConfig(), true)
+	if err != nil {
+		t.Fatalf("planTestFlightSync() error: %v", err)
+	}
+	if len(plan.Actions) != 0 {
+		t.Fatalf("expected empty plan, got %v", planActionSummaries(plan))
+	}
+}
+
+func TestPlanTestFlightSync_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		desired *TestFlightConfig
+	}{
+		{
+			name: "unknown group reference",
+			desired: &TestFlightConfig{
+				Groups:  []TestFlightGroupConfig{{Name: "Alpha"}},
+				Testers: []TestFlightTesterConfig{{Email: "a@example.com", Groups: []string{"Nope"}}},
+			},
+		},
+		{
+			name: "duplicate group names",
+			desired: &TestFlightConfig{
+				Groups: []TestFlightGroupConfig{{Name: "Alpha"}, {Name: "alpha"}},
+			},
+		},
+		{
+			name: "internal flag change",
+			desired: &TestFlightConfig{
+				Groups: []TestFlightGroupConfig{{ID: "group-1", Name: "Alpha", IsInternalGroup: true, FeedbackEnabled: true}},
+			},
+		},
+		{
+			name: "new tester without email",
+			desired: &TestFlightConfig{
+				Groups:  []TestFlightGroupConfig{{Name: "Alpha"}},
+				Testers: []TestFlightTesterConfig{{ID: "missing", Groups: []string{"Alpha"}}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := planTestFlightSync(test.desired, testFlightSyncLiveConfig(), false); err == nil {
+				t.Fatal("expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestApplyTestFlightSyncPlan_ResolvesCreatedGroups(t *testing.T) {
+	desired := testFlightSyncDesiredConfig()
+	plan, err := planTestFlightSync(desired, testFlightSyncLiveConfig(), true)
+	if err != nil {
+		t.Fatalf("planTestFlightSync() error: %v", err)
+	}
+
+	stub := &testFlightSyncPushStub{}
+	result := &testFlightSyncPushResult{Actions: plan.Actions}
+	if err := applyTestFlightSyncPlan(context.Background(), stub, "app-1", desired, plan, result); err != nil {
+		t.Fatalf("applyTestFlightSyncPlan() error: %v", err)
+	}
+
+	want := []string{
+		"create-group Gamma",
+		"update-group group-1",
+		"create-tester new@example.com new-group-1",
+		"add-tester new-group-1 tester-1",
+		"add-build build-3 group-1",
+		"add-build build-4 new-group-1",
+		"remove-tester group-1 tester-2",
+		"delete-group group-2",
+	}
+	if strings.Join(stub.calls, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("unexpected calls:\n got: %q\nwant: %q", stub.calls, want)
+	}
+	if result.Applied != len(want) || result.Failed != 0 {
+		t.Fatalf("expected %d applied and 0 failed, got %d/%d", len(want), result.Applied, result.Failed)
+	}
+	if result.Actions[0].GroupID != "new-group-1" {
+		t.Fatalf("expected created group ID to be recorded, got %q", result.Actions[0].GroupID)
+	}
+}
+
+func TestApplyTestFlightSyncPlan_StopsOnFailure(t *testing.T) {
+	desired := testFlightSyncDesiredConfig()
+	plan, err := planTestFlightSync(desired, testFlightSyncLiveConfig(), false)
+	if err != nil {
+		t.Fatalf("planTestFlightSync() error: %v", err)
+	}
+
+	stub := &testFlightSyncPushStub{failOn: "update-group"}
+	result := &testFlightSyncPushResult{Actions: plan.Actions}
+	if err := applyTestFlightSyncPlan(context.Background(), stub, "app-1", desired, plan, result); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if len(stub.calls) != 2 {
+		t.Fatalf("expected 2 calls before stopping, got %v", stub.calls)
+	}
+	if result.Applied != 1 || result.Failed != 1 {
+		t.Fatalf("expected 1 applied and 1 failed, got %d/%d", result.Applied, result.Failed)
+	}
+	if result.Actions[1].Status != "failed" || result.Actions[2].Status != "" {
+		t.Fatalf("unexpected statuses: %q, %q", result.Actions[1].Status, result.Actions[2].Status)
+	}
+}
+
+func TestReadTestFlightConfigYAML_RoundTripsPullOutput(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "testflight.yaml")
+	if err := writeTestFlightConfigYAML(path, testFlightSyncLiveConfig()); err != nil {
+		t.Fatalf("writeTestFlightConfigYAML() error: %v", err)
+	}
+
+	config, err := readTestFlightConfigYAML(path)
+	if err != nil {
+		t.Fatalf("readTestFlightConfigYAML() error: %v", err)
+	}
+	if len(config.Groups) != 2 || len(config.Testers) != 2 {
+		t.Fatalf("unexpected config: %+v", config)
+	}
+	if !testFlightConfigManagesBuilds(config) || !testFlightConfigManagesTesters(config) {
+		t.Fatalf("expected builds and testers to be managed")
+	}
+
+	empty := filepath.Join(t.TempDir(), "empty.yaml")
+	if err := os.WriteFile(empty, []byte("  \n"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+	if _, err := readTestFlightConfigYAML(empty); err == nil {
+		t.Fatal("expected error for empty config, got nil")
+	}
+}
+
+func TestResolveTestFlightPushAppID(t *testing.T) {
+	config := &TestFlightConfig{App: TestFlightAppConfig{ID: "app-1"}}
+
+	if got, err := resolveTestFlightPushAppID("", config); err != nil || got != "app-1" {
+		t.Fatalf("expected app-1 from config, got %q (%v)", got, err)
+	}
+	if got, err := resolveTestFlightPushAppID("app-1", config); err != nil || got != "app-1" {
+		t.Fatalf("expected app-1 from flag, got %q (%v)", got, err)
+	}
+	if _, err := resolveTestFlightPushAppID("app-2", config); err == nil {
+		t.Fatal("expected mismatch error, got nil")
+	}
+}
@@ -64,6 +64,8 @@ func XcodeCloudRunCommand() *ffcli.Command {
 	gitReferenceID := fs.String("git-reference-id", "", "Git reference ID to build (alternative to --branch)")
 	wait := fs.Bool("wait", false, "Wait for build to complete")
 	pollInterval := fs.Duration("poll-interval", 10*time.Second, "Poll interval when waiting")
+	logsDir := fs.String("logs-dir", "", "Download and unpack each action's log bundle into this directory (requires --wait)")
+	junitPath := fs.String("junit", "", "Write test results as JUnit XML to this path (requires --wait)")
 	timeout := fs.Duration("timeout", 0, "Timeout for Xcode Cloud requests (0 = use ASC_TIMEOUT or 30m default)")
 	output := fs.String("output", "json", "Output format: json (default), table, markdown")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
@@ -77,11 +79,16 @@ func XcodeCloudRunCommand() *ffcli.Command {
 You can specify the workflow by name (requires --app) or by ID (--workflow-id).
 You can specify the branch/tag by name (--branch) or by ID (--git-reference-id).
 
+With --wait, run and action state changes are printed to stderr. When the run
+completes, the output lists each action's issues and test results; use
+--logs-dir to unpack the log bundles and --junit to export a JUnit XML report.
+
 Examples:
   asc xcode-cloud run --app "123456789" --workflow "CI" --branch "main"
   asc xcode-cloud run --workflow-id "WORKFLOW_ID" --git-reference-id "REF_ID"
   asc xcode-cloud run --app "123456789" --workflow "Deploy" --branch "release/1.0" --wait
-  asc xcode-cloud run --app "123456789" --workflow "CI" --branch "main" --wait --poll-interval 30s --timeout 1h`,
+  asc xcode-cloud run --app "123456789" --workflow "CI" --branch "main" --wait --poll-interval 30s --timeout 1h
+  asc xcode-cloud run --app "123456789" --workflow "CI" --branch "main" --wait --junit ./junit.xml --logs-dir ./logs`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -111,6 +118,9 @@ Examples:
 			if *wait && *pollInterval <= 0 {
 				return fmt.Errorf("xcode-cloud run: --poll-interval must be greater than 0")
 			}
+			if !*wait && (strings.TrimSpace(*logsDir) != "" || strings.TrimSpace(*junitPath) != "") {
+				return fmt.Errorf("xcode-cloud run: --logs-dir and --junit require --wait")
+			}
 
 			resolvedAppID := resolveAppID(*appID)
 			if hasWorkflowName && resolvedAppID == "" {
@@ -205,7 +215,13 @@ Examples:
 			}
 
 			// Wait for completion
-			return waitForBuildCompletion(requestCtx, client, resp.Data.ID, *pollInterval, *output, *pretty)
+			return waitForBuildCompletion(requestCtx, client, resp.Data.ID, buildRunWaitOptions{
+				pollInterval: *pollInterval,
+				logsDir:      strings.TrimSpace(*logsDir),
+				junitPath:    strings.TrimSpace(*junitPath),
+				output:       *output,
+				pretty:       *pretty,
+			})
 		},
 	}
 }
@@ -217,6 +233,8 @@ func XcodeCloudStatusCommand() *ffcli.Command {
 	runID := fs.String("run-id", "", "Build run ID to check")
 	wait := fs.Bool("wait", false, "Wait for build to complete")
 	pollInterval := fs.Duration("poll-interval", 10*time.Second, "Poll interval when waiting")
+	logsDir := fs.String("logs-dir", "", "Download and unpack each action's log bundle into this directory (requires --wait)")
+	junitPath := fs.String("junit", "", "Write test results as JUnit XML to this path (requires --wait)")
 	timeout := fs.Duration("timeout", 0, "Timeout for Xcode Cloud requests (0 = use ASC_TIMEOUT or 30m default)")
 	output := fs.String("output", "json", "Output format: json (default), table, markdown")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
@@ -227,11 +245,15 @@ func XcodeCloudStatusCommand() *ffcli.Command {
 		ShortHelp:  "Check the status of an Xcode Cloud build run.",
 		LongHelp: `Check the status of an Xcode Cloud build run.
 
+With --wait, state changes are printed to stderr and the final output includes
+each action's issues and test results (see "asc xcode-cloud run --help").
+
 Examples:
   asc xcode-cloud status --run-id "BUILD_RUN_ID"
   asc xcode-cloud status --run-id "BUILD_RUN_ID" --output table
   asc xcode-cloud status --run-id "BUILD_RUN_ID" --wait
-  asc xcode-cloud status --run-id "BUILD_RUN_ID" --wait --poll-interval 30s --timeout 1h`,
+  asc xcode-cloud status --run-id "BUILD_RUN_ID" --wait --poll-interval 30s --timeout 1h
+  asc xcode-cloud status --run-id "BUILD_RUN_ID" --wait --junit ./junit.xml --logs-dir ./logs`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -245,6 +267,9 @@ Examples:
 			if *wait && *pollInterval <= 0 {
 				return fmt.Errorf("xcode-cloud status: --poll-interval must be greater than 0")
 			}
+			if !*wait && (strings.TrimSpace(*logsDir) != "" || strings.TrimSpace(*junitPath) != "") {
+				return fmt.Errorf("xcode-cloud status: --logs-dir and --junit require --wait")
+			}
 
 			client, err := getASCClient()
 			if err != nil {
@@ -255,7 +280,13 @@ Examples:
 			defer cancel()
 
 			if *wait {
-				return waitForBuildCompletion(requestCtx, client, strings.TrimSpace(*runID), *pollInterval, *output, *pretty)
+				return waitForBuildCompletion(requestCtx, client, strings.TrimSpace(*runID), buildRunWaitOptions{
+					pollInterval: *pollInterval,
+					logsDir:      strings.TrimSpace(*logsDir),
+					junitPath:    strings.TrimSpace(*junitPath),
+					output:       *output,
+					pretty:       *pretty,
+				})
 			}
 
 			// Single status check
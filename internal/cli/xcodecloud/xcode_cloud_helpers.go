@@ -5,26 +5,51 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"os"
 	"syscall"
 	"time"
 
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
 )
 
+// buildRunWaitOptions configures waitForBuildCompletion.
+type buildRunWaitOptions struct {
+	pollInterval time.Duration
+	logsDir      string
+	junitPath    string
+	output       string
+	pretty       bool
+}
+
 // waitForBuildCompletion polls until the build run completes or times out.
-func waitForBuildCompletion(ctx context.Context, client *asc.Client, buildRunID string, pollInterval time.Duration, outputFormat string, pretty bool) error {
-	ticker := time.NewTicker(pollInterval)
+// Run and action state changes are printed to stderr while polling; once the
+// run completes, the result includes per-action issues and test results.
+func waitForBuildCompletion(ctx context.Context, client *asc.Client, buildRunID string, opts buildRunWaitOptions) error {
+	ticker := time.NewTicker(opts.pollInterval)
 	defer ticker.Stop()
 
+	tracker := newBuildRunProgressTracker(os.Stderr)
 	for {
 		resp, err := getCiBuildRunWithRetry(ctx, client, buildRunID)
 		if err != nil {
 			return fmt.Errorf("xcode-cloud: failed to check status: %w", err)
 		}
+		tracker.observeRun(resp)
+
+		// Action listing is best effort while polling; the run state decides completion.
+		actions, actionsErr := fetchBuildRunActions(ctx, client, buildRunID)
+		if actionsErr == nil {
+			tracker.observeActions(actions)
+		}
 
 		if asc.IsBuildRunComplete(resp.Data.Attributes.ExecutionProgress) {
 			result := buildStatusResult(resp)
-			if err := printOutput(result, outputFormat, pretty); err != nil {
+			if actionsErr != nil {
+				fmt.Fprintf(os.Stderr, "Warning: failed to list build actions: %v\n", actionsErr)
+			} else if err := summarizeBuildRun(ctx, client, result, actions, opts); err != nil {
+				return fmt.Errorf("xcode-cloud: %w", err)
+			}
+			if err := printOutput(result, opts.output, opts.pretty); err != nil {
 				return err
 			}
 
@@ -47,6 +72,39 @@ func waitForBuildCompletion(ctx context.Context, client *asc.Client, buildRunID
 	}
 }
 
+// summarizeBuildRun fills in action summaries, downloads logs when requested
+// and writes the JUnit report. Failures to fetch details are reported as
+// warnings so the run's own status is still printed; only a JUnit write
+// failure is returned, since the caller explicitly asked for that file.
+func summarizeBuildRun(ctx context.Context, client *asc.Client, result *asc.XcodeCloudStatusResult, actions []asc.CiBuildActionResource, opts buildRunWaitOptions) error {
+	var testResults []actionTestResults
+	for _, action := range actions {
+		summary, results, err := summarizeBuildAction(ctx, client, action)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+		}
+		if action.Attributes.ActionType == ciActionTypeTest {
+			testResults = append(testResults, actionTestResults{Action: action, Results: results})
+		}
+		if opts.logsDir != "" {
+			logsPath, err := downloadActionLogs(ctx, client, action, opts.logsDir)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: failed to download logs for %s: %v\n", actionDisplayName(action), err)
+			}
+			summary.LogsPath = logsPath
+		}
+		result.Actions = append(result.Actions, summary)
+	}
+
+	if opts.junitPath != "" {
+		if _, err := writeJUnitReport(opts.junitPath, "Xcode Cloud build run "+result.BuildRunID, testResults); err != nil {
+			return fmt.Errorf("write JUnit report: %w", err)
+		}
+		result.JUnitPath = opts.junitPath
+	}
+	return nil
+}
+
 // buildStatusResult converts a CiBuildRunResponse to XcodeCloudStatusResult.
 func buildStatusResult(resp *asc.CiBuildRunResponse) *asc.XcodeCloudStatusResult {
 	result := &asc.XcodeCloudStatusResult{
@@ -0,0 +1,462 @@
+package xcodecloud
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	ciActionTypeTest      = "TEST"
+	ciArtifactTypeLogs    = "LOG_BUNDLE"
+	maxLogBundleEntrySize = 1 << 30
+)
+
+// buildRunProgressTracker prints build run and action state changes while waiting.
+type buildRunProgressTracker struct {
+	out     io.Writer
+	run     string
+	actions map[string]string
+}
+
+func newBuildRunProgressTracker(out io.Writer) *buildRunProgressTracker {
+	return &buildRunProgressTracker{out: out, actions: map[string]string{}}
+}
+
+func (t *buildRunProgressTracker) observeRun(resp *asc.CiBuildRunResponse) {
+	state := formatCiProgress(resp.Data.Attributes.ExecutionProgress, resp.Data.Attributes.CompletionStatus)
+	if state == t.run {
+		return
+	}
+	t.run = state
+	fmt.Fprintf(t.out, "Build run %d (%s): %s\n", resp.Data.Attributes.Number, resp.Data.ID, state)
+}
+
+func (t *buildRunProgressTracker) observeActions(actions []asc.CiBuildActionResource) {
+	for _, action := range actions {
+		state := formatCiProgress(action.Attributes.ExecutionProgress, action.Attributes.CompletionStatus)
+		if t.actions[action.ID] == state {
+			continue
+		}
+		t.actions[action.ID] = state
+		fmt.Fprintf(t.out, "  %s (%s): %s\n", actionDisplayName(action), action.Attributes.ActionType, state)
+	}
+}
+
+func formatCiProgress(progress asc.CiBuildRunExecutionProgress, status asc.CiBuildRunCompletionStatus) string {
+	if asc.IsBuildRunComplete(progress) && status != "" {
+		return fmt.Sprintf("%s (%s)", progress, status)
+	}
+	return string(progress)
+}
+
+func actionDisplayName(action asc.CiBuildActionResource) string {
+	if name := strings.TrimSpace(action.Attributes.Name); name != "" {
+		return name
+	}
+	return action.ID
+}
+
+func fetchBuildRunActions(ctx context.Context, client *asc.Client, buildRunID string) ([]asc.CiBuildActionResource, error) {
+	firstPage, err := client.GetCiBuildActions(ctx, buildRunID, asc.WithCiBuildActionsLimit(200))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetCiBuildActions(ctx, buildRunID, asc.WithCiBuildActionsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, err
+	}
+	actions, ok := resp.(*asc.CiBuildActionsResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected build actions response type %T", resp)
+	}
+	return actions.Data, nil
+}
+
+func fetchBuildActionIssues(ctx context.Context, client *asc.Client, actionID string) ([]asc.CiIssueResource, error) {
+	firstPage, err := client.GetCiBuildActionIssues(ctx, actionID, asc.WithCiIssuesLimit(200))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetCiBuildActionIssues(ctx, actionID, asc.WithCiIssuesNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, err
+	}
+	issues, ok := resp.(*asc.CiIssuesResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected issues response type %T", resp)
+	}
+	return issues.Data, nil
+}
+
+func fetchBuildActionTestResults(ctx context.Context, client *asc.Client, actionID string) ([]asc.CiTestResultResource, error) {
+	firstPage, err := client.GetCiBuildActionTestResults(ctx, actionID, asc.WithCiTestResultsLimit(200))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetCiBuildActionTestResults(ctx, actionID, asc.WithCiTestResultsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, err
+	}
+	results, ok := resp.(*asc.CiTestResultsResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected test results response type %T", resp)
+	}
+	return results.Data, nil
+}
+
+// actionTestResults pairs a build action with its test results for JUnit export.
+type actionTestResults struct {
+	Action  asc.CiBuildActionResource
+	Results []asc.CiTestResultResource
+}
+
+// summarizeBuildAction collects issues for an action, plus test results for TEST actions.
+func summarizeBuildAction(ctx context.Context, client *asc.Client, action asc.CiBuildActionResource) (asc.XcodeCloudActionSummary, []asc.CiTestResultResource, error) {
+	summary := asc.XcodeCloudActionSummary{
+		ID:               action.ID,
+		Name:             action.Attributes.Name,
+		ActionType:       action.Attributes.ActionType,
+		CompletionStatus: string(action.Attributes.CompletionStatus),
+		IssueCounts:      action.Attributes.IssueCounts,
+	}
+
+	if hasCiIssues(action.Attributes.IssueCounts) {
+		issues, err := fetchBuildActionIssues(ctx, client, action.ID)
+		if err != nil {
+			return summary, nil, fmt.Errorf("issues for %s: %w", actionDisplayName(action), err)
+		}
+		for _, issue := range issues {
+			summary.Issues = append(summary.Issues, asc.XcodeCloudIssueSummary{
+				IssueType: issue.Attributes.IssueType,
+				Message:   issue.Attributes.Message,
+				File:      asc.FormatFileLocation(issue.Attributes.FileSource),
+				Category:  issue.Attributes.Category,
+			})
+		}
+	}
+
+	if action.Attributes.ActionType != ciActionTypeTest {
+		return summary, nil, nil
+	}
+	results, err := fetchBuildActionTestResults(ctx, client, action.ID)
+	if err != nil {
+		return summary, nil, fmt.Errorf("test results for %s: %w", actionDisplayName(action), err)
+	}
+	summary.Tests = summarizeTestResults(results)
+	return summary, results, nil
+}
+
+// hasCiIssues reports whether issues should be fetched; unknown counts are fetched.
+func hasCiIssues(counts *asc.CiIssueCounts) bool {
+	if counts == nil {
+		return true
+	}
+	return counts.Errors+counts.Warnings+counts.AnalyzerWarnings+counts.TestFailures > 0
+}
+
+func summarizeTestResults(results []asc.CiTestResultResource) *asc.XcodeCloudTestSummary {
+	summary := &asc.XcodeCloudTestSummary{Total: len(results)}
+	for _, result := range results {
+		attrs := result.Attributes
+		switch attrs.Status {
+		case asc.CiTestStatusFailure, asc.CiTestStatusMixed:
+			summary.Failed++
+			summary.Failures = append(summary.Failures, asc.XcodeCloudTestFailure{
+				ClassName: attrs.ClassName,
+				Name:      attrs.Name,
+				Message:   attrs.Message,
+				File:      asc.FormatFileLocation(attrs.FileSource),
+			})
+		case asc.CiTestStatusSkipped:
+			summary.Skipped++
+		default:
+			summary.Passed++
+		}
+	}
+	return summary
+}
+
+// junitTestSuites is the root element of a JUnit XML report.
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr,omitempty"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Skipped  int             `xml:"skipped,attr"`
+	Time     string          `xml:"time,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	ClassName string        `xml:"classname,attr,omitempty"`
+	Name      string        `xml:"name,attr"`
+	Time      string        `xml:"time,attr"`
+	File      string        `xml:"file,attr,omitempty"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	Skipped   *junitSkipped `xml:"skipped,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr,omitempty"`
+	Type    string `xml:"type,attr,omitempty"`
+	Body    string `xml:",chardata"`
+}
+
+type junitSkipped struct{}
+
+// buildJUnitReport converts Xcode Cloud test results into JUnit XML, with one
+// test suite per build action.
+func buildJUnitReport(name string, actions []actionTestResults) *junitTestSuites {
+	report := &junitTestSuites{Name: name}
+	totalTime := 0.0
+	for _, action := range actions {
+		suite := junitTestSuite{Name: actionDisplayName(action.Action)}
+		suiteTime := 0.0
+		for _, result := range action.Results {
+			attrs := result.Attributes
+			duration := 0.0
+			for _, destination := range attrs.DestinationTestResults {
+				duration += destination.Duration
+			}
+			testCase := junitTestCase{
+				ClassName: attrs.ClassName,
+				Name:      attrs.Name,
+				Time:      formatJUnitSeconds(duration),
+				File:      asc.FormatFileLocation(attrs.FileSource),
+			}
+			switch attrs.Status {
+			case asc.CiTestStatusFailure, asc.CiTestStatusMixed:
+				testCase.Failure = &junitFailure{
+					Message: attrs.Message,
+					Type:    string(attrs.Status),
+					Body:    junitFailureBody(attrs),
+				}
+				suite.Failures++
+			case asc.CiTestStatusSkipped:
+				testCase.Skipped = &junitSkipped{}
+				suite.Skipped++
+			}
+			suite.Tests++
+			suiteTime += duration
+			suite.Cases = append(suite.Cases, testCase)
+		}
+		suite.Time = formatJUnitSeconds(suiteTime)
+		report.Tests += suite.Tests
+		report.Failures += suite.Failures
+		report.Skipped += suite.Skipped
+		totalTime += suiteTime
+		report.Suites = append(report.Suites, suite)
+	}
+	report.Time = formatJUnitSeconds(totalTime)
+	return report
+}
+
+func junitFailureBody(attrs asc.CiTestResultAttributes) string {
+	lines := make([]string, 0, len(attrs.DestinationTestResults)+2)
+	if attrs.Message != "" {
+		lines = append(lines, attrs.Message)
+	}
+	if location := asc.FormatFileLocation(attrs.FileSource); location != "" {
+		lines = append(lines, location)
+	}
+	for _, destination := range attrs.DestinationTestResults {
+		if destination.Status != asc.CiTestStatusFailure {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("%s (%s): %s", destination.DeviceName, destination.OSVersion, destination.Status))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func formatJUnitSeconds(seconds float64) string {
+	return strconv.FormatFloat(seconds, 'f', 3, 64)
+}
+
+// writeJUnitReport writes the report to path and returns it for counting.
+func writeJUnitReport(path, name string, actions []actionTestResults) (*junitTestSuites, error) {
+	report := buildJUnitReport(name, actions)
+	data, err := xml.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if dir := filepath.Dir(path); dir != "" {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, err
+		}
+	}
+	data = append([]byte(xml.Header), append(data, '\n')...)
+	if err := shared.WriteFileAtomic(path, data, 0o644); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+var unsafeDirNameChars = regexp.MustCompile(`[^A-Za-z0-9._]+`)
+
+// actionLogsDirName returns a filesystem-safe directory name for an action.
+func actionLogsDirName(action asc.CiBuildActionResource) string {
+	name := strings.Trim(unsafeDirNameChars.ReplaceAllString(actionDisplayName(action), "-"), "-.")
+	if name == "" {
+		return action.ID
+	}
+	return name
+}
+
+// downloadActionLogs downloads the action's LOG_BUNDLE artifact and unpacks it
+// into logsDir/<action>. It returns an empty path when the action has no logs.
+func downloadActionLogs(ctx context.Context, client *asc.Client, action asc.CiBuildActionResource, logsDir string) (string, error) {
+	firstPage, err := client.GetCiBuildActionArtifacts(ctx, action.ID, asc.WithCiArtifactsLimit(200))
+	if err != nil {
+		return "", err
+	}
+	resp, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetCiBuildActionArtifacts(ctx, action.ID, asc.WithCiArtifactsNextURL(nextURL))
+	})
+	if err != nil {
+		return "", err
+	}
+	artifacts, ok := resp.(*asc.CiArtifactsResponse)
+	if !ok {
+		return "", fmt.Errorf("unexpected artifacts response type %T", resp)
+	}
+
+	var logs *asc.CiArtifactResource
+	for i := range artifacts.Data {
+		if artifacts.Data[i].Attributes.FileType == ciArtifactTypeLogs {
+			logs = &artifacts.Data[i]
+			break
+		}
+	}
+	if logs == nil {
+		return "", nil
+	}
+	downloadURL := strings.TrimSpace(logs.Attributes.DownloadURL)
+	if downloadURL == "" {
+		// The list endpoint may omit download URLs; fetch the artifact itself.
+		artifact, err := client.GetCiArtifact(ctx, logs.ID)
+		if err != nil {
+			return "", err
+		}
+		downloadURL = strings.TrimSpace(artifact.Data.Attributes.DownloadURL)
+	}
+	if downloadURL == "" {
+		return "", fmt.Errorf("log artifact %s has no download URL", logs.ID)
+	}
+
+	if err := os.MkdirAll(logsDir, 0o755); err != nil {
+		return "", err
+	}
+	download, err := client.DownloadCiArtifact(ctx, downloadURL)
+	if err != nil {
+		return "", err
+	}
+	defer download.Body.Close()
+
+	tempFile, err := os.CreateTemp(logsDir, ".asc-logs-*.zip")
+	if err != nil {
+		return "", err
+	}
+	tempPath := tempFile.Name()
+	defer os.Remove(tempPath)
+	if _, err := io.Copy(tempFile, download.Body); err != nil {
+		tempFile.Close()
+		return "", err
+	}
+	if err := tempFile.Close(); err != nil {
+		return "", err
+	}
+
+	dest := filepath.Join(logsDir, actionLogsDirName(action))
+	if err := extractZipArchive(tempPath, dest); err != nil {
+		return "", fmt.Errorf("unpack logs: %w", err)
+	}
+	return dest, nil
+}
+
+// extractZipArchive unpacks a zip file into dest, rejecting entries that would
+// escape it and skipping symlinks.
+func extractZipArchive(zipPath, dest string) error {
+	reader, err := zip.OpenReader(zipPath)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	root, err := filepath.Abs(dest)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return err
+	}
+	for _, file := range reader.File {
+		target := filepath.Join(root, filepath.FromSlash(file.Name))
+		if target != root && !strings.HasPrefix(target, root+string(os.PathSeparator)) {
+			return fmt.Errorf("entry %q escapes the destination directory", file.Name)
+		}
+		mode := file.Mode()
+		switch {
+		case mode.IsDir():
+			if err := os.MkdirAll(target, 0o755); err != nil {
+				return err
+			}
+			continue
+		case !mode.IsRegular():
+			continue
+		}
+		if file.UncompressedSize64 > maxLogBundleEntrySize {
+			return fmt.Errorf("entry %q exceeds %d bytes", file.Name, maxLogBundleEntrySize)
+		}
+		if err := extractZipEntry(file, target); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func extractZipEntry(file *zip.File, target string) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return err
+	}
+	src, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	dst, err := os.OpenFile(target, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(dst, io.LimitReader(src, maxLogBundleEntrySize)); err != nil {
+		dst.Close()
+		return err
+	}
+	return dst.Close()
+}
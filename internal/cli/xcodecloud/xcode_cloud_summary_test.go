@@ -0,0 +1,84 @@
+package xcodecloud
+
+import (
+	"archive/zip"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestExtractZipArchive(t *testing.T) {
+	dest := filepath.Join(t.TempDir(), "logs")
+	zipPath := writeTestZip(t, map[string]string{
+		"logs/build.log":       "build output",
+		"logs/nested/test.log": "test output",
+	})
+
+	if err := extractZipArchive(zipPath, dest); err != nil {
+		t.Fatalf("extractZipArchive() error: %v", err)
+	}
+	data, err := os.ReadFile(filepath.Join(dest, "logs", "nested", "test.log"))
+	if err != nil || string(data) != "test output" {
+		t.Fatalf("unexpected extracted file %q (%v)", data, err)
+	}
+}
+
+func TestExtractZipArchive_RejectsPathTraversal(t *testing.T) {
+	parent := t.TempDir()
+	zipPath := writeTestZip(t, map[string]string{"../escape.log": "nope"})
+
+	err := extractZipArchive(zipPath, filepath.Join(parent, "logs"))
+	if err == nil || !strings.Contains(err.Error(), "escapes the destination") {
+		t.Fatalf("expected traversal error, got %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(parent, "escape.log")); !os.IsNotExist(err) {
+		t.Fatalf("expected escaped file not to be written, got %v", err)
+	}
+}
+
+func TestActionLogsDirName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"Build - iOS", "Build-iOS"},
+		{"Test / macOS (Intel)", "Test-macOS-Intel"},
+		{"..", "action-1"},
+		{"", "action-1"},
+	}
+	for _, test := range tests {
+		action := asc.CiBuildActionResource{ID: "action-1", Attributes: asc.CiBuildActionAttributes{Name: test.name}}
+		if got := actionLogsDirName(action); got != test.want {
+			t.Fatalf("actionLogsDirName(%q) = %q, want %q", test.name, got, test.want)
+		}
+	}
+}
+
+func writeTestZip(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	zipPath := filepath.Join(t.TempDir(), "logs.zip")
+	file, err := os.Create(zipPath)
+	if err != nil {
+		t.Fatalf("create zip: %v", err)
+	}
+	defer file.Close()
+
+	writer := zip.NewWriter(file)
+	for name, data := range files {
+		entry, err := writer.Create(name)
+		if err != nil {
+			t.Fatalf("create zip entry %q: %v", name, err)
+		}
+		if _, err := entry.Write([]byte(data)); err != nil {
+			t.Fatalf("write zip entry %q: %v", name, err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("close zip: %v", err)
+	}
+	return zipPath
+}
@@ -24,12 +24,14 @@ func XcodeCloudTestResultsCommand() *ffcli.Command {
 
 Examples:
   asc xcode-cloud test-results list --action-id "ACTION_ID"
-  asc xcode-cloud test-results get --id "TEST_RESULT_ID"`,
+  asc xcode-cloud test-results get --id "TEST_RESULT_ID"
+  asc xcode-cloud test-results export --run-id "BUILD_RUN_ID" --path ./junit.xml`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			XcodeCloudTestResultsListCommand(),
 			XcodeCloudTestResultsGetCommand(),
+			XcodeCloudTestResultsExportCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -158,3 +160,103 @@ Examples:
 		},
 	}
 }
+
+// XcodeCloudTestResultsExportCommand returns the xcode-cloud test-results export subcommand.
+func XcodeCloudTestResultsExportCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+
+	runID := fs.String("run-id", "", "Build run ID (exports every TEST action)")
+	actionID := fs.String("action-id", "", "Build action ID (alternative to --run-id)")
+	path := fs.String("path", "", "Output path for the JUnit XML report")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "export",
+		ShortUsage: "asc xcode-cloud test-results export [flags]",
+		ShortHelp:  "Export test results as JUnit XML.",
+		LongHelp: `Export test results as JUnit XML.
+
+Each build action becomes a <testsuite>; FAILURE and MIXED results are
+reported as failures and SKIPPED results as skipped. The report can be
+consumed by CI systems such as GitLab and Jenkins.
+
+Examples:
+  asc xcode-cloud test-results export --run-id "BUILD_RUN_ID" --path ./junit.xml
+  asc xcode-cloud test-results export --action-id "ACTION_ID" --path ./reports/junit.xml`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			runIDValue := strings.TrimSpace(*runID)
+			actionIDValue := strings.TrimSpace(*actionID)
+			if runIDValue != "" && actionIDValue != "" {
+				return fmt.Errorf("xcode-cloud test-results export: --run-id and --action-id are mutually exclusive")
+			}
+			if runIDValue == "" && actionIDValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --run-id or --action-id is required")
+				return flag.ErrHelp
+			}
+			pathValue := strings.TrimSpace(*path)
+			if pathValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --path is required")
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("xcode-cloud test-results export: %w", err)
+			}
+
+			requestCtx, cancel := contextWithXcodeCloudTimeout(ctx, 0)
+			defer cancel()
+
+			var actions []asc.CiBuildActionResource
+			if actionIDValue != "" {
+				resp, err := client.GetCiBuildAction(requestCtx, actionIDValue)
+				if err != nil {
+					return fmt.Errorf("xcode-cloud test-results export: %w", err)
+				}
+				actions = append(actions, resp.Data)
+			} else {
+				runActions, err := fetchBuildRunActions(requestCtx, client, runIDValue)
+				if err != nil {
+					return fmt.Errorf("xcode-cloud test-results export: %w", err)
+				}
+				for _, action := range runActions {
+					if action.Attributes.ActionType == ciActionTypeTest {
+						actions = append(actions, action)
+					}
+				}
+			}
+
+			result := &asc.CiTestResultsExportResult{
+				BuildRunID: runIDValue,
+				ActionIDs:  []string{},
+				OutputPath: pathValue,
+			}
+			testResults := make([]actionTestResults, 0, len(actions))
+			for _, action := range actions {
+				results, err := fetchBuildActionTestResults(requestCtx, client, action.ID)
+				if err != nil {
+					return fmt.Errorf("xcode-cloud test-results export: %w", err)
+				}
+				testResults = append(testResults, actionTestResults{Action: action, Results: results})
+				result.ActionIDs = append(result.ActionIDs, action.ID)
+			}
+
+			name := "Xcode Cloud"
+			if runIDValue != "" {
+				name = "Xcode Cloud build run " + runIDValue
+			}
+			report, err := writeJUnitReport(pathValue, name, testResults)
+			if err != nil {
+				return fmt.Errorf("xcode-cloud test-results export: %w", err)
+			}
+			result.Tests = report.Tests
+			result.Failures = report.Failures
+			result.Skipped = report.Skipped
+
+			return printOutput(result, *output, *pretty)
+		},
+	}
+}
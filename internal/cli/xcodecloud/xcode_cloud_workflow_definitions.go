@@ -0,0 +1,457 @@
+package xcodecloud
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+	"gopkg.in/yaml.v3"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// WorkflowDefinition is the YAML document written by workflows export and read
+// by workflows import. Referenced resources are stored by name so a definition
+// can be applied to other products.
+type WorkflowDefinition struct {
+	Name         string                 `yaml:"name"`
+	Repository   string                 `yaml:"repository,omitempty"`
+	XcodeVersion string                 `yaml:"xcodeVersion"`
+	MacOsVersion string                 `yaml:"macOsVersion"`
+	Attributes   map[string]interface{} `yaml:"attributes"`
+}
+
+// workflowReadOnlyAttributes are returned by the API but rejected on create and update.
+var workflowReadOnlyAttributes = []string{"name", "isLockedForEditing", "lastModifiedDate"}
+
+const (
+	workflowImportActionCreate = "create"
+	workflowImportActionUpdate = "update"
+)
+
+func XcodeCloudWorkflowsExportCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+
+	id := fs.String("id", "", "Workflow ID")
+
+	return &ffcli.Command{
+		Name:       "export",
+		ShortUsage: "asc xcode-cloud workflows export --id \"WORKFLOW_ID\" > workflow.yaml",
+		ShortHelp:  "Export a workflow definition as YAML.",
+		LongHelp: `Export a workflow definition as YAML.
+
+The definition includes the start conditions, actions, clean build setting and
+container path, plus the repository, Xcode version and macOS version by name.
+Custom environment variables and secrets are not available through the API and
+are not exported.
+
+Examples:
+  asc xcode-cloud workflows export --id "WORKFLOW_ID" > workflow.yaml`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			idValue := strings.TrimSpace(*id)
+			if idValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("xcode-cloud workflows export: %w", err)
+			}
+
+			requestCtx, cancel := contextWithXcodeCloudTimeout(ctx, 0)
+			defer cancel()
+
+			workflow, err := client.GetCiWorkflowDefinition(requestCtx, idValue)
+			if err != nil {
+				return fmt.Errorf("xcode-cloud workflows export: %w", err)
+			}
+
+			data, err := yaml.Marshal(buildWorkflowDefinition(workflow))
+			if err != nil {
+				return fmt.Errorf("xcode-cloud workflows export: failed to encode YAML: %w", err)
+			}
+			_, err = os.Stdout.Write(data)
+			return err
+		},
+	}
+}
+
+func XcodeCloudWorkflowsImportCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+
+	productID := fs.String("product-id", "", "Xcode Cloud product ID")
+	appID := fs.String("app", "", "App Store Connect app ID to resolve the product (or ASC_APP_ID env)")
+	file := fs.String("file", "", "Path to workflow YAML definition")
+	repository := fs.String("repository", "", "Repository to use instead of the definition's (owner/name or clone URL)")
+	dryRun := fs.Bool("dry-run", false, "Resolve references and report the planned change without applying it")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "import",
+		ShortUsage: "asc xcode-cloud workflows import --product-id \"PRODUCT_ID\" --file workflow.yaml [flags]",
+		ShortHelp:  "Create or update a workflow from a YAML definition.",
+		LongHelp: `Create or update a workflow from a YAML definition.
+
+The workflow is matched by name within the product: an existing workflow is
+updated, otherwise a new one is created. The Xcode and macOS versions are
+resolved by name or version, and the repository by owner/name or clone URL
+among the product's primary and additional repositories. When the definition
+has no repository and the product has a single primary repository, that one is
+used. The repository of an existing workflow cannot be changed.
+
+Examples:
+  asc xcode-cloud workflows import --product-id "PRODUCT_ID" --file workflow.yaml
+  asc xcode-cloud workflows import --app "APP_ID" --file workflow.yaml --dry-run
+  asc xcode-cloud workflows import --product-id "PRODUCT_ID" --file workflow.yaml --repository "acme/other-app"`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			productValue := strings.TrimSpace(*productID)
+			appValue := strings.TrimSpace(*appID)
+			if productValue != "" && appValue != "" {
+				return fmt.Errorf("xcode-cloud workflows import: --product-id and --app are mutually exclusive")
+			}
+			if productValue == "" {
+				appValue = resolveAppID(appValue)
+			}
+			if productValue == "" && appValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --product-id or --app is required")
+				return flag.ErrHelp
+			}
+			fileValue := strings.TrimSpace(*file)
+			if fileValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --file is required")
+				return flag.ErrHelp
+			}
+
+			definition, err := readWorkflowDefinition(fileValue)
+			if err != nil {
+				return fmt.Errorf("xcode-cloud workflows import: %w", err)
+			}
+			if repositoryValue := strings.TrimSpace(*repository); repositoryValue != "" {
+				definition.Repository = repositoryValue
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("xcode-cloud workflows import: %w", err)
+			}
+
+			requestCtx, cancel := contextWithXcodeCloudTimeout(ctx, 0)
+			defer cancel()
+
+			if productValue == "" {
+				product, err := client.ResolveCiProductForApp(requestCtx, appValue)
+				if err != nil {
+					return fmt.Errorf("xcode-cloud workflows import: %w", err)
+				}
+				productValue = product.ID
+			}
+
+			result, err := importWorkflowDefinition(requestCtx, client, productValue, definition, *dryRun)
+			if err != nil {
+				return fmt.Errorf("xcode-cloud workflows import: %w", err)
+			}
+
+			return printOutput(result, *output, *pretty)
+		},
+	}
+}
+
+func buildWorkflowDefinition(workflow *asc.CiWorkflowDefinition) *WorkflowDefinition {
+	definition := &WorkflowDefinition{
+		Attributes: make(map[string]interface{}, len(workflow.Attributes)),
+	}
+	for key, value := range workflow.Attributes {
+		definition.Attributes[key] = value
+	}
+	if name, ok := workflow.Attributes["name"].(string); ok {
+		definition.Name = name
+	}
+	for _, key := range workflowReadOnlyAttributes {
+		delete(definition.Attributes, key)
+	}
+
+	if workflow.Repository != nil {
+		definition.Repository = repositoryReference(workflow.Repository.Attributes)
+	}
+	if workflow.XcodeVersion != nil {
+		definition.XcodeVersion = firstNonEmpty(workflow.XcodeVersion.Attributes.Name, workflow.XcodeVersion.Attributes.Version)
+	}
+	if workflow.MacOsVersion != nil {
+		definition.MacOsVersion = firstNonEmpty(workflow.MacOsVersion.Attributes.Name, workflow.MacOsVersion.Attributes.Version)
+	}
+	return definition
+}
+
+func readWorkflowDefinition(path string) (*WorkflowDefinition, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return nil, fmt.Errorf("definition path must be a file")
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var definition WorkflowDefinition
+	if err := yaml.Unmarshal(data, &definition); err != nil {
+		return nil, fmt.Errorf("invalid YAML: %w", err)
+	}
+	definition.Name = strings.TrimSpace(definition.Name)
+	if definition.Name == "" {
+		return nil, fmt.Errorf("definition is missing name")
+	}
+	if strings.TrimSpace(definition.XcodeVersion) == "" {
+		return nil, fmt.Errorf("definition is missing xcodeVersion")
+	}
+	if strings.TrimSpace(definition.MacOsVersion) == "" {
+		return nil, fmt.Errorf("definition is missing macOsVersion")
+	}
+	if definition.Attributes == nil {
+		definition.Attributes = map[string]interface{}{}
+	}
+	for _, key := range workflowReadOnlyAttributes {
+		delete(definition.Attributes, key)
+	}
+	return &definition, nil
+}
+
+func importWorkflowDefinition(ctx context.Context, client *asc.Client, productID string, definition *WorkflowDefinition, dryRun bool) (*asc.CiWorkflowImportResult, error) {
+	existing, err := findWorkflowByName(ctx, client, productID, definition.Name)
+	if err != nil {
+		return nil, err
+	}
+	xcodeVersionID, err := resolveXcodeVersionID(ctx, client, definition.XcodeVersion)
+	if err != nil {
+		return nil, err
+	}
+	macOsVersionID, err := resolveMacOsVersionID(ctx, client, definition.MacOsVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &asc.CiWorkflowImportResult{
+		Name:           definition.Name,
+		ProductID:      productID,
+		XcodeVersionID: xcodeVersionID,
+		MacOsVersionID: macOsVersionID,
+		DryRun:         dryRun,
+	}
+
+	attributes := make(map[string]interface{}, len(definition.Attributes)+1)
+	for key, value := range definition.Attributes {
+		attributes[key] = value
+	}
+	attributes["name"] = definition.Name
+	relationships := map[string]interface{}{
+		"xcodeVersion": relationshipData(asc.ResourceTypeCiXcodeVersions, xcodeVersionID),
+		"macOsVersion": relationshipData(asc.ResourceTypeCiMacOsVersions, macOsVersionID),
+	}
+
+	if existing != nil {
+		result.Action = workflowImportActionUpdate
+		result.WorkflowID = existing.ID
+		if dryRun {
+			return result, nil
+		}
+		payload, err := json.Marshal(map[string]interface{}{
+			"data": map[string]interface{}{
+				"type":          asc.ResourceTypeCiWorkflows,
+				"id":            existing.ID,
+				"attributes":    attributes,
+				"relationships": relationships,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if _, err := client.UpdateCiWorkflow(ctx, existing.ID, payload); err != nil {
+			return nil, fmt.Errorf("failed to update workflow %q: %w", definition.Name, err)
+		}
+		return result, nil
+	}
+
+	repositoryID, err := resolveWorkflowRepositoryID(ctx, client, productID, definition.Repository)
+	if err != nil {
+		return nil, err
+	}
+	result.Action = workflowImportActionCreate
+	result.RepositoryID = repositoryID
+	if dryRun {
+		return result, nil
+	}
+
+	relationships["product"] = relationshipData(asc.ResourceTypeCiProducts, productID)
+	relationships["repository"] = relationshipData(asc.ResourceTypeScmRepositories, repositoryID)
+	payload, err := json.Marshal(map[string]interface{}{
+		"data": map[string]interface{}{
+			"type":          asc.ResourceTypeCiWorkflows,
+			"attributes":    attributes,
+			"relationships": relationships,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.CreateCiWorkflow(ctx, payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create workflow %q: %w", definition.Name, err)
+	}
+	result.WorkflowID = resp.Data.ID
+	return result, nil
+}
+
+func relationshipData(resourceType asc.ResourceType, id string) asc.Relationship {
+	return asc.Relationship{Data: asc.ResourceData{Type: resourceType, ID: id}}
+}
+
+func findWorkflowByName(ctx context.Context, client *asc.Client, productID, name string) (*asc.CiWorkflowResource, error) {
+	firstPage, err := client.GetCiWorkflows(ctx, productID, asc.WithCiWorkflowsLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch workflows: %w", err)
+	}
+	all, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetCiWorkflows(ctx, productID, asc.WithCiWorkflowsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch workflows: %w", err)
+	}
+	workflows, ok := all.(*asc.CiWorkflowsResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected workflows response type %T", all)
+	}
+
+	var match *asc.CiWorkflowResource
+	for i := range workflows.Data {
+		if !strings.EqualFold(strings.TrimSpace(workflows.Data[i].Attributes.Name), name) {
+			continue
+		}
+		if match != nil {
+			return nil, fmt.Errorf("multiple workflows named %q in product %s", name, productID)
+		}
+		match = &workflows.Data[i]
+	}
+	return match, nil
+}
+
+func resolveXcodeVersionID(ctx context.Context, client *asc.Client, value string) (string, error) {
+	firstPage, err := client.GetCiXcodeVersions(ctx, asc.WithCiXcodeVersionsLimit(200))
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch Xcode versions: %w", err)
+	}
+	all, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetCiXcodeVersions(ctx, asc.WithCiXcodeVersionsNextURL(nextURL))
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch Xcode versions: %w", err)
+	}
+	versions, ok := all.(*asc.CiXcodeVersionsResponse)
+	if !ok {
+		return "", fmt.Errorf("unexpected Xcode versions response type %T", all)
+	}
+
+	value = strings.TrimSpace(value)
+	for _, version := range versions.Data {
+		if strings.EqualFold(version.Attributes.Name, value) || version.Attributes.Version == value {
+			return version.ID, nil
+		}
+	}
+	return "", fmt.Errorf("Xcode version %q not found", value)
+}
+
+func resolveMacOsVersionID(ctx context.Context, client *asc.Client, value string) (string, error) {
+	firstPage, err := client.GetCiMacOsVersions(ctx, asc.WithCiMacOsVersionsLimit(200))
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch macOS versions: %w", err)
+	}
+	all, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetCiMacOsVersions(ctx, asc.WithCiMacOsVersionsNextURL(nextURL))
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch macOS versions: %w", err)
+	}
+	versions, ok := all.(*asc.CiMacOsVersionsResponse)
+	if !ok {
+		return "", fmt.Errorf("unexpected macOS versions response type %T", all)
+	}
+
+	value = strings.TrimSpace(value)
+	for _, version := range versions.Data {
+		if strings.EqualFold(version.Attributes.Name, value) || version.Attributes.Version == value {
+			return version.ID, nil
+		}
+	}
+	return "", fmt.Errorf("macOS version %q not found", value)
+}
+
+func resolveWorkflowRepositoryID(ctx context.Context, client *asc.Client, productID, value string) (string, error) {
+	primary, err := client.GetCiProductPrimaryRepositories(ctx, productID, asc.WithCiProductRepositoriesLimit(200))
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch primary repositories: %w", err)
+	}
+	value = strings.TrimSpace(value)
+	if value == "" {
+		if len(primary.Data) == 1 {
+			return primary.Data[0].ID, nil
+		}
+		return "", fmt.Errorf("definition has no repository and product %s has %d primary repositories; use --repository", productID, len(primary.Data))
+	}
+
+	additional, err := client.GetCiProductAdditionalRepositories(ctx, productID, asc.WithCiProductRepositoriesLimit(200))
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch additional repositories: %w", err)
+	}
+
+	repositories := append(primary.Data, additional.Data...)
+	available := make([]string, 0, len(repositories))
+	for _, repository := range repositories {
+		if repositoryMatches(repository.Attributes, value) {
+			return repository.ID, nil
+		}
+		available = append(available, repositoryReference(repository.Attributes))
+	}
+	sort.Strings(available)
+	return "", fmt.Errorf("repository %q not found in product %s (available: %s)", value, productID, strings.Join(available, ", "))
+}
+
+func repositoryReference(attributes asc.ScmRepositoryAttributes) string {
+	if attributes.OwnerName != "" && attributes.RepositoryName != "" {
+		return attributes.OwnerName + "/" + attributes.RepositoryName
+	}
+	return firstNonEmpty(attributes.RepositoryName, attributes.HTTPCloneURL, attributes.SSHCloneURL)
+}
+
+func repositoryMatches(attributes asc.ScmRepositoryAttributes, value string) bool {
+	if strings.EqualFold(repositoryReference(attributes), value) {
+		return true
+	}
+	for _, candidate := range []string{attributes.RepositoryName, attributes.HTTPCloneURL, attributes.SSHCloneURL} {
+		if candidate != "" && strings.EqualFold(strings.TrimSuffix(candidate, ".git"), strings.TrimSuffix(value, ".git")) {
+			return true
+		}
+	}
+	return false
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if strings.TrimSpace(value) != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -39,6 +39,8 @@ Examples:
   asc xcode-cloud workflows list --app "APP_ID"
   asc xcode-cloud workflows get --id "WORKFLOW_ID"
   asc xcode-cloud workflows repository --id "WORKFLOW_ID"
+  asc xcode-cloud workflows export --id "WORKFLOW_ID" > workflow.yaml
+  asc xcode-cloud workflows import --product-id "PRODUCT_ID" --file workflow.yaml
   asc xcode-cloud workflows --app "APP_ID" --limit 50
   asc xcode-cloud workflows --app "APP_ID" --paginate`,
 		FlagSet:   fs,
@@ -50,6 +52,8 @@ Examples:
 			XcodeCloudWorkflowsCreateCommand(),
 			XcodeCloudWorkflowsUpdateCommand(),
 			XcodeCloudWorkflowsDeleteCommand(),
+			XcodeCloudWorkflowsExportCommand(),
+			XcodeCloudWorkflowsImportCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return xcodeCloudWorkflowsList(ctx, *appID, *limit, *next, *paginate, *output, *pretty)
@@ -135,6 +135,9 @@ type Config struct {
 	BaseDelay            string        `json:"base_delay"`
 	MaxDelay             string        `json:"max_delay"`
 	RetryLog             string        `json:"retry_log"`
+
+	BaseURL              string `json:"base_url,omitempty"`
+	AllowInsecureBaseURL string `json:"allow_insecure_base_url,omitempty"`
 }
 
 // ErrNotFound is returned when the config file doesn't exist
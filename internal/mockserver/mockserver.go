@@ -0,0 +1,695 @@
+// Package mockserver implements an in-memory JSON:API fake of the App Store
+// Connect API for local and integration testing.
+//
+// It supports the resources most CLI workflows touch (apps, builds,
+// betaGroups, betaTesters, appStoreVersions, and localizations) with generic
+// list/get/create/update/delete handlers, related-resource lookups, and
+// to-many relationship edits.
+package mockserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	defaultPageLimit = 50
+	maxPageLimit     = 200
+)
+
+// SupportedTypes lists the resource types served by the mock server.
+var SupportedTypes = []string{
+	"apps",
+	"builds",
+	"betaGroups",
+	"betaTesters",
+	"appStoreVersions",
+	"appStoreVersionLocalizations",
+	"appInfos",
+	"appInfoLocalizations",
+	"betaBuildLocalizations",
+}
+
+// Ref identifies a resource.
+type Ref struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// Linkage is a JSON:API relationship linkage (to-one or to-many).
+type Linkage struct {
+	One  *Ref
+	Many []Ref
+	List bool
+}
+
+// MarshalJSON encodes the linkage as {"data": ...}.
+func (l Linkage) MarshalJSON() ([]byte, error) {
+	if l.List {
+		many := l.Many
+		if many == nil {
+			many = []Ref{}
+		}
+		return json.Marshal(struct {
+			Data []Ref `json:"data"`
+		}{Data: many})
+	}
+	return json.Marshal(struct {
+		Data *Ref `json:"data"`
+	}{Data: l.One})
+}
+
+// UnmarshalJSON decodes {"data": {...}} or {"data": [...]}.
+func (l *Linkage) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	trimmed := strings.TrimSpace(string(raw.Data))
+	switch {
+	case trimmed == "" || trimmed == "null":
+		*l = Linkage{}
+	case strings.HasPrefix(trimmed, "["):
+		var many []Ref
+		if err := json.Unmarshal(raw.Data, &many); err != nil {
+			return err
+		}
+		*l = Linkage{Many: many, List: true}
+	default:
+		var one Ref
+		if err := json.Unmarshal(raw.Data, &one); err != nil {
+			return err
+		}
+		*l = Linkage{One: &one}
+	}
+	return nil
+}
+
+func (l Linkage) refs() []Ref {
+	if l.List {
+		return l.Many
+	}
+	if l.One != nil {
+		return []Ref{*l.One}
+	}
+	return nil
+}
+
+// Resource is a stored JSON:API resource.
+type Resource struct {
+	Type          string             `json:"type"`
+	ID            string             `json:"id"`
+	Attributes    map[string]any     `json:"attributes,omitempty"`
+	Relationships map[string]Linkage `json:"relationships,omitempty"`
+}
+
+func (r *Resource) clone() *Resource {
+	copied := &Resource{Type: r.Type, ID: r.ID}
+	if r.Attributes != nil {
+		copied.Attributes = make(map[string]any, len(r.Attributes))
+		for key, value := range r.Attributes {
+			copied.Attributes[key] = value
+		}
+	}
+	if r.Relationships != nil {
+		copied.Relationships = make(map[string]Linkage, len(r.Relationships))
+		for key, value := range r.Relationships {
+			copied.Relationships[key] = Linkage{One: value.One, Many: append([]Ref(nil), value.Many...), List: value.List}
+		}
+	}
+	return copied
+}
+
+// Server is an in-memory App Store Connect API fake. It is safe for concurrent use.
+type Server struct {
+	mu        sync.Mutex
+	resources map[string]map[string]*Resource
+	order     map[string][]string
+	nextID    int
+	supported map[string]bool
+}
+
+// New creates a server preloaded with the given resources.
+func New(seed []Resource) (*Server, error) {
+	s := &Server{
+		resources: make(map[string]map[string]*Resource),
+		order:     make(map[string][]string),
+		supported: make(map[string]bool, len(SupportedTypes)),
+	}
+	for _, resourceType := range SupportedTypes {
+		s.supported[resourceType] = true
+	}
+	for i := range seed {
+		item := seed[i]
+		if !s.supported[item.Type] {
+			return nil, fmt.Errorf("seed resource %d: unsupported type %q", i, item.Type)
+		}
+		if strings.TrimSpace(item.ID) == "" {
+			return nil, fmt.Errorf("seed resource %d: id is required", i)
+		}
+		if _, exists := s.resources[item.Type][item.ID]; exists {
+			return nil, fmt.Errorf("seed resource %d: duplicate %s %q", i, item.Type, item.ID)
+		}
+		s.store(item.clone())
+	}
+	return s, nil
+}
+
+// DefaultSeed returns a small, linked set of demo resources.
+func DefaultSeed() []Resource {
+	app := Ref{Type: "apps", ID: "app-1"}
+	version := Ref{Type: "appStoreVersions", ID: "version-1"}
+	group := Ref{Type: "betaGroups", ID: "group-1"}
+	return []Resource{
+		{
+			Type:       "apps",
+			ID:         "app-1",
+			Attributes: map[string]any{"name": "Demo App", "bundleId": "com.example.demo", "sku": "DEMO1", "primaryLocale": "en-US"},
+		},
+		{
+			Type:          "builds",
+			ID:            "build-1",
+			Attributes:    map[string]any{"version": "1", "uploadedDate": "2026-01-01T00:00:00Z", "processingState": "VALID", "expired": false},
+			Relationships: map[string]Linkage{"app": {One: &app}},
+		},
+		{
+			Type:          "betaGroups",
+			ID:            "group-1",
+			Attributes:    map[string]any{"name": "Internal", "isInternalGroup": true},
+			Relationships: map[string]Linkage{"app": {One: &app}},
+		},
+		{
+			Type:       "betaTesters",
+			ID:         "tester-1",
+			Attributes: map[string]any{"email": "tester@example.com", "firstName": "Test", "lastName": "User", "inviteType": "EMAIL"},
+			Relationships: map[string]Linkage{
+				"apps":       {Many: []Ref{app}, List: true},
+				"betaGroups": {Many: []Ref{group}, List: true},
+			},
+		},
+		{
+			Type:          "appStoreVersions",
+			ID:            "version-1",
+			Attributes:    map[string]any{"versionString": "1.0", "platform": "IOS", "appStoreState": "PREPARE_FOR_SUBMISSION"},
+			Relationships: map[string]Linkage{"app": {One: &app}},
+		},
+		{
+			Type:          "appStoreVersionLocalizations",
+			ID:            "localization-1",
+			Attributes:    map[string]any{"locale": "en-US", "description": "A demo app."},
+			Relationships: map[string]Linkage{"appStoreVersion": {One: &version}},
+		},
+	}
+}
+
+func (s *Server) store(resource *Resource) {
+	if s.resources[resource.Type] == nil {
+		s.resources[resource.Type] = make(map[string]*Resource)
+	}
+	if _, exists := s.resources[resource.Type][resource.ID]; !exists {
+		s.order[resource.Type] = append(s.order[resource.Type], resource.ID)
+	}
+	s.resources[resource.Type][resource.ID] = resource
+}
+
+func (s *Server) remove(resourceType, id string) {
+	delete(s.resources[resourceType], id)
+	ids := s.order[resourceType]
+	for i, existing := range ids {
+		if existing == id {
+			s.order[resourceType] = append(ids[:i:i], ids[i+1:]...)
+			break
+		}
+	}
+}
+
+func (s *Server) lookup(resourceType, id string) *Resource {
+	return s.resources[resourceType][id]
+}
+
+func (s *Server) list(resourceType string) []*Resource {
+	items := make([]*Resource, 0, len(s.order[resourceType]))
+	for _, id := range s.order[resourceType] {
+		items = append(items, s.resources[resourceType][id])
+	}
+	return items
+}
+
+// ServeHTTP routes JSON:API requests.
+//
+//	GET    /v1/{type}                              list (filter[...], limit, cursor)
+//	POST   /v1/{type}                              create
+//	GET    /v1/{type}/{id}                         get
+//	PATCH  /v1/{type}/{id}                         update
+//	DELETE /v1/{type}/{id}                         delete
+//	GET    /v1/{type}/{id}/{related}               related resource(s)
+//	GET    /v1/{type}/{id}/relationships/{name}    linkage
+//	POST   /v1/{type}/{id}/relationships/{name}    add to-many linkage
+//	DELETE /v1/{type}/{id}/relationships/{name}    remove to-many linkage
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ") {
+		writeError(w, http.StatusUnauthorized, "NOT_AUTHORIZED", "Authentication credentials are missing or invalid.")
+		return
+	}
+
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(segments) < 2 || segments[0] != "v1" {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("The path %q is not supported.", r.URL.Path))
+		return
+	}
+	resourceType := segments[1]
+	if !s.supported[resourceType] {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("The resource type %q is not supported.", resourceType))
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch {
+	case len(segments) == 2 && r.Method == http.MethodGet:
+		s.handleList(w, r, s.list(resourceType))
+	case len(segments) == 2 && r.Method == http.MethodPost:
+		s.handleCreate(w, r, resourceType)
+	case len(segments) == 3 && r.Method == http.MethodGet:
+		s.handleGet(w, resourceType, segments[2])
+	case len(segments) == 3 && r.Method == http.MethodPatch:
+		s.handleUpdate(w, r, resourceType, segments[2])
+	case len(segments) == 3 && r.Method == http.MethodDelete:
+		s.handleDelete(w, resourceType, segments[2])
+	case len(segments) == 4 && r.Method == http.MethodGet:
+		s.handleRelated(w, r, resourceType, segments[2], segments[3])
+	case len(segments) == 5 && segments[3] == "relationships":
+		s.handleRelationship(w, r, resourceType, segments[2], segments[4])
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", fmt.Sprintf("%s %s is not supported.", r.Method, r.URL.Path))
+	}
+}
+
+func (s *Server) handleList(w http.ResponseWriter, r *http.Request, items []*Resource) {
+	query := r.URL.Query()
+	filtered := make([]*Resource, 0, len(items))
+	for _, item := range items {
+		if matchesFilters(item, query) {
+			filtered = append(filtered, item)
+		}
+	}
+
+	limit := defaultPageLimit
+	if raw := query.Get("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 || parsed > maxPageLimit {
+			writeError(w, http.StatusBadRequest, "PARAMETER_ERROR.INVALID", fmt.Sprintf("limit must be between 1 and %d.", maxPageLimit))
+			return
+		}
+		limit = parsed
+	}
+	offset := 0
+	if raw := query.Get("cursor"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 0 {
+			writeError(w, http.StatusBadRequest, "PARAMETER_ERROR.INVALID", "cursor is invalid.")
+			return
+		}
+		offset = parsed
+	}
+	if offset > len(filtered) {
+		offset = len(filtered)
+	}
+	end := offset + limit
+	if end > len(filtered) {
+		end = len(filtered)
+	}
+
+	links := map[string]string{"self": requestURL(r, query)}
+	if end < len(filtered) {
+		next := cloneValues(query)
+		next.Set("cursor", strconv.Itoa(end))
+		links["next"] = requestURL(r, next)
+	}
+
+	data := make([]*Resource, 0, end-offset)
+	data = append(data, filtered[offset:end]...)
+	writeJSON(w, http.StatusOK, map[string]any{
+		"data":  data,
+		"links": links,
+		"meta":  map[string]any{"paging": map[string]int{"total": len(filtered), "limit": limit}},
+	})
+}
+
+func (s *Server) handleGet(w http.ResponseWriter, resourceType, id string) {
+	item := s.lookup(resourceType, id)
+	if item == nil {
+		writeNotFound(w, resourceType, id)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"data": item})
+}
+
+type resourceDocument struct {
+	Data Resource `json:"data"`
+}
+
+func (s *Server) handleCreate(w http.ResponseWriter, r *http.Request, resourceType string) {
+	var doc resourceDocument
+	if !decodeBody(w, r, &doc) {
+		return
+	}
+	if doc.Data.Type != resourceType {
+		writeError(w, http.StatusConflict, "ENTITY_ERROR.TYPE_MISMATCH", fmt.Sprintf("Expected type %q, got %q.", resourceType, doc.Data.Type))
+		return
+	}
+	if err := s.validateRelationships(doc.Data.Relationships); err != nil {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", err.Error())
+		return
+	}
+
+	s.nextID++
+	created := doc.Data.clone()
+	created.ID = fmt.Sprintf("%s-%d", singular(resourceType), 1000+s.nextID)
+	if created.Attributes == nil {
+		created.Attributes = map[string]any{}
+	}
+	s.store(created)
+	writeJSON(w, http.StatusCreated, map[string]any{"data": created})
+}
+
+func (s *Server) handleUpdate(w http.ResponseWriter, r *http.Request, resourceType, id string) {
+	item := s.lookup(resourceType, id)
+	if item == nil {
+		writeNotFound(w, resourceType, id)
+		return
+	}
+	var doc resourceDocument
+	if !decodeBody(w, r, &doc) {
+		return
+	}
+	if doc.Data.Type != resourceType || doc.Data.ID != id {
+		writeError(w, http.StatusConflict, "ENTITY_ERROR.ID_MISMATCH", "The resource type or ID in the body does not match the URL.")
+		return
+	}
+	if err := s.validateRelationships(doc.Data.Relationships); err != nil {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", err.Error())
+		return
+	}
+
+	updated := item.clone()
+	if updated.Attributes == nil {
+		updated.Attributes = map[string]any{}
+	}
+	for key, value := range doc.Data.Attributes {
+		updated.Attributes[key] = value
+	}
+	if len(doc.Data.Relationships) > 0 && updated.Relationships == nil {
+		updated.Relationships = map[string]Linkage{}
+	}
+	for key, value := range doc.Data.Relationships {
+		updated.Relationships[key] = value
+	}
+	s.store(updated)
+	writeJSON(w, http.StatusOK, map[string]any{"data": updated})
+}
+
+func (s *Server) handleDelete(w http.ResponseWriter, resourceType, id string) {
+	if s.lookup(resourceType, id) == nil {
+		writeNotFound(w, resourceType, id)
+		return
+	}
+	s.remove(resourceType, id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleRelated serves /v1/{type}/{id}/{related}. When the resource has a
+// relationship with that name the linked resources are returned; otherwise
+// {related} is treated as a child type and resources linking back are listed.
+func (s *Server) handleRelated(w http.ResponseWriter, r *http.Request, resourceType, id, related string) {
+	item := s.lookup(resourceType, id)
+	if item == nil {
+		writeNotFound(w, resourceType, id)
+		return
+	}
+
+	if linkage, ok := item.Relationships[related]; ok {
+		if !linkage.List {
+			if linkage.One == nil {
+				writeJSON(w, http.StatusOK, map[string]any{"data": nil})
+				return
+			}
+			s.handleGet(w, linkage.One.Type, linkage.One.ID)
+			return
+		}
+		items := make([]*Resource, 0, len(linkage.Many))
+		for _, ref := range linkage.Many {
+			if linked := s.lookup(ref.Type, ref.ID); linked != nil {
+				items = append(items, linked)
+			}
+		}
+		s.handleList(w, r, items)
+		return
+	}
+
+	if !s.supported[related] {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("The relationship %q is not supported.", related))
+		return
+	}
+	s.handleList(w, r, s.children(related, Ref{Type: resourceType, ID: id}))
+}
+
+func (s *Server) children(childType string, parent Ref) []*Resource {
+	items := make([]*Resource, 0)
+	for _, child := range s.list(childType) {
+		if linksTo(child, parent) {
+			items = append(items, child)
+		}
+	}
+	return items
+}
+
+func linksTo(item *Resource, target Ref) bool {
+	for _, linkage := range item.Relationships {
+		for _, ref := range linkage.refs() {
+			if ref == target {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+type linkageDocument struct {
+	Data []Ref `json:"data"`
+}
+
+// handleRelationship manages /v1/{type}/{id}/relationships/{name}. To-many
+// edits are stored on the linked resources (for example, adding testers to a
+// group records the group in each tester's betaGroups relationship).
+func (s *Server) handleRelationship(w http.ResponseWriter, r *http.Request, resourceType, id, name string) {
+	item := s.lookup(resourceType, id)
+	if item == nil {
+		writeNotFound(w, resourceType, id)
+		return
+	}
+	parent := Ref{Type: resourceType, ID: id}
+
+	if r.Method == http.MethodGet {
+		if linkage, ok := item.Relationships[name]; ok {
+			writeJSON(w, http.StatusOK, linkage)
+			return
+		}
+		refs := make([]Ref, 0)
+		if s.supported[name] {
+			for _, child := range s.children(name, parent) {
+				refs = append(refs, Ref{Type: child.Type, ID: child.ID})
+			}
+		}
+		writeJSON(w, http.StatusOK, Linkage{Many: refs, List: true})
+		return
+	}
+
+	if r.Method != http.MethodPost && r.Method != http.MethodDelete {
+		writeError(w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", fmt.Sprintf("%s %s is not supported.", r.Method, r.URL.Path))
+		return
+	}
+
+	var doc linkageDocument
+	if !decodeBody(w, r, &doc) {
+		return
+	}
+	for _, ref := range doc.Data {
+		target := s.lookup(ref.Type, ref.ID)
+		if target == nil {
+			writeNotFound(w, ref.Type, ref.ID)
+			return
+		}
+	}
+	for _, ref := range doc.Data {
+		target := s.lookup(ref.Type, ref.ID).clone()
+		if target.Relationships == nil {
+			target.Relationships = map[string]Linkage{}
+		}
+		linkage := target.Relationships[resourceType]
+		linkage.List = true
+		if r.Method == http.MethodPost {
+			if !containsRef(linkage.Many, parent) {
+				linkage.Many = append(linkage.Many, parent)
+			}
+		} else {
+			linkage.Many = removeRef(linkage.Many, parent)
+		}
+		target.Relationships[resourceType] = linkage
+		s.store(target)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) validateRelationships(relationships map[string]Linkage) error {
+	for name, linkage := range relationships {
+		for _, ref := range linkage.refs() {
+			if !s.supported[ref.Type] {
+				continue
+			}
+			if s.lookup(ref.Type, ref.ID) == nil {
+				return fmt.Errorf("relationship %q references missing %s %q", name, ref.Type, ref.ID)
+			}
+		}
+	}
+	return nil
+}
+
+// matchesFilters applies filter[name]=a,b against attributes and
+// relationships. Relationship filters match on linked IDs, and plural
+// names (filter[apps]) also match singular relationships (app).
+func matchesFilters(item *Resource, query url.Values) bool {
+	for key, values := range query {
+		if !strings.HasPrefix(key, "filter[") || !strings.HasSuffix(key, "]") {
+			continue
+		}
+		name := strings.TrimSuffix(strings.TrimPrefix(key, "filter["), "]")
+		allowed := make(map[string]bool)
+		for _, value := range values {
+			for _, part := range strings.Split(value, ",") {
+				if part = strings.TrimSpace(part); part != "" {
+					allowed[part] = true
+				}
+			}
+		}
+		if len(allowed) == 0 {
+			continue
+		}
+		if name == "id" {
+			if !allowed[item.ID] {
+				return false
+			}
+			continue
+		}
+		if value, ok := item.Attributes[name]; ok {
+			if !allowed[fmt.Sprint(value)] {
+				return false
+			}
+			continue
+		}
+		linkage, ok := item.Relationships[name]
+		if !ok {
+			linkage, ok = item.Relationships[strings.TrimSuffix(name, "s")]
+		}
+		if !ok {
+			return false
+		}
+		matched := false
+		for _, ref := range linkage.refs() {
+			if allowed[ref.ID] {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+func containsRef(refs []Ref, target Ref) bool {
+	for _, ref := range refs {
+		if ref == target {
+			return true
+		}
+	}
+	return false
+}
+
+func removeRef(refs []Ref, target Ref) []Ref {
+	filtered := make([]Ref, 0, len(refs))
+	for _, ref := range refs {
+		if ref != target {
+			filtered = append(filtered, ref)
+		}
+	}
+	return filtered
+}
+
+func singular(resourceType string) string {
+	return strings.TrimSuffix(resourceType, "s")
+}
+
+func cloneValues(values url.Values) url.Values {
+	cloned := make(url.Values, len(values))
+	for key, items := range values {
+		cloned[key] = append([]string(nil), items...)
+	}
+	return cloned
+}
+
+func requestURL(r *http.Request, query url.Values) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	u := url.URL{Scheme: scheme, Host: r.Host, Path: r.URL.Path}
+	if len(query) > 0 {
+		u.RawQuery = query.Encode()
+	}
+	return u.String()
+}
+
+func decodeBody(w http.ResponseWriter, r *http.Request, target any) bool {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "PARAMETER_ERROR.INVALID", "Unable to read request body.")
+		return false
+	}
+	if err := json.Unmarshal(data, target); err != nil {
+		writeError(w, http.StatusBadRequest, "PARAMETER_ERROR.INVALID", fmt.Sprintf("Invalid JSON body: %v", err))
+		return false
+	}
+	return true
+}
+
+func writeNotFound(w http.ResponseWriter, resourceType, id string) {
+	writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("There is no resource of type '%s' with id '%s'", resourceType, id))
+}
+
+func writeError(w http.ResponseWriter, status int, code, detail string) {
+	writeJSON(w, status, map[string]any{
+		"errors": []map[string]string{{
+			"status": strconv.Itoa(status),
+			"code":   code,
+			"title":  http.StatusText(status),
+			"detail": detail,
+		}},
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
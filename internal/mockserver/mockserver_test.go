@@ -0,0 +1,210 @@
+package mockserver
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type listDocument struct {
+	Data  []Resource `json:"data"`
+	Links struct {
+		Next string `json:"next"`
+	} `json:"links"`
+}
+
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	handler, err := New(DefaultSeed())
+	if err != nil {
+		t.Fatalf("New() error: %v", err)
+	}
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func doRequest(t *testing.T, method, rawURL, body string) (int, []byte) {
+	t.Helper()
+	var reader io.Reader
+	if body != "" {
+		reader = strings.NewReader(body)
+	}
+	req, err := http.NewRequest(method, rawURL, reader)
+	if err != nil {
+		t.Fatalf("NewRequest() error: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer test")
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Do() error: %v", err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("ReadAll() error: %v", err)
+	}
+	return resp.StatusCode, data
+}
+
+func decodeList(t *testing.T, data []byte) listDocument {
+	t.Helper()
+	var doc listDocument
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("decode list: %v (%s)", err, data)
+	}
+	return doc
+}
+
+func TestServer_RequiresBearerToken(t *testing.T) {
+	server := newTestServer(t)
+
+	resp, err := http.Get(server.URL + "/v1/apps")
+	if err != nil {
+		t.Fatalf("Get() error: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected 401, got %d", resp.StatusCode)
+	}
+}
+
+func TestServer_ListFilterAndPaginate(t *testing.T) {
+	server := newTestServer(t)
+
+	for _, name := range []string{"Second", "Third"} {
+		body := `{"data":{"type":"apps","attributes":{"name":"` + name + `","bundleId":"com.example.` + strings.ToLower(name) + `"}}}`
+		if status, data := doRequest(t, http.MethodPost, server.URL+"/v1/apps", body); status != http.StatusCreated {
+			t.Fatalf("expected 201, got %d (%s)", status, data)
+		}
+	}
+
+	status, data := doRequest(t, http.MethodGet, server.URL+"/v1/apps?limit=2", "")
+	if status != http.StatusOK {
+		t.Fatalf("expected 200, got %d", status)
+	}
+	page := decodeList(t, data)
+	if len(page.Data) != 2 || page.Links.Next == "" {
+		t.Fatalf("expected 2 items and a next link, got %d items next=%q", len(page.Data), page.Links.Next)
+	}
+	if !strings.HasPrefix(page.Links.Next, server.URL) {
+		t.Fatalf("expected absolute next link, got %q", page.Links.Next)
+	}
+
+	_, data = doRequest(t, http.MethodGet, page.Links.Next, "")
+	next := decodeList(t, data)
+	if len(next.Data) != 1 || next.Links.Next != "" {
+		t.Fatalf("expected final page with 1 item, got %d next=%q", len(next.Data), next.Links.Next)
+	}
+
+	_, data = doRequest(t, http.MethodGet, server.URL+"/v1/apps?filter[bundleId]=com.example.third", "")
+	filtered := decodeList(t, data)
+	if len(filtered.Data) != 1 || filtered.Data[0].Attributes["name"] != "Third" {
+		t.Fatalf("expected filtered app Third, got %+v", filtered.Data)
+	}
+}
+
+func TestServer_CRUD(t *testing.T) {
+	server := newTestServer(t)
+
+	body := `{"data":{"type":"betaGroups","attributes":{"name":"External"},"relationships":{"app":{"data":{"type":"apps","id":"app-1"}}}}}`
+	status, data := doRequest(t, http.MethodPost, server.URL+"/v1/betaGroups", body)
+	if status != http.StatusCreated {
+		t.Fatalf("expected 201, got %d (%s)", status, data)
+	}
+	var created struct {
+		Data Resource `json:"data"`
+	}
+	if err := json.Unmarshal(data, &created); err != nil {
+		t.Fatalf("decode created: %v", err)
+	}
+	if created.Data.ID == "" {
+		t.Fatal("expected created ID")
+	}
+	itemURL := server.URL + "/v1/betaGroups/" + created.Data.ID
+
+	patch := `{"data":{"type":"betaGroups","id":"` + created.Data.ID + `","attributes":{"name":"Renamed"}}}`
+	if status, data := doRequest(t, http.MethodPatch, itemURL, patch); status != http.StatusOK {
+		t.Fatalf("expected 200, got %d (%s)", status, data)
+	}
+	_, data = doRequest(t, http.MethodGet, itemURL, "")
+	if !strings.Contains(string(data), `"Renamed"`) {
+		t.Fatalf("expected renamed group, got %s", data)
+	}
+
+	_, data = doRequest(t, http.MethodGet, server.URL+"/v1/apps/app-1/betaGroups", "")
+	if groups := decodeList(t, data); len(groups.Data) != 2 {
+		t.Fatalf("expected 2 groups for app-1, got %d", len(groups.Data))
+	}
+
+	if status, _ := doRequest(t, http.MethodDelete, itemURL, ""); status != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d", status)
+	}
+	if status, _ := doRequest(t, http.MethodGet, itemURL, ""); status != http.StatusNotFound {
+		t.Fatalf("expected 404 after delete, got %d", status)
+	}
+}
+
+func TestServer_CreateRejectsMissingRelationship(t *testing.T) {
+	server := newTestServer(t)
+
+	body := `{"data":{"type":"builds","attributes":{"version":"2"},"relationships":{"app":{"data":{"type":"apps","id":"missing"}}}}}`
+	if status, _ := doRequest(t, http.MethodPost, server.URL+"/v1/builds", body); status == http.StatusCreated {
+		t.Fatal("expected create with missing relationship to fail")
+	}
+}
+
+func TestServer_RelationshipEdits(t *testing.T) {
+	server := newTestServer(t)
+	relURL := server.URL + "/v1/betaGroups/group-1/relationships/betaTesters"
+
+	body := `{"data":{"type":"betaTesters","attributes":{"email":"new@example.com"}}}`
+	status, data := doRequest(t, http.MethodPost, server.URL+"/v1/betaTesters", body)
+	if status != http.StatusCreated {
+		t.Fatalf("expected 201, got %d (%s)", status, data)
+	}
+	var created struct {
+		Data Resource `json:"data"`
+	}
+	if err := json.Unmarshal(data, &created); err != nil {
+		t.Fatalf("decode created: %v", err)
+	}
+
+	link := `{"data":[{"type":"betaTesters","id":"` + created.Data.ID + `"}]}`
+	if status, data := doRequest(t, http.MethodPost, relURL, link); status != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d (%s)", status, data)
+	}
+	_, data = doRequest(t, http.MethodGet, server.URL+"/v1/betaGroups/group-1/betaTesters", "")
+	if testers := decodeList(t, data); len(testers.Data) != 2 {
+		t.Fatalf("expected 2 testers after add, got %d", len(testers.Data))
+	}
+
+	if status, _ := doRequest(t, http.MethodDelete, relURL, link); status != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d", status)
+	}
+	_, data = doRequest(t, http.MethodGet, server.URL+"/v1/betaGroups/group-1/betaTesters", "")
+	if testers := decodeList(t, data); len(testers.Data) != 1 {
+		t.Fatalf("expected 1 tester after remove, got %d", len(testers.Data))
+	}
+
+	missing := `{"data":[{"type":"betaTesters","id":"nope"}]}`
+	if status, _ := doRequest(t, http.MethodPost, relURL, missing); status != http.StatusNotFound {
+		t.Fatalf("expected 404 for missing tester, got %d", status)
+	}
+}
+
+func TestServer_UnsupportedType(t *testing.T) {
+	server := newTestServer(t)
+
+	status, data := doRequest(t, http.MethodGet, server.URL+"/v1/unknownThings", "")
+	if status != http.StatusNotFound {
+		t.Fatalf("expected 404, got %d", status)
+	}
+	if !strings.Contains(string(data), `"errors"`) {
+		t.Fatalf("expected JSON:API errors body, got %s", data)
+	}
+}